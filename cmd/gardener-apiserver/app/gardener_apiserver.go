@@ -38,10 +38,14 @@ import (
 	plantvalidator "github.com/gardener/gardener/plugin/pkg/plant"
 
 	"github.com/gardener/gardener/plugin/pkg/global/deletionconfirmation"
+	"github.com/gardener/gardener/plugin/pkg/global/deletionprotection"
 	"github.com/gardener/gardener/plugin/pkg/global/resourcereferencemanager"
 	shootdns "github.com/gardener/gardener/plugin/pkg/shoot/dns"
+	shootmigration "github.com/gardener/gardener/plugin/pkg/shoot/migration"
 	clusteropenidconnectpreset "github.com/gardener/gardener/plugin/pkg/shoot/oidc/clusteropenidconnectpreset"
 	openidconnectpreset "github.com/gardener/gardener/plugin/pkg/shoot/oidc/openidconnectpreset"
+	shootpolicy "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+	shootpreset "github.com/gardener/gardener/plugin/pkg/shoot/preset"
 	shootquotavalidator "github.com/gardener/gardener/plugin/pkg/shoot/quotavalidator"
 	shootvalidator "github.com/gardener/gardener/plugin/pkg/shoot/validator"
 
@@ -139,6 +143,7 @@ func (o *Options) complete() error {
 	// Admission plugin registration
 	resourcereferencemanager.Register(o.Recommended.Admission.Plugins)
 	deletionconfirmation.Register(o.Recommended.Admission.Plugins)
+	deletionprotection.Register(o.Recommended.Admission.Plugins)
 	shootquotavalidator.Register(o.Recommended.Admission.Plugins)
 	shootdns.Register(o.Recommended.Admission.Plugins)
 	shootvalidator.Register(o.Recommended.Admission.Plugins)
@@ -146,15 +151,22 @@ func (o *Options) complete() error {
 	plantvalidator.Register(o.Recommended.Admission.Plugins)
 	openidconnectpreset.Register(o.Recommended.Admission.Plugins)
 	clusteropenidconnectpreset.Register(o.Recommended.Admission.Plugins)
+	shootmigration.Register(o.Recommended.Admission.Plugins)
+	shootpreset.Register(o.Recommended.Admission.Plugins)
+	shootpolicy.Register(o.Recommended.Admission.Plugins)
 
 	allOrderedPlugins := []string{
+		shootpreset.PluginName,
 		resourcereferencemanager.PluginName,
 		shootdns.PluginName,
 		shootquotavalidator.PluginName,
 		shootvalidator.PluginName,
+		shootpolicy.PluginName,
+		shootmigration.PluginName,
 		controllerregistrationresources.PluginName,
 		plantvalidator.PluginName,
 		deletionconfirmation.PluginName,
+		deletionprotection.PluginName,
 		openidconnectpreset.PluginName,
 		clusteropenidconnectpreset.PluginName,
 	}
@@ -236,7 +248,9 @@ func (o *Options) config() (*apiserver.Config, error) {
 
 	return &apiserver.Config{
 		GenericConfig: gardenerAPIServerConfig,
-		ExtraConfig:   apiserver.ExtraConfig{},
+		ExtraConfig: apiserver.ExtraConfig{
+			KubeClient: kubeClient,
+		},
 	}, nil
 }
 
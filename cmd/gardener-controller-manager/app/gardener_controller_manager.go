@@ -31,7 +31,9 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerconfigv1alpha1 "github.com/gardener/gardener/pkg/controllermanager/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller"
+	shootcontroller "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
 	"github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/controllermanager/server/handlers/webhooks"
 	"github.com/gardener/gardener/pkg/logger"
@@ -165,6 +167,10 @@ func (o *Options) run(ctx context.Context, cancel context.CancelFunc) error {
 		return err
 	}
 
+	if err := shootcontroller.NewDefaultMaintenanceTaskRegistry().Validate(o.config.Controllers.ShootMaintenance.Tasks); err != nil {
+		return err
+	}
+
 	gardener, err := NewGardener(o.config)
 	if err != nil {
 		return err
@@ -258,6 +264,11 @@ func NewGardener(cfg *config.ControllerManagerConfiguration) (*Gardener, error)
 		return nil, errors.New("config is required")
 	}
 
+	// validate the configuration
+	if err := validation.ValidateConfiguration(cfg); err != nil {
+		return nil, err
+	}
+
 	// Initialize logger
 	logger := logger.NewLogger(cfg.LogLevel)
 	logger.Info("Starting Gardener controller manager...")
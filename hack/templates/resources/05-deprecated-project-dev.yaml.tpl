@@ -74,3 +74,4 @@ spec:<% owner = value("spec.owner", {}); description = value("spec.description",
   # and `project.garden.sapcloud.io/name: <project-name>` (<project-name>=dev in this case).
   namespace: garden-dev
   % endif
+# shootDeletionProtection: true # protects new shoots of this project against deletion by default
@@ -0,0 +1,62 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")), Loader=yaml.Loader)
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+
+  annotations = value("metadata.annotations", {}); labels = value("metadata.labels", {})
+%># ClusterShootPreset contains default settings that are applied to Shoots cluster-wide at creation time.
+---
+apiVersion: settings.gardener.cloud/v1alpha1
+kind: ClusterShootPreset
+metadata:
+  name: ${value("metadata.name", "example-preset")}
+  % if annotations != {}:
+  annotations: ${yaml.dump(annotations, width=1000, default_flow_style=None)}
+  % endif
+  % if labels != {}:
+  labels: ${yaml.dump(labels, width=10000, default_flow_style=None)}
+  % endif
+spec:
+  shootSelector: # use {} to select all Shoots in a matched namespace
+    matchExpressions:
+    - {key: purpose, operator: In, values: [evaluation]}
+  projectSelector: # use {} to select all Projects
+    matchExpressions:
+    - {key: tier, operator: In, values: [internal]}
+  defaults: # settings which are already specified in the Shoot are not overwritten
+    addons:
+      kubernetes-dashboard:
+        enabled: true
+        authenticationMode: token
+    extensions: # merged with the extensions of the Shoot by their type
+    - type: shoot-cert-service
+    kubelet:
+      maxPods: 110
+  # maintenance:
+  #   timeWindow:
+  #     begin: 220000+0100
+  #     end: 230000+0100
+  # hibernation:
+  #   schedules:
+  #   - start: "00 17 * * 1,2,3,4,5"
+  #     location: Europe/Berlin
+  # auditConfig:
+  #   auditPolicy:
+  #     configMapRef:
+  #       name: audit-policy # must exist in the namespaces of the matching Shoots
+  weight: 10 # value from 1 to 100, presets with a higher weight take precedence
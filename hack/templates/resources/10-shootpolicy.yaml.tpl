@@ -0,0 +1,59 @@
+<%
+  import os, yaml
+
+  values={}
+  if context.get("values", "") != "":
+    values=yaml.load(open(context.get("values", "")), Loader=yaml.Loader)
+
+  def value(path, default):
+    keys=str.split(path, ".")
+    root=values
+    for key in keys:
+      if isinstance(root, dict):
+        if key in root:
+          root=root[key]
+        else:
+          return default
+      else:
+        return default
+    return root
+
+  annotations = value("metadata.annotations", {}); labels = value("metadata.labels", {})
+%># ShootPolicy contains rules which are evaluated against Shoots when they are created or updated.
+---
+apiVersion: settings.gardener.cloud/v1alpha1
+kind: ShootPolicy
+metadata:
+  name: ${value("metadata.name", "production")}
+  % if annotations != {}:
+  annotations: ${yaml.dump(annotations, width=1000, default_flow_style=None)}
+  % endif
+  % if labels != {}:
+  labels: ${yaml.dump(labels, width=10000, default_flow_style=None)}
+  % endif
+spec:
+  projectSelector: # use {} to select all Projects
+    matchLabels:
+      purpose: production
+  enforcementAction: Deny # Deny or Audit, violations of policies in Audit mode are only recorded in the audit log
+  rules: # paths refer to the garden.sapcloud.io/v1beta1 representation of the Shoot
+  - name: minimum-workers
+    path: spec.cloud.aws.workers[*].autoScalerMin
+    operator: GreaterThanOrEqual # In, NotIn, Matches, DoesNotMatch, GreaterThanOrEqual, LessThanOrEqual, Exists, DoesNotExist
+    values: ["3"]
+    message: production clusters must have at least 3 workers per pool
+  - name: no-privileged-containers
+    path: spec.kubernetes.allowPrivilegedContainers
+    operator: In
+    values: ["false"]
+  - name: machine-types
+    path: spec.cloud.aws.workers[*].machineType
+    operator: In
+    values: [m5.large, m5.xlarge]
+# - name: maintenance-window
+#   path: spec.maintenance.timeWindow
+#   operator: Exists
+# - name: cluster-names
+#   path: metadata.name
+#   operator: Matches # values are regular expressions
+#   values: ["^prod-"]
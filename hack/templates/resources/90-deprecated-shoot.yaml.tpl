@@ -644,6 +644,8 @@ spec:
   % else:
 # hibernation:
 #   enabled: false
+#   mode: Full                # allowed values: Full,WorkersOnly
+#   excludedWorkerPools: []   # only allowed for mode WorkersOnly, these worker pools keep running
 #   schedules:
 #   - start: "0 20 * * *" # Start hibernation every day at 8PM
 #     end: "0 6 * * *"    # Stop hibernation every day at 6AM
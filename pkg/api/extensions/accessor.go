@@ -193,6 +193,20 @@ func (u unstructuredStatusAccessor) GetLastError() extensionsv1alpha1.LastError
 	return unstructuredLastErrorAccessor{u.Unstructured}
 }
 
+// GetConditions implements Status.
+func (u unstructuredStatusAccessor) GetConditions() []gardencorev1alpha1.Condition {
+	status, ok, err := unstructured.NestedMap(u.UnstructuredContent(), "status")
+	if err != nil || !ok {
+		return nil
+	}
+
+	var defaultStatus extensionsv1alpha1.DefaultStatus
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(status, &defaultStatus); err != nil {
+		return nil
+	}
+	return defaultStatus.Conditions
+}
+
 // GetExtensionStatus implements Object.
 func (u unstructuredAccessor) GetExtensionStatus() extensionsv1alpha1.Status {
 	return unstructuredStatusAccessor{u.Unstructured}
@@ -151,6 +151,31 @@ var _ = Describe("Accessor", func() {
 					})
 				})
 			})
+
+			Describe("#GetConditions", func() {
+				It("should get the conditions", func() {
+					var (
+						conditions = []gardencorev1alpha1.Condition{
+							{
+								Type:           extensionsv1alpha1.ConditionTypeControlPlaneHealthy,
+								Status:         gardencorev1alpha1.ConditionTrue,
+								LastUpdateTime: metav1.NewTime(time.Unix(50, 0)),
+								Reason:         "reason",
+								Message:        "message",
+							},
+						}
+						acc = mkUnstructuredAccessorWithStatus(extensionsv1alpha1.DefaultStatus{Conditions: conditions})
+					)
+
+					Expect(acc.GetConditions()).To(Equal(conditions))
+				})
+
+				It("should return nil if no conditions are reported", func() {
+					acc := mkUnstructuredAccessorWithStatus(extensionsv1alpha1.DefaultStatus{})
+
+					Expect(acc.GetConditions()).To(BeNil())
+				})
+			})
 		})
 	})
 })
@@ -68,6 +68,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&garden.SeedList{},
 		&garden.Shoot{},
 		&garden.ShootList{},
+		&ShootBackupCatalog{},
+		&ShootClone{},
 	)
 	return nil
 }
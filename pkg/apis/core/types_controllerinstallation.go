@@ -51,6 +51,9 @@ type ControllerInstallationSpec struct {
 	RegistrationRef corev1.ObjectReference
 	// SeedRef is used to reference a Seed resources.
 	SeedRef corev1.ObjectReference
+	// Version is the version of the deployment of the referenced ControllerRegistration which shall be installed. If
+	// not set then the providerConfig of the deployment is installed.
+	Version *string
 }
 
 // ControllerInstallationStatus is the status of a ControllerInstallation.
@@ -60,6 +63,9 @@ type ControllerInstallationStatus struct {
 	// ProviderStatus contains type-specific status.
 	// +optional
 	ProviderStatus *ProviderConfig
+	// DeployedVersion is the version of the deployment of the referenced ControllerRegistration which has been
+	// installed successfully.
+	DeployedVersion *string
 }
 
 const (
@@ -62,12 +62,58 @@ type ControllerResource struct {
 	GloballyEnabled *bool
 	// ReconcileTimeout defines how long Gardener should wait for the resource reconciliation.
 	ReconcileTimeout *metav1.Duration
+	// LifecyclePhase is the phase of the Shoot flows in which resources of kind "Extension" are reconciled and
+	// deleted. If not set, the resources are reconciled in the "AfterKubeAPIServer" phase.
+	LifecyclePhase *ControllerResourceLifecyclePhase
+	// DependsOn is a list of types of other resources of kind "Extension" which must be ready before resources of kind
+	// "Extension" of this type are reconciled. They are deleted only after resources of this type have been deleted.
+	DependsOn []string
 }
 
+// ControllerResourceLifecyclePhase is a phase of the Shoot flows in which resources of kind "Extension" are
+// reconciled and deleted.
+type ControllerResourceLifecyclePhase string
+
+const (
+	// ControllerResourceLifecyclePhaseBeforeKubeAPIServer is a constant for resources which are reconciled before the
+	// kube-apiserver of the Shoot is deployed and which are deleted after it has been deleted.
+	ControllerResourceLifecyclePhaseBeforeKubeAPIServer ControllerResourceLifecyclePhase = "BeforeKubeAPIServer"
+	// ControllerResourceLifecyclePhaseAfterKubeAPIServer is a constant for resources which are reconciled as soon as
+	// the kube-apiserver of the Shoot is reachable and which are deleted before the control plane is destroyed.
+	ControllerResourceLifecyclePhaseAfterKubeAPIServer ControllerResourceLifecyclePhase = "AfterKubeAPIServer"
+	// ControllerResourceLifecyclePhaseAfterWorker is a constant for resources which are reconciled after the worker
+	// nodes of the Shoot have joined the cluster and which are deleted before the worker nodes are destroyed.
+	ControllerResourceLifecyclePhaseAfterWorker ControllerResourceLifecyclePhase = "AfterWorker"
+)
+
 // ControllerDeployment contains information for how this controller is deployed.
 type ControllerDeployment struct {
 	// Type is the deployment type.
 	Type string
+	// ProviderConfig contains type-specific configuration. It is deployed to all seeds if no versions are specified.
+	ProviderConfig *ProviderConfig
+	// Versions is a list of versions of this controller. Versions with a seed selector are pinned to the matching
+	// seeds, all other seeds get the last version without a seed selector according to the rollout policy.
+	Versions []ControllerDeploymentVersion
+	// Rollout defines how a new version is rolled out to the seeds which are not pinned to a version.
+	Rollout *ControllerDeploymentRollout
+}
+
+// ControllerDeploymentVersion is a version of a controller with its type-specific configuration.
+type ControllerDeploymentVersion struct {
+	// Version is the name of this version, e.g. "v1.2.0".
+	Version string
 	// ProviderConfig contains type-specific configuration.
 	ProviderConfig *ProviderConfig
+	// SeedSelector pins this version to the seeds matching the label selector.
+	SeedSelector *metav1.LabelSelector
+}
+
+// ControllerDeploymentRollout defines how a new version of a controller is rolled out to the seeds.
+type ControllerDeploymentRollout struct {
+	// CanarySeedSelector selects the seeds to which a new version is rolled out first.
+	CanarySeedSelector *metav1.LabelSelector
+	// SoakTime is the duration for which a new version must have been installed successfully on all canary seeds
+	// before it is rolled out to the remaining seeds.
+	SoakTime *metav1.Duration
 }
@@ -0,0 +1,57 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootBackupCatalog lists the snapshots of the etcd of a Shoot. It is served read-only by the `shoots/backups`
+// subresource.
+type ShootBackupCatalog struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// BackupEntryName is the name of the BackupEntry holding the snapshots.
+	BackupEntryName string
+	// Snapshots is the list of snapshots of the etcd, ordered by their revisions.
+	Snapshots []ETCDSnapshot
+}
+
+// ETCDSnapshot describes a snapshot of the etcd of a Shoot.
+type ETCDSnapshot struct {
+	// Name is the name of the snapshot in the object store.
+	Name string
+	// Kind is the kind of the snapshot.
+	Kind ETCDSnapshotKind
+	// CreationTimestamp is the time the snapshot has been taken.
+	CreationTimestamp metav1.Time
+	// StartRevision is the first etcd revision contained in the snapshot.
+	StartRevision int64
+	// LastRevision is the last etcd revision contained in the snapshot.
+	LastRevision int64
+}
+
+// ETCDSnapshotKind is a string alias.
+type ETCDSnapshotKind string
+
+const (
+	// ETCDSnapshotKindFull is a constant for a snapshot containing the complete etcd data.
+	ETCDSnapshotKindFull ETCDSnapshotKind = "Full"
+	// ETCDSnapshotKindDelta is a constant for a snapshot containing the changes since the previous snapshot.
+	ETCDSnapshotKindDelta ETCDSnapshotKind = "Delta"
+)
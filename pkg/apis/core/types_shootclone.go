@@ -0,0 +1,46 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClone requests a new Shoot which is generated from an existing Shoot. It is posted to the `shoots/clone`
+// subresource of the existing Shoot which responds with the generated Shoot.
+type ShootClone struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the settings of the new Shoot which differ from the cloned Shoot.
+	Spec ShootCloneSpec
+}
+
+// ShootCloneSpec contains the settings of the new Shoot which differ from the cloned Shoot.
+type ShootCloneSpec struct {
+	// Name is the name of the new Shoot.
+	Name string
+	// Namespace is the namespace of the new Shoot. Defaults to the namespace of the cloned Shoot.
+	Namespace *string
+	// Region is the region of the new Shoot. Defaults to the region of the cloned Shoot. The availability zones
+	// are mapped to the zones of the new region in the order they are listed in the CloudProfile.
+	Region *string
+	// SeedName is the name of the Seed the new Shoot shall be scheduled to. If the new Shoot is created in another
+	// region, it defaults to nil, i.e., the Seed will be determined by the scheduler. Otherwise, it defaults to the
+	// Seed of the cloned Shoot.
+	SeedName *string
+}
@@ -106,6 +106,12 @@ const (
 	// GardenerOperationMigrate is a constant for the value of the operation annotation describing a migration
 	// operation.
 	GardenerOperationMigrate = "migrate"
+	// GardenerOperationRestore is a constant for the value of the operation annotation describing a restore
+	// operation.
+	GardenerOperationRestore = "restore"
+	// GardenerOperationDrain is a constant for the value of the operation annotation on a Seed describing that all
+	// shoot control planes shall be migrated away from it.
+	GardenerOperationDrain = "drain"
 
 	// GardenRole is a constant for a label that describes a role.
 	GardenRole = "gardener.cloud/role"
@@ -123,6 +129,13 @@ const (
 	LabelShootProvider = "shoot.gardener.cloud/provider"
 	// LabelNetworkingProvider is used to identify the networking provider for the cni plugin.
 	LabelNetworkingProvider = "networking.shoot.gardener.cloud/provider"
+	// LabelWorkerPool is used to identify the nodes belonging to a worker pool of a Shoot.
+	LabelWorkerPool = "worker.gardener.cloud/pool"
+	// LabelWorkerPoolCRIName is used to identify the container runtime interface used by the nodes of a worker pool.
+	LabelWorkerPoolCRIName = "worker.gardener.cloud/cri-name"
+	// LabelContainerRuntimePrefix is the prefix of the labels used to identify the additional container runtimes
+	// available on the nodes of a worker pool, e.g. 'containerruntime.worker.gardener.cloud/gvisor=true'.
+	LabelContainerRuntimePrefix = "containerruntime.worker.gardener.cloud/"
 	// LabelExtensionConfiguration is used to identify the provider's configuration which will be added to Gardener configuration
 	LabelExtensionConfiguration = "extensions.gardener.cloud/configuration"
 	// LabelLogging is a constant for a label for logging stack configurations
@@ -179,6 +192,9 @@ const (
 	OperatingSystemConfigUnitNameKubeletService = "kubelet.service"
 	// OperatingSystemConfigFilePathKernelSettings is a constant for a path to a file in the operating system config that contains some general kernel settings.
 	OperatingSystemConfigFilePathKernelSettings = "/etc/sysctl.d/99-k8s-general.conf"
+	// OperatingSystemConfigFilePathWorkerKernelSettings is a constant for a path to a file in the operating system config that contains the kernel settings
+	// of the worker pool. It is applied after the general kernel settings so that it takes precedence.
+	OperatingSystemConfigFilePathWorkerKernelSettings = "/etc/sysctl.d/99-k8s-worker.conf"
 	// OperatingSystemConfigFilePathKubeletConfig is a constant for a path to a file in the operating system config that contains the kubelet configuration.
 	OperatingSystemConfigFilePathKubeletConfig = "/var/lib/kubelet/config/kubelet"
 
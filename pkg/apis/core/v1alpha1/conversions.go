@@ -730,6 +730,10 @@ func Convert_garden_CloudProfileSpec_To_v1alpha1_CloudProfileSpec(in *garden.Clo
 	return autoConvert_garden_CloudProfileSpec_To_v1alpha1_CloudProfileSpec(in, out, s)
 }
 
+func Convert_v1alpha1_MachineImage_To_garden_MachineImage(in *MachineImage, out *garden.MachineImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineImage_To_garden_MachineImage(in, out, s)
+}
+
 func dnsProviderConstraintToStringSlice(dnsConstraints []garden.DNSProviderConstraint) []string {
 	out := make([]string, 0, len(dnsConstraints))
 	for _, d := range dnsConstraints {
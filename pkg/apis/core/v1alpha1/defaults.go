@@ -16,10 +16,12 @@ package v1alpha1
 
 import (
 	"math"
+	"time"
 
 	"github.com/gardener/gardener/pkg/utils"
 
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -156,6 +158,17 @@ func SetDefaults_Worker(obj *Worker) {
 	if obj.MaxUnavailable == nil {
 		obj.MaxUnavailable = &DefaultWorkerMaxUnavailable
 	}
+	if obj.AutoRepair != nil && obj.AutoRepair.MaxConcurrentRepairs == nil {
+		maxConcurrentRepairs := int32(1)
+		obj.AutoRepair.MaxConcurrentRepairs = &maxConcurrentRepairs
+	}
+}
+
+// SetDefaults_ControllerDeploymentRollout sets default values for ControllerDeploymentRollout objects.
+func SetDefaults_ControllerDeploymentRollout(obj *ControllerDeploymentRollout) {
+	if obj.SoakTime == nil {
+		obj.SoakTime = &metav1.Duration{Duration: time.Hour}
+	}
 }
 
 // Helper functions
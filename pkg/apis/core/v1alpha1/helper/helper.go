@@ -15,6 +15,7 @@
 package helper
 
 import (
+	"fmt"
 	"net"
 	"strings"
 
@@ -154,6 +155,32 @@ func IsControllerInstallationSuccessful(controllerInstallation gardencorev1alpha
 	return false
 }
 
+// GetControllerDeploymentProviderConfig returns the provider config of the given controller deployment which belongs
+// to the given version. If no version is given then the top-level provider config of the deployment is returned.
+func GetControllerDeploymentProviderConfig(deployment *gardencorev1alpha1.ControllerDeployment, version *string) (*gardencorev1alpha1.ProviderConfig, error) {
+	if deployment == nil {
+		return nil, fmt.Errorf("controller registration does not specify a deployment")
+	}
+
+	if version == nil {
+		if deployment.ProviderConfig == nil {
+			return nil, fmt.Errorf("controller deployment does not specify a provider config")
+		}
+		return deployment.ProviderConfig, nil
+	}
+
+	for _, v := range deployment.Versions {
+		if v.Version == *version {
+			if v.ProviderConfig == nil {
+				return nil, fmt.Errorf("version %q of controller deployment does not specify a provider config", *version)
+			}
+			return v.ProviderConfig, nil
+		}
+	}
+
+	return nil, fmt.Errorf("version %q is not part of the controller deployment", *version)
+}
+
 // ComputeOperationType checksthe <lastOperation> and determines whether is it is Create operation or reconcile operation
 func ComputeOperationType(meta metav1.ObjectMeta, lastOperation *gardencorev1alpha1.LastOperation) gardencorev1alpha1.LastOperationType {
 	switch {
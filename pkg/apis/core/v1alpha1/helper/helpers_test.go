@@ -21,6 +21,7 @@ import (
 	. "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
@@ -240,5 +241,41 @@ var _ = Describe("helper", func() {
 			Entry("taint exists", []gardencorev1alpha1.SeedTaint{{Key: "foo"}}, "foo", true),
 			Entry("taint does not exist", []gardencorev1alpha1.SeedTaint{{Key: "foo"}}, "bar", false),
 		)
+
+		Describe("#GetControllerDeploymentProviderConfig", func() {
+			var (
+				version        = "v2"
+				unknownVersion = "v3"
+
+				providerConfig        = &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte(`{"chart":"v1"}`)}}
+				versionProviderConfig = &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte(`{"chart":"v2"}`)}}
+
+				deployment = &gardencorev1alpha1.ControllerDeployment{
+					Type:           "helm",
+					ProviderConfig: providerConfig,
+					Versions: []gardencorev1alpha1.ControllerDeploymentVersion{
+						{Version: version, ProviderConfig: versionProviderConfig},
+					},
+				}
+			)
+
+			It("should return the top-level provider config if no version is given", func() {
+				Expect(GetControllerDeploymentProviderConfig(deployment, nil)).To(Equal(providerConfig))
+			})
+
+			It("should return the provider config of the given version", func() {
+				Expect(GetControllerDeploymentProviderConfig(deployment, &version)).To(Equal(versionProviderConfig))
+			})
+
+			It("should fail if the given version is unknown", func() {
+				_, err := GetControllerDeploymentProviderConfig(deployment, &unknownVersion)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("should fail if no deployment is given", func() {
+				_, err := GetControllerDeploymentProviderConfig(nil, nil)
+				Expect(err).To(HaveOccurred())
+			})
+		})
 	})
 })
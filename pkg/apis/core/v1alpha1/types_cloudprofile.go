@@ -49,6 +49,9 @@ type CloudProfileList struct {
 // CloudProfileSpec is the specification of a CloudProfile.
 // It must contain exactly one of its defined keys.
 type CloudProfileSpec struct {
+	// BackupLimits contains limits for the etcd backup settings in the Shoot specification.
+	// +optional
+	BackupLimits *BackupLimits `json:"backupLimits,omitempty"`
 	// CABundle is a certificate bundle which will be installed onto every host machine of shoot cluster targetting this profile.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
@@ -75,6 +78,19 @@ type CloudProfileSpec struct {
 	VolumeTypes []VolumeType `json:"volumeTypes,omitempty"`
 }
 
+// BackupLimits contains limits for the etcd backup settings in the Shoot specification.
+type BackupLimits struct {
+	// MinFullSnapshotInterval is the minimum interval between two full snapshots of the etcd a Shoot may configure.
+	// +optional
+	MinFullSnapshotInterval *metav1.Duration `json:"minFullSnapshotInterval,omitempty"`
+	// MinDeltaSnapshotPeriod is the minimum period between two delta snapshots of the etcd a Shoot may configure.
+	// +optional
+	MinDeltaSnapshotPeriod *metav1.Duration `json:"minDeltaSnapshotPeriod,omitempty"`
+	// MaxBackups is the maximum number of full snapshots of the etcd a Shoot may retain.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+}
+
 // KubernetesSettings contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.
 type KubernetesSettings struct {
 	// Versions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.
@@ -88,6 +104,10 @@ type MachineImage struct {
 	Name string `json:"name"`
 	// Versions contains versions and expiration dates of the machine image
 	Versions []ExpirableVersion `json:"versions"`
+	// CRI is the list of container runtime interfaces and container runtimes supported by the machine image. If it is
+	// empty then only the docker CRI is supported.
+	// +optional
+	CRI []CRI `json:"cri,omitempty"`
 }
 
 // ExpirableVersion contains a version and an expiration date.
@@ -52,6 +52,10 @@ type ControllerInstallationSpec struct {
 	RegistrationRef corev1.ObjectReference `json:"registrationRef"`
 	// SeedRef is used to reference a Seed resources.
 	SeedRef corev1.ObjectReference `json:"seedRef"`
+	// Version is the version of the deployment of the referenced ControllerRegistration which shall be installed. If
+	// not set then the providerConfig of the deployment is installed.
+	// +optional
+	Version *string `json:"version,omitempty"`
 }
 
 // ControllerInstallationStatus is the status of a ControllerInstallation.
@@ -62,6 +66,10 @@ type ControllerInstallationStatus struct {
 	// ProviderStatus contains type-specific status.
 	// +optional
 	ProviderStatus *ProviderConfig `json:"providerStatus,omitempty"`
+	// DeployedVersion is the version of the deployment of the referenced ControllerRegistration which has been
+	// installed successfully.
+	// +optional
+	DeployedVersion *string `json:"deployedVersion,omitempty"`
 }
 
 const (
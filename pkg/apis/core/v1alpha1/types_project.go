@@ -73,6 +73,31 @@ type ProjectSpec struct {
 	// A nil value means that Gardener will determine the name of the namespace.
 	// +optional
 	Namespace *string `json:"namespace,omitempty"`
+	// MaintenanceBlackoutWindows is a list of periods of time in which no automatic maintenance operations are
+	// performed for the Shoots of this project.
+	// +optional
+	MaintenanceBlackoutWindows []MaintenanceBlackoutWindow `json:"maintenanceBlackoutWindows,omitempty"`
+	// ShootDeletionProtection defines whether Shoots created in this project are protected against deletion by
+	// default.
+	// +optional
+	ShootDeletionProtection *bool `json:"shootDeletionProtection,omitempty"`
+}
+
+// MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.
+type MaintenanceBlackoutWindow struct {
+	// Name is the unique name of the blackout window.
+	Name string `json:"name"`
+	// Begin is the point in time when the blackout window starts.
+	Begin metav1.Time `json:"begin"`
+	// End is the point in time when the blackout window ends.
+	End metav1.Time `json:"end"`
+	// Reason is a human-readable explanation why automatic maintenance operations are blocked.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+	// ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector
+	// matches all Shoots.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -55,12 +55,18 @@ type SeedSpec struct {
 	// under the configured object store.
 	// +optional
 	Backup *SeedBackup `json:"backup,omitempty"`
+	// BackupLimits contains limits for the etcd backup settings of shoots whose control plane runs in this seed.
+	// +optional
+	BackupLimits *BackupLimits `json:"backupLimits,omitempty"`
 	// BlockCIDRs is a list of network addresses tha should be blocked for shoot control plane components running
 	// in the seed cluster.
 	// +optional
 	BlockCIDRs []string `json:"blockCIDRs,omitempty"`
 	// DNS contains DNS-relevant information about this seed cluster.
 	DNS SeedDNS `json:"dns"`
+	// Logging contains settings for the logging of the seed cluster and the shoot control planes running in it.
+	// +optional
+	Logging *SeedLogging `json:"logging,omitempty"`
 	// Networks defines the pod, service and worker network of the Seed cluster.
 	Networks SeedNetworks `json:"networks"`
 	// Provider defines the provider type and region for this Seed cluster.
@@ -88,6 +94,26 @@ type SeedStatus struct {
 	// Seed's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Capacity contains the allocatable and requested resources of the Seed cluster and the number of hosted
+	// shoot control planes.
+	// +optional
+	Capacity *SeedCapacity `json:"capacity,omitempty"`
+}
+
+// SeedCapacity contains information about the resources of a Seed cluster.
+type SeedCapacity struct {
+	// Allocatable is the sum of the allocatable CPU and memory of all nodes of the Seed cluster.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Requested is the sum of the CPU and memory requests of all pods of the Seed cluster which are not terminated.
+	// +optional
+	Requested corev1.ResourceList `json:"requested,omitempty"`
+	// ExcessCapacityReserved is the CPU and memory reserved for new shoot control planes. It is empty if no
+	// excess capacity is reserved.
+	// +optional
+	ExcessCapacityReserved corev1.ResourceList `json:"excessCapacityReserved,omitempty"`
+	// ShootControlPlanes is the number of shoot control planes hosted by the Seed cluster.
+	ShootControlPlanes int `json:"shootControlPlanes"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -156,6 +182,9 @@ const (
 	// SeedTaintInvisible is a constant for a taint key on a seed that marks it as invisible. Invisible seeds
 	// are not considered by the gardener-scheduler.
 	SeedTaintInvisible = "seed.gardener.cloud/invisible"
+	// SeedTaintCordoned is a constant for a taint key on a seed that marks it as cordoned. No new shoots may be
+	// scheduled onto cordoned seeds while the existing ones keep running.
+	SeedTaintCordoned = "seed.gardener.cloud/cordoned"
 )
 
 // SeedVolume contains settings for persistentvolumes created in the seed cluster.
@@ -176,7 +205,35 @@ type SeedVolumeProvider struct {
 	Name string `json:"name"`
 }
 
+// SeedLogging contains settings for the logging of the seed cluster.
+type SeedLogging struct {
+	// Endpoint is the URL of an external HTTP(S) endpoint the logs of the seed cluster are shipped to. It is only
+	// used if the external logging backend is configured for the Gardener controller manager.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+}
+
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// SeedDrained is a constant for a condition type indicating the progress of draining the Seed cluster.
+	SeedDrained ConditionType = "Drained"
+	// SeedMonitoringHealthy is a constant for a condition type indicating the health of the monitoring components (Prometheus,
+	// Alertmanager, Grafana) of the Seed cluster.
+	SeedMonitoringHealthy ConditionType = "MonitoringHealthy"
+	// SeedLoggingHealthy is a constant for a condition type indicating the health of the logging components (fluent-bit, fluentd,
+	// Elasticsearch, Kibana) of the Seed cluster.
+	SeedLoggingHealthy ConditionType = "LoggingHealthy"
+	// SeedAutoscalingHealthy is a constant for a condition type indicating the health of the autoscaling components (VPA, HVPA) of the
+	// Seed cluster.
+	SeedAutoscalingHealthy ConditionType = "AutoscalingHealthy"
+	// SeedResourceManagerHealthy is a constant for a condition type indicating the health of the gardener-resource-manager of the Seed
+	// cluster.
+	SeedResourceManagerHealthy ConditionType = "ResourceManagerHealthy"
+	// SeedIngressHealthy is a constant for a condition type indicating the health of the nginx-ingress controller of the Seed
+	// cluster, which is required to expose the monitoring and logging dashboards.
+	SeedIngressHealthy ConditionType = "IngressHealthy"
+	// SeedExcessCapacityReserved is a constant for a condition type indicating whether the desired excess capacity for new shoot
+	// control planes is reserved in the Seed cluster.
+	SeedExcessCapacityReserved ConditionType = "ExcessCapacityReserved"
 )
@@ -58,6 +58,9 @@ type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
 	// +optional
 	Addons *Addons `json:"addons,omitempty"`
+	// Backup contains settings for the backups of the etcd of the Shoot.
+	// +optional
+	Backup *ShootBackup `json:"backup,omitempty"`
 	// CloudProfileName is a name of a CloudProfile object.
 	CloudProfileName string `json:"cloudProfileName"`
 	// DNS contains information about the DNS settings of the Shoot.
@@ -122,8 +125,87 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID `json:"uid"`
+	// Maintenance holds information about the last maintenance operation of the Shoot.
+	// +optional
+	Maintenance *ShootMaintenanceStatus `json:"maintenance,omitempty"`
+	// AutoRepair holds information about the machines which were replaced because their nodes stayed unhealthy.
+	// +optional
+	AutoRepair *ShootAutoRepairStatus `json:"autoRepair,omitempty"`
+	// DNSProviders contains the status of the DNS records of the additional DNS providers of the Shoot.
+	// +optional
+	DNSProviders []DNSProviderStatus `json:"dnsProviders,omitempty"`
+}
+
+// ShootMaintenanceStatus contains information about the last maintenance operation of a Shoot.
+type ShootMaintenanceStatus struct {
+	// LastMaintenanceTime is the point in time when the last maintenance operation was executed.
+	LastMaintenanceTime metav1.Time `json:"lastMaintenanceTime"`
+	// Tasks contains the results of the maintenance tasks which were executed during the last maintenance operation.
+	// +optional
+	Tasks []MaintenanceTaskStatus `json:"tasks,omitempty"`
+}
+
+// ShootAutoRepairStatus contains information about the auto repair of the worker pools of a Shoot.
+type ShootAutoRepairStatus struct {
+	// Pools contains the auto repair status of the worker pools whose machines have been replaced.
+	// +optional
+	Pools []WorkerPoolAutoRepairStatus `json:"pools,omitempty"`
+}
+
+// WorkerPoolAutoRepairStatus contains information about the last auto repair of a worker pool.
+type WorkerPoolAutoRepairStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// LastRepairTime is the point in time when machines of the worker pool were replaced the last time.
+	LastRepairTime metav1.Time `json:"lastRepairTime"`
 }
 
+// MaintenanceTaskStatus contains the result of a single maintenance task.
+type MaintenanceTaskStatus struct {
+	// Name is the name of the maintenance task.
+	Name string `json:"name"`
+	// State is the result of the maintenance task.
+	State MaintenanceTaskState `json:"state"`
+	// Description is a human-readable message describing what the maintenance task changed or why it failed.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// MaintenanceTaskState is a string alias.
+type MaintenanceTaskState string
+
+const (
+	// MaintenanceTaskStateSucceeded indicates that the maintenance task has changed the Shoot successfully.
+	MaintenanceTaskStateSucceeded MaintenanceTaskState = "Succeeded"
+	// MaintenanceTaskStateSkipped indicates that the maintenance task did not apply to the Shoot.
+	MaintenanceTaskStateSkipped MaintenanceTaskState = "Skipped"
+	// MaintenanceTaskStateFailed indicates that the maintenance task has failed.
+	MaintenanceTaskStateFailed MaintenanceTaskState = "Failed"
+)
+
+// DNSProviderStatus contains the status of the DNS record of an additional DNS provider of a Shoot.
+type DNSProviderStatus struct {
+	// Type is the type of the DNS provider.
+	Type string `json:"type"`
+	// Domain is the domain for which the DNS record is managed.
+	Domain string `json:"domain"`
+	// State is the state of the DNS record.
+	State DNSProviderState `json:"state"`
+	// Description is a human-readable message describing why the DNS record is not ready.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// DNSProviderState is a string alias.
+type DNSProviderState string
+
+const (
+	// DNSProviderStateReady indicates that the DNS record of the DNS provider is ready.
+	DNSProviderStateReady DNSProviderState = "Ready"
+	// DNSProviderStateError indicates that the DNS record of the DNS provider could not be created.
+	DNSProviderStateError DNSProviderState = "Error"
+)
+
 //////////////////////////////////////////////////////////////////////////////////////////////////
 // Addons relevant types                                                                        //
 //////////////////////////////////////////////////////////////////////////////////////////////////
@@ -185,6 +267,11 @@ type DNS struct {
 
 // DNSProvider contains information about a DNS provider.
 type DNSProvider struct {
+	// Domain is the domain for which this provider shall create a DNS record pointing to the API server of the shoot
+	// cluster. It is only relevant for additional providers, i.e., all providers except the first one. If it is not
+	// set then the external domain of the shoot is used, e.g., to create the same record in a private hosted zone.
+	// +optional
+	Domain *string `json:"domain,omitempty"`
 	// Domains contains information about which domains shall be included/excluded for this provider.
 	// +optional
 	Domains *DNSIncludeExclude `json:"domains,omitempty"`
@@ -215,6 +302,39 @@ type DNSIncludeExclude struct {
 // DefaultDomain is the default value in the Shoot's '.spec.dns.domain' when '.spec.dns.provider' is 'unmanaged'
 const DefaultDomain = "cluster.local"
 
+//////////////////////////////////////////////////////////////////////////////////////////////////
+// Backup relevant types                                                                        //
+//////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ShootBackup contains settings for the backups of the etcd of the Shoot.
+type ShootBackup struct {
+	// Schedule is the cron schedule in which full snapshots of the etcd are taken.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// DeltaSnapshotPeriod is the period after which delta snapshots of the etcd are taken.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// GarbageCollectionPolicy is the policy used to garbage collect old snapshots of the etcd.
+	// +optional
+	GarbageCollectionPolicy *BackupGarbageCollectionPolicy `json:"garbageCollectionPolicy,omitempty"`
+	// MaxBackups is the number of full snapshots of the etcd which are retained. It is only used for the
+	// `LimitBased` garbage collection policy.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+}
+
+// BackupGarbageCollectionPolicy is a string alias.
+type BackupGarbageCollectionPolicy string
+
+const (
+	// BackupGarbageCollectionPolicyExponential is a constant for a garbage collection policy which retains the latest
+	// snapshots of every hour, day and week.
+	BackupGarbageCollectionPolicyExponential BackupGarbageCollectionPolicy = "Exponential"
+	// BackupGarbageCollectionPolicyLimitBased is a constant for a garbage collection policy which retains a fixed
+	// number of full snapshots.
+	BackupGarbageCollectionPolicyLimitBased BackupGarbageCollectionPolicy = "LimitBased"
+)
+
 //////////////////////////////////////////////////////////////////////////////////////////////////
 // Extension relevant types                                                                     //
 //////////////////////////////////////////////////////////////////////////////////////////////////
@@ -237,11 +357,30 @@ type Hibernation struct {
 	// Enabled is true if the Shoot's desired state is hibernated, false otherwise.
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
+	// Mode determines which parts of the Shoot are hibernated. Defaults to `Full` if not specified.
+	// +optional
+	Mode *HibernationMode `json:"mode,omitempty"`
+	// ExcludedWorkerPools is a list of names of worker pools which keep running while the Shoot is hibernated.
+	// It may only be set if the mode is `WorkersOnly`.
+	// +optional
+	ExcludedWorkerPools []string `json:"excludedWorkerPools,omitempty"`
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty"`
 }
 
+// HibernationMode is a string alias.
+type HibernationMode string
+
+const (
+	// HibernationModeFull is a constant for the hibernation mode which scales down the worker pools and the
+	// entire control plane of the Shoot.
+	HibernationModeFull HibernationMode = "Full"
+	// HibernationModeWorkersOnly is a constant for the hibernation mode which only scales down the worker pools
+	// while the control plane of the Shoot stays available.
+	HibernationModeWorkersOnly HibernationMode = "WorkersOnly"
+)
+
 // HibernationSchedule determines the hibernation schedule of a Shoot.
 // A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
 // Start or End can be omitted, though at least one of each has to be specified.
@@ -684,6 +823,21 @@ type Networking struct {
 	// Services is the CIDR of the service network.
 	// +optional
 	Services *string `json:"services,omitempty"`
+	// ControlPlaneEgressDestinations is a list of additional destinations which the control plane components of
+	// the Shoot (e.g., the kube-apiserver calling webhooks) are allowed to reach.
+	// +optional
+	ControlPlaneEgressDestinations []EgressDestination `json:"controlPlaneEgressDestinations,omitempty"`
+}
+
+// EgressDestination is a destination which the control plane components of a Shoot are allowed to reach. Exactly
+// one of the fields must be set.
+type EgressDestination struct {
+	// CIDR is a network block in CIDR notation.
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+	// FQDN is a fully qualified domain name. It is resolved to its IPv4 addresses whenever the Shoot is reconciled.
+	// +optional
+	FQDN *string `json:"fqdn,omitempty"`
 }
 
 const (
@@ -752,9 +906,15 @@ type Worker struct {
 	// Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.
 	// +optional
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// AutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.
+	// +optional
+	AutoRepair *WorkerAutoRepair `json:"autoRepair,omitempty"`
 	// CABundle is a certificate bundle which will be installed onto every machine of this worker pool.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
+	// CRI contains configurations of CRI support of every machine in the worker pool.
+	// +optional
+	CRI *CRI `json:"cri,omitempty"`
 	// Kubernetes contains configuration for Kubernetes components related to this worker pool.
 	// +optional
 	Kubernetes *WorkerKubernetes `json:"kubernetes,omitempty"`
@@ -765,6 +925,9 @@ type Worker struct {
 	Name string `json:"name"`
 	// Machine contains information about the machine type and image.
 	Machine Machine `json:"machine"`
+	// MaxNodeAge is the maximum age of the nodes of this worker pool. Once it is exceeded, the nodes are rolled.
+	// +optional
+	MaxNodeAge *metav1.Duration `json:"maxNodeAge,omitempty"`
 	// Maximum is the maximum number of VMs to create.
 	Maximum int32 `json:"maximum"`
 	// Minimum is the minimum number of VMs to create.
@@ -775,6 +938,10 @@ type Worker struct {
 	// MaxUnavailable is the maximum number of VMs that can be unavailable during an update.
 	// +optional
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// OperatingSystemConfig contains additional systemd units, files and kernel settings which are added to the
+	// operating system configuration of every machine in this worker pool.
+	// +optional
+	OperatingSystemConfig *WorkerOperatingSystemConfig `json:"operatingSystemConfig,omitempty"`
 	// ProviderConfig is the provider-specific configuration for this worker pool.
 	// +optional
 	ProviderConfig *ProviderConfig `json:"providerConfig,omitempty"`
@@ -790,11 +957,135 @@ type Worker struct {
 	Zones []string `json:"zones,omitempty"`
 }
 
+// WorkerAutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.
+type WorkerAutoRepair struct {
+	// UnhealthyThreshold is the duration a node must be continuously unhealthy before its machine is replaced.
+	UnhealthyThreshold metav1.Duration `json:"unhealthyThreshold"`
+	// MaxConcurrentRepairs is the maximum number of machines of this worker pool which are replaced at the same
+	// time because their nodes are unhealthy. Defaults to 1.
+	// +optional
+	MaxConcurrentRepairs *int32 `json:"maxConcurrentRepairs,omitempty"`
+}
+
+// WorkerOperatingSystemConfig contains additional systemd units, files and kernel settings for the machines of a
+// worker pool. Units and files owned by Gardener must not be overwritten.
+type WorkerOperatingSystemConfig struct {
+	// Units is a list of additional systemd units.
+	// +optional
+	Units []WorkerUnit `json:"units,omitempty"`
+	// Files is a list of additional files that should get written to the host's file system.
+	// +optional
+	Files []WorkerFile `json:"files,omitempty"`
+	// Sysctls is a map of kernel parameters to their values. They take precedence over the kernel settings
+	// configured by Gardener.
+	// +optional
+	Sysctls map[string]string `json:"sysctls,omitempty"`
+}
+
+// WorkerUnit is an additional systemd unit for the machines of a worker pool.
+type WorkerUnit struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// Command is the command which is executed for the unit (start, restart or stop).
+	// +optional
+	Command *string `json:"command,omitempty"`
+	// Enable describes whether the unit is enabled or not.
+	// +optional
+	Enable *bool `json:"enable,omitempty"`
+	// Content is the unit's content.
+	// +optional
+	Content *string `json:"content,omitempty"`
+	// DropIns is a list of drop-ins for this unit.
+	// +optional
+	DropIns []WorkerUnitDropIn `json:"dropIns,omitempty"`
+}
+
+// WorkerUnitDropIn is a drop-in configuration for a systemd unit.
+type WorkerUnitDropIn struct {
+	// Name is the name of the drop-in.
+	Name string `json:"name"`
+	// Content is the content of the drop-in.
+	Content string `json:"content"`
+}
+
+// WorkerFile is an additional file that should get written to the host's file system. The content can either be
+// inlined or referenced from a secret in the namespace of the Shoot.
+type WorkerFile struct {
+	// Path is the path of the file system where the file should get written to.
+	Path string `json:"path"`
+	// Permissions describes with which permissions the file should get written to the file system.
+	// Defaults to octal 0644.
+	// +optional
+	Permissions *int32 `json:"permissions,omitempty"`
+	// Content describes the file's content.
+	Content WorkerFileContent `json:"content"`
+}
+
+// WorkerFileContent can either reference a secret or contain inline configuration.
+type WorkerFileContent struct {
+	// SecretRef is a reference to a key of a secret in the namespace of the Shoot.
+	// +optional
+	SecretRef *WorkerFileContentSecretRef `json:"secretRef,omitempty"`
+	// Inline contains the inlined data of the file.
+	// +optional
+	Inline *WorkerFileContentInline `json:"inline,omitempty"`
+}
+
+// WorkerFileContentSecretRef contains keys for referencing a file content's data from a secret in the namespace
+// of the Shoot.
+type WorkerFileContentSecretRef struct {
+	// Name is the name of the secret.
+	Name string `json:"name"`
+	// DataKey is the key in the secret's `.data` field that should be read.
+	DataKey string `json:"dataKey"`
+}
+
+// WorkerFileContentInline contains keys for inlining a file content's data and encoding.
+type WorkerFileContentInline struct {
+	// Encoding is the file's encoding (empty or b64).
+	// +optional
+	Encoding string `json:"encoding,omitempty"`
+	// Data is the file's data.
+	Data string `json:"data"`
+}
+
 // WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.
 type WorkerKubernetes struct {
 	// Kubelet contains configuration settings for all kubelets of this worker pool.
 	// +optional
 	Kubelet *KubeletConfig `json:"kubelet,omitempty"`
+	// Version is the semantic Kubernetes version to use for the kubelets of this worker pool. It must not be newer than
+	// the version of the control plane and at most two minor versions older. Defaults to the control plane version.
+	// +optional
+	Version *string `json:"version,omitempty"`
+}
+
+// CRI contains information about the Container Runtimes.
+type CRI struct {
+	// Name is the name of the CRI library.
+	Name CRIName `json:"name"`
+	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
+	// +optional
+	ContainerRuntimes []ContainerRuntime `json:"containerRuntimes,omitempty"`
+}
+
+// CRIName is a type alias for the CRI name string.
+type CRIName string
+
+const (
+	// CRINameDocker is a constant for the docker CRI.
+	CRINameDocker CRIName = "docker"
+	// CRINameContainerD is a constant for the containerd CRI.
+	CRINameContainerD CRIName = "containerd"
+)
+
+// ContainerRuntime contains information about worker's available container runtime.
+type ContainerRuntime struct {
+	// Type is the type of the Container Runtime.
+	Type string `json:"type"`
+	// ProviderConfig is the configuration passed to the ContainerRuntime resource.
+	// +optional
+	ProviderConfig *ProviderConfig `json:"providerConfig,omitempty"`
 }
 
 // Machine contains information about the machine type and image.
@@ -192,6 +192,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupLimits)(nil), (*garden.BackupLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BackupLimits_To_garden_BackupLimits(a.(*BackupLimits), b.(*garden.BackupLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.BackupLimits)(nil), (*BackupLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_BackupLimits_To_v1alpha1_BackupLimits(a.(*garden.BackupLimits), b.(*BackupLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CRI)(nil), (*garden.CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CRI_To_garden_CRI(a.(*CRI), b.(*garden.CRI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CRI)(nil), (*CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CRI_To_v1alpha1_CRI(a.(*garden.CRI), b.(*CRI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudInfo)(nil), (*core.CloudInfo)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudInfo_To_core_CloudInfo(a.(*CloudInfo), b.(*core.CloudInfo), scope)
 	}); err != nil {
@@ -262,6 +282,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerRuntime)(nil), (*garden.ContainerRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ContainerRuntime_To_garden_ContainerRuntime(a.(*ContainerRuntime), b.(*garden.ContainerRuntime), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ContainerRuntime)(nil), (*ContainerRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ContainerRuntime_To_v1alpha1_ContainerRuntime(a.(*garden.ContainerRuntime), b.(*ContainerRuntime), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeployment)(nil), (*core.ControllerDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(a.(*ControllerDeployment), b.(*core.ControllerDeployment), scope)
 	}); err != nil {
@@ -272,6 +302,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentRollout)(nil), (*core.ControllerDeploymentRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(a.(*ControllerDeploymentRollout), b.(*core.ControllerDeploymentRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerDeploymentRollout)(nil), (*ControllerDeploymentRollout)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDeploymentRollout_To_v1alpha1_ControllerDeploymentRollout(a.(*core.ControllerDeploymentRollout), b.(*ControllerDeploymentRollout), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentVersion)(nil), (*core.ControllerDeploymentVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ControllerDeploymentVersion_To_core_ControllerDeploymentVersion(a.(*ControllerDeploymentVersion), b.(*core.ControllerDeploymentVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControllerDeploymentVersion)(nil), (*ControllerDeploymentVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControllerDeploymentVersion_To_v1alpha1_ControllerDeploymentVersion(a.(*core.ControllerDeploymentVersion), b.(*ControllerDeploymentVersion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerInstallation)(nil), (*core.ControllerInstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ControllerInstallation_To_core_ControllerInstallation(a.(*ControllerInstallation), b.(*core.ControllerInstallation), scope)
 	}); err != nil {
@@ -382,6 +432,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSProviderStatus)(nil), (*garden.DNSProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSProviderStatus_To_garden_DNSProviderStatus(a.(*DNSProviderStatus), b.(*garden.DNSProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DNSProviderStatus)(nil), (*DNSProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DNSProviderStatus_To_v1alpha1_DNSProviderStatus(a.(*garden.DNSProviderStatus), b.(*DNSProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDSnapshot)(nil), (*core.ETCDSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ETCDSnapshot_To_core_ETCDSnapshot(a.(*ETCDSnapshot), b.(*core.ETCDSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDSnapshot)(nil), (*ETCDSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDSnapshot_To_v1alpha1_ETCDSnapshot(a.(*core.ETCDSnapshot), b.(*ETCDSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressDestination)(nil), (*garden.EgressDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EgressDestination_To_garden_EgressDestination(a.(*EgressDestination), b.(*garden.EgressDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.EgressDestination)(nil), (*EgressDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_EgressDestination_To_v1alpha1_EgressDestination(a.(*garden.EgressDestination), b.(*EgressDestination), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Endpoint)(nil), (*core.Endpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Endpoint_To_core_Endpoint(a.(*Endpoint), b.(*core.Endpoint), scope)
 	}); err != nil {
@@ -662,6 +742,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceBlackoutWindow)(nil), (*garden.MaintenanceBlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(a.(*MaintenanceBlackoutWindow), b.(*garden.MaintenanceBlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.MaintenanceBlackoutWindow)(nil), (*MaintenanceBlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(a.(*garden.MaintenanceBlackoutWindow), b.(*MaintenanceBlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceTaskStatus)(nil), (*garden.MaintenanceTaskStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(a.(*MaintenanceTaskStatus), b.(*garden.MaintenanceTaskStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.MaintenanceTaskStatus)(nil), (*MaintenanceTaskStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_MaintenanceTaskStatus_To_v1alpha1_MaintenanceTaskStatus(a.(*garden.MaintenanceTaskStatus), b.(*MaintenanceTaskStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*garden.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MaintenanceTimeWindow_To_garden_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*garden.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -902,6 +1002,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedCapacity)(nil), (*garden.SeedCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedCapacity_To_garden_SeedCapacity(a.(*SeedCapacity), b.(*garden.SeedCapacity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedCapacity)(nil), (*SeedCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedCapacity_To_v1alpha1_SeedCapacity(a.(*garden.SeedCapacity), b.(*SeedCapacity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*garden.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedList_To_garden_SeedList(a.(*SeedList), b.(*garden.SeedList), scope)
 	}); err != nil {
@@ -912,6 +1022,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedLogging)(nil), (*garden.SeedLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedLogging_To_garden_SeedLogging(a.(*SeedLogging), b.(*garden.SeedLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedLogging)(nil), (*SeedLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedLogging_To_v1alpha1_SeedLogging(a.(*garden.SeedLogging), b.(*SeedLogging), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedNetworks)(nil), (*garden.SeedNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedNetworks_To_garden_SeedNetworks(a.(*SeedNetworks), b.(*garden.SeedNetworks), scope)
 	}); err != nil {
@@ -1002,6 +1122,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAutoRepairStatus)(nil), (*garden.ShootAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(a.(*ShootAutoRepairStatus), b.(*garden.ShootAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootAutoRepairStatus)(nil), (*ShootAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootAutoRepairStatus_To_v1alpha1_ShootAutoRepairStatus(a.(*garden.ShootAutoRepairStatus), b.(*ShootAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBackup)(nil), (*garden.ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootBackup_To_garden_ShootBackup(a.(*ShootBackup), b.(*garden.ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootBackup)(nil), (*ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootBackup_To_v1alpha1_ShootBackup(a.(*garden.ShootBackup), b.(*ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBackupCatalog)(nil), (*core.ShootBackupCatalog)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootBackupCatalog_To_core_ShootBackupCatalog(a.(*ShootBackupCatalog), b.(*core.ShootBackupCatalog), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootBackupCatalog)(nil), (*ShootBackupCatalog)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootBackupCatalog_To_v1alpha1_ShootBackupCatalog(a.(*core.ShootBackupCatalog), b.(*ShootBackupCatalog), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootClone)(nil), (*core.ShootClone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootClone_To_core_ShootClone(a.(*ShootClone), b.(*core.ShootClone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootClone)(nil), (*ShootClone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootClone_To_v1alpha1_ShootClone(a.(*core.ShootClone), b.(*ShootClone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootCloneSpec)(nil), (*core.ShootCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootCloneSpec_To_core_ShootCloneSpec(a.(*ShootCloneSpec), b.(*core.ShootCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootCloneSpec)(nil), (*ShootCloneSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootCloneSpec_To_v1alpha1_ShootCloneSpec(a.(*core.ShootCloneSpec), b.(*ShootCloneSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1022,6 +1192,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMaintenanceStatus)(nil), (*garden.ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(a.(*ShootMaintenanceStatus), b.(*garden.ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootMaintenanceStatus)(nil), (*ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootMaintenanceStatus_To_v1alpha1_ShootMaintenanceStatus(a.(*garden.ShootMaintenanceStatus), b.(*ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNetworks)(nil), (*garden.ShootNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootNetworks_To_garden_ShootNetworks(a.(*ShootNetworks), b.(*garden.ShootNetworks), scope)
 	}); err != nil {
@@ -1082,6 +1262,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerAutoRepair)(nil), (*garden.WorkerAutoRepair)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerAutoRepair_To_garden_WorkerAutoRepair(a.(*WorkerAutoRepair), b.(*garden.WorkerAutoRepair), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerAutoRepair)(nil), (*WorkerAutoRepair)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerAutoRepair_To_v1alpha1_WorkerAutoRepair(a.(*garden.WorkerAutoRepair), b.(*WorkerAutoRepair), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerFile)(nil), (*garden.WorkerFile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerFile_To_garden_WorkerFile(a.(*WorkerFile), b.(*garden.WorkerFile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerFile)(nil), (*WorkerFile)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerFile_To_v1alpha1_WorkerFile(a.(*garden.WorkerFile), b.(*WorkerFile), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerFileContent)(nil), (*garden.WorkerFileContent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerFileContent_To_garden_WorkerFileContent(a.(*WorkerFileContent), b.(*garden.WorkerFileContent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerFileContent)(nil), (*WorkerFileContent)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerFileContent_To_v1alpha1_WorkerFileContent(a.(*garden.WorkerFileContent), b.(*WorkerFileContent), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerFileContentInline)(nil), (*garden.WorkerFileContentInline)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerFileContentInline_To_garden_WorkerFileContentInline(a.(*WorkerFileContentInline), b.(*garden.WorkerFileContentInline), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerFileContentInline)(nil), (*WorkerFileContentInline)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerFileContentInline_To_v1alpha1_WorkerFileContentInline(a.(*garden.WorkerFileContentInline), b.(*WorkerFileContentInline), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerFileContentSecretRef)(nil), (*garden.WorkerFileContentSecretRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerFileContentSecretRef_To_garden_WorkerFileContentSecretRef(a.(*WorkerFileContentSecretRef), b.(*garden.WorkerFileContentSecretRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerFileContentSecretRef)(nil), (*WorkerFileContentSecretRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerFileContentSecretRef_To_v1alpha1_WorkerFileContentSecretRef(a.(*garden.WorkerFileContentSecretRef), b.(*WorkerFileContentSecretRef), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerKubernetes)(nil), (*garden.WorkerKubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerKubernetes_To_garden_WorkerKubernetes(a.(*WorkerKubernetes), b.(*garden.WorkerKubernetes), scope)
 	}); err != nil {
@@ -1092,6 +1322,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerOperatingSystemConfig)(nil), (*garden.WorkerOperatingSystemConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerOperatingSystemConfig_To_garden_WorkerOperatingSystemConfig(a.(*WorkerOperatingSystemConfig), b.(*garden.WorkerOperatingSystemConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerOperatingSystemConfig)(nil), (*WorkerOperatingSystemConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerOperatingSystemConfig_To_v1alpha1_WorkerOperatingSystemConfig(a.(*garden.WorkerOperatingSystemConfig), b.(*WorkerOperatingSystemConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolAutoRepairStatus)(nil), (*garden.WorkerPoolAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(a.(*WorkerPoolAutoRepairStatus), b.(*garden.WorkerPoolAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerPoolAutoRepairStatus)(nil), (*WorkerPoolAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerPoolAutoRepairStatus_To_v1alpha1_WorkerPoolAutoRepairStatus(a.(*garden.WorkerPoolAutoRepairStatus), b.(*WorkerPoolAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerUnit)(nil), (*garden.WorkerUnit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerUnit_To_garden_WorkerUnit(a.(*WorkerUnit), b.(*garden.WorkerUnit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerUnit)(nil), (*WorkerUnit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerUnit_To_v1alpha1_WorkerUnit(a.(*garden.WorkerUnit), b.(*WorkerUnit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerUnitDropIn)(nil), (*garden.WorkerUnitDropIn)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerUnitDropIn_To_garden_WorkerUnitDropIn(a.(*WorkerUnitDropIn), b.(*garden.WorkerUnitDropIn), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerUnitDropIn)(nil), (*WorkerUnitDropIn)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerUnitDropIn_To_v1alpha1_WorkerUnitDropIn(a.(*garden.WorkerUnitDropIn), b.(*WorkerUnitDropIn), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*garden.Addons)(nil), (*Addons)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_garden_Addons_To_v1alpha1_Addons(a.(*garden.Addons), b.(*Addons), scope)
 	}); err != nil {
@@ -1162,6 +1432,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*MachineImage)(nil), (*garden.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineImage_To_garden_MachineImage(a.(*MachineImage), b.(*garden.MachineImage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*ProjectSpec)(nil), (*garden.ProjectSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(a.(*ProjectSpec), b.(*garden.ProjectSpec), scope)
 	}); err != nil {
@@ -1548,6 +1823,52 @@ func Convert_core_BackupEntryStatus_To_v1alpha1_BackupEntryStatus(in *core.Backu
 	return autoConvert_core_BackupEntryStatus_To_v1alpha1_BackupEntryStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_BackupLimits_To_garden_BackupLimits(in *BackupLimits, out *garden.BackupLimits, s conversion.Scope) error {
+	out.MinFullSnapshotInterval = (*metav1.Duration)(unsafe.Pointer(in.MinFullSnapshotInterval))
+	out.MinDeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.MinDeltaSnapshotPeriod))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_v1alpha1_BackupLimits_To_garden_BackupLimits is an autogenerated conversion function.
+func Convert_v1alpha1_BackupLimits_To_garden_BackupLimits(in *BackupLimits, out *garden.BackupLimits, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BackupLimits_To_garden_BackupLimits(in, out, s)
+}
+
+func autoConvert_garden_BackupLimits_To_v1alpha1_BackupLimits(in *garden.BackupLimits, out *BackupLimits, s conversion.Scope) error {
+	out.MinFullSnapshotInterval = (*metav1.Duration)(unsafe.Pointer(in.MinFullSnapshotInterval))
+	out.MinDeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.MinDeltaSnapshotPeriod))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_garden_BackupLimits_To_v1alpha1_BackupLimits is an autogenerated conversion function.
+func Convert_garden_BackupLimits_To_v1alpha1_BackupLimits(in *garden.BackupLimits, out *BackupLimits, s conversion.Scope) error {
+	return autoConvert_garden_BackupLimits_To_v1alpha1_BackupLimits(in, out, s)
+}
+
+func autoConvert_v1alpha1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	out.Name = garden.CRIName(in.Name)
+	out.ContainerRuntimes = *(*[]garden.ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	return nil
+}
+
+// Convert_v1alpha1_CRI_To_garden_CRI is an autogenerated conversion function.
+func Convert_v1alpha1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CRI_To_garden_CRI(in, out, s)
+}
+
+func autoConvert_garden_CRI_To_v1alpha1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	out.Name = CRIName(in.Name)
+	out.ContainerRuntimes = *(*[]ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	return nil
+}
+
+// Convert_garden_CRI_To_v1alpha1_CRI is an autogenerated conversion function.
+func Convert_garden_CRI_To_v1alpha1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	return autoConvert_garden_CRI_To_v1alpha1_CRI(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudInfo_To_core_CloudInfo(in *CloudInfo, out *core.CloudInfo, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Region = in.Region
@@ -1629,6 +1950,7 @@ func Convert_garden_CloudProfileList_To_v1alpha1_CloudProfileList(in *garden.Clo
 }
 
 func autoConvert_v1alpha1_CloudProfileSpec_To_garden_CloudProfileSpec(in *CloudProfileSpec, out *garden.CloudProfileSpec, s conversion.Scope) error {
+	out.BackupLimits = (*garden.BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	if err := Convert_v1alpha1_KubernetesSettings_To_garden_KubernetesSettings(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
@@ -1670,6 +1992,7 @@ func autoConvert_garden_CloudProfileSpec_To_v1alpha1_CloudProfileSpec(in *garden
 	// WARNING: in.OpenStack requires manual conversion: does not exist in peer-type
 	// WARNING: in.Alicloud requires manual conversion: does not exist in peer-type
 	// WARNING: in.Packet requires manual conversion: does not exist in peer-type
+	out.BackupLimits = (*BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	if err := Convert_garden_KubernetesSettings_To_v1alpha1_KubernetesSettings(&in.Kubernetes, &out.Kubernetes, s); err != nil {
 		return err
@@ -1794,9 +2117,33 @@ func Convert_core_Condition_To_v1alpha1_Condition(in *core.Condition, out *Condi
 	return autoConvert_core_Condition_To_v1alpha1_Condition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ContainerRuntime_To_garden_ContainerRuntime(in *ContainerRuntime, out *garden.ContainerRuntime, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1alpha1_ContainerRuntime_To_garden_ContainerRuntime is an autogenerated conversion function.
+func Convert_v1alpha1_ContainerRuntime_To_garden_ContainerRuntime(in *ContainerRuntime, out *garden.ContainerRuntime, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ContainerRuntime_To_garden_ContainerRuntime(in, out, s)
+}
+
+func autoConvert_garden_ContainerRuntime_To_v1alpha1_ContainerRuntime(in *garden.ContainerRuntime, out *ContainerRuntime, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_garden_ContainerRuntime_To_v1alpha1_ContainerRuntime is an autogenerated conversion function.
+func Convert_garden_ContainerRuntime_To_v1alpha1_ContainerRuntime(in *garden.ContainerRuntime, out *ContainerRuntime, s conversion.Scope) error {
+	return autoConvert_garden_ContainerRuntime_To_v1alpha1_ContainerRuntime(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.Versions = *(*[]core.ControllerDeploymentVersion)(unsafe.Pointer(&in.Versions))
+	out.Rollout = (*core.ControllerDeploymentRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -1808,6 +2155,8 @@ func Convert_v1alpha1_ControllerDeployment_To_core_ControllerDeployment(in *Cont
 func autoConvert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in *core.ControllerDeployment, out *ControllerDeployment, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.Versions = *(*[]ControllerDeploymentVersion)(unsafe.Pointer(&in.Versions))
+	out.Rollout = (*ControllerDeploymentRollout)(unsafe.Pointer(in.Rollout))
 	return nil
 }
 
@@ -1816,6 +2165,52 @@ func Convert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in *core
 	return autoConvert_core_ControllerDeployment_To_v1alpha1_ControllerDeployment(in, out, s)
 }
 
+func autoConvert_v1alpha1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(in *ControllerDeploymentRollout, out *core.ControllerDeploymentRollout, s conversion.Scope) error {
+	out.CanarySeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.CanarySeedSelector))
+	out.SoakTime = (*metav1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(in *ControllerDeploymentRollout, out *core.ControllerDeploymentRollout, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerDeploymentRollout_To_core_ControllerDeploymentRollout(in, out, s)
+}
+
+func autoConvert_core_ControllerDeploymentRollout_To_v1alpha1_ControllerDeploymentRollout(in *core.ControllerDeploymentRollout, out *ControllerDeploymentRollout, s conversion.Scope) error {
+	out.CanarySeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.CanarySeedSelector))
+	out.SoakTime = (*metav1.Duration)(unsafe.Pointer(in.SoakTime))
+	return nil
+}
+
+// Convert_core_ControllerDeploymentRollout_To_v1alpha1_ControllerDeploymentRollout is an autogenerated conversion function.
+func Convert_core_ControllerDeploymentRollout_To_v1alpha1_ControllerDeploymentRollout(in *core.ControllerDeploymentRollout, out *ControllerDeploymentRollout, s conversion.Scope) error {
+	return autoConvert_core_ControllerDeploymentRollout_To_v1alpha1_ControllerDeploymentRollout(in, out, s)
+}
+
+func autoConvert_v1alpha1_ControllerDeploymentVersion_To_core_ControllerDeploymentVersion(in *ControllerDeploymentVersion, out *core.ControllerDeploymentVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.ProviderConfig = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ControllerDeploymentVersion_To_core_ControllerDeploymentVersion is an autogenerated conversion function.
+func Convert_v1alpha1_ControllerDeploymentVersion_To_core_ControllerDeploymentVersion(in *ControllerDeploymentVersion, out *core.ControllerDeploymentVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ControllerDeploymentVersion_To_core_ControllerDeploymentVersion(in, out, s)
+}
+
+func autoConvert_core_ControllerDeploymentVersion_To_v1alpha1_ControllerDeploymentVersion(in *core.ControllerDeploymentVersion, out *ControllerDeploymentVersion, s conversion.Scope) error {
+	out.Version = in.Version
+	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.SeedSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.SeedSelector))
+	return nil
+}
+
+// Convert_core_ControllerDeploymentVersion_To_v1alpha1_ControllerDeploymentVersion is an autogenerated conversion function.
+func Convert_core_ControllerDeploymentVersion_To_v1alpha1_ControllerDeploymentVersion(in *core.ControllerDeploymentVersion, out *ControllerDeploymentVersion, s conversion.Scope) error {
+	return autoConvert_core_ControllerDeploymentVersion_To_v1alpha1_ControllerDeploymentVersion(in, out, s)
+}
+
 func autoConvert_v1alpha1_ControllerInstallation_To_core_ControllerInstallation(in *ControllerInstallation, out *core.ControllerInstallation, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -1873,6 +2268,7 @@ func Convert_core_ControllerInstallationList_To_v1alpha1_ControllerInstallationL
 func autoConvert_v1alpha1_ControllerInstallationSpec_To_core_ControllerInstallationSpec(in *ControllerInstallationSpec, out *core.ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	out.Version = (*string)(unsafe.Pointer(in.Version))
 	return nil
 }
 
@@ -1884,6 +2280,7 @@ func Convert_v1alpha1_ControllerInstallationSpec_To_core_ControllerInstallationS
 func autoConvert_core_ControllerInstallationSpec_To_v1alpha1_ControllerInstallationSpec(in *core.ControllerInstallationSpec, out *ControllerInstallationSpec, s conversion.Scope) error {
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	out.Version = (*string)(unsafe.Pointer(in.Version))
 	return nil
 }
 
@@ -1895,6 +2292,7 @@ func Convert_core_ControllerInstallationSpec_To_v1alpha1_ControllerInstallationS
 func autoConvert_v1alpha1_ControllerInstallationStatus_To_core_ControllerInstallationStatus(in *ControllerInstallationStatus, out *core.ControllerInstallationStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ProviderStatus = (*core.ProviderConfig)(unsafe.Pointer(in.ProviderStatus))
+	out.DeployedVersion = (*string)(unsafe.Pointer(in.DeployedVersion))
 	return nil
 }
 
@@ -1906,6 +2304,7 @@ func Convert_v1alpha1_ControllerInstallationStatus_To_core_ControllerInstallatio
 func autoConvert_core_ControllerInstallationStatus_To_v1alpha1_ControllerInstallationStatus(in *core.ControllerInstallationStatus, out *ControllerInstallationStatus, s conversion.Scope) error {
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.ProviderStatus = (*ProviderConfig)(unsafe.Pointer(in.ProviderStatus))
+	out.DeployedVersion = (*string)(unsafe.Pointer(in.DeployedVersion))
 	return nil
 }
 
@@ -1989,6 +2388,8 @@ func autoConvert_v1alpha1_ControllerResource_To_core_ControllerResource(in *Cont
 	out.Type = in.Type
 	out.GloballyEnabled = (*bool)(unsafe.Pointer(in.GloballyEnabled))
 	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.LifecyclePhase = (*core.ControllerResourceLifecyclePhase)(unsafe.Pointer(in.LifecyclePhase))
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	return nil
 }
 
@@ -2002,6 +2403,8 @@ func autoConvert_core_ControllerResource_To_v1alpha1_ControllerResource(in *core
 	out.Type = in.Type
 	out.GloballyEnabled = (*bool)(unsafe.Pointer(in.GloballyEnabled))
 	out.ReconcileTimeout = (*metav1.Duration)(unsafe.Pointer(in.ReconcileTimeout))
+	out.LifecyclePhase = (*ControllerResourceLifecyclePhase)(unsafe.Pointer(in.LifecyclePhase))
+	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	return nil
 }
 
@@ -2055,6 +2458,7 @@ func Convert_garden_DNSIncludeExclude_To_v1alpha1_DNSIncludeExclude(in *garden.D
 }
 
 func autoConvert_v1alpha1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *garden.DNSProvider, s conversion.Scope) error {
+	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Domains = (*garden.DNSIncludeExclude)(unsafe.Pointer(in.Domains))
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
 	out.Type = (*string)(unsafe.Pointer(in.Type))
@@ -2068,6 +2472,7 @@ func Convert_v1alpha1_DNSProvider_To_garden_DNSProvider(in *DNSProvider, out *ga
 }
 
 func autoConvert_garden_DNSProvider_To_v1alpha1_DNSProvider(in *garden.DNSProvider, out *DNSProvider, s conversion.Scope) error {
+	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	out.Domains = (*DNSIncludeExclude)(unsafe.Pointer(in.Domains))
 	out.SecretName = (*string)(unsafe.Pointer(in.SecretName))
 	out.Type = (*string)(unsafe.Pointer(in.Type))
@@ -2080,6 +2485,82 @@ func Convert_garden_DNSProvider_To_v1alpha1_DNSProvider(in *garden.DNSProvider,
 	return autoConvert_garden_DNSProvider_To_v1alpha1_DNSProvider(in, out, s)
 }
 
+func autoConvert_v1alpha1_DNSProviderStatus_To_garden_DNSProviderStatus(in *DNSProviderStatus, out *garden.DNSProviderStatus, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Domain = in.Domain
+	out.State = garden.DNSProviderState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha1_DNSProviderStatus_To_garden_DNSProviderStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DNSProviderStatus_To_garden_DNSProviderStatus(in *DNSProviderStatus, out *garden.DNSProviderStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSProviderStatus_To_garden_DNSProviderStatus(in, out, s)
+}
+
+func autoConvert_garden_DNSProviderStatus_To_v1alpha1_DNSProviderStatus(in *garden.DNSProviderStatus, out *DNSProviderStatus, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Domain = in.Domain
+	out.State = DNSProviderState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_garden_DNSProviderStatus_To_v1alpha1_DNSProviderStatus is an autogenerated conversion function.
+func Convert_garden_DNSProviderStatus_To_v1alpha1_DNSProviderStatus(in *garden.DNSProviderStatus, out *DNSProviderStatus, s conversion.Scope) error {
+	return autoConvert_garden_DNSProviderStatus_To_v1alpha1_DNSProviderStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ETCDSnapshot_To_core_ETCDSnapshot(in *ETCDSnapshot, out *core.ETCDSnapshot, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = core.ETCDSnapshotKind(in.Kind)
+	out.CreationTimestamp = in.CreationTimestamp
+	out.StartRevision = in.StartRevision
+	out.LastRevision = in.LastRevision
+	return nil
+}
+
+// Convert_v1alpha1_ETCDSnapshot_To_core_ETCDSnapshot is an autogenerated conversion function.
+func Convert_v1alpha1_ETCDSnapshot_To_core_ETCDSnapshot(in *ETCDSnapshot, out *core.ETCDSnapshot, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ETCDSnapshot_To_core_ETCDSnapshot(in, out, s)
+}
+
+func autoConvert_core_ETCDSnapshot_To_v1alpha1_ETCDSnapshot(in *core.ETCDSnapshot, out *ETCDSnapshot, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = ETCDSnapshotKind(in.Kind)
+	out.CreationTimestamp = in.CreationTimestamp
+	out.StartRevision = in.StartRevision
+	out.LastRevision = in.LastRevision
+	return nil
+}
+
+// Convert_core_ETCDSnapshot_To_v1alpha1_ETCDSnapshot is an autogenerated conversion function.
+func Convert_core_ETCDSnapshot_To_v1alpha1_ETCDSnapshot(in *core.ETCDSnapshot, out *ETCDSnapshot, s conversion.Scope) error {
+	return autoConvert_core_ETCDSnapshot_To_v1alpha1_ETCDSnapshot(in, out, s)
+}
+
+func autoConvert_v1alpha1_EgressDestination_To_garden_EgressDestination(in *EgressDestination, out *garden.EgressDestination, s conversion.Scope) error {
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.FQDN = (*string)(unsafe.Pointer(in.FQDN))
+	return nil
+}
+
+// Convert_v1alpha1_EgressDestination_To_garden_EgressDestination is an autogenerated conversion function.
+func Convert_v1alpha1_EgressDestination_To_garden_EgressDestination(in *EgressDestination, out *garden.EgressDestination, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EgressDestination_To_garden_EgressDestination(in, out, s)
+}
+
+func autoConvert_garden_EgressDestination_To_v1alpha1_EgressDestination(in *garden.EgressDestination, out *EgressDestination, s conversion.Scope) error {
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.FQDN = (*string)(unsafe.Pointer(in.FQDN))
+	return nil
+}
+
+// Convert_garden_EgressDestination_To_v1alpha1_EgressDestination is an autogenerated conversion function.
+func Convert_garden_EgressDestination_To_v1alpha1_EgressDestination(in *garden.EgressDestination, out *EgressDestination, s conversion.Scope) error {
+	return autoConvert_garden_EgressDestination_To_v1alpha1_EgressDestination(in, out, s)
+}
+
 func autoConvert_v1alpha1_Endpoint_To_core_Endpoint(in *Endpoint, out *core.Endpoint, s conversion.Scope) error {
 	out.Name = in.Name
 	out.URL = in.URL
@@ -2174,6 +2655,8 @@ func Convert_garden_Gardener_To_v1alpha1_Gardener(in *garden.Gardener, out *Gard
 
 func autoConvert_v1alpha1_Hibernation_To_garden_Hibernation(in *Hibernation, out *garden.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Mode = (*garden.HibernationMode)(unsafe.Pointer(in.Mode))
+	out.ExcludedWorkerPools = *(*[]string)(unsafe.Pointer(&in.ExcludedWorkerPools))
 	out.Schedules = *(*[]garden.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
 	return nil
 }
@@ -2185,6 +2668,8 @@ func Convert_v1alpha1_Hibernation_To_garden_Hibernation(in *Hibernation, out *ga
 
 func autoConvert_garden_Hibernation_To_v1alpha1_Hibernation(in *garden.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Mode = (*HibernationMode)(unsafe.Pointer(in.Mode))
+	out.ExcludedWorkerPools = *(*[]string)(unsafe.Pointer(&in.ExcludedWorkerPools))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
 	return nil
 }
@@ -2790,14 +3275,10 @@ func Convert_garden_Machine_To_v1alpha1_Machine(in *garden.Machine, out *Machine
 func autoConvert_v1alpha1_MachineImage_To_garden_MachineImage(in *MachineImage, out *garden.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Versions = *(*[]garden.MachineImageVersion)(unsafe.Pointer(&in.Versions))
+	// WARNING: in.CRI requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1alpha1_MachineImage_To_garden_MachineImage is an autogenerated conversion function.
-func Convert_v1alpha1_MachineImage_To_garden_MachineImage(in *MachineImage, out *garden.MachineImage, s conversion.Scope) error {
-	return autoConvert_v1alpha1_MachineImage_To_garden_MachineImage(in, out, s)
-}
-
 func autoConvert_garden_MachineImage_To_v1alpha1_MachineImage(in *garden.MachineImage, out *MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Versions = *(*[]ExpirableVersion)(unsafe.Pointer(&in.Versions))
@@ -2925,6 +3406,58 @@ func Convert_garden_MaintenanceAutoUpdate_To_v1alpha1_MaintenanceAutoUpdate(in *
 	return autoConvert_garden_MaintenanceAutoUpdate_To_v1alpha1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1alpha1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(in *MaintenanceBlackoutWindow, out *garden.MaintenanceBlackoutWindow, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(in *MaintenanceBlackoutWindow, out *garden.MaintenanceBlackoutWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(in, out, s)
+}
+
+func autoConvert_garden_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(in *garden.MaintenanceBlackoutWindow, out *MaintenanceBlackoutWindow, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_garden_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow is an autogenerated conversion function.
+func Convert_garden_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(in *garden.MaintenanceBlackoutWindow, out *MaintenanceBlackoutWindow, s conversion.Scope) error {
+	return autoConvert_garden_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(in *MaintenanceTaskStatus, out *garden.MaintenanceTaskStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = garden.MaintenanceTaskState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(in *MaintenanceTaskStatus, out *garden.MaintenanceTaskStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(in, out, s)
+}
+
+func autoConvert_garden_MaintenanceTaskStatus_To_v1alpha1_MaintenanceTaskStatus(in *garden.MaintenanceTaskStatus, out *MaintenanceTaskStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = MaintenanceTaskState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_garden_MaintenanceTaskStatus_To_v1alpha1_MaintenanceTaskStatus is an autogenerated conversion function.
+func Convert_garden_MaintenanceTaskStatus_To_v1alpha1_MaintenanceTaskStatus(in *garden.MaintenanceTaskStatus, out *MaintenanceTaskStatus, s conversion.Scope) error {
+	return autoConvert_garden_MaintenanceTaskStatus_To_v1alpha1_MaintenanceTaskStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_MaintenanceTimeWindow_To_garden_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *garden.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -2953,6 +3486,7 @@ func autoConvert_v1alpha1_Networking_To_garden_Networking(in *Networking, out *g
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Nodes = in.Nodes
 	out.Services = (*string)(unsafe.Pointer(in.Services))
+	out.ControlPlaneEgressDestinations = *(*[]garden.EgressDestination)(unsafe.Pointer(&in.ControlPlaneEgressDestinations))
 	return nil
 }
 
@@ -2967,6 +3501,7 @@ func autoConvert_garden_Networking_To_v1alpha1_Networking(in *garden.Networking,
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Nodes = in.Nodes
 	out.Services = (*string)(unsafe.Pointer(in.Services))
+	out.ControlPlaneEgressDestinations = *(*[]EgressDestination)(unsafe.Pointer(&in.ControlPlaneEgressDestinations))
 	return nil
 }
 
@@ -3280,6 +3815,8 @@ func autoConvert_v1alpha1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.Members requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.MaintenanceBlackoutWindows = *(*[]garden.MaintenanceBlackoutWindow)(unsafe.Pointer(&in.MaintenanceBlackoutWindows))
+	out.ShootDeletionProtection = (*bool)(unsafe.Pointer(in.ShootDeletionProtection))
 	return nil
 }
 
@@ -3290,6 +3827,8 @@ func autoConvert_garden_ProjectSpec_To_v1alpha1_ProjectSpec(in *garden.ProjectSp
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.ProjectMembers requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.MaintenanceBlackoutWindows = *(*[]MaintenanceBlackoutWindow)(unsafe.Pointer(&in.MaintenanceBlackoutWindows))
+	out.ShootDeletionProtection = (*bool)(unsafe.Pointer(in.ShootDeletionProtection))
 	return nil
 }
 
@@ -3567,6 +4106,32 @@ func Convert_garden_SeedBackup_To_v1alpha1_SeedBackup(in *garden.SeedBackup, out
 	return autoConvert_garden_SeedBackup_To_v1alpha1_SeedBackup(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedCapacity_To_garden_SeedCapacity(in *SeedCapacity, out *garden.SeedCapacity, s conversion.Scope) error {
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requested = *(*v1.ResourceList)(unsafe.Pointer(&in.Requested))
+	out.ExcessCapacityReserved = *(*v1.ResourceList)(unsafe.Pointer(&in.ExcessCapacityReserved))
+	out.ShootControlPlanes = in.ShootControlPlanes
+	return nil
+}
+
+// Convert_v1alpha1_SeedCapacity_To_garden_SeedCapacity is an autogenerated conversion function.
+func Convert_v1alpha1_SeedCapacity_To_garden_SeedCapacity(in *SeedCapacity, out *garden.SeedCapacity, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedCapacity_To_garden_SeedCapacity(in, out, s)
+}
+
+func autoConvert_garden_SeedCapacity_To_v1alpha1_SeedCapacity(in *garden.SeedCapacity, out *SeedCapacity, s conversion.Scope) error {
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requested = *(*v1.ResourceList)(unsafe.Pointer(&in.Requested))
+	out.ExcessCapacityReserved = *(*v1.ResourceList)(unsafe.Pointer(&in.ExcessCapacityReserved))
+	out.ShootControlPlanes = in.ShootControlPlanes
+	return nil
+}
+
+// Convert_garden_SeedCapacity_To_v1alpha1_SeedCapacity is an autogenerated conversion function.
+func Convert_garden_SeedCapacity_To_v1alpha1_SeedCapacity(in *garden.SeedCapacity, out *SeedCapacity, s conversion.Scope) error {
+	return autoConvert_garden_SeedCapacity_To_v1alpha1_SeedCapacity(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedList_To_garden_SeedList(in *SeedList, out *garden.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -3609,6 +4174,26 @@ func Convert_garden_SeedList_To_v1alpha1_SeedList(in *garden.SeedList, out *Seed
 	return autoConvert_garden_SeedList_To_v1alpha1_SeedList(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedLogging_To_garden_SeedLogging(in *SeedLogging, out *garden.SeedLogging, s conversion.Scope) error {
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	return nil
+}
+
+// Convert_v1alpha1_SeedLogging_To_garden_SeedLogging is an autogenerated conversion function.
+func Convert_v1alpha1_SeedLogging_To_garden_SeedLogging(in *SeedLogging, out *garden.SeedLogging, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedLogging_To_garden_SeedLogging(in, out, s)
+}
+
+func autoConvert_garden_SeedLogging_To_v1alpha1_SeedLogging(in *garden.SeedLogging, out *SeedLogging, s conversion.Scope) error {
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	return nil
+}
+
+// Convert_garden_SeedLogging_To_v1alpha1_SeedLogging is an autogenerated conversion function.
+func Convert_garden_SeedLogging_To_v1alpha1_SeedLogging(in *garden.SeedLogging, out *SeedLogging, s conversion.Scope) error {
+	return autoConvert_garden_SeedLogging_To_v1alpha1_SeedLogging(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks, out *garden.SeedNetworks, s conversion.Scope) error {
 	out.Nodes = in.Nodes
 	out.Pods = in.Pods
@@ -3659,8 +4244,10 @@ func Convert_garden_SeedProvider_To_v1alpha1_SeedProvider(in *garden.SeedProvide
 
 func autoConvert_v1alpha1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.SeedSpec, s conversion.Scope) error {
 	out.Backup = (*garden.SeedBackup)(unsafe.Pointer(in.Backup))
+	out.BackupLimits = (*garden.BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	out.BlockCIDRs = *(*[]string)(unsafe.Pointer(&in.BlockCIDRs))
 	// WARNING: in.DNS requires manual conversion: does not exist in peer-type
+	out.Logging = (*garden.SeedLogging)(unsafe.Pointer(in.Logging))
 	if err := Convert_v1alpha1_SeedNetworks_To_garden_SeedNetworks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
@@ -3686,7 +4273,9 @@ func autoConvert_garden_SeedSpec_To_v1alpha1_SeedSpec(in *garden.SeedSpec, out *
 	out.BlockCIDRs = *(*[]string)(unsafe.Pointer(&in.BlockCIDRs))
 	out.Taints = *(*[]SeedTaint)(unsafe.Pointer(&in.Taints))
 	out.Backup = (*SeedBackup)(unsafe.Pointer(in.Backup))
+	out.BackupLimits = (*BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	out.Volume = (*SeedVolume)(unsafe.Pointer(in.Volume))
+	out.Logging = (*SeedLogging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
@@ -3696,6 +4285,7 @@ func autoConvert_v1alpha1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *g
 	}
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Capacity = (*garden.SeedCapacity)(unsafe.Pointer(in.Capacity))
 	return nil
 }
 
@@ -3710,6 +4300,7 @@ func autoConvert_garden_SeedStatus_To_v1alpha1_SeedStatus(in *garden.SeedStatus,
 		return err
 	}
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Capacity = (*SeedCapacity)(unsafe.Pointer(in.Capacity))
 	return nil
 }
 
@@ -3828,6 +4419,128 @@ func autoConvert_garden_Shoot_To_v1alpha1_Shoot(in *garden.Shoot, out *Shoot, s
 	return nil
 }
 
+func autoConvert_v1alpha1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(in *ShootAutoRepairStatus, out *garden.ShootAutoRepairStatus, s conversion.Scope) error {
+	out.Pools = *(*[]garden.WorkerPoolAutoRepairStatus)(unsafe.Pointer(&in.Pools))
+	return nil
+}
+
+// Convert_v1alpha1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(in *ShootAutoRepairStatus, out *garden.ShootAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootAutoRepairStatus_To_v1alpha1_ShootAutoRepairStatus(in *garden.ShootAutoRepairStatus, out *ShootAutoRepairStatus, s conversion.Scope) error {
+	out.Pools = *(*[]WorkerPoolAutoRepairStatus)(unsafe.Pointer(&in.Pools))
+	return nil
+}
+
+// Convert_garden_ShootAutoRepairStatus_To_v1alpha1_ShootAutoRepairStatus is an autogenerated conversion function.
+func Convert_garden_ShootAutoRepairStatus_To_v1alpha1_ShootAutoRepairStatus(in *garden.ShootAutoRepairStatus, out *ShootAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootAutoRepairStatus_To_v1alpha1_ShootAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.DeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.GarbageCollectionPolicy = (*garden.BackupGarbageCollectionPolicy)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_v1alpha1_ShootBackup_To_garden_ShootBackup is an autogenerated conversion function.
+func Convert_v1alpha1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootBackup_To_garden_ShootBackup(in, out, s)
+}
+
+func autoConvert_garden_ShootBackup_To_v1alpha1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.DeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.GarbageCollectionPolicy = (*BackupGarbageCollectionPolicy)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_garden_ShootBackup_To_v1alpha1_ShootBackup is an autogenerated conversion function.
+func Convert_garden_ShootBackup_To_v1alpha1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	return autoConvert_garden_ShootBackup_To_v1alpha1_ShootBackup(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootBackupCatalog_To_core_ShootBackupCatalog(in *ShootBackupCatalog, out *core.ShootBackupCatalog, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.BackupEntryName = in.BackupEntryName
+	out.Snapshots = *(*[]core.ETCDSnapshot)(unsafe.Pointer(&in.Snapshots))
+	return nil
+}
+
+// Convert_v1alpha1_ShootBackupCatalog_To_core_ShootBackupCatalog is an autogenerated conversion function.
+func Convert_v1alpha1_ShootBackupCatalog_To_core_ShootBackupCatalog(in *ShootBackupCatalog, out *core.ShootBackupCatalog, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootBackupCatalog_To_core_ShootBackupCatalog(in, out, s)
+}
+
+func autoConvert_core_ShootBackupCatalog_To_v1alpha1_ShootBackupCatalog(in *core.ShootBackupCatalog, out *ShootBackupCatalog, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.BackupEntryName = in.BackupEntryName
+	out.Snapshots = *(*[]ETCDSnapshot)(unsafe.Pointer(&in.Snapshots))
+	return nil
+}
+
+// Convert_core_ShootBackupCatalog_To_v1alpha1_ShootBackupCatalog is an autogenerated conversion function.
+func Convert_core_ShootBackupCatalog_To_v1alpha1_ShootBackupCatalog(in *core.ShootBackupCatalog, out *ShootBackupCatalog, s conversion.Scope) error {
+	return autoConvert_core_ShootBackupCatalog_To_v1alpha1_ShootBackupCatalog(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootClone_To_core_ShootClone(in *ShootClone, out *core.ShootClone, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootCloneSpec_To_core_ShootCloneSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootClone_To_core_ShootClone is an autogenerated conversion function.
+func Convert_v1alpha1_ShootClone_To_core_ShootClone(in *ShootClone, out *core.ShootClone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootClone_To_core_ShootClone(in, out, s)
+}
+
+func autoConvert_core_ShootClone_To_v1alpha1_ShootClone(in *core.ShootClone, out *ShootClone, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootCloneSpec_To_v1alpha1_ShootCloneSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootClone_To_v1alpha1_ShootClone is an autogenerated conversion function.
+func Convert_core_ShootClone_To_v1alpha1_ShootClone(in *core.ShootClone, out *ShootClone, s conversion.Scope) error {
+	return autoConvert_core_ShootClone_To_v1alpha1_ShootClone(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootCloneSpec_To_core_ShootCloneSpec(in *ShootCloneSpec, out *core.ShootCloneSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	return nil
+}
+
+// Convert_v1alpha1_ShootCloneSpec_To_core_ShootCloneSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootCloneSpec_To_core_ShootCloneSpec(in *ShootCloneSpec, out *core.ShootCloneSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootCloneSpec_To_core_ShootCloneSpec(in, out, s)
+}
+
+func autoConvert_core_ShootCloneSpec_To_v1alpha1_ShootCloneSpec(in *core.ShootCloneSpec, out *ShootCloneSpec, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Region = (*string)(unsafe.Pointer(in.Region))
+	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	return nil
+}
+
+// Convert_core_ShootCloneSpec_To_v1alpha1_ShootCloneSpec is an autogenerated conversion function.
+func Convert_core_ShootCloneSpec_To_v1alpha1_ShootCloneSpec(in *core.ShootCloneSpec, out *ShootCloneSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootCloneSpec_To_v1alpha1_ShootCloneSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -3894,6 +4607,28 @@ func Convert_garden_ShootMachineImage_To_v1alpha1_ShootMachineImage(in *garden.S
 	return autoConvert_garden_ShootMachineImage_To_v1alpha1_ShootMachineImage(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *garden.ShootMaintenanceStatus, s conversion.Scope) error {
+	out.LastMaintenanceTime = in.LastMaintenanceTime
+	out.Tasks = *(*[]garden.MaintenanceTaskStatus)(unsafe.Pointer(&in.Tasks))
+	return nil
+}
+
+// Convert_v1alpha1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *garden.ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootMaintenanceStatus_To_v1alpha1_ShootMaintenanceStatus(in *garden.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	out.LastMaintenanceTime = in.LastMaintenanceTime
+	out.Tasks = *(*[]MaintenanceTaskStatus)(unsafe.Pointer(&in.Tasks))
+	return nil
+}
+
+// Convert_garden_ShootMaintenanceStatus_To_v1alpha1_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_garden_ShootMaintenanceStatus_To_v1alpha1_ShootMaintenanceStatus(in *garden.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootMaintenanceStatus_To_v1alpha1_ShootMaintenanceStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootNetworks_To_garden_ShootNetworks(in *ShootNetworks, out *garden.ShootNetworks, s conversion.Scope) error {
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
@@ -3926,6 +4661,7 @@ func autoConvert_v1alpha1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *gard
 	} else {
 		out.Addons = nil
 	}
+	out.Backup = (*garden.ShootBackup)(unsafe.Pointer(in.Backup))
 	out.CloudProfileName = in.CloudProfileName
 	out.DNS = (*garden.DNS)(unsafe.Pointer(in.DNS))
 	out.Extensions = *(*[]garden.Extension)(unsafe.Pointer(&in.Extensions))
@@ -3964,6 +4700,7 @@ func autoConvert_garden_ShootSpec_To_v1alpha1_ShootSpec(in *garden.ShootSpec, ou
 	} else {
 		out.Addons = nil
 	}
+	out.Backup = (*ShootBackup)(unsafe.Pointer(in.Backup))
 	// WARNING: in.Cloud requires manual conversion: does not exist in peer-type
 	out.CloudProfileName = in.CloudProfileName
 	out.DNS = (*DNS)(unsafe.Pointer(in.DNS))
@@ -4008,6 +4745,9 @@ func autoConvert_v1alpha1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 	out.Seed = (*string)(unsafe.Pointer(in.Seed))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.Maintenance = (*garden.ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.AutoRepair = (*garden.ShootAutoRepairStatus)(unsafe.Pointer(in.AutoRepair))
+	out.DNSProviders = *(*[]garden.DNSProviderStatus)(unsafe.Pointer(&in.DNSProviders))
 	return nil
 }
 
@@ -4026,6 +4766,9 @@ func autoConvert_garden_ShootStatus_To_v1alpha1_ShootStatus(in *garden.ShootStat
 	}
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.Maintenance = (*ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.AutoRepair = (*ShootAutoRepairStatus)(unsafe.Pointer(in.AutoRepair))
+	out.DNSProviders = *(*[]DNSProviderStatus)(unsafe.Pointer(&in.DNSProviders))
 	return nil
 }
 
@@ -4077,7 +4820,9 @@ func Convert_garden_VolumeType_To_v1alpha1_VolumeType(in *garden.VolumeType, out
 
 func autoConvert_v1alpha1_Worker_To_garden_Worker(in *Worker, out *garden.Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.AutoRepair = (*garden.WorkerAutoRepair)(unsafe.Pointer(in.AutoRepair))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*garden.CRI)(unsafe.Pointer(in.CRI))
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(garden.WorkerKubernetes)
@@ -4092,10 +4837,12 @@ func autoConvert_v1alpha1_Worker_To_garden_Worker(in *Worker, out *garden.Worker
 	if err := Convert_v1alpha1_Machine_To_garden_Machine(&in.Machine, &out.Machine, s); err != nil {
 		return err
 	}
+	out.MaxNodeAge = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeAge))
 	out.Maximum = int(in.Maximum)
 	out.Minimum = int(in.Minimum)
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.OperatingSystemConfig = (*garden.WorkerOperatingSystemConfig)(unsafe.Pointer(in.OperatingSystemConfig))
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*garden.Volume)(unsafe.Pointer(in.Volume))
@@ -4110,7 +4857,9 @@ func Convert_v1alpha1_Worker_To_garden_Worker(in *Worker, out *garden.Worker, s
 
 func autoConvert_garden_Worker_To_v1alpha1_Worker(in *garden.Worker, out *Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.AutoRepair = (*WorkerAutoRepair)(unsafe.Pointer(in.AutoRepair))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*CRI)(unsafe.Pointer(in.CRI))
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(WorkerKubernetes)
@@ -4125,10 +4874,12 @@ func autoConvert_garden_Worker_To_v1alpha1_Worker(in *garden.Worker, out *Worker
 	if err := Convert_garden_Machine_To_v1alpha1_Machine(&in.Machine, &out.Machine, s); err != nil {
 		return err
 	}
+	out.MaxNodeAge = (*metav1.Duration)(unsafe.Pointer(in.MaxNodeAge))
 	out.Maximum = int32(in.Maximum)
 	out.Minimum = int32(in.Minimum)
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	out.OperatingSystemConfig = (*WorkerOperatingSystemConfig)(unsafe.Pointer(in.OperatingSystemConfig))
 	out.ProviderConfig = (*ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	out.Volume = (*Volume)(unsafe.Pointer(in.Volume))
@@ -4141,6 +4892,122 @@ func Convert_garden_Worker_To_v1alpha1_Worker(in *garden.Worker, out *Worker, s
 	return autoConvert_garden_Worker_To_v1alpha1_Worker(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerAutoRepair_To_garden_WorkerAutoRepair(in *WorkerAutoRepair, out *garden.WorkerAutoRepair, s conversion.Scope) error {
+	out.UnhealthyThreshold = in.UnhealthyThreshold
+	out.MaxConcurrentRepairs = (*int32)(unsafe.Pointer(in.MaxConcurrentRepairs))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerAutoRepair_To_garden_WorkerAutoRepair is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerAutoRepair_To_garden_WorkerAutoRepair(in *WorkerAutoRepair, out *garden.WorkerAutoRepair, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerAutoRepair_To_garden_WorkerAutoRepair(in, out, s)
+}
+
+func autoConvert_garden_WorkerAutoRepair_To_v1alpha1_WorkerAutoRepair(in *garden.WorkerAutoRepair, out *WorkerAutoRepair, s conversion.Scope) error {
+	out.UnhealthyThreshold = in.UnhealthyThreshold
+	out.MaxConcurrentRepairs = (*int32)(unsafe.Pointer(in.MaxConcurrentRepairs))
+	return nil
+}
+
+// Convert_garden_WorkerAutoRepair_To_v1alpha1_WorkerAutoRepair is an autogenerated conversion function.
+func Convert_garden_WorkerAutoRepair_To_v1alpha1_WorkerAutoRepair(in *garden.WorkerAutoRepair, out *WorkerAutoRepair, s conversion.Scope) error {
+	return autoConvert_garden_WorkerAutoRepair_To_v1alpha1_WorkerAutoRepair(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerFile_To_garden_WorkerFile(in *WorkerFile, out *garden.WorkerFile, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Permissions = (*int32)(unsafe.Pointer(in.Permissions))
+	if err := Convert_v1alpha1_WorkerFileContent_To_garden_WorkerFileContent(&in.Content, &out.Content, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_WorkerFile_To_garden_WorkerFile is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerFile_To_garden_WorkerFile(in *WorkerFile, out *garden.WorkerFile, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerFile_To_garden_WorkerFile(in, out, s)
+}
+
+func autoConvert_garden_WorkerFile_To_v1alpha1_WorkerFile(in *garden.WorkerFile, out *WorkerFile, s conversion.Scope) error {
+	out.Path = in.Path
+	out.Permissions = (*int32)(unsafe.Pointer(in.Permissions))
+	if err := Convert_garden_WorkerFileContent_To_v1alpha1_WorkerFileContent(&in.Content, &out.Content, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_garden_WorkerFile_To_v1alpha1_WorkerFile is an autogenerated conversion function.
+func Convert_garden_WorkerFile_To_v1alpha1_WorkerFile(in *garden.WorkerFile, out *WorkerFile, s conversion.Scope) error {
+	return autoConvert_garden_WorkerFile_To_v1alpha1_WorkerFile(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerFileContent_To_garden_WorkerFileContent(in *WorkerFileContent, out *garden.WorkerFileContent, s conversion.Scope) error {
+	out.SecretRef = (*garden.WorkerFileContentSecretRef)(unsafe.Pointer(in.SecretRef))
+	out.Inline = (*garden.WorkerFileContentInline)(unsafe.Pointer(in.Inline))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerFileContent_To_garden_WorkerFileContent is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerFileContent_To_garden_WorkerFileContent(in *WorkerFileContent, out *garden.WorkerFileContent, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerFileContent_To_garden_WorkerFileContent(in, out, s)
+}
+
+func autoConvert_garden_WorkerFileContent_To_v1alpha1_WorkerFileContent(in *garden.WorkerFileContent, out *WorkerFileContent, s conversion.Scope) error {
+	out.SecretRef = (*WorkerFileContentSecretRef)(unsafe.Pointer(in.SecretRef))
+	out.Inline = (*WorkerFileContentInline)(unsafe.Pointer(in.Inline))
+	return nil
+}
+
+// Convert_garden_WorkerFileContent_To_v1alpha1_WorkerFileContent is an autogenerated conversion function.
+func Convert_garden_WorkerFileContent_To_v1alpha1_WorkerFileContent(in *garden.WorkerFileContent, out *WorkerFileContent, s conversion.Scope) error {
+	return autoConvert_garden_WorkerFileContent_To_v1alpha1_WorkerFileContent(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerFileContentInline_To_garden_WorkerFileContentInline(in *WorkerFileContentInline, out *garden.WorkerFileContentInline, s conversion.Scope) error {
+	out.Encoding = in.Encoding
+	out.Data = in.Data
+	return nil
+}
+
+// Convert_v1alpha1_WorkerFileContentInline_To_garden_WorkerFileContentInline is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerFileContentInline_To_garden_WorkerFileContentInline(in *WorkerFileContentInline, out *garden.WorkerFileContentInline, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerFileContentInline_To_garden_WorkerFileContentInline(in, out, s)
+}
+
+func autoConvert_garden_WorkerFileContentInline_To_v1alpha1_WorkerFileContentInline(in *garden.WorkerFileContentInline, out *WorkerFileContentInline, s conversion.Scope) error {
+	out.Encoding = in.Encoding
+	out.Data = in.Data
+	return nil
+}
+
+// Convert_garden_WorkerFileContentInline_To_v1alpha1_WorkerFileContentInline is an autogenerated conversion function.
+func Convert_garden_WorkerFileContentInline_To_v1alpha1_WorkerFileContentInline(in *garden.WorkerFileContentInline, out *WorkerFileContentInline, s conversion.Scope) error {
+	return autoConvert_garden_WorkerFileContentInline_To_v1alpha1_WorkerFileContentInline(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerFileContentSecretRef_To_garden_WorkerFileContentSecretRef(in *WorkerFileContentSecretRef, out *garden.WorkerFileContentSecretRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DataKey = in.DataKey
+	return nil
+}
+
+// Convert_v1alpha1_WorkerFileContentSecretRef_To_garden_WorkerFileContentSecretRef is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerFileContentSecretRef_To_garden_WorkerFileContentSecretRef(in *WorkerFileContentSecretRef, out *garden.WorkerFileContentSecretRef, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerFileContentSecretRef_To_garden_WorkerFileContentSecretRef(in, out, s)
+}
+
+func autoConvert_garden_WorkerFileContentSecretRef_To_v1alpha1_WorkerFileContentSecretRef(in *garden.WorkerFileContentSecretRef, out *WorkerFileContentSecretRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.DataKey = in.DataKey
+	return nil
+}
+
+// Convert_garden_WorkerFileContentSecretRef_To_v1alpha1_WorkerFileContentSecretRef is an autogenerated conversion function.
+func Convert_garden_WorkerFileContentSecretRef_To_v1alpha1_WorkerFileContentSecretRef(in *garden.WorkerFileContentSecretRef, out *WorkerFileContentSecretRef, s conversion.Scope) error {
+	return autoConvert_garden_WorkerFileContentSecretRef_To_v1alpha1_WorkerFileContentSecretRef(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerKubernetes_To_garden_WorkerKubernetes(in *WorkerKubernetes, out *garden.WorkerKubernetes, s conversion.Scope) error {
 	if in.Kubelet != nil {
 		in, out := &in.Kubelet, &out.Kubelet
@@ -4151,6 +5018,7 @@ func autoConvert_v1alpha1_WorkerKubernetes_To_garden_WorkerKubernetes(in *Worker
 	} else {
 		out.Kubelet = nil
 	}
+	out.Version = (*string)(unsafe.Pointer(in.Version))
 	return nil
 }
 
@@ -4169,6 +5037,7 @@ func autoConvert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in *garden
 	} else {
 		out.Kubelet = nil
 	}
+	out.Version = (*string)(unsafe.Pointer(in.Version))
 	return nil
 }
 
@@ -4176,3 +5045,99 @@ func autoConvert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in *garden
 func Convert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in *garden.WorkerKubernetes, out *WorkerKubernetes, s conversion.Scope) error {
 	return autoConvert_garden_WorkerKubernetes_To_v1alpha1_WorkerKubernetes(in, out, s)
 }
+
+func autoConvert_v1alpha1_WorkerOperatingSystemConfig_To_garden_WorkerOperatingSystemConfig(in *WorkerOperatingSystemConfig, out *garden.WorkerOperatingSystemConfig, s conversion.Scope) error {
+	out.Units = *(*[]garden.WorkerUnit)(unsafe.Pointer(&in.Units))
+	out.Files = *(*[]garden.WorkerFile)(unsafe.Pointer(&in.Files))
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerOperatingSystemConfig_To_garden_WorkerOperatingSystemConfig is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerOperatingSystemConfig_To_garden_WorkerOperatingSystemConfig(in *WorkerOperatingSystemConfig, out *garden.WorkerOperatingSystemConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerOperatingSystemConfig_To_garden_WorkerOperatingSystemConfig(in, out, s)
+}
+
+func autoConvert_garden_WorkerOperatingSystemConfig_To_v1alpha1_WorkerOperatingSystemConfig(in *garden.WorkerOperatingSystemConfig, out *WorkerOperatingSystemConfig, s conversion.Scope) error {
+	out.Units = *(*[]WorkerUnit)(unsafe.Pointer(&in.Units))
+	out.Files = *(*[]WorkerFile)(unsafe.Pointer(&in.Files))
+	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	return nil
+}
+
+// Convert_garden_WorkerOperatingSystemConfig_To_v1alpha1_WorkerOperatingSystemConfig is an autogenerated conversion function.
+func Convert_garden_WorkerOperatingSystemConfig_To_v1alpha1_WorkerOperatingSystemConfig(in *garden.WorkerOperatingSystemConfig, out *WorkerOperatingSystemConfig, s conversion.Scope) error {
+	return autoConvert_garden_WorkerOperatingSystemConfig_To_v1alpha1_WorkerOperatingSystemConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(in *WorkerPoolAutoRepairStatus, out *garden.WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastRepairTime = in.LastRepairTime
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(in *WorkerPoolAutoRepairStatus, out *garden.WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_garden_WorkerPoolAutoRepairStatus_To_v1alpha1_WorkerPoolAutoRepairStatus(in *garden.WorkerPoolAutoRepairStatus, out *WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastRepairTime = in.LastRepairTime
+	return nil
+}
+
+// Convert_garden_WorkerPoolAutoRepairStatus_To_v1alpha1_WorkerPoolAutoRepairStatus is an autogenerated conversion function.
+func Convert_garden_WorkerPoolAutoRepairStatus_To_v1alpha1_WorkerPoolAutoRepairStatus(in *garden.WorkerPoolAutoRepairStatus, out *WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_garden_WorkerPoolAutoRepairStatus_To_v1alpha1_WorkerPoolAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerUnit_To_garden_WorkerUnit(in *WorkerUnit, out *garden.WorkerUnit, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Command = (*string)(unsafe.Pointer(in.Command))
+	out.Enable = (*bool)(unsafe.Pointer(in.Enable))
+	out.Content = (*string)(unsafe.Pointer(in.Content))
+	out.DropIns = *(*[]garden.WorkerUnitDropIn)(unsafe.Pointer(&in.DropIns))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerUnit_To_garden_WorkerUnit is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerUnit_To_garden_WorkerUnit(in *WorkerUnit, out *garden.WorkerUnit, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerUnit_To_garden_WorkerUnit(in, out, s)
+}
+
+func autoConvert_garden_WorkerUnit_To_v1alpha1_WorkerUnit(in *garden.WorkerUnit, out *WorkerUnit, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Command = (*string)(unsafe.Pointer(in.Command))
+	out.Enable = (*bool)(unsafe.Pointer(in.Enable))
+	out.Content = (*string)(unsafe.Pointer(in.Content))
+	out.DropIns = *(*[]WorkerUnitDropIn)(unsafe.Pointer(&in.DropIns))
+	return nil
+}
+
+// Convert_garden_WorkerUnit_To_v1alpha1_WorkerUnit is an autogenerated conversion function.
+func Convert_garden_WorkerUnit_To_v1alpha1_WorkerUnit(in *garden.WorkerUnit, out *WorkerUnit, s conversion.Scope) error {
+	return autoConvert_garden_WorkerUnit_To_v1alpha1_WorkerUnit(in, out, s)
+}
+
+func autoConvert_v1alpha1_WorkerUnitDropIn_To_garden_WorkerUnitDropIn(in *WorkerUnitDropIn, out *garden.WorkerUnitDropIn, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_v1alpha1_WorkerUnitDropIn_To_garden_WorkerUnitDropIn is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerUnitDropIn_To_garden_WorkerUnitDropIn(in *WorkerUnitDropIn, out *garden.WorkerUnitDropIn, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerUnitDropIn_To_garden_WorkerUnitDropIn(in, out, s)
+}
+
+func autoConvert_garden_WorkerUnitDropIn_To_v1alpha1_WorkerUnitDropIn(in *garden.WorkerUnitDropIn, out *WorkerUnitDropIn, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Content = in.Content
+	return nil
+}
+
+// Convert_garden_WorkerUnitDropIn_To_v1alpha1_WorkerUnitDropIn is an autogenerated conversion function.
+func Convert_garden_WorkerUnitDropIn_To_v1alpha1_WorkerUnitDropIn(in *garden.WorkerUnitDropIn, out *WorkerUnitDropIn, s conversion.Scope) error {
+	return autoConvert_garden_WorkerUnitDropIn_To_v1alpha1_WorkerUnitDropIn(in, out, s)
+}
@@ -398,6 +398,60 @@ func (in *BackupEntryStatus) DeepCopy() *BackupEntryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupLimits) DeepCopyInto(out *BackupLimits) {
+	*out = *in
+	if in.MinFullSnapshotInterval != nil {
+		in, out := &in.MinFullSnapshotInterval, &out.MinFullSnapshotInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinDeltaSnapshotPeriod != nil {
+		in, out := &in.MinDeltaSnapshotPeriod, &out.MinDeltaSnapshotPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupLimits.
+func (in *BackupLimits) DeepCopy() *BackupLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRI) DeepCopyInto(out *CRI) {
+	*out = *in
+	if in.ContainerRuntimes != nil {
+		in, out := &in.ContainerRuntimes, &out.ContainerRuntimes
+		*out = make([]ContainerRuntime, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRI.
+func (in *CRI) DeepCopy() *CRI {
+	if in == nil {
+		return nil
+	}
+	out := new(CRI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudInfo) DeepCopyInto(out *CloudInfo) {
 	*out = *in
@@ -477,6 +531,11 @@ func (in *CloudProfileList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 	*out = *in
+	if in.BackupLimits != nil {
+		in, out := &in.BackupLimits, &out.BackupLimits
+		*out = new(BackupLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CABundle != nil {
 		in, out := &in.CABundle, &out.CABundle
 		*out = new(string)
@@ -616,6 +675,27 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRuntime) DeepCopyInto(out *ContainerRuntime) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(ProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntime.
+func (in *ContainerRuntime) DeepCopy() *ContainerRuntime {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRuntime)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -624,6 +704,18 @@ func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 		*out = new(ProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ControllerDeploymentVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ControllerDeploymentRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -637,12 +729,64 @@ func (in *ControllerDeployment) DeepCopy() *ControllerDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentRollout) DeepCopyInto(out *ControllerDeploymentRollout) {
+	*out = *in
+	if in.CanarySeedSelector != nil {
+		in, out := &in.CanarySeedSelector, &out.CanarySeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentRollout.
+func (in *ControllerDeploymentRollout) DeepCopy() *ControllerDeploymentRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentVersion) DeepCopyInto(out *ControllerDeploymentVersion) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(ProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentVersion.
+func (in *ControllerDeploymentVersion) DeepCopy() *ControllerDeploymentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallation) DeepCopyInto(out *ControllerInstallation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -703,6 +847,11 @@ func (in *ControllerInstallationSpec) DeepCopyInto(out *ControllerInstallationSp
 	*out = *in
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -731,6 +880,11 @@ func (in *ControllerInstallationStatus) DeepCopyInto(out *ControllerInstallation
 		*out = new(ProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeployedVersion != nil {
+		in, out := &in.DeployedVersion, &out.DeployedVersion
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -845,6 +999,16 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.LifecyclePhase != nil {
+		in, out := &in.LifecyclePhase, &out.LifecyclePhase
+		*out = new(ControllerResourceLifecyclePhase)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -915,6 +1079,11 @@ func (in *DNSIncludeExclude) DeepCopy() *DNSIncludeExclude {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSProvider) DeepCopyInto(out *DNSProvider) {
 	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
 	if in.Domains != nil {
 		in, out := &in.Domains, &out.Domains
 		*out = new(DNSIncludeExclude)
@@ -948,6 +1117,65 @@ func (in *DNSProvider) DeepCopy() *DNSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProviderStatus) DeepCopyInto(out *DNSProviderStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProviderStatus.
+func (in *DNSProviderStatus) DeepCopy() *DNSProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDSnapshot) DeepCopyInto(out *ETCDSnapshot) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDSnapshot.
+func (in *ETCDSnapshot) DeepCopy() *ETCDSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressDestination) DeepCopyInto(out *EgressDestination) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.FQDN != nil {
+		in, out := &in.FQDN, &out.FQDN
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressDestination.
+func (in *EgressDestination) DeepCopy() *EgressDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -1045,6 +1273,16 @@ func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(HibernationMode)
+		**out = **in
+	}
+	if in.ExcludedWorkerPools != nil {
+		in, out := &in.ExcludedWorkerPools, &out.ExcludedWorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Schedules != nil {
 		in, out := &in.Schedules, &out.Schedules
 		*out = make([]HibernationSchedule, len(*in))
@@ -1667,6 +1905,13 @@ func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CRI != nil {
+		in, out := &in.CRI, &out.CRI
+		*out = make([]CRI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1768,6 +2013,50 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceBlackoutWindow) DeepCopyInto(out *MaintenanceBlackoutWindow) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceBlackoutWindow.
+func (in *MaintenanceBlackoutWindow) DeepCopy() *MaintenanceBlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceBlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTaskStatus) DeepCopyInto(out *MaintenanceTaskStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTaskStatus.
+func (in *MaintenanceTaskStatus) DeepCopy() *MaintenanceTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -1802,6 +2091,13 @@ func (in *Networking) DeepCopyInto(out *Networking) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ControlPlaneEgressDestinations != nil {
+		in, out := &in.ControlPlaneEgressDestinations, &out.ControlPlaneEgressDestinations
+		*out = make([]EgressDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2160,6 +2456,18 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceBlackoutWindows != nil {
+		in, out := &in.MaintenanceBlackoutWindows, &out.MaintenanceBlackoutWindows
+		*out = make([]MaintenanceBlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShootDeletionProtection != nil {
+		in, out := &in.ShootDeletionProtection, &out.ShootDeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2466,6 +2774,43 @@ func (in *SeedBackup) DeepCopy() *SeedBackup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCapacity) DeepCopyInto(out *SeedCapacity) {
+	*out = *in
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ExcessCapacityReserved != nil {
+		in, out := &in.ExcessCapacityReserved, &out.ExcessCapacityReserved
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCapacity.
+func (in *SeedCapacity) DeepCopy() *SeedCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedDNS) DeepCopyInto(out *SeedDNS) {
 	*out = *in
@@ -2516,31 +2861,52 @@ func (in *SeedList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
+func (in *SeedLogging) DeepCopyInto(out *SeedLogging) {
 	*out = *in
-	if in.ShootDefaults != nil {
-		in, out := &in.ShootDefaults, &out.ShootDefaults
-		*out = new(ShootNetworks)
-		(*in).DeepCopyInto(*out)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedNetworks.
-func (in *SeedNetworks) DeepCopy() *SeedNetworks {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedLogging.
+func (in *SeedLogging) DeepCopy() *SeedLogging {
 	if in == nil {
 		return nil
 	}
-	out := new(SeedNetworks)
+	out := new(SeedLogging)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
+func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 	*out = *in
-	return
-}
+	if in.ShootDefaults != nil {
+		in, out := &in.ShootDefaults, &out.ShootDefaults
+		*out = new(ShootNetworks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedNetworks.
+func (in *SeedNetworks) DeepCopy() *SeedNetworks {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedNetworks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
+	*out = *in
+	return
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedProvider.
 func (in *SeedProvider) DeepCopy() *SeedProvider {
@@ -2560,12 +2926,22 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 		*out = new(SeedBackup)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupLimits != nil {
+		in, out := &in.BackupLimits, &out.BackupLimits
+		*out = new(BackupLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BlockCIDRs != nil {
 		in, out := &in.BlockCIDRs, &out.BlockCIDRs
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	out.DNS = in.DNS
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(SeedLogging)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Networks.DeepCopyInto(&out.Networks)
 	out.Provider = in.Provider
 	out.SecretRef = in.SecretRef
@@ -2605,6 +2981,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(SeedCapacity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2735,6 +3116,156 @@ func (in *Shoot) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAutoRepairStatus) DeepCopyInto(out *ShootAutoRepairStatus) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]WorkerPoolAutoRepairStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAutoRepairStatus.
+func (in *ShootAutoRepairStatus) DeepCopy() *ShootAutoRepairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAutoRepairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBackup) DeepCopyInto(out *ShootBackup) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GarbageCollectionPolicy != nil {
+		in, out := &in.GarbageCollectionPolicy, &out.GarbageCollectionPolicy
+		*out = new(BackupGarbageCollectionPolicy)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBackup.
+func (in *ShootBackup) DeepCopy() *ShootBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBackupCatalog) DeepCopyInto(out *ShootBackupCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]ETCDSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBackupCatalog.
+func (in *ShootBackupCatalog) DeepCopy() *ShootBackupCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBackupCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootBackupCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootClone) DeepCopyInto(out *ShootClone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClone.
+func (in *ShootClone) DeepCopy() *ShootClone {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootClone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootClone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCloneSpec) DeepCopyInto(out *ShootCloneSpec) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SeedName != nil {
+		in, out := &in.SeedName, &out.SeedName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCloneSpec.
+func (in *ShootCloneSpec) DeepCopy() *ShootCloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCloneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootList) DeepCopyInto(out *ShootList) {
 	*out = *in
@@ -2789,6 +3320,28 @@ func (in *ShootMachineImage) DeepCopy() *ShootMachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMaintenanceStatus) DeepCopyInto(out *ShootMaintenanceStatus) {
+	*out = *in
+	in.LastMaintenanceTime.DeepCopyInto(&out.LastMaintenanceTime)
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]MaintenanceTaskStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMaintenanceStatus.
+func (in *ShootMaintenanceStatus) DeepCopy() *ShootMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootNetworks) DeepCopyInto(out *ShootNetworks) {
 	*out = *in
@@ -2823,6 +3376,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(Addons)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(ShootBackup)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
 		*out = new(DNS)
@@ -2896,6 +3454,21 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ShootMaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRepair != nil {
+		in, out := &in.AutoRepair, &out.AutoRepair
+		*out = new(ShootAutoRepairStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSProviders != nil {
+		in, out := &in.DNSProviders, &out.DNSProviders
+		*out = make([]DNSProviderStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2956,11 +3529,21 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 			(*out)[key] = val
 		}
 	}
+	if in.AutoRepair != nil {
+		in, out := &in.AutoRepair, &out.AutoRepair
+		*out = new(WorkerAutoRepair)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CABundle != nil {
 		in, out := &in.CABundle, &out.CABundle
 		*out = new(string)
 		**out = **in
 	}
+	if in.CRI != nil {
+		in, out := &in.CRI, &out.CRI
+		*out = new(CRI)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(WorkerKubernetes)
@@ -2974,6 +3557,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		}
 	}
 	in.Machine.DeepCopyInto(&out.Machine)
+	if in.MaxNodeAge != nil {
+		in, out := &in.MaxNodeAge, &out.MaxNodeAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.MaxSurge != nil {
 		in, out := &in.MaxSurge, &out.MaxSurge
 		*out = new(intstr.IntOrString)
@@ -2984,6 +3572,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.OperatingSystemConfig != nil {
+		in, out := &in.OperatingSystemConfig, &out.OperatingSystemConfig
+		*out = new(WorkerOperatingSystemConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProviderConfig != nil {
 		in, out := &in.ProviderConfig, &out.ProviderConfig
 		*out = new(ProviderConfig)
@@ -3019,6 +3612,108 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAutoRepair) DeepCopyInto(out *WorkerAutoRepair) {
+	*out = *in
+	out.UnhealthyThreshold = in.UnhealthyThreshold
+	if in.MaxConcurrentRepairs != nil {
+		in, out := &in.MaxConcurrentRepairs, &out.MaxConcurrentRepairs
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAutoRepair.
+func (in *WorkerAutoRepair) DeepCopy() *WorkerAutoRepair {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAutoRepair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFile) DeepCopyInto(out *WorkerFile) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(int32)
+		**out = **in
+	}
+	in.Content.DeepCopyInto(&out.Content)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFile.
+func (in *WorkerFile) DeepCopy() *WorkerFile {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFileContent) DeepCopyInto(out *WorkerFileContent) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(WorkerFileContentSecretRef)
+		**out = **in
+	}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(WorkerFileContentInline)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFileContent.
+func (in *WorkerFileContent) DeepCopy() *WorkerFileContent {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFileContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFileContentInline) DeepCopyInto(out *WorkerFileContentInline) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFileContentInline.
+func (in *WorkerFileContentInline) DeepCopy() *WorkerFileContentInline {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFileContentInline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFileContentSecretRef) DeepCopyInto(out *WorkerFileContentSecretRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFileContentSecretRef.
+func (in *WorkerFileContentSecretRef) DeepCopy() *WorkerFileContentSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFileContentSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 	*out = *in
@@ -3027,6 +3722,11 @@ func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 		*out = new(KubeletConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3039,3 +3739,109 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerOperatingSystemConfig) DeepCopyInto(out *WorkerOperatingSystemConfig) {
+	*out = *in
+	if in.Units != nil {
+		in, out := &in.Units, &out.Units
+		*out = make([]WorkerUnit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]WorkerFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerOperatingSystemConfig.
+func (in *WorkerOperatingSystemConfig) DeepCopy() *WorkerOperatingSystemConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerOperatingSystemConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolAutoRepairStatus) DeepCopyInto(out *WorkerPoolAutoRepairStatus) {
+	*out = *in
+	in.LastRepairTime.DeepCopyInto(&out.LastRepairTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolAutoRepairStatus.
+func (in *WorkerPoolAutoRepairStatus) DeepCopy() *WorkerPoolAutoRepairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolAutoRepairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerUnit) DeepCopyInto(out *WorkerUnit) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.DropIns != nil {
+		in, out := &in.DropIns, &out.DropIns
+		*out = make([]WorkerUnitDropIn, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerUnit.
+func (in *WorkerUnit) DeepCopy() *WorkerUnit {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerUnit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerUnitDropIn) DeepCopyInto(out *WorkerUnitDropIn) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerUnitDropIn.
+func (in *WorkerUnitDropIn) DeepCopy() *WorkerUnitDropIn {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerUnitDropIn)
+	in.DeepCopyInto(out)
+	return out
+}
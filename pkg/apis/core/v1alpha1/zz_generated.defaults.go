@@ -14,6 +14,8 @@ import (
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&CloudProfile{}, func(obj interface{}) { SetObjectDefaults_CloudProfile(obj.(*CloudProfile)) })
 	scheme.AddTypeDefaultingFunc(&CloudProfileList{}, func(obj interface{}) { SetObjectDefaults_CloudProfileList(obj.(*CloudProfileList)) })
+	scheme.AddTypeDefaultingFunc(&ControllerRegistration{}, func(obj interface{}) { SetObjectDefaults_ControllerRegistration(obj.(*ControllerRegistration)) })
+	scheme.AddTypeDefaultingFunc(&ControllerRegistrationList{}, func(obj interface{}) { SetObjectDefaults_ControllerRegistrationList(obj.(*ControllerRegistrationList)) })
 	scheme.AddTypeDefaultingFunc(&Project{}, func(obj interface{}) { SetObjectDefaults_Project(obj.(*Project)) })
 	scheme.AddTypeDefaultingFunc(&ProjectList{}, func(obj interface{}) { SetObjectDefaults_ProjectList(obj.(*ProjectList)) })
 	scheme.AddTypeDefaultingFunc(&SecretBinding{}, func(obj interface{}) { SetObjectDefaults_SecretBinding(obj.(*SecretBinding)) })
@@ -41,6 +43,21 @@ func SetObjectDefaults_CloudProfileList(in *CloudProfileList) {
 	}
 }
 
+func SetObjectDefaults_ControllerRegistration(in *ControllerRegistration) {
+	if in.Spec.Deployment != nil {
+		if in.Spec.Deployment.Rollout != nil {
+			SetDefaults_ControllerDeploymentRollout(in.Spec.Deployment.Rollout)
+		}
+	}
+}
+
+func SetObjectDefaults_ControllerRegistrationList(in *ControllerRegistrationList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ControllerRegistration(a)
+	}
+}
+
 func SetObjectDefaults_Project(in *Project) {
 	SetDefaults_Project(in)
 }
@@ -21,6 +21,8 @@ import (
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -58,10 +60,104 @@ func ValidateControllerRegistrationSpec(spec *core.ControllerRegistrationSpec, f
 		if resource.GloballyEnabled != nil && resource.Kind != v1alpha1.ExtensionResource {
 			allErrs = append(allErrs, field.Forbidden(idxPath.Child("globallyEnabled"), fmt.Sprintf("field must not be set when kind != %s", v1alpha1.ExtensionResource)))
 		}
+		if resource.LifecyclePhase != nil {
+			if resource.Kind != v1alpha1.ExtensionResource {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("lifecyclePhase"), fmt.Sprintf("field must not be set when kind != %s", v1alpha1.ExtensionResource)))
+			} else if !availableLifecyclePhases.Has(string(*resource.LifecyclePhase)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("lifecyclePhase"), *resource.LifecyclePhase, availableLifecyclePhases.List()))
+			}
+		}
+		if len(resource.DependsOn) > 0 {
+			if resource.Kind != v1alpha1.ExtensionResource {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("dependsOn"), fmt.Sprintf("field must not be set when kind != %s", v1alpha1.ExtensionResource)))
+			} else {
+				allErrs = append(allErrs, validateControllerResourceDependencies(resource.Type, resource.DependsOn, idxPath.Child("dependsOn"))...)
+			}
+		}
 
 		resources[resource.Kind] = resource.Type
 	}
 
+	if spec.Deployment != nil {
+		allErrs = append(allErrs, validateControllerDeployment(spec.Deployment, fldPath.Child("deployment"))...)
+	}
+
+	return allErrs
+}
+
+func validateControllerDeployment(deployment *core.ControllerDeployment, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var (
+		versions         = sets.NewString()
+		versionsPath     = fldPath.Child("versions")
+		hasLatestVersion bool
+	)
+
+	for i, version := range deployment.Versions {
+		idxPath := versionsPath.Index(i)
+
+		if len(version.Version) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("version"), "field is required"))
+		} else if versions.Has(version.Version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("version"), version.Version))
+		}
+		versions.Insert(version.Version)
+
+		if version.SeedSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(version.SeedSelector, idxPath.Child("seedSelector"))...)
+		} else {
+			hasLatestVersion = true
+		}
+	}
+
+	if len(deployment.Versions) > 0 && !hasLatestVersion {
+		allErrs = append(allErrs, field.Invalid(versionsPath, len(deployment.Versions), "at least one version without a seed selector is required"))
+	}
+
+	if rollout := deployment.Rollout; rollout != nil {
+		rolloutPath := fldPath.Child("rollout")
+
+		if len(deployment.Versions) == 0 {
+			allErrs = append(allErrs, field.Forbidden(rolloutPath, "field must not be set when no versions are specified"))
+		}
+		if rollout.CanarySeedSelector == nil {
+			allErrs = append(allErrs, field.Required(rolloutPath.Child("canarySeedSelector"), "field is required"))
+		} else {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(rollout.CanarySeedSelector, rolloutPath.Child("canarySeedSelector"))...)
+		}
+		if rollout.SoakTime != nil && rollout.SoakTime.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(rolloutPath.Child("soakTime"), rollout.SoakTime.Duration.String(), "must not be negative"))
+		}
+	}
+
+	return allErrs
+}
+
+var availableLifecyclePhases = sets.NewString(
+	string(core.ControllerResourceLifecyclePhaseBeforeKubeAPIServer),
+	string(core.ControllerResourceLifecyclePhaseAfterKubeAPIServer),
+	string(core.ControllerResourceLifecyclePhaseAfterWorker),
+)
+
+func validateControllerResourceDependencies(resourceType string, dependsOn []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	dependencies := sets.NewString()
+	for i, dependency := range dependsOn {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case len(dependency) == 0:
+			allErrs = append(allErrs, field.Invalid(idxPath, dependency, "dependency must not be empty"))
+		case dependency == resourceType:
+			allErrs = append(allErrs, field.Invalid(idxPath, dependency, "resource must not depend on itself"))
+		case dependencies.Has(dependency):
+			allErrs = append(allErrs, field.Duplicate(idxPath, dependency))
+		}
+		dependencies.Insert(dependency)
+	}
+
 	return allErrs
 }
 
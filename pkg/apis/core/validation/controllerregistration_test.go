@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"time"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/test"
@@ -120,6 +122,141 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.resources[0].globallyEnabled"),
 			}))))
 		})
+
+		It("should allow to set the lifecycle phase and dependencies for kind Extension", func() {
+			phase := core.ControllerResourceLifecyclePhaseBeforeKubeAPIServer
+			controllerRegistration.Spec.Resources = []core.ControllerResource{{
+				Kind:           v1alpha1.ExtensionResource,
+				Type:           "arbitrary",
+				LifecyclePhase: &phase,
+				DependsOn:      []string{"foo", "bar"},
+			}}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid to set the lifecycle phase and dependencies for kind != Extension", func() {
+			phase := core.ControllerResourceLifecyclePhaseAfterWorker
+			ctrlResource.LifecyclePhase = &phase
+			ctrlResource.DependsOn = []string{"foo"}
+			controllerRegistration.Spec.Resources = []core.ControllerResource{ctrlResource}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.resources[0].lifecyclePhase"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.resources[0].dependsOn"),
+			}))))
+		})
+
+		It("should forbid unsupported lifecycle phases and invalid dependencies", func() {
+			phase := core.ControllerResourceLifecyclePhase("AfterEverything")
+			controllerRegistration.Spec.Resources = []core.ControllerResource{{
+				Kind:           v1alpha1.ExtensionResource,
+				Type:           "arbitrary",
+				LifecyclePhase: &phase,
+				DependsOn:      []string{"", "arbitrary", "foo", "foo"},
+			}}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.resources[0].lifecyclePhase"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.resources[0].dependsOn[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.resources[0].dependsOn[1]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.resources[0].dependsOn[3]"),
+			}))))
+		})
+
+		It("should allow versions with seed selectors and a rollout policy", func() {
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type: "helm",
+				Versions: []core.ControllerDeploymentVersion{
+					{
+						Version:      "v1.0.0",
+						SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pinned": "true"}},
+					},
+					{Version: "v1.1.0"},
+				},
+				Rollout: &core.ControllerDeploymentRollout{
+					CanarySeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					SoakTime:           &metav1.Duration{Duration: time.Hour},
+				},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid invalid versions and rollout policies", func() {
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type: "helm",
+				Versions: []core.ControllerDeploymentVersion{
+					{
+						SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pinned": "true"}},
+					},
+					{
+						Version:      "v1.0.0",
+						SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pinned": "true"}},
+					},
+					{
+						Version:      "v1.0.0",
+						SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pinned": "true"}},
+					},
+				},
+				Rollout: &core.ControllerDeploymentRollout{
+					SoakTime: &metav1.Duration{Duration: -time.Hour},
+				},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.deployment.versions[0].version"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.deployment.versions[2].version"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.deployment.versions"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.deployment.rollout.canarySeedSelector"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.deployment.rollout.soakTime"),
+			}))))
+		})
+
+		It("should forbid a rollout policy without versions", func() {
+			controllerRegistration.Spec.Deployment = &core.ControllerDeployment{
+				Type: "helm",
+				Rollout: &core.ControllerDeploymentRollout{
+					CanarySeedSelector: &metav1.LabelSelector{},
+				},
+			}
+
+			errorList := ValidateControllerRegistration(controllerRegistration)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.deployment.rollout"),
+			}))))
+		})
 	})
 
 	Describe("#ValidateControllerRegistrationUpdate", func() {
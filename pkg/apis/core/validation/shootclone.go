@@ -0,0 +1,59 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateShootClone validates a ShootClone object.
+func ValidateShootClone(shootClone *core.ShootClone) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateShootCloneSpec(&shootClone.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootCloneSpec validates the specification of a ShootClone object.
+func ValidateShootCloneSpec(spec *core.ShootCloneSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "field is required"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSLabel(spec.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), spec.Name, msg))
+		}
+	}
+
+	if spec.Namespace != nil {
+		for _, msg := range apivalidation.ValidateNamespaceName(*spec.Namespace, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("namespace"), *spec.Namespace, msg))
+		}
+	}
+
+	if spec.Region != nil && len(*spec.Region) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("region"), *spec.Region, "region must not be empty if set"))
+	}
+
+	if spec.SeedName != nil && len(*spec.SeedName) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("seedName"), *spec.SeedName, "seedName must not be empty if set"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/core"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("ShootClone validation", func() {
+	var shootClone *core.ShootClone
+
+	BeforeEach(func() {
+		shootClone = &core.ShootClone{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foo",
+				Namespace: "garden-dev",
+			},
+			Spec: core.ShootCloneSpec{
+				Name: "bar",
+			},
+		}
+	})
+
+	Describe("#ValidateShootClone", func() {
+		It("should allow a valid ShootClone", func() {
+			region, seedName, namespace := "eu-west-1", "aws-eu1", "garden-prod"
+			shootClone.Spec.Region = &region
+			shootClone.Spec.SeedName = &seedName
+			shootClone.Spec.Namespace = &namespace
+
+			Expect(ValidateShootClone(shootClone)).To(BeEmpty())
+		})
+
+		It("should forbid a ShootClone without name", func() {
+			shootClone.Spec.Name = ""
+
+			Expect(ValidateShootClone(shootClone)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.name"),
+			}))))
+		})
+
+		It("should forbid invalid names and namespaces", func() {
+			namespace := "Garden_Dev"
+			shootClone.Spec.Name = "Bar.Baz"
+			shootClone.Spec.Namespace = &namespace
+
+			Expect(ValidateShootClone(shootClone)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.namespace"),
+			}))))
+		})
+
+		It("should forbid empty regions and seed names", func() {
+			region, seedName := "", ""
+			shootClone.Spec.Region = &region
+			shootClone.Spec.SeedName = &seedName
+
+			Expect(ValidateShootClone(shootClone)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.region"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.seedName"),
+			}))))
+		})
+	})
+})
@@ -325,6 +325,18 @@ func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 		*out = new(ProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]ControllerDeploymentVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ControllerDeploymentRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -338,12 +350,64 @@ func (in *ControllerDeployment) DeepCopy() *ControllerDeployment {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentRollout) DeepCopyInto(out *ControllerDeploymentRollout) {
+	*out = *in
+	if in.CanarySeedSelector != nil {
+		in, out := &in.CanarySeedSelector, &out.CanarySeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SoakTime != nil {
+		in, out := &in.SoakTime, &out.SoakTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentRollout.
+func (in *ControllerDeploymentRollout) DeepCopy() *ControllerDeploymentRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerDeploymentVersion) DeepCopyInto(out *ControllerDeploymentVersion) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(ProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedSelector != nil {
+		in, out := &in.SeedSelector, &out.SeedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerDeploymentVersion.
+func (in *ControllerDeploymentVersion) DeepCopy() *ControllerDeploymentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerDeploymentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerInstallation) DeepCopyInto(out *ControllerInstallation) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -404,6 +468,11 @@ func (in *ControllerInstallationSpec) DeepCopyInto(out *ControllerInstallationSp
 	*out = *in
 	out.RegistrationRef = in.RegistrationRef
 	out.SeedRef = in.SeedRef
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -432,6 +501,11 @@ func (in *ControllerInstallationStatus) DeepCopyInto(out *ControllerInstallation
 		*out = new(ProviderConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DeployedVersion != nil {
+		in, out := &in.DeployedVersion, &out.DeployedVersion
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -546,6 +620,16 @@ func (in *ControllerResource) DeepCopyInto(out *ControllerResource) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.LifecyclePhase != nil {
+		in, out := &in.LifecyclePhase, &out.LifecyclePhase
+		*out = new(ControllerResourceLifecyclePhase)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -559,6 +643,23 @@ func (in *ControllerResource) DeepCopy() *ControllerResource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDSnapshot) DeepCopyInto(out *ETCDSnapshot) {
+	*out = *in
+	in.CreationTimestamp.DeepCopyInto(&out.CreationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDSnapshot.
+func (in *ETCDSnapshot) DeepCopy() *ETCDSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Endpoint) DeepCopyInto(out *Endpoint) {
 	*out = *in
@@ -765,3 +866,94 @@ func (in *ProviderConfig) DeepCopy() *ProviderConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBackupCatalog) DeepCopyInto(out *ShootBackupCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.Snapshots != nil {
+		in, out := &in.Snapshots, &out.Snapshots
+		*out = make([]ETCDSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBackupCatalog.
+func (in *ShootBackupCatalog) DeepCopy() *ShootBackupCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBackupCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootBackupCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootClone) DeepCopyInto(out *ShootClone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClone.
+func (in *ShootClone) DeepCopy() *ShootClone {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootClone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootClone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootCloneSpec) DeepCopyInto(out *ShootCloneSpec) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	if in.Region != nil {
+		in, out := &in.Region, &out.Region
+		*out = new(string)
+		**out = **in
+	}
+	if in.SeedName != nil {
+		in, out := &in.SeedName, &out.SeedName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootCloneSpec.
+func (in *ShootCloneSpec) DeepCopy() *ShootCloneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootCloneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -49,8 +49,12 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&BackupEntryList{},
 		&Cluster{},
 		&ClusterList{},
+		&ContainerRuntime{},
+		&ContainerRuntimeList{},
 		&ControlPlane{},
 		&ControlPlaneList{},
+		&DNSRecord{},
+		&DNSRecordList{},
 		&Extension{},
 		&ExtensionList{},
 		&Infrastructure{},
@@ -29,6 +29,8 @@ type Status interface {
 	// GetLastError retrieves the LastError of a status.
 	// LastError may be nil.
 	GetLastError() LastError
+	// GetConditions retrieves the health conditions reported by the extension controller.
+	GetConditions() []gardencorev1alpha1.Condition
 }
 
 // LastOperation is the last operation on an object.
@@ -16,6 +16,18 @@ package v1alpha1
 
 import gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 
+const (
+	// ConditionTypeControlPlaneHealthy is a condition type reported by extension controllers indicating the health
+	// of the control plane components they manage.
+	ConditionTypeControlPlaneHealthy gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
+	// ConditionTypeSystemComponentsHealthy is a condition type reported by extension controllers indicating the health
+	// of the system components they manage in the shoot cluster.
+	ConditionTypeSystemComponentsHealthy gardencorev1alpha1.ConditionType = "SystemComponentsHealthy"
+	// ConditionTypeEveryNodeReady is a condition type reported by extension controllers indicating the health of the
+	// nodes they manage.
+	ConditionTypeEveryNodeReady gardencorev1alpha1.ConditionType = "EveryNodeReady"
+)
+
 // DefaultSpec contains common status fields for every extension resource.
 type DefaultSpec struct {
 	// Type contains the instance of the resource's kind.
@@ -29,7 +41,9 @@ func (d *DefaultSpec) GetExtensionType() string {
 
 // DefaultStatus contains common status fields for every extension resource.
 type DefaultStatus struct {
-	// Conditions represents the latest available observations of a Seed's current state.
+	// Conditions represents the latest available observations of the resource's current state. Extension controllers
+	// report the health of the components they manage with the condition types ControlPlaneHealthy,
+	// SystemComponentsHealthy and EveryNodeReady and refresh their lastUpdateTime with every health check.
 	// +optional
 	Conditions []gardencorev1alpha1.Condition `json:"conditions,omitempty"`
 	// LastError holds information about the last occurred error during an operation.
@@ -60,6 +74,11 @@ func (d *DefaultStatus) GetLastError() LastError {
 	return d.LastError
 }
 
+// GetConditions implements Status.
+func (d *DefaultStatus) GetConditions() []gardencorev1alpha1.Condition {
+	return d.Conditions
+}
+
 // GetObservedGeneration implements Status.
 func (d *DefaultStatus) GetObservedGeneration() int64 {
 	return d.ObservedGeneration
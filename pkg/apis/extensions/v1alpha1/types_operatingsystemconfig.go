@@ -86,8 +86,25 @@ type OperatingSystemConfigSpec struct {
 	// ProviderConfig is the configuration passed to extension resource.
 	// +optional
 	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
+	// CRI config is a structure contains configurations of the CRI library
+	// +optional
+	CRIConfig *CRIConfig `json:"criConfig,omitempty"`
+}
+
+// CRIConfig contains configurations of the CRI library.
+type CRIConfig struct {
+	// Name is a mandatory string containing the name of the CRI library.
+	Name CRIName `json:"name"`
 }
 
+// CRIName is a type alias for the CRI name string.
+type CRIName string
+
+const (
+	// CRINameContainerD is a constant for ContainerD CRI name.
+	CRINameContainerD CRIName = "containerd"
+)
+
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
 type Unit struct {
 	// Name is the name of a unit.
@@ -120,6 +120,22 @@ type WorkerPool struct {
 	// Zones contains information about availability zones for this worker pool.
 	// +optional
 	Zones []string `json:"zones,omitempty"`
+	// Hibernated is true if the machines of this worker pool shall be terminated because the shoot is hibernated. It
+	// is false for pools which are excluded from the hibernation.
+	// +optional
+	Hibernated bool `json:"hibernated,omitempty"`
+	// KubernetesVersion is the semantic Kubernetes version of the kubelets of this worker pool. If it is not set then
+	// the nodes use the Kubernetes version of the control plane.
+	// +optional
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
+	// NodeGeneration is incremented by Gardener whenever the nodes of this worker pool shall be replaced although
+	// their configuration did not change (e.g., because it was requested by the user or because the nodes exceeded
+	// their maximum age). Extension controllers must roll the machines of this worker pool when it changes.
+	// +optional
+	NodeGeneration int64 `json:"nodeGeneration,omitempty"`
+	// NodeGenerationTimestamp is the time at which the NodeGeneration was set to its current value.
+	// +optional
+	NodeGenerationTimestamp *metav1.Time `json:"nodeGenerationTimestamp,omitempty"`
 }
 
 // MachineImage contains logical information about the name and the version of the machie image that
@@ -103,6 +103,37 @@ func DetermineCloudProviderInShoot(cloudObj garden.Cloud) (garden.CloudProvider,
 	return cloud, nil
 }
 
+// GetShootCloudProviderWorkers retrieves the cloud-specific workers of the given Shoot cloud section.
+func GetShootCloudProviderWorkers(cloudProvider garden.CloudProvider, cloud garden.Cloud) []garden.Worker {
+	switch cloudProvider {
+	case garden.CloudProviderAWS:
+		if cloud.AWS != nil {
+			return cloud.AWS.Workers
+		}
+	case garden.CloudProviderAzure:
+		if cloud.Azure != nil {
+			return cloud.Azure.Workers
+		}
+	case garden.CloudProviderGCP:
+		if cloud.GCP != nil {
+			return cloud.GCP.Workers
+		}
+	case garden.CloudProviderAlicloud:
+		if cloud.Alicloud != nil {
+			return cloud.Alicloud.Workers
+		}
+	case garden.CloudProviderOpenStack:
+		if cloud.OpenStack != nil {
+			return cloud.OpenStack.Workers
+		}
+	case garden.CloudProviderPacket:
+		if cloud.Packet != nil {
+			return cloud.Packet.Workers
+		}
+	}
+	return nil
+}
+
 // DetermineLatestMachineImageVersions determines the latest versions (semVer) of the given machine images from a slice of machine images
 func DetermineLatestMachineImageVersions(images []garden.MachineImage) (map[string]garden.MachineImageVersion, error) {
 	resultMapVersions := make(map[string]garden.MachineImageVersion)
@@ -66,6 +66,8 @@ type CloudProfileSpec struct {
 	Alicloud *AlicloudProfile
 	// Packet is the profile specification for the Packet cloud.
 	Packet *PacketProfile
+	// BackupLimits contains limits for the etcd backup settings in the Shoot specification.
+	BackupLimits *BackupLimits
 	// CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.
 	CABundle *string
 	//
@@ -89,6 +91,16 @@ type CloudProfileSpec struct {
 	VolumeTypes []VolumeType
 }
 
+// BackupLimits contains limits for the etcd backup settings in the Shoot specification.
+type BackupLimits struct {
+	// MinFullSnapshotInterval is the minimum interval between two full snapshots of the etcd a Shoot may configure.
+	MinFullSnapshotInterval *metav1.Duration
+	// MinDeltaSnapshotPeriod is the minimum period between two delta snapshots of the etcd a Shoot may configure.
+	MinDeltaSnapshotPeriod *metav1.Duration
+	// MaxBackups is the maximum number of full snapshots of the etcd a Shoot may retain.
+	MaxBackups *int32
+}
+
 // KubernetesSettings contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.
 type KubernetesSettings struct {
 	// Versions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.
@@ -101,6 +113,9 @@ type CloudProfileMachineImage struct {
 	Name string
 	// Versions contains versions and expiration dates of the machine image
 	Versions []ExpirableVersion
+	// CRI is the list of container runtime interfaces and container runtimes supported by the machine image. If it is
+	// empty then only the docker CRI is supported.
+	CRI []CRI
 }
 
 // ExpirableVersion contains a version and an expiration date.
@@ -465,6 +480,27 @@ type ProjectSpec struct {
 	ProjectMembers []ProjectMember
 	// Namespace is the name of the namespace that has been created for the Project object.
 	Namespace *string
+	// MaintenanceBlackoutWindows is a list of periods of time in which no automatic maintenance operations are
+	// performed for the Shoots of this project.
+	MaintenanceBlackoutWindows []MaintenanceBlackoutWindow
+	// ShootDeletionProtection defines whether Shoots created in this project are protected against deletion by
+	// default.
+	ShootDeletionProtection *bool
+}
+
+// MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.
+type MaintenanceBlackoutWindow struct {
+	// Name is the unique name of the blackout window.
+	Name string
+	// Begin is the point in time when the blackout window starts.
+	Begin metav1.Time
+	// End is the point in time when the blackout window ends.
+	End metav1.Time
+	// Reason is a human-readable explanation why automatic maintenance operations are blocked.
+	Reason *string
+	// ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector
+	// matches all Shoots.
+	ShootSelector *metav1.LabelSelector
 }
 
 // ProjectMember is a member of a project.
@@ -559,8 +595,12 @@ type SeedSpec struct {
 	// If backup field is present in Seed, then backups of the etcd from Shoot controlplane will be stored under the
 	// configured object store.
 	Backup *SeedBackup
+	// BackupLimits contains limits for the etcd backup settings of Shoots whose control plane runs in this Seed.
+	BackupLimits *BackupLimits
 	// Volume contains settings for persistentvolumes created in the seed cluster.
 	Volume *SeedVolume
+	// Logging contains settings for the logging of the seed cluster and the shoot control planes running in it.
+	Logging *SeedLogging
 }
 
 const (
@@ -592,6 +632,22 @@ type SeedStatus struct {
 	// ObservedGeneration is the most recent generation observed for this Seed. It corresponds to the
 	// Seed's generation, which is updated on mutation by the API Server.
 	ObservedGeneration int64
+	// Capacity contains the allocatable and requested resources of the Seed cluster and the number of hosted
+	// shoot control planes.
+	Capacity *SeedCapacity
+}
+
+// SeedCapacity contains information about the resources of a Seed cluster.
+type SeedCapacity struct {
+	// Allocatable is the sum of the allocatable CPU and memory of all nodes of the Seed cluster.
+	Allocatable corev1.ResourceList
+	// Requested is the sum of the CPU and memory requests of all pods of the Seed cluster which are not terminated.
+	Requested corev1.ResourceList
+	// ExcessCapacityReserved is the CPU and memory reserved for new shoot control planes. It is empty if no
+	// excess capacity is reserved.
+	ExcessCapacityReserved corev1.ResourceList
+	// ShootControlPlanes is the number of shoot control planes hosted by the Seed cluster.
+	ShootControlPlanes int
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -626,6 +682,13 @@ type SeedVolumeProvider struct {
 	Name string
 }
 
+// SeedLogging contains settings for the logging of the seed cluster.
+type SeedLogging struct {
+	// Endpoint is the URL of an external HTTP(S) endpoint the logs of the seed cluster are shipped to. It is only
+	// used if the external logging backend is configured for the Gardener controller manager.
+	Endpoint *string
+}
+
 // SeedVolumeProviderPurposeEtcdMain is a constant for the etcd-main volume provider purpose.
 const SeedVolumeProviderPurposeEtcdMain = "etcd-main"
 
@@ -664,6 +727,9 @@ const (
 	// SeedTaintInvisible is a constant for a taint key on a seed that marks it as invisible. Invisible seeds
 	// are not considered by the gardener-scheduler.
 	SeedTaintInvisible = "seed.gardener.cloud/invisible"
+	// SeedTaintCordoned is a constant for a taint key on a seed that marks it as cordoned. No new shoots may be
+	// scheduled onto cordoned seeds while the existing ones keep running.
+	SeedTaintCordoned = "seed.gardener.cloud/cordoned"
 )
 
 ////////////////////////////////////////////////////
@@ -788,6 +854,8 @@ type ShootList struct {
 type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
 	Addons *Addons
+	// Backup contains settings for the backups of the etcd of the Shoot.
+	Backup *ShootBackup
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud
 	// CloudProfileName is a name of a CloudProfile object.
@@ -856,8 +924,80 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID
+	// Maintenance holds information about the last maintenance operation of the Shoot.
+	Maintenance *ShootMaintenanceStatus
+	// AutoRepair holds information about the machines which were replaced because their nodes stayed unhealthy.
+	AutoRepair *ShootAutoRepairStatus
+	// DNSProviders contains the status of the DNS records of the additional DNS providers of the Shoot.
+	DNSProviders []DNSProviderStatus
 }
 
+// ShootMaintenanceStatus contains information about the last maintenance operation of a Shoot.
+type ShootMaintenanceStatus struct {
+	// LastMaintenanceTime is the point in time when the last maintenance operation was executed.
+	LastMaintenanceTime metav1.Time
+	// Tasks contains the results of the maintenance tasks which were executed during the last maintenance operation.
+	Tasks []MaintenanceTaskStatus
+}
+
+// ShootAutoRepairStatus contains information about the auto repair of the worker pools of a Shoot.
+type ShootAutoRepairStatus struct {
+	// Pools contains the auto repair status of the worker pools whose machines have been replaced.
+	Pools []WorkerPoolAutoRepairStatus
+}
+
+// WorkerPoolAutoRepairStatus contains information about the last auto repair of a worker pool.
+type WorkerPoolAutoRepairStatus struct {
+	// Name is the name of the worker pool.
+	Name string
+	// LastRepairTime is the point in time when machines of the worker pool were replaced the last time.
+	LastRepairTime metav1.Time
+}
+
+// MaintenanceTaskStatus contains the result of a single maintenance task.
+type MaintenanceTaskStatus struct {
+	// Name is the name of the maintenance task.
+	Name string
+	// State is the result of the maintenance task.
+	State MaintenanceTaskState
+	// Description is a human-readable message describing what the maintenance task changed or why it failed.
+	Description string
+}
+
+// MaintenanceTaskState is a string alias.
+type MaintenanceTaskState string
+
+const (
+	// MaintenanceTaskStateSucceeded indicates that the maintenance task has changed the Shoot successfully.
+	MaintenanceTaskStateSucceeded MaintenanceTaskState = "Succeeded"
+	// MaintenanceTaskStateSkipped indicates that the maintenance task did not apply to the Shoot.
+	MaintenanceTaskStateSkipped MaintenanceTaskState = "Skipped"
+	// MaintenanceTaskStateFailed indicates that the maintenance task has failed.
+	MaintenanceTaskStateFailed MaintenanceTaskState = "Failed"
+)
+
+// DNSProviderStatus contains the status of the DNS record of an additional DNS provider of a Shoot.
+type DNSProviderStatus struct {
+	// Type is the type of the DNS provider.
+	Type string
+	// Domain is the domain for which the DNS record is managed.
+	Domain string
+	// State is the state of the DNS record.
+	State DNSProviderState
+	// Description is a human-readable message describing why the DNS record is not ready.
+	Description string
+}
+
+// DNSProviderState is a string alias.
+type DNSProviderState string
+
+const (
+	// DNSProviderStateReady indicates that the DNS record of the DNS provider is ready.
+	DNSProviderStateReady DNSProviderState = "Ready"
+	// DNSProviderStateError indicates that the DNS record of the DNS provider could not be created.
+	DNSProviderStateError DNSProviderState = "Error"
+)
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -877,6 +1017,18 @@ type Networking struct {
 	Nodes string
 	// Services is the CIDR of the service network.
 	Services *string
+	// ControlPlaneEgressDestinations is a list of additional destinations which the control plane components of
+	// the Shoot (e.g., the kube-apiserver calling webhooks) are allowed to reach.
+	ControlPlaneEgressDestinations []EgressDestination
+}
+
+// EgressDestination is a destination which the control plane components of a Shoot are allowed to reach. Exactly
+// one of the fields must be set.
+type EgressDestination struct {
+	// CIDR is a network block in CIDR notation.
+	CIDR *string
+	// FQDN is a fully qualified domain name. It is resolved to its IPv4 addresses whenever the Shoot is reconciled.
+	FQDN *string
 }
 
 // Cloud contains information about the cloud environment and their specific settings.
@@ -1221,6 +1373,10 @@ type DNS struct {
 
 // DNSProvider contains information about a DNS provider.
 type DNSProvider struct {
+	// Domain is the domain for which this provider shall create a DNS record pointing to the API server of the shoot
+	// cluster. It is only relevant for additional providers, i.e., all providers except the first one. If it is not
+	// set then the external domain of the shoot is used, e.g., to create the same record in a private hosted zone.
+	Domain *string
 	// Domains contains information about which domains shall be included/excluded for this provider.
 	Domains *DNSIncludeExclude
 	// SecretName is a name of a secret containing credentials for the stated domain and the
@@ -1263,14 +1419,56 @@ const (
 	CloudProviderPacket CloudProvider = "packet"
 )
 
+// ShootBackup contains settings for the backups of the etcd of the Shoot.
+type ShootBackup struct {
+	// Schedule is the cron schedule in which full snapshots of the etcd are taken.
+	Schedule *string
+	// DeltaSnapshotPeriod is the period after which delta snapshots of the etcd are taken.
+	DeltaSnapshotPeriod *metav1.Duration
+	// GarbageCollectionPolicy is the policy used to garbage collect old snapshots of the etcd.
+	GarbageCollectionPolicy *BackupGarbageCollectionPolicy
+	// MaxBackups is the number of full snapshots of the etcd which are retained. It is only used for the
+	// `LimitBased` garbage collection policy.
+	MaxBackups *int32
+}
+
+// BackupGarbageCollectionPolicy is a string alias.
+type BackupGarbageCollectionPolicy string
+
+const (
+	// BackupGarbageCollectionPolicyExponential is a constant for a garbage collection policy which retains the latest
+	// snapshots of every hour, day and week.
+	BackupGarbageCollectionPolicyExponential BackupGarbageCollectionPolicy = "Exponential"
+	// BackupGarbageCollectionPolicyLimitBased is a constant for a garbage collection policy which retains a fixed
+	// number of full snapshots.
+	BackupGarbageCollectionPolicyLimitBased BackupGarbageCollectionPolicy = "LimitBased"
+)
+
 // Hibernation contains information whether the Shoot is suspended or not.
 type Hibernation struct {
 	// Enabled is true if the Shoot's desired state is hibernated, false otherwise.
 	Enabled *bool
+	// Mode determines which parts of the Shoot are hibernated. Defaults to `Full` if not specified.
+	Mode *HibernationMode
+	// ExcludedWorkerPools is a list of names of worker pools which keep running while the Shoot is hibernated.
+	// It may only be set if the mode is `WorkersOnly`.
+	ExcludedWorkerPools []string
 	// Schedules determines the hibernation schedules.
 	Schedules []HibernationSchedule
 }
 
+// HibernationMode is a string alias.
+type HibernationMode string
+
+const (
+	// HibernationModeFull is a constant for the hibernation mode which scales down the worker pools and the
+	// entire control plane of the Shoot.
+	HibernationModeFull HibernationMode = "Full"
+	// HibernationModeWorkersOnly is a constant for the hibernation mode which only scales down the worker pools
+	// while the control plane of the Shoot stays available.
+	HibernationModeWorkersOnly HibernationMode = "WorkersOnly"
+)
+
 // HibernationSchedule determines the hibernation schedule of a Shoot.
 // A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
 // Start or End can be omitted, though at least one of each has to be specified.
@@ -1616,8 +1814,12 @@ type Provider struct {
 type Worker struct {
 	// Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.
 	Annotations map[string]string
+	// AutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.
+	AutoRepair *WorkerAutoRepair
 	// CABundle is a certificate bundle which will be installed onto every machine of this worker pool.
 	CABundle *string
+	// CRI contains configurations of CRI support of every machine in the worker pool.
+	CRI *CRI
 	// Kubernetes contains configuration for Kubernetes components related to this worker pool.
 	Kubernetes *WorkerKubernetes
 	// Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.
@@ -1626,6 +1828,8 @@ type Worker struct {
 	Name string
 	// Machine contains information about the machine type and image.
 	Machine Machine
+	// MaxNodeAge is the maximum age of the nodes of this worker pool. Once it is exceeded, the nodes are rolled.
+	MaxNodeAge *metav1.Duration
 	// Maximum is the maximum number of VMs to create.
 	Maximum int
 	// Minimum is the minimum number of VMs to create.
@@ -1634,6 +1838,9 @@ type Worker struct {
 	MaxSurge *intstr.IntOrString
 	// MaxUnavailable is the maximum number of VMs that can be unavailable during an update.
 	MaxUnavailable *intstr.IntOrString
+	// OperatingSystemConfig contains additional systemd units, files and kernel settings which are added to the
+	// operating system configuration of every machine in this worker pool.
+	OperatingSystemConfig *WorkerOperatingSystemConfig
 	// ProviderConfig is the provider-specific configuration for this worker pool.
 	ProviderConfig *ProviderConfig
 	// Taints is a list of taints for all the `Node` objects in this worker pool.
@@ -1650,6 +1857,14 @@ type WorkerMigrationInfo map[string]WorkerMigrationData
 
 // WorkerMigrationInfo is used to store fields that are not present in both API versions (core/garden).
 type WorkerMigrationData struct {
+	// AutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.
+	AutoRepair *WorkerAutoRepair
+	// KubernetesVersion is the semantic Kubernetes version to use for the kubelets of this worker pool.
+	KubernetesVersion *string
+	// MaxNodeAge is the maximum age of the nodes of this worker pool. Once it is exceeded, the nodes are rolled.
+	MaxNodeAge *metav1.Duration
+	// OperatingSystemConfig contains additional systemd units, files and kernel settings for this worker pool.
+	OperatingSystemConfig *WorkerOperatingSystemConfig
 	// ProviderConfig is the provider-specific configuration for this worker pool.
 	ProviderConfig *ProviderConfig
 	// Volume contains information about the volume type and size.
@@ -1659,10 +1874,118 @@ type WorkerMigrationData struct {
 	Zones []string
 }
 
+// WorkerAutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.
+type WorkerAutoRepair struct {
+	// UnhealthyThreshold is the duration a node must be continuously unhealthy before its machine is replaced.
+	UnhealthyThreshold metav1.Duration
+	// MaxConcurrentRepairs is the maximum number of machines of this worker pool which are replaced at the same
+	// time because their nodes are unhealthy.
+	MaxConcurrentRepairs *int32
+}
+
+// WorkerOperatingSystemConfig contains additional systemd units, files and kernel settings for the machines of a
+// worker pool. Units and files owned by Gardener must not be overwritten.
+type WorkerOperatingSystemConfig struct {
+	// Units is a list of additional systemd units.
+	Units []WorkerUnit
+	// Files is a list of additional files that should get written to the host's file system.
+	Files []WorkerFile
+	// Sysctls is a map of kernel parameters to their values. They take precedence over the kernel settings
+	// configured by Gardener.
+	Sysctls map[string]string
+}
+
+// WorkerUnit is an additional systemd unit for the machines of a worker pool.
+type WorkerUnit struct {
+	// Name is the name of the unit.
+	Name string
+	// Command is the command which is executed for the unit (start, restart or stop).
+	Command *string
+	// Enable describes whether the unit is enabled or not.
+	Enable *bool
+	// Content is the unit's content.
+	Content *string
+	// DropIns is a list of drop-ins for this unit.
+	DropIns []WorkerUnitDropIn
+}
+
+// WorkerUnitDropIn is a drop-in configuration for a systemd unit.
+type WorkerUnitDropIn struct {
+	// Name is the name of the drop-in.
+	Name string
+	// Content is the content of the drop-in.
+	Content string
+}
+
+// WorkerFile is an additional file that should get written to the host's file system. The content can either be
+// inlined or referenced from a secret in the namespace of the Shoot.
+type WorkerFile struct {
+	// Path is the path of the file system where the file should get written to.
+	Path string
+	// Permissions describes with which permissions the file should get written to the file system.
+	Permissions *int32
+	// Content describes the file's content.
+	Content WorkerFileContent
+}
+
+// WorkerFileContent can either reference a secret or contain inline configuration.
+type WorkerFileContent struct {
+	// SecretRef is a reference to a key of a secret in the namespace of the Shoot.
+	SecretRef *WorkerFileContentSecretRef
+	// Inline contains the inlined data of the file.
+	Inline *WorkerFileContentInline
+}
+
+// WorkerFileContentSecretRef contains keys for referencing a file content's data from a secret in the namespace
+// of the Shoot.
+type WorkerFileContentSecretRef struct {
+	// Name is the name of the secret.
+	Name string
+	// DataKey is the key in the secret's `.data` field that should be read.
+	DataKey string
+}
+
+// WorkerFileContentInline contains keys for inlining a file content's data and encoding.
+type WorkerFileContentInline struct {
+	// Encoding is the file's encoding (empty or b64).
+	Encoding string
+	// Data is the file's data.
+	Data string
+}
+
 // WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.
 type WorkerKubernetes struct {
 	// Kubelet contains configuration settings for all kubelets of this worker pool.
 	Kubelet *KubeletConfig
+	// Version is the semantic Kubernetes version to use for the kubelets of this worker pool. It must not be newer than
+	// the version of the control plane and at most two minor versions older. Defaults to the control plane version.
+	Version *string
+}
+
+// CRI contains information about the Container Runtimes.
+type CRI struct {
+	// Name is the name of the CRI library.
+	Name CRIName
+	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
+	ContainerRuntimes []ContainerRuntime
+}
+
+// CRIName is a type alias for the CRI name string.
+type CRIName string
+
+const (
+	// CRINameDocker is a constant for the docker CRI.
+	CRINameDocker CRIName = "docker"
+	// CRINameContainerD is a constant for the containerd CRI.
+	CRINameContainerD CRIName = "containerd"
+)
+
+// ContainerRuntime contains information about worker's available container runtime.
+type ContainerRuntime struct {
+	// Type is the type of the Container Runtime.
+	Type string
+	// ProviderConfig is the configuration passed to the ContainerRuntime resource.
+	ProviderConfig *ProviderConfig
 }
 
 // Machine contains information about the machine type and image.
@@ -1752,6 +2075,26 @@ const (
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable ConditionType = "Available"
+	// SeedDrained is a constant for a condition type indicating the progress of draining the Seed cluster.
+	SeedDrained ConditionType = "Drained"
+	// SeedMonitoringHealthy is a constant for a condition type indicating the health of the monitoring components (Prometheus,
+	// Alertmanager, Grafana) of the Seed cluster.
+	SeedMonitoringHealthy ConditionType = "MonitoringHealthy"
+	// SeedLoggingHealthy is a constant for a condition type indicating the health of the logging components (fluent-bit, fluentd,
+	// Elasticsearch, Kibana) of the Seed cluster.
+	SeedLoggingHealthy ConditionType = "LoggingHealthy"
+	// SeedAutoscalingHealthy is a constant for a condition type indicating the health of the autoscaling components (VPA, HVPA) of the
+	// Seed cluster.
+	SeedAutoscalingHealthy ConditionType = "AutoscalingHealthy"
+	// SeedResourceManagerHealthy is a constant for a condition type indicating the health of the gardener-resource-manager of the Seed
+	// cluster.
+	SeedResourceManagerHealthy ConditionType = "ResourceManagerHealthy"
+	// SeedIngressHealthy is a constant for a condition type indicating the health of the nginx-ingress controller of the Seed
+	// cluster, which is required to expose the monitoring and logging dashboards.
+	SeedIngressHealthy ConditionType = "IngressHealthy"
+	// SeedExcessCapacityReserved is a constant for a condition type indicating whether the desired excess capacity for new shoot
+	// control planes is reserved in the Seed cluster.
+	SeedExcessCapacityReserved ConditionType = "ExcessCapacityReserved"
 
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy ConditionType = "ControlPlaneHealthy"
@@ -1761,6 +2104,9 @@ const (
 	ShootSystemComponentsHealthy ConditionType = "SystemComponentsHealthy"
 	// ShootAPIServerAvailable is a constant for a condition type indicating the api server is available.
 	ShootAPIServerAvailable ConditionType = "APIServerAvailable"
+	// ShootETCDRestored is a constant for a condition type indicating the result of the last point-in-time restoration
+	// of the main etcd.
+	ShootETCDRestored ConditionType = "ETCDRestored"
 )
 
 ////////////////////////////////////////////////////
@@ -1459,9 +1459,27 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
+				w.AutoRepair = data.AutoRepair
+				w.MaxNodeAge = data.MaxNodeAge
+				w.OperatingSystemConfig = data.OperatingSystemConfig
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
+
+				if data.KubernetesVersion != nil {
+					if w.Kubernetes == nil {
+						w.Kubernetes = &garden.WorkerKubernetes{}
+					}
+					w.Kubernetes.Version = data.KubernetesVersion
+				}
 			}
 
 			if w.Zones == nil {
@@ -1590,9 +1608,27 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
+				w.AutoRepair = data.AutoRepair
+				w.MaxNodeAge = data.MaxNodeAge
+				w.OperatingSystemConfig = data.OperatingSystemConfig
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
+
+				if data.KubernetesVersion != nil {
+					if w.Kubernetes == nil {
+						w.Kubernetes = &garden.WorkerKubernetes{}
+					}
+					w.Kubernetes.Version = data.KubernetesVersion
+				}
 			}
 
 			out.Spec.Provider.Workers = append(out.Spec.Provider.Workers, w)
@@ -1722,9 +1758,27 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
+				w.AutoRepair = data.AutoRepair
+				w.MaxNodeAge = data.MaxNodeAge
+				w.OperatingSystemConfig = data.OperatingSystemConfig
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
+
+				if data.KubernetesVersion != nil {
+					if w.Kubernetes == nil {
+						w.Kubernetes = &garden.WorkerKubernetes{}
+					}
+					w.Kubernetes.Version = data.KubernetesVersion
+				}
 			}
 
 			if w.Zones == nil {
@@ -1858,9 +1912,27 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
+				w.AutoRepair = data.AutoRepair
+				w.MaxNodeAge = data.MaxNodeAge
+				w.OperatingSystemConfig = data.OperatingSystemConfig
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
+
+				if data.KubernetesVersion != nil {
+					if w.Kubernetes == nil {
+						w.Kubernetes = &garden.WorkerKubernetes{}
+					}
+					w.Kubernetes.Version = data.KubernetesVersion
+				}
 			}
 
 			if w.Zones == nil {
@@ -1997,9 +2069,27 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
+				w.AutoRepair = data.AutoRepair
+				w.MaxNodeAge = data.MaxNodeAge
+				w.OperatingSystemConfig = data.OperatingSystemConfig
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
+
+				if data.KubernetesVersion != nil {
+					if w.Kubernetes == nil {
+						w.Kubernetes = &garden.WorkerKubernetes{}
+					}
+					w.Kubernetes.Version = data.KubernetesVersion
+				}
 			}
 
 			if w.Zones == nil {
@@ -2098,9 +2188,27 @@ func Convert_v1beta1_Shoot_To_garden_Shoot(in *Shoot, out *garden.Shoot, s conve
 				w.Kubernetes = &garden.WorkerKubernetes{Kubelet: kubeletConfig}
 			}
 
+			if worker.CRI != nil {
+				cri := &garden.CRI{}
+				if err := autoConvert_v1beta1_CRI_To_garden_CRI(worker.CRI, cri, s); err != nil {
+					return err
+				}
+				w.CRI = cri
+			}
+
 			if data, ok := workerMigrationInfo[worker.Name]; ok {
+				w.AutoRepair = data.AutoRepair
+				w.MaxNodeAge = data.MaxNodeAge
+				w.OperatingSystemConfig = data.OperatingSystemConfig
 				w.ProviderConfig = data.ProviderConfig
 				w.Zones = data.Zones
+
+				if data.KubernetesVersion != nil {
+					if w.Kubernetes == nil {
+						w.Kubernetes = &garden.WorkerKubernetes{}
+					}
+					w.Kubernetes.Version = data.KubernetesVersion
+				}
 			}
 
 			if w.Zones == nil {
@@ -2192,10 +2300,17 @@ func Convert_garden_Shoot_To_v1beta1_Shoot(in *garden.Shoot, out *Shoot, s conve
 	if in.Spec.Cloud.AWS != nil || in.Spec.Cloud.Azure != nil || in.Spec.Cloud.GCP != nil || in.Spec.Cloud.OpenStack != nil || in.Spec.Cloud.Alicloud != nil || in.Spec.Cloud.Packet != nil {
 		workerMigrationInfo := make(garden.WorkerMigrationInfo, len(in.Spec.Provider.Workers))
 		for _, worker := range in.Spec.Provider.Workers {
-			workerMigrationInfo[worker.Name] = garden.WorkerMigrationData{
-				ProviderConfig: worker.ProviderConfig,
-				Zones:          worker.Zones,
+			data := garden.WorkerMigrationData{
+				AutoRepair:            worker.AutoRepair,
+				MaxNodeAge:            worker.MaxNodeAge,
+				OperatingSystemConfig: worker.OperatingSystemConfig,
+				ProviderConfig:        worker.ProviderConfig,
+				Zones:                 worker.Zones,
+			}
+			if worker.Kubernetes != nil {
+				data.KubernetesVersion = worker.Kubernetes.Version
 			}
+			workerMigrationInfo[worker.Name] = data
 		}
 		data, err := json.Marshal(workerMigrationInfo)
 		if err != nil {
@@ -2292,7 +2407,7 @@ func Convert_garden_Worker_To_v1beta1_AWSWorker(in *garden.Worker, out *AWSWorke
 	}
 
 	var kubeletConfig *KubeletConfig
-	if in.Kubernetes != nil {
+	if in.Kubernetes != nil && in.Kubernetes.Kubelet != nil {
 		kubeletConfig = &KubeletConfig{}
 		if err := autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in.Kubernetes.Kubelet, kubeletConfig, s); err != nil {
 			return err
@@ -2300,6 +2415,15 @@ func Convert_garden_Worker_To_v1beta1_AWSWorker(in *garden.Worker, out *AWSWorke
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2334,7 +2458,7 @@ func Convert_garden_Worker_To_v1beta1_AzureWorker(in *garden.Worker, out *AzureW
 	}
 
 	var kubeletConfig *KubeletConfig
-	if in.Kubernetes != nil {
+	if in.Kubernetes != nil && in.Kubernetes.Kubelet != nil {
 		kubeletConfig = &KubeletConfig{}
 		if err := autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in.Kubernetes.Kubelet, kubeletConfig, s); err != nil {
 			return err
@@ -2342,6 +2466,15 @@ func Convert_garden_Worker_To_v1beta1_AzureWorker(in *garden.Worker, out *AzureW
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2376,7 +2509,7 @@ func Convert_garden_Worker_To_v1beta1_GCPWorker(in *garden.Worker, out *GCPWorke
 	}
 
 	var kubeletConfig *KubeletConfig
-	if in.Kubernetes != nil {
+	if in.Kubernetes != nil && in.Kubernetes.Kubelet != nil {
 		kubeletConfig = &KubeletConfig{}
 		if err := autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in.Kubernetes.Kubelet, kubeletConfig, s); err != nil {
 			return err
@@ -2384,6 +2517,15 @@ func Convert_garden_Worker_To_v1beta1_GCPWorker(in *garden.Worker, out *GCPWorke
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2413,7 +2555,7 @@ func Convert_garden_Worker_To_v1beta1_OpenStackWorker(in *garden.Worker, out *Op
 	}
 
 	var kubeletConfig *KubeletConfig
-	if in.Kubernetes != nil {
+	if in.Kubernetes != nil && in.Kubernetes.Kubelet != nil {
 		kubeletConfig = &KubeletConfig{}
 		if err := autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in.Kubernetes.Kubelet, kubeletConfig, s); err != nil {
 			return err
@@ -2421,6 +2563,15 @@ func Convert_garden_Worker_To_v1beta1_OpenStackWorker(in *garden.Worker, out *Op
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2455,7 +2606,7 @@ func Convert_garden_Worker_To_v1beta1_AlicloudWorker(in *garden.Worker, out *Ali
 	}
 
 	var kubeletConfig *KubeletConfig
-	if in.Kubernetes != nil {
+	if in.Kubernetes != nil && in.Kubernetes.Kubelet != nil {
 		kubeletConfig = &KubeletConfig{}
 		if err := autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in.Kubernetes.Kubelet, kubeletConfig, s); err != nil {
 			return err
@@ -2463,6 +2614,15 @@ func Convert_garden_Worker_To_v1beta1_AlicloudWorker(in *garden.Worker, out *Ali
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -2497,7 +2657,7 @@ func Convert_garden_Worker_To_v1beta1_PacketWorker(in *garden.Worker, out *Packe
 	}
 
 	var kubeletConfig *KubeletConfig
-	if in.Kubernetes != nil {
+	if in.Kubernetes != nil && in.Kubernetes.Kubelet != nil {
 		kubeletConfig = &KubeletConfig{}
 		if err := autoConvert_garden_KubeletConfig_To_v1beta1_KubeletConfig(in.Kubernetes.Kubelet, kubeletConfig, s); err != nil {
 			return err
@@ -2505,6 +2665,15 @@ func Convert_garden_Worker_To_v1beta1_PacketWorker(in *garden.Worker, out *Packe
 	}
 	out.Kubelet = kubeletConfig
 
+	var cri *CRI
+	if in.CRI != nil {
+		cri = &CRI{}
+		if err := autoConvert_garden_CRI_To_v1beta1_CRI(in.CRI, cri, s); err != nil {
+			return err
+		}
+	}
+	out.CRI = cri
+
 	return nil
 }
 
@@ -15,12 +15,15 @@
 package helper
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	"github.com/gardener/gardener/pkg/utils"
@@ -28,6 +31,7 @@ import (
 	"github.com/Masterminds/semver"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -83,6 +87,29 @@ func HibernationIsEnabled(shoot *gardenv1beta1.Shoot) bool {
 	return shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled
 }
 
+// GetHibernationMode returns the hibernation mode of the given shoot. It defaults to `Full` if no mode is specified.
+func GetHibernationMode(shoot *gardenv1beta1.Shoot) gardenv1beta1.HibernationMode {
+	if shoot.Spec.Hibernation == nil || shoot.Spec.Hibernation.Mode == nil {
+		return gardenv1beta1.HibernationModeFull
+	}
+	return *shoot.Spec.Hibernation.Mode
+}
+
+// ControlPlaneHibernationIsEnabled checks if the given shoot's desired state is hibernated and whether the
+// control plane shall be hibernated as well.
+func ControlPlaneHibernationIsEnabled(shoot *gardenv1beta1.Shoot) bool {
+	return HibernationIsEnabled(shoot) && GetHibernationMode(shoot) == gardenv1beta1.HibernationModeFull
+}
+
+// GetHibernationExcludedWorkerPools returns the names of the worker pools of the given shoot which keep
+// running while the shoot is hibernated.
+func GetHibernationExcludedWorkerPools(shoot *gardenv1beta1.Shoot) []string {
+	if GetHibernationMode(shoot) != gardenv1beta1.HibernationModeWorkersOnly {
+		return nil
+	}
+	return shoot.Spec.Hibernation.ExcludedWorkerPools
+}
+
 // ShootWantsClusterAutoscaler checks if the given Shoot needs a cluster autoscaler.
 // This is determined by checking whether one of the Shoot workers has a different
 // AutoScalerMax than AutoScalerMin.
@@ -133,6 +160,45 @@ func ShootIgnoreAlerts(shoot *gardenv1beta1.Shoot) bool {
 	return ignore
 }
 
+// ShootLoggingDisabled checks if the logs of the annotated shoot cluster should not be collected.
+func ShootLoggingDisabled(shoot *gardenv1beta1.Shoot) bool {
+	disabled := false
+	if value, ok := shoot.Annotations[common.ShootDisableLogging]; ok {
+		disabled, _ = strconv.ParseBool(value)
+	}
+	return disabled
+}
+
+// GetShootWorkerMigrationInfo returns the settings of the worker pools of the given Shoot which cannot be represented
+// in the v1beta1 API (e.g., the zones or the Kubernetes version of a worker pool).
+func GetShootWorkerMigrationInfo(shoot *gardenv1beta1.Shoot) (garden.WorkerMigrationInfo, error) {
+	var workerMigrationInfo garden.WorkerMigrationInfo
+
+	data, ok := shoot.Annotations[garden.MigrationShootWorkers]
+	if !ok {
+		return workerMigrationInfo, nil
+	}
+	if err := json.Unmarshal([]byte(data), &workerMigrationInfo); err != nil {
+		return nil, err
+	}
+	return workerMigrationInfo, nil
+}
+
+// GetShootAdditionalDNSProviders returns the additional DNS providers of the given Shoot, i.e. all providers except
+// the primary one, which cannot be represented in the v1beta1 API.
+func GetShootAdditionalDNSProviders(shoot *gardenv1beta1.Shoot) ([]garden.DNSProvider, error) {
+	var providers []garden.DNSProvider
+
+	data, ok := shoot.Annotations[garden.MigrationShootDNSProviders]
+	if !ok {
+		return providers, nil
+	}
+	if err := json.Unmarshal([]byte(data), &providers); err != nil {
+		return nil, err
+	}
+	return providers, nil
+}
+
 // GetShootCloudProviderWorkers retrieves the cloud-specific workers of the given Shoot.
 func GetShootCloudProviderWorkers(cloudProvider gardenv1beta1.CloudProvider, shoot *gardenv1beta1.Shoot) []gardenv1beta1.Worker {
 	var (
@@ -1038,3 +1104,28 @@ func GetShootMachineImageFromLatestMachineImageVersion(image gardenv1beta1.Machi
 	}
 	return latestSemVerVersion, gardenv1beta1.ShootMachineImage{Name: image.Name, Version: latestImage.Version}, nil
 }
+
+// ActiveMaintenanceBlackoutWindow returns the first of the given maintenance blackout windows which contains the given
+// point in time and whose shoot selector matches the labels of the given Shoot. It returns nil if no such blackout
+// window exists.
+func ActiveMaintenanceBlackoutWindow(shoot *gardenv1beta1.Shoot, windows []gardenv1beta1.MaintenanceBlackoutWindow, now time.Time) (*gardenv1beta1.MaintenanceBlackoutWindow, error) {
+	for _, window := range windows {
+		if now.Before(window.Begin.Time) || !now.Before(window.End.Time) {
+			continue
+		}
+
+		if window.ShootSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(window.ShootSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid shoot selector in maintenance blackout window %q: %v", window.Name, err)
+			}
+			if !selector.Matches(labels.Set(shoot.Labels)) {
+				continue
+			}
+		}
+
+		activeWindow := window
+		return &activeWindow, nil
+	}
+	return nil, nil
+}
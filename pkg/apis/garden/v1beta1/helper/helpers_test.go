@@ -15,6 +15,8 @@
 package helper_test
 
 import (
+	"time"
+
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -509,6 +511,17 @@ var _ = Describe("helper", func() {
 			},
 		}, alertingSecrets, false))
 
+	DescribeTable("#ShootLoggingDisabled",
+		func(annotations map[string]string, disabled bool) {
+			shoot := &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+			Expect(ShootLoggingDisabled(shoot)).To(Equal(disabled))
+		},
+		Entry("no annotations", nil, false),
+		Entry("logging disabled", map[string]string{common.ShootDisableLogging: "true"}, true),
+		Entry("logging explicitly enabled", map[string]string{common.ShootDisableLogging: "false"}, false),
+		Entry("invalid annotation value", map[string]string{common.ShootDisableLogging: "foo"}, false),
+	)
+
 	Describe("#ReadShootedSeed", func() {
 		var (
 			shoot                    *gardenv1beta1.Shoot
@@ -802,4 +815,49 @@ var _ = Describe("helper", func() {
 			Expect(exists).To(Equal(false))
 		})
 	})
+
+	Describe("#ActiveMaintenanceBlackoutWindow", func() {
+		var (
+			now   = time.Date(2019, time.December, 24, 12, 0, 0, 0, time.UTC)
+			shoot = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{"purpose": "production"},
+				},
+			}
+			yearEnd = gardenv1beta1.MaintenanceBlackoutWindow{
+				Name:  "year-end",
+				Begin: metav1.NewTime(time.Date(2019, time.December, 20, 0, 0, 0, 0, time.UTC)),
+				End:   metav1.NewTime(time.Date(2020, time.January, 6, 0, 0, 0, 0, time.UTC)),
+			}
+		)
+
+		It("should return the blackout window containing the given time", func() {
+			window, err := ActiveMaintenanceBlackoutWindow(shoot, []gardenv1beta1.MaintenanceBlackoutWindow{yearEnd}, now)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).To(Equal(&yearEnd))
+		})
+
+		It("should not return a blackout window not containing the given time", func() {
+			window, err := ActiveMaintenanceBlackoutWindow(shoot, []gardenv1beta1.MaintenanceBlackoutWindow{yearEnd}, now.AddDate(0, 1, 0))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).To(BeNil())
+		})
+
+		It("should respect the shoot selector", func() {
+			window := yearEnd.DeepCopy()
+			window.ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "evaluation"}}
+
+			activeWindow, err := ActiveMaintenanceBlackoutWindow(shoot, []gardenv1beta1.MaintenanceBlackoutWindow{*window}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeWindow).To(BeNil())
+
+			window.ShootSelector.MatchLabels["purpose"] = "production"
+
+			activeWindow, err = ActiveMaintenanceBlackoutWindow(shoot, []gardenv1beta1.MaintenanceBlackoutWindow{*window}, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(activeWindow).To(Equal(window))
+		})
+	})
 })
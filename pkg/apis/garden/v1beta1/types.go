@@ -80,11 +80,27 @@ type CloudProfileSpec struct {
 	// Packet is the profile specification for the Packet cloud.
 	// +optional
 	Packet *PacketProfile `json:"packet,omitempty"`
+	// BackupLimits contains limits for the etcd backup settings in the Shoot specification.
+	// +optional
+	BackupLimits *BackupLimits `json:"backupLimits,omitempty"`
 	// CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
 }
 
+// BackupLimits contains limits for the etcd backup settings in the Shoot specification.
+type BackupLimits struct {
+	// MinFullSnapshotInterval is the minimum interval between two full snapshots of the etcd a Shoot may configure.
+	// +optional
+	MinFullSnapshotInterval *metav1.Duration `json:"minFullSnapshotInterval,omitempty"`
+	// MinDeltaSnapshotPeriod is the minimum period between two delta snapshots of the etcd a Shoot may configure.
+	// +optional
+	MinDeltaSnapshotPeriod *metav1.Duration `json:"minDeltaSnapshotPeriod,omitempty"`
+	// MaxBackups is the maximum number of full snapshots of the etcd a Shoot may retain.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+}
+
 // AWSProfile defines certain constraints and definitions for the AWS cloud.
 type AWSProfile struct {
 	// Constraints is an object containing constraints for certain values in the Shoot specification.
@@ -391,6 +407,14 @@ type BackupProfile struct {
 	SecretRef corev1.SecretReference `json:"secretRef"`
 }
 
+// SeedLogging contains settings for the logging of the Seed cluster.
+type SeedLogging struct {
+	// Endpoint is the URL of an external HTTP(S) endpoint the logs of the Seed cluster are shipped to. It is only
+	// used if the external logging backend is configured for the Gardener controller manager.
+	// +optional
+	Endpoint *string `json:"endpoint,omitempty"`
+}
+
 ////////////////////////////////////////////////////
 //                    PROJECTS                    //
 ////////////////////////////////////////////////////
@@ -453,6 +477,31 @@ type ProjectSpec struct {
 	// that should be part of this project with limited permissions to only view some resources.
 	// +optional
 	Viewers []rbacv1.Subject `json:"viewers,omitempty"`
+	// MaintenanceBlackoutWindows is a list of periods of time in which no automatic maintenance operations are
+	// performed for the Shoots of this project.
+	// +optional
+	MaintenanceBlackoutWindows []MaintenanceBlackoutWindow `json:"maintenanceBlackoutWindows,omitempty"`
+	// ShootDeletionProtection defines whether Shoots created in this project are protected against deletion by
+	// default.
+	// +optional
+	ShootDeletionProtection *bool `json:"shootDeletionProtection,omitempty"`
+}
+
+// MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.
+type MaintenanceBlackoutWindow struct {
+	// Name is the unique name of the blackout window.
+	Name string `json:"name"`
+	// Begin is the point in time when the blackout window starts.
+	Begin metav1.Time `json:"begin"`
+	// End is the point in time when the blackout window ends.
+	End metav1.Time `json:"end"`
+	// Reason is a human-readable explanation why automatic maintenance operations are blocked.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+	// ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector
+	// matches all Shoots.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -541,6 +590,12 @@ type SeedSpec struct {
 	// configured object store.
 	// +optional
 	Backup *BackupProfile `json:"backup,omitempty"`
+	// BackupLimits contains limits for the etcd backup settings of Shoots whose control plane runs in this Seed.
+	// +optional
+	BackupLimits *BackupLimits `json:"backupLimits,omitempty"`
+	// Logging contains settings for the logging of the Seed cluster and the Shoot control planes running in it.
+	// +optional
+	Logging *SeedLogging `json:"logging,omitempty"`
 }
 
 // SeedStatus holds the most recently observed status of the Seed cluster.
@@ -555,6 +610,26 @@ type SeedStatus struct {
 	// Seed's generation, which is updated on mutation by the API Server.
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Capacity contains the allocatable and requested resources of the Seed cluster and the number of hosted
+	// shoot control planes.
+	// +optional
+	Capacity *SeedCapacity `json:"capacity,omitempty"`
+}
+
+// SeedCapacity contains information about the resources of a Seed cluster.
+type SeedCapacity struct {
+	// Allocatable is the sum of the allocatable CPU and memory of all nodes of the Seed cluster.
+	// +optional
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+	// Requested is the sum of the CPU and memory requests of all pods of the Seed cluster which are not terminated.
+	// +optional
+	Requested corev1.ResourceList `json:"requested,omitempty"`
+	// ExcessCapacityReserved is the CPU and memory reserved for new shoot control planes. It is empty if no
+	// excess capacity is reserved.
+	// +optional
+	ExcessCapacityReserved corev1.ResourceList `json:"excessCapacityReserved,omitempty"`
+	// ShootControlPlanes is the number of shoot control planes hosted by the Seed cluster.
+	ShootControlPlanes int `json:"shootControlPlanes"`
 }
 
 // SeedCloud defines the cloud profile and the region this Seed cluster belongs to.
@@ -707,6 +782,9 @@ type ShootSpec struct {
 	// Addons contains information about enabled/disabled addons and their configuration.
 	// +optional
 	Addons *Addons `json:"addons,omitempty"`
+	// Backup contains settings for the backups of the etcd of the Shoot.
+	// +optional
+	Backup *ShootBackup `json:"backup,omitempty"`
 	// Cloud contains information about the cloud environment and their specific settings.
 	Cloud Cloud `json:"cloud"`
 	// DNS contains information about the DNS settings of the Shoot.
@@ -760,8 +838,87 @@ type ShootStatus struct {
 	// UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters.
 	// It is used to compute unique hashes.
 	UID types.UID `json:"uid"`
+	// Maintenance holds information about the last maintenance operation of the Shoot.
+	// +optional
+	Maintenance *ShootMaintenanceStatus `json:"maintenance,omitempty"`
+	// AutoRepair holds information about the machines which were replaced because their nodes stayed unhealthy.
+	// +optional
+	AutoRepair *ShootAutoRepairStatus `json:"autoRepair,omitempty"`
+	// DNSProviders contains the status of the DNS records of the additional DNS providers of the Shoot.
+	// +optional
+	DNSProviders []DNSProviderStatus `json:"dnsProviders,omitempty"`
+}
+
+// ShootMaintenanceStatus contains information about the last maintenance operation of a Shoot.
+type ShootMaintenanceStatus struct {
+	// LastMaintenanceTime is the point in time when the last maintenance operation was executed.
+	LastMaintenanceTime metav1.Time `json:"lastMaintenanceTime"`
+	// Tasks contains the results of the maintenance tasks which were executed during the last maintenance operation.
+	// +optional
+	Tasks []MaintenanceTaskStatus `json:"tasks,omitempty"`
+}
+
+// ShootAutoRepairStatus contains information about the auto repair of the worker pools of a Shoot.
+type ShootAutoRepairStatus struct {
+	// Pools contains the auto repair status of the worker pools whose machines have been replaced.
+	// +optional
+	Pools []WorkerPoolAutoRepairStatus `json:"pools,omitempty"`
+}
+
+// WorkerPoolAutoRepairStatus contains information about the last auto repair of a worker pool.
+type WorkerPoolAutoRepairStatus struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// LastRepairTime is the point in time when machines of the worker pool were replaced the last time.
+	LastRepairTime metav1.Time `json:"lastRepairTime"`
+}
+
+// MaintenanceTaskStatus contains the result of a single maintenance task.
+type MaintenanceTaskStatus struct {
+	// Name is the name of the maintenance task.
+	Name string `json:"name"`
+	// State is the result of the maintenance task.
+	State MaintenanceTaskState `json:"state"`
+	// Description is a human-readable message describing what the maintenance task changed or why it failed.
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
+// MaintenanceTaskState is a string alias.
+type MaintenanceTaskState string
+
+const (
+	// MaintenanceTaskStateSucceeded indicates that the maintenance task has changed the Shoot successfully.
+	MaintenanceTaskStateSucceeded MaintenanceTaskState = "Succeeded"
+	// MaintenanceTaskStateSkipped indicates that the maintenance task did not apply to the Shoot.
+	MaintenanceTaskStateSkipped MaintenanceTaskState = "Skipped"
+	// MaintenanceTaskStateFailed indicates that the maintenance task has failed.
+	MaintenanceTaskStateFailed MaintenanceTaskState = "Failed"
+)
+
+// DNSProviderStatus contains the status of the DNS record of an additional DNS provider of a Shoot.
+type DNSProviderStatus struct {
+	// Type is the type of the DNS provider.
+	Type string `json:"type"`
+	// Domain is the domain for which the DNS record is managed.
+	Domain string `json:"domain"`
+	// State is the state of the DNS record.
+	State DNSProviderState `json:"state"`
+	// Description is a human-readable message describing why the DNS record is not ready.
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
+// DNSProviderState is a string alias.
+type DNSProviderState string
+
+const (
+	// DNSProviderStateReady indicates that the DNS record of the DNS provider is ready.
+	DNSProviderStateReady DNSProviderState = "Ready"
+	// DNSProviderStateError indicates that the DNS record of the DNS provider could not be created.
+	DNSProviderStateError DNSProviderState = "Error"
+)
+
 ///////////////////////////////
 // Shoot Specification Types //
 ///////////////////////////////
@@ -777,6 +934,21 @@ type Networking struct {
 	// ProviderConfig is the configuration passed to network resource.
 	// +optional
 	ProviderConfig *gardencorev1alpha1.ProviderConfig `json:"providerConfig,omitempty"`
+	// ControlPlaneEgressDestinations is a list of additional destinations which the control plane components of
+	// the Shoot (e.g., the kube-apiserver calling webhooks) are allowed to reach.
+	// +optional
+	ControlPlaneEgressDestinations []EgressDestination `json:"controlPlaneEgressDestinations,omitempty"`
+}
+
+// EgressDestination is a destination which the control plane components of a Shoot are allowed to reach. Exactly
+// one of the fields must be set.
+type EgressDestination struct {
+	// CIDR is a network block in CIDR notation.
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+	// FQDN is a fully qualified domain name. It is resolved to its IPv4 addresses whenever the Shoot is reconciled.
+	// +optional
+	FQDN *string `json:"fqdn,omitempty"`
 }
 
 // Cloud contains information about the cloud environment and their specific settings.
@@ -1118,6 +1290,37 @@ type Worker struct {
 	// CABundle is a certificate bundle which will be installed onto every machine of this worker pool.
 	// +optional
 	CABundle *string `json:"caBundle,omitempty"`
+	// CRI contains configurations of CRI support of every machine in the worker pool.
+	// +optional
+	CRI *CRI `json:"cri,omitempty"`
+}
+
+// CRI contains information about the Container Runtimes.
+type CRI struct {
+	// Name is the name of the CRI library.
+	Name CRIName `json:"name"`
+	// ContainerRuntimes is the list of the required container runtimes supported for a worker pool.
+	// +optional
+	ContainerRuntimes []ContainerRuntime `json:"containerRuntimes,omitempty"`
+}
+
+// CRIName is a type alias for the CRI name string.
+type CRIName string
+
+const (
+	// CRINameDocker is a constant for the docker CRI.
+	CRINameDocker CRIName = "docker"
+	// CRINameContainerD is a constant for the containerd CRI.
+	CRINameContainerD CRIName = "containerd"
+)
+
+// ContainerRuntime contains information about worker's available container runtime.
+type ContainerRuntime struct {
+	// Type is the type of the Container Runtime.
+	Type string `json:"type"`
+	// ProviderConfig is the configuration passed to the ContainerRuntime resource.
+	// +optional
+	ProviderConfig *gardencorev1alpha1.ProviderConfig `json:"providerConfig,omitempty"`
 }
 
 var (
@@ -1298,16 +1501,64 @@ const (
 	CloudProviderPacket CloudProvider = "packet"
 )
 
+// ShootBackup contains settings for the backups of the etcd of the Shoot.
+type ShootBackup struct {
+	// Schedule is the cron schedule in which full snapshots of the etcd are taken.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// DeltaSnapshotPeriod is the period after which delta snapshots of the etcd are taken.
+	// +optional
+	DeltaSnapshotPeriod *metav1.Duration `json:"deltaSnapshotPeriod,omitempty"`
+	// GarbageCollectionPolicy is the policy used to garbage collect old snapshots of the etcd.
+	// +optional
+	GarbageCollectionPolicy *BackupGarbageCollectionPolicy `json:"garbageCollectionPolicy,omitempty"`
+	// MaxBackups is the number of full snapshots of the etcd which are retained. It is only used for the
+	// `LimitBased` garbage collection policy.
+	// +optional
+	MaxBackups *int32 `json:"maxBackups,omitempty"`
+}
+
+// BackupGarbageCollectionPolicy is a string alias.
+type BackupGarbageCollectionPolicy string
+
+const (
+	// BackupGarbageCollectionPolicyExponential is a constant for a garbage collection policy which retains the latest
+	// snapshots of every hour, day and week.
+	BackupGarbageCollectionPolicyExponential BackupGarbageCollectionPolicy = "Exponential"
+	// BackupGarbageCollectionPolicyLimitBased is a constant for a garbage collection policy which retains a fixed
+	// number of full snapshots.
+	BackupGarbageCollectionPolicyLimitBased BackupGarbageCollectionPolicy = "LimitBased"
+)
+
 // Hibernation contains information whether the Shoot is suspended or not.
 type Hibernation struct {
 	// Enabled is true if the Shoot's desired state is hibernated, false otherwise.
 	// +optional
 	Enabled *bool `json:"enabled,omitempty"`
+	// Mode determines which parts of the Shoot are hibernated. Defaults to `Full` if not specified.
+	// +optional
+	Mode *HibernationMode `json:"mode,omitempty"`
+	// ExcludedWorkerPools is a list of names of worker pools which keep running while the Shoot is hibernated.
+	// It may only be set if the mode is `WorkersOnly`.
+	// +optional
+	ExcludedWorkerPools []string `json:"excludedWorkerPools,omitempty"`
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty"`
 }
 
+// HibernationMode is a string alias.
+type HibernationMode string
+
+const (
+	// HibernationModeFull is a constant for the hibernation mode which scales down the worker pools and the
+	// entire control plane of the Shoot.
+	HibernationModeFull HibernationMode = "Full"
+	// HibernationModeWorkersOnly is a constant for the hibernation mode which only scales down the worker pools
+	// while the control plane of the Shoot stays available.
+	HibernationModeWorkersOnly HibernationMode = "WorkersOnly"
+)
+
 // HibernationSchedule determines the hibernation schedule of a Shoot.
 // A Shoot will be regularly hibernated at each start time and will be woken up at each end time.
 // Start or End can be omitted, though at least one of each has to be specified.
@@ -1811,6 +2062,20 @@ const (
 	ShootEventMaintenanceDone = "MaintenanceDone"
 	// ShootEventMaintenanceError indicates that a maintenance operation has failed.
 	ShootEventMaintenanceError = "MaintenanceError"
+	// ShootEventMaintenanceSkipped indicates that a maintenance operation has been skipped.
+	ShootEventMaintenanceSkipped = "MaintenanceSkipped"
+	// ShootEventMigrating indicates that the control plane migration of a Shoot started.
+	ShootEventMigrating = "Migrating"
+	// ShootEventMigrated indicates that the control plane migration of a Shoot was successful.
+	ShootEventMigrated = "Migrated"
+	// ShootEventMigrateError indicates that the control plane migration of a Shoot failed.
+	ShootEventMigrateError = "MigrateError"
+	// ShootEventETCDRestoring indicates that the point-in-time restoration of the main etcd of a Shoot started.
+	ShootEventETCDRestoring = "ETCDRestoring"
+	// ShootEventETCDRestored indicates that the point-in-time restoration of the main etcd of a Shoot was successful.
+	ShootEventETCDRestored = "ETCDRestored"
+	// ShootEventETCDRestoreError indicates that the point-in-time restoration of the main etcd of a Shoot failed.
+	ShootEventETCDRestoreError = "ETCDRestoreError"
 
 	// ProjectEventNamespaceReconcileFailed indicates that the namespace reconciliation has failed.
 	ProjectEventNamespaceReconcileFailed = "NamespaceReconcileFailed"
@@ -1843,6 +2108,26 @@ const (
 const (
 	// SeedAvailable is a constant for a condition type indicating the Seed cluster availability.
 	SeedAvailable gardencorev1alpha1.ConditionType = "Available"
+	// SeedDrained is a constant for a condition type indicating the progress of draining the Seed cluster.
+	SeedDrained gardencorev1alpha1.ConditionType = "Drained"
+	// SeedMonitoringHealthy is a constant for a condition type indicating the health of the monitoring components (Prometheus,
+	// Alertmanager, Grafana) of the Seed cluster.
+	SeedMonitoringHealthy gardencorev1alpha1.ConditionType = "MonitoringHealthy"
+	// SeedLoggingHealthy is a constant for a condition type indicating the health of the logging components (fluent-bit, fluentd,
+	// Elasticsearch, Kibana) of the Seed cluster.
+	SeedLoggingHealthy gardencorev1alpha1.ConditionType = "LoggingHealthy"
+	// SeedAutoscalingHealthy is a constant for a condition type indicating the health of the autoscaling components (VPA, HVPA) of the
+	// Seed cluster.
+	SeedAutoscalingHealthy gardencorev1alpha1.ConditionType = "AutoscalingHealthy"
+	// SeedResourceManagerHealthy is a constant for a condition type indicating the health of the gardener-resource-manager of the Seed
+	// cluster.
+	SeedResourceManagerHealthy gardencorev1alpha1.ConditionType = "ResourceManagerHealthy"
+	// SeedIngressHealthy is a constant for a condition type indicating the health of the nginx-ingress controller of the Seed
+	// cluster, which is required to expose the monitoring and logging dashboards.
+	SeedIngressHealthy gardencorev1alpha1.ConditionType = "IngressHealthy"
+	// SeedExcessCapacityReserved is a constant for a condition type indicating whether the desired excess capacity for new shoot
+	// control planes is reserved in the Seed cluster.
+	SeedExcessCapacityReserved gardencorev1alpha1.ConditionType = "ExcessCapacityReserved"
 
 	// ShootControlPlaneHealthy is a constant for a condition type indicating the control plane health.
 	ShootControlPlaneHealthy gardencorev1alpha1.ConditionType = "ControlPlaneHealthy"
@@ -1854,6 +2139,9 @@ const (
 	ShootAlertsInactive gardencorev1alpha1.ConditionType = "AlertsInactive"
 	// ShootAPIServerAvailable is a constant for a condition type indicating that the Shoot clusters API server is available.
 	ShootAPIServerAvailable gardencorev1alpha1.ConditionType = "APIServerAvailable"
+	// ShootETCDRestored is a constant for a condition type indicating the result of the last point-in-time restoration
+	// of the main etcd.
+	ShootETCDRestored gardencorev1alpha1.ConditionType = "ETCDRestored"
 )
 
 ////////////////////////////////////////////////////
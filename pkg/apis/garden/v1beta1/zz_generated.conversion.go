@@ -316,6 +316,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupLimits)(nil), (*garden.BackupLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupLimits_To_garden_BackupLimits(a.(*BackupLimits), b.(*garden.BackupLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.BackupLimits)(nil), (*BackupLimits)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_BackupLimits_To_v1beta1_BackupLimits(a.(*garden.BackupLimits), b.(*BackupLimits), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CRI)(nil), (*garden.CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CRI_To_garden_CRI(a.(*CRI), b.(*garden.CRI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.CRI)(nil), (*CRI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_CRI_To_v1beta1_CRI(a.(*garden.CRI), b.(*CRI), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Cloud)(nil), (*garden.Cloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Cloud_To_garden_Cloud(a.(*Cloud), b.(*garden.Cloud), scope)
 	}); err != nil {
@@ -376,6 +396,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ContainerRuntime)(nil), (*garden.ContainerRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ContainerRuntime_To_garden_ContainerRuntime(a.(*ContainerRuntime), b.(*garden.ContainerRuntime), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ContainerRuntime)(nil), (*ContainerRuntime)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ContainerRuntime_To_v1beta1_ContainerRuntime(a.(*garden.ContainerRuntime), b.(*ContainerRuntime), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DNS)(nil), (*garden.DNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DNS_To_garden_DNS(a.(*DNS), b.(*garden.DNS), scope)
 	}); err != nil {
@@ -396,6 +426,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSProviderStatus)(nil), (*garden.DNSProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DNSProviderStatus_To_garden_DNSProviderStatus(a.(*DNSProviderStatus), b.(*garden.DNSProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.DNSProviderStatus)(nil), (*DNSProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_DNSProviderStatus_To_v1beta1_DNSProviderStatus(a.(*garden.DNSProviderStatus), b.(*DNSProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EgressDestination)(nil), (*garden.EgressDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EgressDestination_To_garden_EgressDestination(a.(*EgressDestination), b.(*garden.EgressDestination), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.EgressDestination)(nil), (*EgressDestination)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_EgressDestination_To_v1beta1_EgressDestination(a.(*garden.EgressDestination), b.(*EgressDestination), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Extension)(nil), (*garden.Extension)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Extension_To_garden_Extension(a.(*Extension), b.(*garden.Extension), scope)
 	}); err != nil {
@@ -746,6 +796,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceBlackoutWindow)(nil), (*garden.MaintenanceBlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(a.(*MaintenanceBlackoutWindow), b.(*garden.MaintenanceBlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.MaintenanceBlackoutWindow)(nil), (*MaintenanceBlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_MaintenanceBlackoutWindow_To_v1beta1_MaintenanceBlackoutWindow(a.(*garden.MaintenanceBlackoutWindow), b.(*MaintenanceBlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceTaskStatus)(nil), (*garden.MaintenanceTaskStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(a.(*MaintenanceTaskStatus), b.(*garden.MaintenanceTaskStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.MaintenanceTaskStatus)(nil), (*MaintenanceTaskStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_MaintenanceTaskStatus_To_v1beta1_MaintenanceTaskStatus(a.(*garden.MaintenanceTaskStatus), b.(*MaintenanceTaskStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*garden.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_garden_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*garden.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1036,6 +1106,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedCapacity)(nil), (*garden.SeedCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedCapacity_To_garden_SeedCapacity(a.(*SeedCapacity), b.(*garden.SeedCapacity), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedCapacity)(nil), (*SeedCapacity)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedCapacity_To_v1beta1_SeedCapacity(a.(*garden.SeedCapacity), b.(*SeedCapacity), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedCloud)(nil), (*garden.SeedCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedCloud_To_garden_SeedCloud(a.(*SeedCloud), b.(*garden.SeedCloud), scope)
 	}); err != nil {
@@ -1056,6 +1136,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedLogging)(nil), (*garden.SeedLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedLogging_To_garden_SeedLogging(a.(*SeedLogging), b.(*garden.SeedLogging), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.SeedLogging)(nil), (*SeedLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_SeedLogging_To_v1beta1_SeedLogging(a.(*garden.SeedLogging), b.(*SeedLogging), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedNetworks)(nil), (*garden.SeedNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedNetworks_To_garden_SeedNetworks(a.(*SeedNetworks), b.(*garden.SeedNetworks), scope)
 	}); err != nil {
@@ -1106,6 +1196,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootAutoRepairStatus)(nil), (*garden.ShootAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(a.(*ShootAutoRepairStatus), b.(*garden.ShootAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootAutoRepairStatus)(nil), (*ShootAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootAutoRepairStatus_To_v1beta1_ShootAutoRepairStatus(a.(*garden.ShootAutoRepairStatus), b.(*ShootAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootBackup)(nil), (*garden.ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootBackup_To_garden_ShootBackup(a.(*ShootBackup), b.(*garden.ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootBackup)(nil), (*ShootBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootBackup_To_v1beta1_ShootBackup(a.(*garden.ShootBackup), b.(*ShootBackup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootList)(nil), (*garden.ShootList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootList_To_garden_ShootList(a.(*ShootList), b.(*garden.ShootList), scope)
 	}); err != nil {
@@ -1126,6 +1236,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMaintenanceStatus)(nil), (*garden.ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(a.(*ShootMaintenanceStatus), b.(*garden.ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.ShootMaintenanceStatus)(nil), (*ShootMaintenanceStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(a.(*garden.ShootMaintenanceStatus), b.(*ShootMaintenanceStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNetworks)(nil), (*garden.ShootNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootNetworks_To_garden_ShootNetworks(a.(*ShootNetworks), b.(*garden.ShootNetworks), scope)
 	}); err != nil {
@@ -1176,6 +1296,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolAutoRepairStatus)(nil), (*garden.WorkerPoolAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(a.(*WorkerPoolAutoRepairStatus), b.(*garden.WorkerPoolAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*garden.WorkerPoolAutoRepairStatus)(nil), (*WorkerPoolAutoRepairStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_garden_WorkerPoolAutoRepairStatus_To_v1beta1_WorkerPoolAutoRepairStatus(a.(*garden.WorkerPoolAutoRepairStatus), b.(*WorkerPoolAutoRepairStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Zone)(nil), (*garden.Zone)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Zone_To_garden_Zone(a.(*Zone), b.(*garden.Zone), scope)
 	}); err != nil {
@@ -2345,6 +2475,52 @@ func Convert_garden_BackupInfrastructureStatus_To_v1beta1_BackupInfrastructureSt
 	return autoConvert_garden_BackupInfrastructureStatus_To_v1beta1_BackupInfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupLimits_To_garden_BackupLimits(in *BackupLimits, out *garden.BackupLimits, s conversion.Scope) error {
+	out.MinFullSnapshotInterval = (*metav1.Duration)(unsafe.Pointer(in.MinFullSnapshotInterval))
+	out.MinDeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.MinDeltaSnapshotPeriod))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_v1beta1_BackupLimits_To_garden_BackupLimits is an autogenerated conversion function.
+func Convert_v1beta1_BackupLimits_To_garden_BackupLimits(in *BackupLimits, out *garden.BackupLimits, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupLimits_To_garden_BackupLimits(in, out, s)
+}
+
+func autoConvert_garden_BackupLimits_To_v1beta1_BackupLimits(in *garden.BackupLimits, out *BackupLimits, s conversion.Scope) error {
+	out.MinFullSnapshotInterval = (*metav1.Duration)(unsafe.Pointer(in.MinFullSnapshotInterval))
+	out.MinDeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.MinDeltaSnapshotPeriod))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_garden_BackupLimits_To_v1beta1_BackupLimits is an autogenerated conversion function.
+func Convert_garden_BackupLimits_To_v1beta1_BackupLimits(in *garden.BackupLimits, out *BackupLimits, s conversion.Scope) error {
+	return autoConvert_garden_BackupLimits_To_v1beta1_BackupLimits(in, out, s)
+}
+
+func autoConvert_v1beta1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	out.Name = garden.CRIName(in.Name)
+	out.ContainerRuntimes = *(*[]garden.ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	return nil
+}
+
+// Convert_v1beta1_CRI_To_garden_CRI is an autogenerated conversion function.
+func Convert_v1beta1_CRI_To_garden_CRI(in *CRI, out *garden.CRI, s conversion.Scope) error {
+	return autoConvert_v1beta1_CRI_To_garden_CRI(in, out, s)
+}
+
+func autoConvert_garden_CRI_To_v1beta1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	out.Name = CRIName(in.Name)
+	out.ContainerRuntimes = *(*[]ContainerRuntime)(unsafe.Pointer(&in.ContainerRuntimes))
+	return nil
+}
+
+// Convert_garden_CRI_To_v1beta1_CRI is an autogenerated conversion function.
+func Convert_garden_CRI_To_v1beta1_CRI(in *garden.CRI, out *CRI, s conversion.Scope) error {
+	return autoConvert_garden_CRI_To_v1beta1_CRI(in, out, s)
+}
+
 func autoConvert_v1beta1_Cloud_To_garden_Cloud(in *Cloud, out *garden.Cloud, s conversion.Scope) error {
 	out.Profile = in.Profile
 	out.Region = in.Region
@@ -2616,6 +2792,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_garden_CloudProfileSpec(in *CloudPr
 	} else {
 		out.Packet = nil
 	}
+	out.BackupLimits = (*garden.BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	return nil
 }
@@ -2675,6 +2852,7 @@ func autoConvert_garden_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *garden.
 	} else {
 		out.Packet = nil
 	}
+	out.BackupLimits = (*BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	// WARNING: in.Kubernetes requires manual conversion: does not exist in peer-type
 	// WARNING: in.MachineImages requires manual conversion: does not exist in peer-type
@@ -2717,6 +2895,28 @@ func Convert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in *garden.Cl
 	return autoConvert_garden_ClusterAutoscaler_To_v1beta1_ClusterAutoscaler(in, out, s)
 }
 
+func autoConvert_v1beta1_ContainerRuntime_To_garden_ContainerRuntime(in *ContainerRuntime, out *garden.ContainerRuntime, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_ContainerRuntime_To_garden_ContainerRuntime is an autogenerated conversion function.
+func Convert_v1beta1_ContainerRuntime_To_garden_ContainerRuntime(in *ContainerRuntime, out *garden.ContainerRuntime, s conversion.Scope) error {
+	return autoConvert_v1beta1_ContainerRuntime_To_garden_ContainerRuntime(in, out, s)
+}
+
+func autoConvert_garden_ContainerRuntime_To_v1beta1_ContainerRuntime(in *garden.ContainerRuntime, out *ContainerRuntime, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*v1alpha1.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_garden_ContainerRuntime_To_v1beta1_ContainerRuntime is an autogenerated conversion function.
+func Convert_garden_ContainerRuntime_To_v1beta1_ContainerRuntime(in *garden.ContainerRuntime, out *ContainerRuntime, s conversion.Scope) error {
+	return autoConvert_garden_ContainerRuntime_To_v1beta1_ContainerRuntime(in, out, s)
+}
+
 func autoConvert_v1beta1_DNS_To_garden_DNS(in *DNS, out *garden.DNS, s conversion.Scope) error {
 	out.Domain = (*string)(unsafe.Pointer(in.Domain))
 	// WARNING: in.SecretName requires manual conversion: does not exist in peer-type
@@ -2754,6 +2954,54 @@ func Convert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in *g
 	return autoConvert_garden_DNSProviderConstraint_To_v1beta1_DNSProviderConstraint(in, out, s)
 }
 
+func autoConvert_v1beta1_DNSProviderStatus_To_garden_DNSProviderStatus(in *DNSProviderStatus, out *garden.DNSProviderStatus, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Domain = in.Domain
+	out.State = garden.DNSProviderState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1beta1_DNSProviderStatus_To_garden_DNSProviderStatus is an autogenerated conversion function.
+func Convert_v1beta1_DNSProviderStatus_To_garden_DNSProviderStatus(in *DNSProviderStatus, out *garden.DNSProviderStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_DNSProviderStatus_To_garden_DNSProviderStatus(in, out, s)
+}
+
+func autoConvert_garden_DNSProviderStatus_To_v1beta1_DNSProviderStatus(in *garden.DNSProviderStatus, out *DNSProviderStatus, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Domain = in.Domain
+	out.State = DNSProviderState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_garden_DNSProviderStatus_To_v1beta1_DNSProviderStatus is an autogenerated conversion function.
+func Convert_garden_DNSProviderStatus_To_v1beta1_DNSProviderStatus(in *garden.DNSProviderStatus, out *DNSProviderStatus, s conversion.Scope) error {
+	return autoConvert_garden_DNSProviderStatus_To_v1beta1_DNSProviderStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_EgressDestination_To_garden_EgressDestination(in *EgressDestination, out *garden.EgressDestination, s conversion.Scope) error {
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.FQDN = (*string)(unsafe.Pointer(in.FQDN))
+	return nil
+}
+
+// Convert_v1beta1_EgressDestination_To_garden_EgressDestination is an autogenerated conversion function.
+func Convert_v1beta1_EgressDestination_To_garden_EgressDestination(in *EgressDestination, out *garden.EgressDestination, s conversion.Scope) error {
+	return autoConvert_v1beta1_EgressDestination_To_garden_EgressDestination(in, out, s)
+}
+
+func autoConvert_garden_EgressDestination_To_v1beta1_EgressDestination(in *garden.EgressDestination, out *EgressDestination, s conversion.Scope) error {
+	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
+	out.FQDN = (*string)(unsafe.Pointer(in.FQDN))
+	return nil
+}
+
+// Convert_garden_EgressDestination_To_v1beta1_EgressDestination is an autogenerated conversion function.
+func Convert_garden_EgressDestination_To_v1beta1_EgressDestination(in *garden.EgressDestination, out *EgressDestination, s conversion.Scope) error {
+	return autoConvert_garden_EgressDestination_To_v1beta1_EgressDestination(in, out, s)
+}
+
 func autoConvert_v1beta1_Extension_To_garden_Extension(in *Extension, out *garden.Extension, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
@@ -3044,6 +3292,8 @@ func Convert_garden_HelmTiller_To_v1beta1_HelmTiller(in *garden.HelmTiller, out
 
 func autoConvert_v1beta1_Hibernation_To_garden_Hibernation(in *Hibernation, out *garden.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Mode = (*garden.HibernationMode)(unsafe.Pointer(in.Mode))
+	out.ExcludedWorkerPools = *(*[]string)(unsafe.Pointer(&in.ExcludedWorkerPools))
 	out.Schedules = *(*[]garden.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
 	return nil
 }
@@ -3055,6 +3305,8 @@ func Convert_v1beta1_Hibernation_To_garden_Hibernation(in *Hibernation, out *gar
 
 func autoConvert_garden_Hibernation_To_v1beta1_Hibernation(in *garden.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Mode = (*HibernationMode)(unsafe.Pointer(in.Mode))
+	out.ExcludedWorkerPools = *(*[]string)(unsafe.Pointer(&in.ExcludedWorkerPools))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
 	return nil
 }
@@ -3710,6 +3962,58 @@ func Convert_garden_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *g
 	return autoConvert_garden_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(in *MaintenanceBlackoutWindow, out *garden.MaintenanceBlackoutWindow, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(in *MaintenanceBlackoutWindow, out *garden.MaintenanceBlackoutWindow, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceBlackoutWindow_To_garden_MaintenanceBlackoutWindow(in, out, s)
+}
+
+func autoConvert_garden_MaintenanceBlackoutWindow_To_v1beta1_MaintenanceBlackoutWindow(in *garden.MaintenanceBlackoutWindow, out *MaintenanceBlackoutWindow, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.ShootSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_garden_MaintenanceBlackoutWindow_To_v1beta1_MaintenanceBlackoutWindow is an autogenerated conversion function.
+func Convert_garden_MaintenanceBlackoutWindow_To_v1beta1_MaintenanceBlackoutWindow(in *garden.MaintenanceBlackoutWindow, out *MaintenanceBlackoutWindow, s conversion.Scope) error {
+	return autoConvert_garden_MaintenanceBlackoutWindow_To_v1beta1_MaintenanceBlackoutWindow(in, out, s)
+}
+
+func autoConvert_v1beta1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(in *MaintenanceTaskStatus, out *garden.MaintenanceTaskStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = garden.MaintenanceTaskState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(in *MaintenanceTaskStatus, out *garden.MaintenanceTaskStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceTaskStatus_To_garden_MaintenanceTaskStatus(in, out, s)
+}
+
+func autoConvert_garden_MaintenanceTaskStatus_To_v1beta1_MaintenanceTaskStatus(in *garden.MaintenanceTaskStatus, out *MaintenanceTaskStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = MaintenanceTaskState(in.State)
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_garden_MaintenanceTaskStatus_To_v1beta1_MaintenanceTaskStatus is an autogenerated conversion function.
+func Convert_garden_MaintenanceTaskStatus_To_v1beta1_MaintenanceTaskStatus(in *garden.MaintenanceTaskStatus, out *MaintenanceTaskStatus, s conversion.Scope) error {
+	return autoConvert_garden_MaintenanceTaskStatus_To_v1beta1_MaintenanceTaskStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_garden_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *garden.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -3760,6 +4064,7 @@ func autoConvert_v1beta1_Networking_To_garden_Networking(in *Networking, out *ga
 	// WARNING: in.K8SNetworks requires manual conversion: does not exist in peer-type
 	out.Type = in.Type
 	out.ProviderConfig = (*garden.ProviderConfig)(unsafe.Pointer(in.ProviderConfig))
+	out.ControlPlaneEgressDestinations = *(*[]garden.EgressDestination)(unsafe.Pointer(&in.ControlPlaneEgressDestinations))
 	return nil
 }
 
@@ -3769,6 +4074,7 @@ func autoConvert_garden_Networking_To_v1beta1_Networking(in *garden.Networking,
 	// WARNING: in.Pods requires manual conversion: does not exist in peer-type
 	// WARNING: in.Nodes requires manual conversion: does not exist in peer-type
 	// WARNING: in.Services requires manual conversion: does not exist in peer-type
+	out.ControlPlaneEgressDestinations = *(*[]EgressDestination)(unsafe.Pointer(&in.ControlPlaneEgressDestinations))
 	return nil
 }
 
@@ -4412,6 +4718,8 @@ func autoConvert_v1beta1_ProjectSpec_To_garden_ProjectSpec(in *ProjectSpec, out
 	// WARNING: in.Members requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	// WARNING: in.Viewers requires manual conversion: does not exist in peer-type
+	out.MaintenanceBlackoutWindows = *(*[]garden.MaintenanceBlackoutWindow)(unsafe.Pointer(&in.MaintenanceBlackoutWindows))
+	out.ShootDeletionProtection = (*bool)(unsafe.Pointer(in.ShootDeletionProtection))
 	return nil
 }
 
@@ -4422,6 +4730,8 @@ func autoConvert_garden_ProjectSpec_To_v1beta1_ProjectSpec(in *garden.ProjectSpe
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
 	// WARNING: in.ProjectMembers requires manual conversion: does not exist in peer-type
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.MaintenanceBlackoutWindows = *(*[]MaintenanceBlackoutWindow)(unsafe.Pointer(&in.MaintenanceBlackoutWindows))
+	out.ShootDeletionProtection = (*bool)(unsafe.Pointer(in.ShootDeletionProtection))
 	return nil
 }
 
@@ -4597,6 +4907,32 @@ func autoConvert_garden_Seed_To_v1beta1_Seed(in *garden.Seed, out *Seed, s conve
 	return nil
 }
 
+func autoConvert_v1beta1_SeedCapacity_To_garden_SeedCapacity(in *SeedCapacity, out *garden.SeedCapacity, s conversion.Scope) error {
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requested = *(*v1.ResourceList)(unsafe.Pointer(&in.Requested))
+	out.ExcessCapacityReserved = *(*v1.ResourceList)(unsafe.Pointer(&in.ExcessCapacityReserved))
+	out.ShootControlPlanes = in.ShootControlPlanes
+	return nil
+}
+
+// Convert_v1beta1_SeedCapacity_To_garden_SeedCapacity is an autogenerated conversion function.
+func Convert_v1beta1_SeedCapacity_To_garden_SeedCapacity(in *SeedCapacity, out *garden.SeedCapacity, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedCapacity_To_garden_SeedCapacity(in, out, s)
+}
+
+func autoConvert_garden_SeedCapacity_To_v1beta1_SeedCapacity(in *garden.SeedCapacity, out *SeedCapacity, s conversion.Scope) error {
+	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
+	out.Requested = *(*v1.ResourceList)(unsafe.Pointer(&in.Requested))
+	out.ExcessCapacityReserved = *(*v1.ResourceList)(unsafe.Pointer(&in.ExcessCapacityReserved))
+	out.ShootControlPlanes = in.ShootControlPlanes
+	return nil
+}
+
+// Convert_garden_SeedCapacity_To_v1beta1_SeedCapacity is an autogenerated conversion function.
+func Convert_garden_SeedCapacity_To_v1beta1_SeedCapacity(in *garden.SeedCapacity, out *SeedCapacity, s conversion.Scope) error {
+	return autoConvert_garden_SeedCapacity_To_v1beta1_SeedCapacity(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedCloud_To_garden_SeedCloud(in *SeedCloud, out *garden.SeedCloud, s conversion.Scope) error {
 	out.Profile = in.Profile
 	out.Region = in.Region
@@ -4661,6 +4997,26 @@ func Convert_garden_SeedList_To_v1beta1_SeedList(in *garden.SeedList, out *SeedL
 	return autoConvert_garden_SeedList_To_v1beta1_SeedList(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedLogging_To_garden_SeedLogging(in *SeedLogging, out *garden.SeedLogging, s conversion.Scope) error {
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	return nil
+}
+
+// Convert_v1beta1_SeedLogging_To_garden_SeedLogging is an autogenerated conversion function.
+func Convert_v1beta1_SeedLogging_To_garden_SeedLogging(in *SeedLogging, out *garden.SeedLogging, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedLogging_To_garden_SeedLogging(in, out, s)
+}
+
+func autoConvert_garden_SeedLogging_To_v1beta1_SeedLogging(in *garden.SeedLogging, out *SeedLogging, s conversion.Scope) error {
+	out.Endpoint = (*string)(unsafe.Pointer(in.Endpoint))
+	return nil
+}
+
+// Convert_garden_SeedLogging_To_v1beta1_SeedLogging is an autogenerated conversion function.
+func Convert_garden_SeedLogging_To_v1beta1_SeedLogging(in *garden.SeedLogging, out *SeedLogging, s conversion.Scope) error {
+	return autoConvert_garden_SeedLogging_To_v1beta1_SeedLogging(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedNetworks_To_garden_SeedNetworks(in *SeedNetworks, out *garden.SeedNetworks, s conversion.Scope) error {
 	out.Nodes = in.Nodes
 	out.Pods = in.Pods
@@ -4700,6 +5056,8 @@ func autoConvert_v1beta1_SeedSpec_To_garden_SeedSpec(in *SeedSpec, out *garden.S
 	// WARNING: in.Visible requires manual conversion: does not exist in peer-type
 	// WARNING: in.Protected requires manual conversion: does not exist in peer-type
 	out.Backup = (*garden.SeedBackup)(unsafe.Pointer(in.Backup))
+	out.BackupLimits = (*garden.BackupLimits)(unsafe.Pointer(in.BackupLimits))
+	out.Logging = (*garden.SeedLogging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
@@ -4716,7 +5074,9 @@ func autoConvert_garden_SeedSpec_To_v1beta1_SeedSpec(in *garden.SeedSpec, out *S
 	out.BlockCIDRs = *(*[]string)(unsafe.Pointer(&in.BlockCIDRs))
 	// WARNING: in.Taints requires manual conversion: does not exist in peer-type
 	out.Backup = (*BackupProfile)(unsafe.Pointer(in.Backup))
+	out.BackupLimits = (*BackupLimits)(unsafe.Pointer(in.BackupLimits))
 	// WARNING: in.Volume requires manual conversion: does not exist in peer-type
+	out.Logging = (*SeedLogging)(unsafe.Pointer(in.Logging))
 	return nil
 }
 
@@ -4726,6 +5086,7 @@ func autoConvert_v1beta1_SeedStatus_To_garden_SeedStatus(in *SeedStatus, out *ga
 	}
 	out.Conditions = *(*[]garden.Condition)(unsafe.Pointer(&in.Conditions))
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Capacity = (*garden.SeedCapacity)(unsafe.Pointer(in.Capacity))
 	return nil
 }
 
@@ -4740,6 +5101,7 @@ func autoConvert_garden_SeedStatus_To_v1beta1_SeedStatus(in *garden.SeedStatus,
 		return err
 	}
 	out.ObservedGeneration = in.ObservedGeneration
+	out.Capacity = (*SeedCapacity)(unsafe.Pointer(in.Capacity))
 	return nil
 }
 
@@ -4792,6 +5154,52 @@ func autoConvert_garden_Shoot_To_v1beta1_Shoot(in *garden.Shoot, out *Shoot, s c
 	return nil
 }
 
+func autoConvert_v1beta1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(in *ShootAutoRepairStatus, out *garden.ShootAutoRepairStatus, s conversion.Scope) error {
+	out.Pools = *(*[]garden.WorkerPoolAutoRepairStatus)(unsafe.Pointer(&in.Pools))
+	return nil
+}
+
+// Convert_v1beta1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(in *ShootAutoRepairStatus, out *garden.ShootAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootAutoRepairStatus_To_garden_ShootAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootAutoRepairStatus_To_v1beta1_ShootAutoRepairStatus(in *garden.ShootAutoRepairStatus, out *ShootAutoRepairStatus, s conversion.Scope) error {
+	out.Pools = *(*[]WorkerPoolAutoRepairStatus)(unsafe.Pointer(&in.Pools))
+	return nil
+}
+
+// Convert_garden_ShootAutoRepairStatus_To_v1beta1_ShootAutoRepairStatus is an autogenerated conversion function.
+func Convert_garden_ShootAutoRepairStatus_To_v1beta1_ShootAutoRepairStatus(in *garden.ShootAutoRepairStatus, out *ShootAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootAutoRepairStatus_To_v1beta1_ShootAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.DeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.GarbageCollectionPolicy = (*garden.BackupGarbageCollectionPolicy)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_v1beta1_ShootBackup_To_garden_ShootBackup is an autogenerated conversion function.
+func Convert_v1beta1_ShootBackup_To_garden_ShootBackup(in *ShootBackup, out *garden.ShootBackup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootBackup_To_garden_ShootBackup(in, out, s)
+}
+
+func autoConvert_garden_ShootBackup_To_v1beta1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	out.Schedule = (*string)(unsafe.Pointer(in.Schedule))
+	out.DeltaSnapshotPeriod = (*metav1.Duration)(unsafe.Pointer(in.DeltaSnapshotPeriod))
+	out.GarbageCollectionPolicy = (*BackupGarbageCollectionPolicy)(unsafe.Pointer(in.GarbageCollectionPolicy))
+	out.MaxBackups = (*int32)(unsafe.Pointer(in.MaxBackups))
+	return nil
+}
+
+// Convert_garden_ShootBackup_To_v1beta1_ShootBackup is an autogenerated conversion function.
+func Convert_garden_ShootBackup_To_v1beta1_ShootBackup(in *garden.ShootBackup, out *ShootBackup, s conversion.Scope) error {
+	return autoConvert_garden_ShootBackup_To_v1beta1_ShootBackup(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootList_To_garden_ShootList(in *ShootList, out *garden.ShootList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -4858,6 +5266,28 @@ func Convert_garden_ShootMachineImage_To_v1beta1_ShootMachineImage(in *garden.Sh
 	return autoConvert_garden_ShootMachineImage_To_v1beta1_ShootMachineImage(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *garden.ShootMaintenanceStatus, s conversion.Scope) error {
+	out.LastMaintenanceTime = in.LastMaintenanceTime
+	out.Tasks = *(*[]garden.MaintenanceTaskStatus)(unsafe.Pointer(&in.Tasks))
+	return nil
+}
+
+// Convert_v1beta1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_v1beta1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(in *ShootMaintenanceStatus, out *garden.ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootMaintenanceStatus_To_garden_ShootMaintenanceStatus(in, out, s)
+}
+
+func autoConvert_garden_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in *garden.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	out.LastMaintenanceTime = in.LastMaintenanceTime
+	out.Tasks = *(*[]MaintenanceTaskStatus)(unsafe.Pointer(&in.Tasks))
+	return nil
+}
+
+// Convert_garden_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus is an autogenerated conversion function.
+func Convert_garden_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in *garden.ShootMaintenanceStatus, out *ShootMaintenanceStatus, s conversion.Scope) error {
+	return autoConvert_garden_ShootMaintenanceStatus_To_v1beta1_ShootMaintenanceStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootNetworks_To_garden_ShootNetworks(in *ShootNetworks, out *garden.ShootNetworks, s conversion.Scope) error {
 	out.Pods = (*string)(unsafe.Pointer(in.Pods))
 	out.Services = (*string)(unsafe.Pointer(in.Services))
@@ -4882,6 +5312,7 @@ func Convert_garden_ShootNetworks_To_v1beta1_ShootNetworks(in *garden.ShootNetwo
 
 func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garden.ShootSpec, s conversion.Scope) error {
 	out.Addons = (*garden.Addons)(unsafe.Pointer(in.Addons))
+	out.Backup = (*garden.ShootBackup)(unsafe.Pointer(in.Backup))
 	if err := Convert_v1beta1_Cloud_To_garden_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
@@ -4898,6 +5329,7 @@ func autoConvert_v1beta1_ShootSpec_To_garden_ShootSpec(in *ShootSpec, out *garde
 
 func autoConvert_garden_ShootSpec_To_v1beta1_ShootSpec(in *garden.ShootSpec, out *ShootSpec, s conversion.Scope) error {
 	out.Addons = (*Addons)(unsafe.Pointer(in.Addons))
+	out.Backup = (*ShootBackup)(unsafe.Pointer(in.Backup))
 	if err := Convert_garden_Cloud_To_v1beta1_Cloud(&in.Cloud, &out.Cloud, s); err != nil {
 		return err
 	}
@@ -4932,6 +5364,9 @@ func autoConvert_v1beta1_ShootStatus_To_garden_ShootStatus(in *ShootStatus, out
 	out.IsHibernated = (*bool)(unsafe.Pointer(in.IsHibernated))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.Maintenance = (*garden.ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.AutoRepair = (*garden.ShootAutoRepairStatus)(unsafe.Pointer(in.AutoRepair))
+	out.DNSProviders = *(*[]garden.DNSProviderStatus)(unsafe.Pointer(&in.DNSProviders))
 	return nil
 }
 
@@ -4950,6 +5385,9 @@ func autoConvert_garden_ShootStatus_To_v1beta1_ShootStatus(in *garden.ShootStatu
 	out.IsHibernated = (*bool)(unsafe.Pointer(in.IsHibernated))
 	out.TechnicalID = in.TechnicalID
 	out.UID = types.UID(in.UID)
+	out.Maintenance = (*ShootMaintenanceStatus)(unsafe.Pointer(in.Maintenance))
+	out.AutoRepair = (*ShootAutoRepairStatus)(unsafe.Pointer(in.AutoRepair))
+	out.DNSProviders = *(*[]DNSProviderStatus)(unsafe.Pointer(&in.DNSProviders))
 	return nil
 }
 
@@ -4990,20 +5428,25 @@ func autoConvert_v1beta1_Worker_To_garden_Worker(in *Worker, out *garden.Worker,
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	// WARNING: in.Kubelet requires manual conversion: does not exist in peer-type
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*garden.CRI)(unsafe.Pointer(in.CRI))
 	return nil
 }
 
 func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	// WARNING: in.AutoRepair requires manual conversion: does not exist in peer-type
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
+	out.CRI = (*CRI)(unsafe.Pointer(in.CRI))
 	// WARNING: in.Kubernetes requires manual conversion: does not exist in peer-type
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.Name = in.Name
 	// WARNING: in.Machine requires manual conversion: does not exist in peer-type
+	// WARNING: in.MaxNodeAge requires manual conversion: does not exist in peer-type
 	// WARNING: in.Maximum requires manual conversion: does not exist in peer-type
 	// WARNING: in.Minimum requires manual conversion: does not exist in peer-type
 	out.MaxSurge = (*intstr.IntOrString)(unsafe.Pointer(in.MaxSurge))
 	out.MaxUnavailable = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailable))
+	// WARNING: in.OperatingSystemConfig requires manual conversion: does not exist in peer-type
 	// WARNING: in.ProviderConfig requires manual conversion: does not exist in peer-type
 	out.Taints = *(*[]v1.Taint)(unsafe.Pointer(&in.Taints))
 	// WARNING: in.Volume requires manual conversion: does not exist in peer-type
@@ -5011,6 +5454,28 @@ func autoConvert_garden_Worker_To_v1beta1_Worker(in *garden.Worker, out *Worker,
 	return nil
 }
 
+func autoConvert_v1beta1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(in *WorkerPoolAutoRepairStatus, out *garden.WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastRepairTime = in.LastRepairTime
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(in *WorkerPoolAutoRepairStatus, out *garden.WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolAutoRepairStatus_To_garden_WorkerPoolAutoRepairStatus(in, out, s)
+}
+
+func autoConvert_garden_WorkerPoolAutoRepairStatus_To_v1beta1_WorkerPoolAutoRepairStatus(in *garden.WorkerPoolAutoRepairStatus, out *WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.LastRepairTime = in.LastRepairTime
+	return nil
+}
+
+// Convert_garden_WorkerPoolAutoRepairStatus_To_v1beta1_WorkerPoolAutoRepairStatus is an autogenerated conversion function.
+func Convert_garden_WorkerPoolAutoRepairStatus_To_v1beta1_WorkerPoolAutoRepairStatus(in *garden.WorkerPoolAutoRepairStatus, out *WorkerPoolAutoRepairStatus, s conversion.Scope) error {
+	return autoConvert_garden_WorkerPoolAutoRepairStatus_To_v1beta1_WorkerPoolAutoRepairStatus(in, out, s)
+}
+
 func autoConvert_v1beta1_Zone_To_garden_Zone(in *Zone, out *garden.Zone, s conversion.Scope) error {
 	out.Region = in.Region
 	out.Names = *(*[]string)(unsafe.Pointer(&in.Names))
@@ -19,11 +19,14 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"net/url"
+	"path"
 	"regexp"
 	"strconv"
 	"strings"
 	"time"
 
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	"github.com/gardener/gardener/pkg/apis/garden/helper"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -54,6 +57,110 @@ var (
 		garden.KubernetesDashboardAuthModeBasic,
 		garden.KubernetesDashboardAuthModeToken,
 	)
+	availableHibernationModes = sets.NewString(
+		string(garden.HibernationModeFull),
+		string(garden.HibernationModeWorkersOnly),
+	)
+	availableSeedTaints = sets.NewString(
+		garden.SeedTaintProtected,
+		garden.SeedTaintInvisible,
+		garden.SeedTaintCordoned,
+	)
+	availableBackupGarbageCollectionPolicies = sets.NewString(
+		string(garden.BackupGarbageCollectionPolicyExponential),
+		string(garden.BackupGarbageCollectionPolicyLimitBased),
+	)
+	availableCRINames = sets.NewString(
+		string(garden.CRINameDocker),
+		string(garden.CRINameContainerD),
+	)
+	availableWorkerUnitCommands = sets.NewString(
+		"start",
+		"restart",
+		"stop",
+	)
+	availableWorkerFileEncodings = sets.NewString(
+		"",
+		"b64",
+	)
+
+	// forbiddenWorkerUnitNames contains the systemd units which are owned by Gardener and must not be overwritten by
+	// the units of a worker pool (see charts/seed-operatingsystemconfig).
+	forbiddenWorkerUnitNames = sets.NewString(
+		"cloud-config-downloader.service",
+		"containerd.service",
+		"docker.service",
+		"docker-logrotate.service",
+		"docker-logrotate.timer",
+		"docker-monitor.service",
+		"gardener-user.service",
+		"kubelet-monitor.service",
+		"systemd-sysctl.service",
+		"updatecacerts.service",
+		v1alpha1constants.OperatingSystemConfigUnitNameKubeletService,
+	)
+	// forbiddenWorkerFilePaths contains the files which are owned by Gardener and must not be overwritten by the files
+	// of a worker pool (see charts/seed-operatingsystemconfig).
+	forbiddenWorkerFilePaths = sets.NewString(
+		"/etc/ssl/certs/ROOTcerts.pem",
+		"/etc/systemd/docker.conf",
+		"/etc/systemd/journald.conf",
+		"/opt/bin/health-monitor",
+		v1alpha1constants.OperatingSystemConfigFilePathKernelSettings,
+		v1alpha1constants.OperatingSystemConfigFilePathWorkerKernelSettings,
+	)
+	// forbiddenWorkerFilePathPrefixes contains the directories whose files are owned by Gardener or by the container
+	// runtime extensions (see ContainerRuntimesBinaryPath in pkg/operation/botanist).
+	forbiddenWorkerFilePathPrefixes = []string{
+		"/var/bin/containerruntimes/",
+		"/var/lib/cloud-config-downloader/",
+		"/var/lib/gardener-user/",
+		"/var/lib/kubelet/",
+	}
+	// systemdUnitDirectories contains the directories from which systemd loads units and their drop-ins. Files in these
+	// directories must neither replace nor extend the units owned by Gardener.
+	systemdUnitDirectories = []string{
+		"/etc/systemd/system/",
+		"/run/systemd/system/",
+		"/lib/systemd/system/",
+		"/usr/lib/systemd/system/",
+	}
+
+	sysctlNameRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]+([./][a-zA-Z0-9_-]+)+$`)
+	// forbiddenSysctlNames contains the kernel parameters which are set by Gardener (see the kernel-config in
+	// charts/seed-operatingsystemconfig) or which are required by the container networking. The kernel parameters of a
+	// worker pool are written to a file which is applied after the one of Gardener, hence, they would override them.
+	forbiddenSysctlNames = sets.NewString(
+		"fs.aio-max-nr",
+		"fs.file-max",
+		"fs.inotify.max_user_instances",
+		"fs.inotify.max_user_watches",
+		"kernel.softlockup_all_cpu_backtrace",
+		"kernel.softlockup_panic",
+		"net.core.netdev_max_backlog",
+		"net.core.rmem_max",
+		"net.core.somaxconn",
+		"net.core.wmem_max",
+		"net.ipv4.ip_forward",
+		"net.ipv4.ip_local_port_range",
+		"net.ipv4.tcp_max_syn_backlog",
+		"net.ipv4.tcp_retries2",
+		"net.ipv4.tcp_rmem",
+		"net.ipv4.tcp_slow_start_after_idle",
+		"net.ipv4.tcp_tw_reuse",
+		"net.ipv4.tcp_wmem",
+		"net.netfilter.nf_conntrack_max",
+		"vm.max_map_count",
+		"vm.memory_failure_early_kill",
+	)
+)
+
+const (
+	// minWorkerMaxNodeAge is the minimum maximum node age of a worker pool to prevent permanent node rolling.
+	minWorkerMaxNodeAge = time.Hour
+	// minWorkerAutoRepairUnhealthyThreshold is the minimum duration a node must be unhealthy before its machine is
+	// replaced, to not replace machines because of short hiccups.
+	minWorkerAutoRepairUnhealthyThreshold = time.Minute
 )
 
 // ValidateName is a helper function for validating that a name is a DNS sub domain.
@@ -193,6 +300,7 @@ func ValidateCloudProfileSpec(spec *garden.CloudProfileSpec, fldPath *field.Path
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.SeedSelector, fldPath.Child("seedSelector"))...)
 	}
+	allErrs = append(allErrs, validateBackupLimits(spec.BackupLimits, fldPath.Child("backupLimits"))...)
 
 	switch {
 	case spec.AWS != nil:
@@ -314,6 +422,22 @@ func validateKubernetesConstraints(kubernetes garden.KubernetesConstraints, fldP
 	return allErrs
 }
 
+func validateBackupLimits(limits *garden.BackupLimits, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if limits == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, ValidatePositiveDuration(limits.MinFullSnapshotInterval, fldPath.Child("minFullSnapshotInterval"))...)
+	allErrs = append(allErrs, ValidatePositiveDuration(limits.MinDeltaSnapshotPeriod, fldPath.Child("minDeltaSnapshotPeriod"))...)
+	if limits.MaxBackups != nil && *limits.MaxBackups <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackups"), *limits.MaxBackups, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
 func validateKubernetesSettings(kubernetes garden.KubernetesSettings, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -511,6 +635,17 @@ func validateCloudProfileMachineImages(machineImages []garden.CloudProfileMachin
 				allErrs = append(allErrs, field.Invalid(versionsPath.Child("version"), machineVersion.Version, "could not parse version. Use SemanticVersioning. In case there is no semVer version for this image use the extensibility provider (define mapping in the ControllerRegistration) to map to the actual non-semVer version"))
 			}
 		}
+
+		criNames := sets.NewString()
+		for j, cri := range image.CRI {
+			criPath := idxPath.Child("cri").Index(j)
+			if criNames.Has(string(cri.Name)) {
+				allErrs = append(allErrs, field.Duplicate(criPath.Child("name"), cri.Name))
+			}
+			criNames.Insert(string(cri.Name))
+
+			allErrs = append(allErrs, validateCRI(cri, criPath)...)
+		}
 	}
 
 	return allErrs
@@ -735,6 +870,38 @@ func ValidateProjectSpec(projectSpec *garden.ProjectSpec, fldPath *field.Path) f
 	if purpose := projectSpec.Description; purpose != nil && len(*purpose) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("purpose"), "must provide a purpose when key is present"))
 	}
+	allErrs = append(allErrs, ValidateMaintenanceBlackoutWindows(projectSpec.MaintenanceBlackoutWindows, fldPath.Child("maintenanceBlackoutWindows"))...)
+
+	return allErrs
+}
+
+// ValidateMaintenanceBlackoutWindows validates a list of maintenance blackout windows.
+func ValidateMaintenanceBlackoutWindows(windows []garden.MaintenanceBlackoutWindow, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.NewString()
+	)
+
+	for i, window := range windows {
+		idxPath := fldPath.Index(i)
+
+		if len(window.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(window.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), window.Name))
+		}
+		names.Insert(window.Name)
+
+		if !window.End.After(window.Begin.Time) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), window.End, "end must be after begin"))
+		}
+		if window.Reason != nil && len(*window.Reason) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("reason"), "must provide a reason when key is present"))
+		}
+		if window.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(window.ShootSelector, idxPath.Child("shootSelector"))...)
+		}
+	}
 
 	return allErrs
 }
@@ -795,6 +962,19 @@ func ValidateSeed(seed *garden.Seed) field.ErrorList {
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&seed.ObjectMeta, false, ValidateName, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, ValidateSeedSpec(&seed.Spec, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateSeedAnnotation(seed.ObjectMeta.Annotations, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateSeedDrain(seed, field.NewPath("metadata", "annotations"))...)
+
+	return allErrs
+}
+
+// validateSeedDrain validates that a Seed is only drained if it is cordoned, as otherwise new shoots could be
+// scheduled onto it while the existing ones are migrated away.
+func validateSeedDrain(seed *garden.Seed, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if seed.Annotations[v1alpha1constants.GardenerOperation] == v1alpha1constants.GardenerOperationDrain && !helper.TaintsHave(seed.Spec.Taints, garden.SeedTaintCordoned) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Key(v1alpha1constants.GardenerOperation), fmt.Sprintf("seed must have the %s taint to be drained", garden.SeedTaintCordoned)))
+	}
 
 	return allErrs
 }
@@ -882,6 +1062,7 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 
 		allErrs = append(allErrs, validateSecretReference(seedSpec.Backup.SecretRef, fldPath.Child("backup", "secretRef"))...)
 	}
+	allErrs = append(allErrs, validateBackupLimits(seedSpec.BackupLimits, fldPath.Child("backupLimits"))...)
 
 	taintKeys := sets.NewString()
 	for i, taint := range seedSpec.Taints {
@@ -892,8 +1073,8 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 		if taintKeys.Has(taint.Key) {
 			allErrs = append(allErrs, field.Duplicate(idxPath.Child("key"), taint.Key))
 		}
-		if taint.Key != garden.SeedTaintProtected && taint.Key != garden.SeedTaintInvisible {
-			allErrs = append(allErrs, field.NotSupported(idxPath.Child("key"), taint.Key, []string{garden.SeedTaintProtected, garden.SeedTaintInvisible}))
+		if !availableSeedTaints.Has(taint.Key) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("key"), taint.Key, availableSeedTaints.List()))
 		}
 		taintKeys.Insert(taint.Key)
 	}
@@ -919,6 +1100,33 @@ func ValidateSeedSpec(seedSpec *garden.SeedSpec, fldPath *field.Path) field.Erro
 		}
 	}
 
+	if seedSpec.Logging != nil && seedSpec.Logging.Endpoint != nil {
+		allErrs = append(allErrs, validateLoggingEndpoint(*seedSpec.Logging.Endpoint, fldPath.Child("logging", "endpoint"))...)
+	}
+
+	return allErrs
+}
+
+func validateLoggingEndpoint(endpoint string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, endpoint, err.Error()))
+		return allErrs
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		allErrs = append(allErrs, field.Invalid(fldPath, endpoint, "scheme must be one of [http,https]"))
+	}
+	if len(u.Hostname()) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, endpoint, "must contain a host"))
+	}
+	if port := u.Port(); len(port) > 0 {
+		if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, endpoint, "port must be a valid port number"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -1117,6 +1325,9 @@ func ValidateShoot(shoot *garden.Shoot) field.ErrorList {
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shoot.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateNameConsecutiveHyphens(shoot.Name, field.NewPath("metadata", "name"))...)
+	allErrs = append(allErrs, validateShootMigration(shoot, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootETCDRestore(shoot, field.NewPath("metadata", "annotations"))...)
+	allErrs = append(allErrs, validateShootRollWorkerPools(shoot, field.NewPath("metadata", "annotations"))...)
 	allErrs = append(allErrs, ValidateShootSpec(&shoot.Spec, field.NewPath("spec"))...)
 
 	return allErrs
@@ -1126,24 +1337,117 @@ func ValidateShoot(shoot *garden.Shoot) field.ErrorList {
 func ValidateShootUpdate(newShoot, oldShoot *garden.Shoot) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	oldSpec := &oldShoot.Spec
+	if isShootMigratedToSeed(oldShoot, newShoot.Spec.Cloud.Seed) {
+		// The control plane is migrated to another Seed, hence, the Seed may be switched to the migration target.
+		// Whether the user may request the migration or switch the Seed is checked by the ShootMigration admission
+		// plugin, as the annotations are writable by everyone who may update the Shoot.
+		oldSpec = oldSpec.DeepCopy()
+		oldSpec.Cloud.Seed = newShoot.Spec.Cloud.Seed
+		oldSpec.SeedName = newShoot.Spec.SeedName
+	}
+
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newShoot.ObjectMeta, &oldShoot.ObjectMeta, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, &oldShoot.Spec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateShootSpecUpdate(&newShoot.Spec, oldSpec, newShoot.DeletionTimestamp != nil, field.NewPath("spec"))...)
 	allErrs = append(allErrs, ValidateShoot(newShoot)...)
 
 	return allErrs
 }
 
+// isShootMigratedToSeed returns true if the migration of the control plane of the given Shoot to the given Seed has
+// been requested.
+func isShootMigratedToSeed(shoot *garden.Shoot, seedName *string) bool {
+	if shoot.Annotations[common.ShootOperation] != common.ShootOperationMigrate || seedName == nil {
+		return false
+	}
+	targetSeed, ok := shoot.Annotations[common.ShootMigrationTargetSeed]
+	return ok && targetSeed == *seedName
+}
+
+func validateShootMigration(shoot *garden.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shoot.Annotations[common.ShootOperation] != common.ShootOperationMigrate {
+		return allErrs
+	}
+
+	targetSeed, ok := shoot.Annotations[common.ShootMigrationTargetSeed]
+	if !ok || len(targetSeed) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Key(common.ShootMigrationTargetSeed), "the target seed must be given when migrating the control plane"))
+		return allErrs
+	}
+	if shoot.Spec.Cloud.Seed == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Key(common.ShootOperation), "the control plane of a shoot which has not been scheduled to a seed cannot be migrated"))
+		return allErrs
+	}
+	if _, switched := shoot.Annotations[common.ShootMigrationSourceSeed]; !switched && targetSeed == *shoot.Spec.Cloud.Seed {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(common.ShootMigrationTargetSeed), targetSeed, "the target seed must differ from the current seed"))
+	}
+
+	return allErrs
+}
+
+func validateShootETCDRestore(shoot *garden.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shoot.Annotations[common.ShootOperation] != common.ShootOperationRestoreETCD {
+		return allErrs
+	}
+
+	target, ok := shoot.Annotations[common.ShootETCDRestoreTarget]
+	if !ok || len(target) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Key(common.ShootETCDRestoreTarget), "the timestamp or revision to restore must be given when restoring the etcd"))
+		return allErrs
+	}
+	if _, err := common.ParseETCDRestoreTarget(target); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Key(common.ShootETCDRestoreTarget), target, err.Error()))
+	}
+	if shoot.Spec.Cloud.Seed == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Key(common.ShootOperation), "the etcd of a shoot which has not been scheduled to a seed cannot be restored"))
+	}
+
+	return allErrs
+}
+
+func validateShootRollWorkerPools(shoot *garden.Shoot, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if shoot.Annotations[common.ShootOperation] != common.ShootOperationRollWorkerPools {
+		return allErrs
+	}
+
+	names := common.ParseRollWorkerPools(shoot.Annotations[common.ShootRollWorkerPools])
+	if len(names) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Key(common.ShootRollWorkerPools), "the worker pools must be given when rolling their nodes"))
+		return allErrs
+	}
+
+	workerNames := sets.NewString()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerNames.Insert(worker.Name)
+	}
+	for _, name := range names {
+		if !workerNames.Has(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(common.ShootRollWorkerPools), name, "the shoot does not have a worker pool with this name"))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateShootSpec validates the specification of a Shoot object.
 func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	cloudPath := fldPath.Child("cloud")
-	if _, err := helper.DetermineCloudProviderInShoot(spec.Cloud); err != nil {
+	cloudProvider, err := helper.DetermineCloudProviderInShoot(spec.Cloud)
+	if err != nil {
 		allErrs = append(allErrs, field.Forbidden(cloudPath.Child("aws/azure/gcp/alicloud/openstack/packet"), "cloud section must only contain exactly one field of aws/azure/gcp/alicloud/openstack/packet"))
 		return allErrs
 	}
 
 	allErrs = append(allErrs, validateAddons(spec.Addons, spec.Kubernetes.KubeAPIServer, fldPath.Child("addons"))...)
+	allErrs = append(allErrs, validateShootBackup(spec.Backup, fldPath.Child("backup"))...)
 	allErrs = append(allErrs, validateCloud(spec.Cloud, spec.Kubernetes, fldPath.Child("cloud"))...)
 	allErrs = append(allErrs, validateDNS(spec.DNS, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
@@ -1151,6 +1455,7 @@ func ValidateShootSpec(spec *garden.ShootSpec, fldPath *field.Path) field.ErrorL
 	allErrs = append(allErrs, validateNetworking(spec.Networking, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
 	allErrs = append(allErrs, ValidateHibernation(spec.Hibernation, fldPath.Child("hibernation"))...)
+	allErrs = append(allErrs, validateHibernationExcludedWorkerPools(spec.Hibernation, helper.GetShootCloudProviderWorkers(cloudProvider, spec.Cloud), fldPath.Child("hibernation", "excludedWorkerPools"))...)
 	allErrs = append(allErrs, validateProvider(spec.Provider, fldPath.Child("provider"))...)
 
 	if len(spec.CloudProfileName) == 0 {
@@ -1245,6 +1550,36 @@ func validateAddons(addons *garden.Addons, kubeAPIServerConfig *garden.KubeAPISe
 	return allErrs
 }
 
+func validateShootBackup(backup *garden.ShootBackup, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if backup == nil {
+		return allErrs
+	}
+
+	if backup.Schedule != nil {
+		if _, err := cron.ParseStandard(*backup.Schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), *backup.Schedule, fmt.Sprintf("not a valid cron spec: %v", err)))
+		}
+	}
+	if backup.DeltaSnapshotPeriod != nil && backup.DeltaSnapshotPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("deltaSnapshotPeriod"), backup.DeltaSnapshotPeriod.Duration.String(), "must be greater than 0"))
+	}
+	if backup.GarbageCollectionPolicy != nil && !availableBackupGarbageCollectionPolicies.Has(string(*backup.GarbageCollectionPolicy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("garbageCollectionPolicy"), *backup.GarbageCollectionPolicy, availableBackupGarbageCollectionPolicies.List()))
+	}
+	if backup.MaxBackups != nil {
+		if *backup.MaxBackups <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackups"), *backup.MaxBackups, "must be greater than 0"))
+		}
+		if backup.GarbageCollectionPolicy == nil || *backup.GarbageCollectionPolicy != garden.BackupGarbageCollectionPolicyLimitBased {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxBackups"), fmt.Sprintf("can only be set for the %s garbage collection policy", garden.BackupGarbageCollectionPolicyLimitBased)))
+		}
+	}
+
+	return allErrs
+}
+
 func validateCloud(cloud garden.Cloud, kubernetes garden.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	workerNames := make(map[string]bool)
@@ -1809,6 +2144,7 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *garden.ShootSpec, deletionTimesta
 	allErrs = append(allErrs, validateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, fldPath.Child("kubernetes", "version"))...)
 	allErrs = append(allErrs, validateKubeProxyModeUpdate(newSpec.Kubernetes.KubeProxy, oldSpec.Kubernetes.KubeProxy, newSpec.Kubernetes.Version, fldPath.Child("kubernetes", "kubeProxy"))...)
 	allErrs = append(allErrs, validateKubeControllerManagerConfiguration(newSpec.Kubernetes.KubeControllerManager, oldSpec.Kubernetes.KubeControllerManager, fldPath.Child("kubernetes", "kubeControllerManager"))...)
+	allErrs = append(allErrs, validateHibernationUpdate(newSpec.Hibernation, oldSpec.Hibernation, fldPath.Child("hibernation"))...)
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Provider.Type, oldSpec.Provider.Type, fldPath.Child("provider", "type"))...)
 
@@ -1884,17 +2220,14 @@ func validateDNSUpdate(new, old *garden.DNS, fldPath *field.Path) field.ErrorLis
 	if old != nil {
 		providersOld = len(old.Providers)
 	}
-	if providersNew != providersOld {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providers"), "adding or removing providers is not yet allowed"))
+	// Additional providers may be added or removed, only the primary provider must stay.
+	if (providersNew == 0) != (providersOld == 0) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providers"), "adding or removing the primary provider is not yet allowed"))
 		return allErrs
 	}
 
-	if new != nil {
-		for i, provider := range new.Providers {
-			if provider.Type != old.Providers[i].Type {
-				allErrs = append(allErrs, apivalidation.ValidateImmutableField(provider.Type, old.Providers[i].Type, fldPath.Child("providers").Index(i).Child("type"))...)
-			}
-		}
+	if providersNew > 0 && new.Providers[0].Type != old.Providers[0].Type {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Providers[0].Type, old.Providers[0].Type, fldPath.Child("providers").Index(0).Child("type"))...)
 	}
 
 	return allErrs
@@ -1961,6 +2294,59 @@ func validateDNS(dns *garden.DNS, fldPath *field.Path) field.ErrorList {
 		if provider.SecretName != nil && provider.Type == nil {
 			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "type must be set when secretName is set"))
 		}
+
+		// The first provider is the primary provider which manages the record for the shoot's domain, all other
+		// providers are additional providers which manage records for additional domains.
+		if i == 0 {
+			if provider.Domain != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("domain"), "domain must not be set for the primary provider, use .spec.dns.domain instead"))
+			}
+			continue
+		}
+
+		if provider.Type == nil {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "type must be set for additional providers"))
+		} else if *provider.Type == garden.DNSUnmanaged {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("type"), fmt.Sprintf("type must not be %q for additional providers", garden.DNSUnmanaged)))
+		}
+
+		if provider.Domain != nil {
+			allErrs = append(allErrs, validateDNS1123Subdomain(*provider.Domain, idxPath.Child("domain"))...)
+		}
+	}
+
+	allErrs = append(allErrs, validateDNSAdditionalProvidersDuplicates(dns, fldPath.Child("providers"))...)
+
+	return allErrs
+}
+
+// validateDNSAdditionalProvidersDuplicates validates that no two additional providers of the same type manage a record
+// for the same domain.
+func validateDNSAdditionalProvidersDuplicates(dns *garden.DNS, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.NewString()
+	)
+
+	for i := 1; i < len(dns.Providers); i++ {
+		provider := dns.Providers[i]
+		if provider.Type == nil {
+			continue
+		}
+
+		domain := provider.Domain
+		if domain == nil {
+			domain = dns.Domain
+		}
+		if domain == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s", *provider.Type, *domain)
+		if seen.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("domain"), *domain))
+		}
+		seen.Insert(key)
 	}
 
 	return allErrs
@@ -2150,6 +2536,41 @@ func validateNetworking(networking garden.Networking, fldPath *field.Path) field
 	if len(networking.Type) == 0 {
 		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "networking type must be provided"))
 	}
+	allErrs = append(allErrs, validateEgressDestinations(networking.ControlPlaneEgressDestinations, fldPath.Child("controlPlaneEgressDestinations"))...)
+
+	return allErrs
+}
+
+func validateEgressDestinations(destinations []garden.EgressDestination, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.NewString()
+	)
+
+	for i, destination := range destinations {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case destination.CIDR != nil && destination.FQDN != nil:
+			allErrs = append(allErrs, field.Forbidden(idxPath, "must not specify both cidr and fqdn"))
+		case destination.CIDR != nil:
+			allErrs = append(allErrs, cidrvalidation.NewCIDR(*destination.CIDR, idxPath.Child("cidr")).ValidateParse()...)
+			if seen.Has(*destination.CIDR) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("cidr"), *destination.CIDR))
+			}
+			seen.Insert(*destination.CIDR)
+		case destination.FQDN != nil:
+			for _, msg := range validation.IsDNS1123Subdomain(*destination.FQDN) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("fqdn"), *destination.FQDN, msg))
+			}
+			if seen.Has(*destination.FQDN) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("fqdn"), *destination.FQDN))
+			}
+			seen.Insert(*destination.FQDN)
+		default:
+			allErrs = append(allErrs, field.Required(idxPath, "must specify either cidr or fqdn"))
+		}
+	}
 
 	return allErrs
 }
@@ -2334,6 +2755,15 @@ func ValidateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 	if worker.Kubernetes != nil && worker.Kubernetes.Kubelet != nil {
 		allErrs = append(allErrs, ValidateKubeletConfig(*worker.Kubernetes.Kubelet, fldPath.Child("kubernetes", "kubelet"))...)
 	}
+	if worker.MaxNodeAge != nil && worker.MaxNodeAge.Duration < minWorkerMaxNodeAge {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodeAge"), worker.MaxNodeAge.Duration.String(), fmt.Sprintf("maximum node age must be at least %s", minWorkerMaxNodeAge)))
+	}
+	if worker.AutoRepair != nil {
+		allErrs = append(allErrs, validateWorkerAutoRepair(*worker.AutoRepair, fldPath.Child("autoRepair"))...)
+	}
+	if worker.Kubernetes != nil && worker.Kubernetes.Version != nil && len(*worker.Kubernetes.Version) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kubernetes", "version"), "kubernetes version of the worker pool must not be empty if set"))
+	}
 
 	if worker.CABundle != nil {
 		if _, err := utils.DecodeCertificate([]byte(*worker.CABundle)); err != nil {
@@ -2341,6 +2771,14 @@ func ValidateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 		}
 	}
 
+	if worker.CRI != nil {
+		allErrs = append(allErrs, validateCRI(*worker.CRI, fldPath.Child("cri"))...)
+	}
+
+	if worker.OperatingSystemConfig != nil {
+		allErrs = append(allErrs, validateWorkerOperatingSystemConfig(*worker.OperatingSystemConfig, fldPath.Child("operatingSystemConfig"))...)
+	}
+
 	if worker.Volume != nil {
 		volumeSizeRegex, _ := regexp.Compile(`^(\d)+Gi$`)
 		if !volumeSizeRegex.MatchString(worker.Volume.Size) {
@@ -2351,6 +2789,158 @@ func ValidateWorker(worker garden.Worker, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+func validateWorkerAutoRepair(autoRepair garden.WorkerAutoRepair, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if autoRepair.UnhealthyThreshold.Duration < minWorkerAutoRepairUnhealthyThreshold {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("unhealthyThreshold"), autoRepair.UnhealthyThreshold.Duration.String(), fmt.Sprintf("unhealthy threshold must be at least %s", minWorkerAutoRepairUnhealthyThreshold)))
+	}
+	if autoRepair.MaxConcurrentRepairs != nil && *autoRepair.MaxConcurrentRepairs < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentRepairs"), *autoRepair.MaxConcurrentRepairs, "maximum number of concurrent repairs must be at least 1"))
+	}
+
+	return allErrs
+}
+
+func validateCRI(cri garden.CRI, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !availableCRINames.Has(string(cri.Name)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), cri.Name, availableCRINames.List()))
+	}
+
+	if len(cri.ContainerRuntimes) > 0 && cri.Name != garden.CRINameContainerD {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("containerRuntimes"), fmt.Sprintf("additional container runtimes are only supported for the %q CRI", garden.CRINameContainerD)))
+	}
+
+	types := sets.NewString()
+	for i, containerRuntime := range cri.ContainerRuntimes {
+		idxPath := fldPath.Child("containerRuntimes").Index(i)
+		if len(containerRuntime.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must specify a container runtime type"))
+			continue
+		}
+		allErrs = append(allErrs, validateDNS1123Label(containerRuntime.Type, idxPath.Child("type"))...)
+
+		if types.Has(containerRuntime.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), containerRuntime.Type))
+		}
+		types.Insert(containerRuntime.Type)
+	}
+
+	return allErrs
+}
+
+func validateWorkerOperatingSystemConfig(osc garden.WorkerOperatingSystemConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	unitNames := sets.NewString()
+	for i, unit := range osc.Units {
+		idxPath := fldPath.Child("units").Index(i)
+
+		if len(unit.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must specify a unit name"))
+		} else if forbiddenWorkerUnitNames.Has(unit.Name) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("unit %q is managed by Gardener", unit.Name)))
+		} else if unitNames.Has(unit.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), unit.Name))
+		}
+		unitNames.Insert(unit.Name)
+
+		if unit.Command != nil && !availableWorkerUnitCommands.Has(*unit.Command) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("command"), *unit.Command, availableWorkerUnitCommands.List()))
+		}
+		if unit.Content == nil && len(unit.DropIns) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("content"), "must specify either the content or drop-ins of the unit"))
+		}
+
+		dropInNames := sets.NewString()
+		for j, dropIn := range unit.DropIns {
+			dropInPath := idxPath.Child("dropIns").Index(j)
+			if len(dropIn.Name) == 0 {
+				allErrs = append(allErrs, field.Required(dropInPath.Child("name"), "must specify a drop-in name"))
+			} else if dropInNames.Has(dropIn.Name) {
+				allErrs = append(allErrs, field.Duplicate(dropInPath.Child("name"), dropIn.Name))
+			}
+			dropInNames.Insert(dropIn.Name)
+		}
+	}
+
+	filePaths := sets.NewString()
+	for i, file := range osc.Files {
+		idxPath := fldPath.Child("files").Index(i)
+
+		if len(file.Path) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("path"), "must specify a file path"))
+		} else if !strings.HasPrefix(file.Path, "/") || path.Clean(file.Path) != file.Path {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("path"), file.Path, "file path must be absolute and clean"))
+		} else if isForbiddenWorkerFilePath(file.Path) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("path"), fmt.Sprintf("file %q is managed by Gardener", file.Path)))
+		} else if filePaths.Has(file.Path) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("path"), file.Path))
+		}
+		filePaths.Insert(file.Path)
+
+		if file.Permissions != nil && (*file.Permissions < 0 || *file.Permissions > 07777) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("permissions"), *file.Permissions, "file permissions must be between 0 and 07777"))
+		}
+
+		contentPath := idxPath.Child("content")
+		switch {
+		case file.Content.SecretRef == nil && file.Content.Inline == nil:
+			allErrs = append(allErrs, field.Required(contentPath, "must specify either secretRef or inline content"))
+		case file.Content.SecretRef != nil && file.Content.Inline != nil:
+			allErrs = append(allErrs, field.Forbidden(contentPath, "must not specify both secretRef and inline content"))
+		case file.Content.SecretRef != nil:
+			if len(file.Content.SecretRef.Name) == 0 {
+				allErrs = append(allErrs, field.Required(contentPath.Child("secretRef", "name"), "must specify a secret name"))
+			}
+			if len(file.Content.SecretRef.DataKey) == 0 {
+				allErrs = append(allErrs, field.Required(contentPath.Child("secretRef", "dataKey"), "must specify a data key"))
+			}
+		case file.Content.Inline != nil:
+			if !availableWorkerFileEncodings.Has(file.Content.Inline.Encoding) {
+				allErrs = append(allErrs, field.NotSupported(contentPath.Child("inline", "encoding"), file.Content.Inline.Encoding, availableWorkerFileEncodings.List()))
+			}
+		}
+	}
+
+	for name, value := range osc.Sysctls {
+		if !sysctlNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(name), name, fmt.Sprintf("kernel parameter name must match the regex %s", sysctlNameRegex)))
+		} else if forbiddenSysctlNames.Has(strings.Replace(name, "/", ".", -1)) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("sysctls").Key(name), fmt.Sprintf("kernel parameter %q is managed by Gardener", name)))
+		}
+		if len(value) == 0 || strings.ContainsAny(value, "\r\n") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("sysctls").Key(name), value, "kernel parameter value must be a non-empty single line"))
+		}
+	}
+
+	return allErrs
+}
+
+func isForbiddenWorkerFilePath(filePath string) bool {
+	if forbiddenWorkerFilePaths.Has(filePath) {
+		return true
+	}
+	for _, prefix := range forbiddenWorkerFilePathPrefixes {
+		if strings.HasPrefix(filePath, prefix) {
+			return true
+		}
+	}
+	for _, dir := range systemdUnitDirectories {
+		if !strings.HasPrefix(filePath, dir) {
+			continue
+		}
+		// Either the unit file itself (e.g. `kubelet.service`) or a drop-in (e.g. `kubelet.service.d/10-foo.conf`).
+		unitName := strings.SplitN(strings.TrimPrefix(filePath, dir), "/", 2)[0]
+		if forbiddenWorkerUnitNames.Has(unitName) || forbiddenWorkerUnitNames.Has(strings.TrimSuffix(unitName, ".d")) {
+			return true
+		}
+	}
+	return false
+}
+
 // ValidateWorker validates the worker object.
 func ValidateKubeletConfig(kubeletConfig garden.KubeletConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2503,11 +3093,75 @@ func ValidateHibernation(hibernation *garden.Hibernation, fldPath *field.Path) f
 		return allErrs
 	}
 
+	if hibernation.Mode != nil && !availableHibernationModes.Has(string(*hibernation.Mode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), *hibernation.Mode, availableHibernationModes.List()))
+	}
+	if len(hibernation.ExcludedWorkerPools) > 0 && (hibernation.Mode == nil || *hibernation.Mode != garden.HibernationModeWorkersOnly) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("excludedWorkerPools"), fmt.Sprintf("worker pools can only be excluded from hibernation if the mode is %q", garden.HibernationModeWorkersOnly)))
+	}
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
 
 	return allErrs
 }
 
+func validateHibernationUpdate(newHibernation, oldHibernation *garden.Hibernation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !hibernationIsEnabled(oldHibernation) {
+		return allErrs
+	}
+
+	// The mode must not be changed while the Shoot is hibernated (including the update which disables hibernation) as
+	// the already scaled down components would not be woken up properly.
+	if getHibernationMode(newHibernation) != getHibernationMode(oldHibernation) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("mode"), "mode must not be changed while hibernation is enabled"))
+	}
+
+	return allErrs
+}
+
+func hibernationIsEnabled(hibernation *garden.Hibernation) bool {
+	return hibernation != nil && hibernation.Enabled != nil && *hibernation.Enabled
+}
+
+func getHibernationMode(hibernation *garden.Hibernation) garden.HibernationMode {
+	if hibernation == nil || hibernation.Mode == nil {
+		return garden.HibernationModeFull
+	}
+	return *hibernation.Mode
+}
+
+func validateHibernationExcludedWorkerPools(hibernation *garden.Hibernation, workers []garden.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if hibernation == nil {
+		return allErrs
+	}
+
+	var (
+		workerNames = sets.NewString()
+		seen        = sets.NewString()
+	)
+
+	for _, worker := range workers {
+		workerNames.Insert(worker.Name)
+	}
+
+	for i, name := range hibernation.ExcludedWorkerPools {
+		idxPath := fldPath.Index(i)
+
+		switch {
+		case seen.Has(name):
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		case !workerNames.Has(name):
+			allErrs = append(allErrs, field.NotFound(idxPath, name))
+		}
+		seen.Insert(name)
+	}
+
+	return allErrs
+}
+
 func ValidateHibernationSchedules(schedules []garden.HibernationSchedule, fldPath *field.Path) field.ErrorList {
 	var (
 		allErrs = field.ErrorList{}
@@ -19,6 +19,7 @@ import (
 	"strings"
 	"time"
 
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	. "github.com/gardener/gardener/pkg/apis/garden/validation"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -199,6 +200,38 @@ var _ = Describe("validation", func() {
 				}))
 			})
 
+			It("should accept machine images supporting additional CRIs", func() {
+				awsCloudProfile.Spec.MachineImages[0].CRI = []garden.CRI{
+					{Name: garden.CRINameDocker},
+					{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}}},
+				}
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid or duplicate CRIs for machine images", func() {
+				awsCloudProfile.Spec.MachineImages[0].CRI = []garden.CRI{
+					{Name: garden.CRINameContainerD},
+					{Name: garden.CRINameContainerD},
+					{Name: "rkt"},
+				}
+
+				errorList := ValidateCloudProfile(awsCloudProfile)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.machineImages[0].cri[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.machineImages[0].cri[2].name"),
+					})),
+				))
+			})
+
 			Context("kubernetes version constraints", func() {
 				It("should enforce that at least one version has been defined", func() {
 					awsCloudProfile.Spec.AWS.Constraints.Kubernetes.OfferedVersions = []garden.KubernetesVersion{}
@@ -2795,6 +2828,58 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.owner"),
 			}))))
 		})
+
+		It("should forbid invalid maintenance blackout windows", func() {
+			var (
+				now         = metav1.Now()
+				emptyReason = ""
+			)
+			project.Spec.MaintenanceBlackoutWindows = []garden.MaintenanceBlackoutWindow{
+				{
+					Name:  "year-end",
+					Begin: now,
+					End:   metav1.NewTime(now.Add(time.Hour)),
+				},
+				{
+					Name:   "year-end",
+					Begin:  now,
+					End:    now,
+					Reason: &emptyReason,
+					ShootSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}},
+					},
+				},
+				{
+					Begin: now,
+					End:   metav1.NewTime(now.Add(time.Hour)),
+				},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.maintenanceBlackoutWindows[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenanceBlackoutWindows[1].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.maintenanceBlackoutWindows[1].reason"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenanceBlackoutWindows[1].shootSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.maintenanceBlackoutWindows[2].name"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateSeed, #ValidateSeedUpdate", func() {
@@ -3016,6 +3101,78 @@ var _ = Describe("validation", func() {
 			))
 		})
 
+		It("should allow draining a cordoned seed", func() {
+			seed.Annotations = map[string]string{v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationDrain}
+			seed.Spec.Taints = append(seed.Spec.Taints, garden.SeedTaint{Key: garden.SeedTaintCordoned})
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid draining a seed which is not cordoned", func() {
+			seed.Annotations = map[string]string{v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationDrain}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("metadata.annotations[gardener.cloud/operation]"),
+				})),
+			))
+		})
+
+		It("should allow a valid logging endpoint", func() {
+			endpoint := "https://logs.example.com:8443/ingest"
+			seed.Spec.Logging = &garden.SeedLogging{Endpoint: &endpoint}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		DescribeTable("invalid logging endpoints",
+			func(endpoint string) {
+				seed.Spec.Logging = &garden.SeedLogging{Endpoint: &endpoint}
+
+				errorList := ValidateSeed(seed)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.logging.endpoint"),
+					})),
+				))
+			},
+			Entry("unsupported scheme", "ftp://logs.example.com"),
+			Entry("missing host", "https:///ingest"),
+			Entry("invalid port", "https://logs.example.com:99999"),
+			Entry("unparseable URL", "https://logs example.com:%zz"),
+		)
+
+		It("should forbid invalid backup limits", func() {
+			maxBackups := int32(0)
+			seed.Spec.BackupLimits = &garden.BackupLimits{
+				MinFullSnapshotInterval: makeDurationPointer(-time.Hour),
+				MinDeltaSnapshotPeriod:  makeDurationPointer(time.Minute),
+				MaxBackups:              &maxBackups,
+			}
+
+			errorList := ValidateSeed(seed)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backupLimits.minFullSnapshotInterval"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backupLimits.maxBackups"),
+				})),
+			))
+		})
+
 		It("should forbid Seed with overlapping networks", func() {
 			shootDefaultPodCIDR := "10.0.1.128/28"     // 10.0.1.128 -> 10.0.1.13
 			shootDefaultServiceCIDR := "10.0.1.144/30" // 10.0.1.144 -> 10.0.1.17
@@ -3299,6 +3456,65 @@ var _ = Describe("validation", func() {
 			Entry("percentage is not less than zero", intstr.FromString("-90%"), intstr.FromString("90%"), field.ErrorTypeInvalid),
 		)
 
+		It("should forbid an empty kubernetes version for the worker pool", func() {
+			maxSurge := intstr.FromInt(1)
+			maxUnavailable := intstr.FromInt(0)
+			worker := garden.Worker{
+				Name: "worker-name",
+				Machine: garden.Machine{
+					Type: "large",
+				},
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				Kubernetes: &garden.WorkerKubernetes{
+					Version: makeStringPointer(""),
+				},
+			}
+
+			errList := ValidateWorker(worker, field.NewPath("worker"))
+
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("worker.kubernetes.version"),
+			}))))
+		})
+
+		It("should forbid a too small maximum node age and invalid auto repair settings", func() {
+			maxSurge := intstr.FromInt(1)
+			maxUnavailable := intstr.FromInt(0)
+			maxConcurrentRepairs := int32(0)
+			worker := garden.Worker{
+				Name: "worker-name",
+				Machine: garden.Machine{
+					Type: "large",
+				},
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				MaxNodeAge:     makeDurationPointer(30 * time.Minute),
+				AutoRepair: &garden.WorkerAutoRepair{
+					UnhealthyThreshold:   metav1.Duration{Duration: 10 * time.Second},
+					MaxConcurrentRepairs: &maxConcurrentRepairs,
+				},
+			}
+
+			errList := ValidateWorker(worker, field.NewPath("worker"))
+
+			Expect(errList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.maxNodeAge"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.autoRepair.unhealthyThreshold"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.autoRepair.maxConcurrentRepairs"),
+				})),
+			))
+		})
+
 		DescribeTable("reject when labels are invalid",
 			func(labels map[string]string, expectType field.ErrorType) {
 				maxSurge := intstr.FromInt(1)
@@ -3398,6 +3614,210 @@ var _ = Describe("validation", func() {
 			// uniqueness by key/effect
 			Entry("not unique", []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}, {Key: "foo", Value: "baz", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeDuplicate),
 		)
+
+		DescribeTable("validate the container runtime interface",
+			func(cri *garden.CRI, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt(1)
+				maxUnavailable := intstr.FromInt(0)
+				worker := garden.Worker{
+					Name: "worker-name",
+					Machine: garden.Machine{
+						Type: "large",
+					},
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+					CRI:            cri,
+				}
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("no cri", nil, BeEmpty()),
+			Entry("docker", &garden.CRI{Name: garden.CRINameDocker}, BeEmpty()),
+			Entry("containerd with runtimes", &garden.CRI{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-containers"}}}, BeEmpty()),
+			Entry("unsupported name", &garden.CRI{Name: "rkt"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("worker.cri.name"),
+			})))),
+			Entry("docker with runtimes", &garden.CRI{Name: garden.CRINameDocker, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("worker.cri.containerRuntimes"),
+			})))),
+			Entry("runtime without type", &garden.CRI{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{}}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("worker.cri.containerRuntimes[0].type"),
+			})))),
+			Entry("runtime with invalid type", &garden.CRI{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gVisor"}}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("worker.cri.containerRuntimes[0].type"),
+			})))),
+			Entry("duplicate runtimes", &garden.CRI{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}, {Type: "gvisor"}}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("worker.cri.containerRuntimes[1].type"),
+			})))),
+		)
+
+		DescribeTable("validate the additional operating system configuration",
+			func(osc *garden.WorkerOperatingSystemConfig, matcher gomegatypes.GomegaMatcher) {
+				maxSurge := intstr.FromInt(1)
+				maxUnavailable := intstr.FromInt(0)
+				worker := garden.Worker{
+					Name: "worker-name",
+					Machine: garden.Machine{
+						Type: "large",
+					},
+					MaxSurge:              &maxSurge,
+					MaxUnavailable:        &maxUnavailable,
+					OperatingSystemConfig: osc,
+				}
+				errList := ValidateWorker(worker, field.NewPath("worker"))
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("no operating system config", nil, BeEmpty()),
+			Entry("valid units, files and sysctls", &garden.WorkerOperatingSystemConfig{
+				Units: []garden.WorkerUnit{
+					{Name: "foo.service", Command: makeStringPointer("start"), Content: makeStringPointer("[Unit]")},
+					{Name: "bar.service", DropIns: []garden.WorkerUnitDropIn{{Name: "10-bar.conf", Content: "[Service]"}}},
+				},
+				Files: []garden.WorkerFile{
+					{Path: "/etc/foo.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Encoding: "b64", Data: "Zm9v"}}},
+					{Path: "/etc/ssl/certs/bar.pem", Content: garden.WorkerFileContent{SecretRef: &garden.WorkerFileContentSecretRef{Name: "bar", DataKey: "ca.crt"}}},
+				},
+				Sysctls: map[string]string{"kernel.pid_max": "4194304", "net/ipv4/tcp_keepalive_time": "600"},
+			}, BeEmpty()),
+			Entry("gardener-owned unit", &garden.WorkerOperatingSystemConfig{
+				Units: []garden.WorkerUnit{{Name: "kubelet.service", Content: makeStringPointer("[Unit]")}},
+			}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("worker.operatingSystemConfig.units[0].name"),
+			})))),
+			Entry("duplicate unit", &garden.WorkerOperatingSystemConfig{
+				Units: []garden.WorkerUnit{{Name: "foo.service", Content: makeStringPointer("[Unit]")}, {Name: "foo.service", Content: makeStringPointer("[Unit]")}},
+			}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("worker.operatingSystemConfig.units[1].name"),
+			})))),
+			Entry("unit with unsupported command and without content", &garden.WorkerOperatingSystemConfig{
+				Units: []garden.WorkerUnit{{Name: "foo.service", Command: makeStringPointer("reload")}},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("worker.operatingSystemConfig.units[0].command"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("worker.operatingSystemConfig.units[0].content"),
+				})),
+			)),
+			Entry("gardener-owned files", &garden.WorkerOperatingSystemConfig{
+				Files: []garden.WorkerFile{
+					{Path: "/etc/sysctl.d/99-k8s-general.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+					{Path: "/var/lib/kubelet/config/kubelet", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+					{Path: "/var/bin/containerruntimes/runsc", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+				},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[0].path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[1].path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[2].path"),
+				})),
+			)),
+			Entry("gardener-owned systemd units and drop-ins", &garden.WorkerOperatingSystemConfig{
+				Files: []garden.WorkerFile{
+					{Path: "/etc/systemd/system/kubelet.service.d/10-foo.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+					{Path: "/etc/systemd/system/cloud-config-downloader.service", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+					{Path: "/run/systemd/system/containerd.service.d/override.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+					{Path: "/etc/systemd/system/foo.service.d/10-foo.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+				},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[0].path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[1].path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[2].path"),
+				})),
+			)),
+			Entry("relative and unclean file paths", &garden.WorkerOperatingSystemConfig{
+				Files: []garden.WorkerFile{
+					{Path: "etc/foo.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+					{Path: "/var/lib/foo/../kubelet/config/kubelet", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}}},
+				},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.operatingSystemConfig.files[0].path"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.operatingSystemConfig.files[1].path"),
+				})),
+			)),
+			Entry("file with invalid content", &garden.WorkerOperatingSystemConfig{
+				Files: []garden.WorkerFile{
+					{Path: "/etc/foo.conf"},
+					{Path: "/etc/bar.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Data: "foo"}, SecretRef: &garden.WorkerFileContentSecretRef{Name: "bar", DataKey: "bar"}}},
+					{Path: "/etc/baz.conf", Content: garden.WorkerFileContent{SecretRef: &garden.WorkerFileContentSecretRef{Name: "baz"}}},
+					{Path: "/etc/qux.conf", Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Encoding: "gzip", Data: "foo"}}},
+				},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("worker.operatingSystemConfig.files[0].content"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.files[1].content"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("worker.operatingSystemConfig.files[2].content.secretRef.dataKey"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("worker.operatingSystemConfig.files[3].content.inline.encoding"),
+				})),
+			)),
+			Entry("invalid sysctls", &garden.WorkerOperatingSystemConfig{
+				Sysctls: map[string]string{"vm": "1", "kernel.pid_max": "1\nkernel.panic = 1"},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.operatingSystemConfig.sysctls[vm]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("worker.operatingSystemConfig.sysctls[kernel.pid_max]"),
+				})),
+			)),
+			Entry("gardener-owned sysctls", &garden.WorkerOperatingSystemConfig{
+				Sysctls: map[string]string{"net.ipv4.ip_forward": "0", "net/core/somaxconn": "128"},
+			}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.sysctls[net.ipv4.ip_forward]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("worker.operatingSystemConfig.sysctls[net/core/somaxconn]"),
+				})),
+			)),
+		)
 	})
 
 	Describe("#ValidateWorkers", func() {
@@ -3721,6 +4141,36 @@ var _ = Describe("validation", func() {
 		)
 	})
 
+	Describe("#ValidateHibernation", func() {
+		var (
+			modeFull        = garden.HibernationModeFull
+			modeWorkersOnly = garden.HibernationModeWorkersOnly
+			modeFoo         = garden.HibernationMode("foo")
+		)
+
+		DescribeTable("validate hibernation mode and excluded worker pools",
+			func(hibernation *garden.Hibernation, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateHibernation(hibernation, nil)).To(matcher)
+			},
+			Entry("nil hibernation", nil, BeEmpty()),
+			Entry("no mode", &garden.Hibernation{}, BeEmpty()),
+			Entry("mode Full", &garden.Hibernation{Mode: &modeFull}, BeEmpty()),
+			Entry("mode WorkersOnly with excluded worker pools", &garden.Hibernation{Mode: &modeWorkersOnly, ExcludedWorkerPools: []string{"cpu-worker"}}, BeEmpty()),
+			Entry("unsupported mode", &garden.Hibernation{Mode: &modeFoo}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal(field.NewPath("mode").String()),
+			})))),
+			Entry("excluded worker pools without mode", &garden.Hibernation{ExcludedWorkerPools: []string{"cpu-worker"}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal(field.NewPath("excludedWorkerPools").String()),
+			})))),
+			Entry("excluded worker pools with mode Full", &garden.Hibernation{Mode: &modeFull, ExcludedWorkerPools: []string{"cpu-worker"}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal(field.NewPath("excludedWorkerPools").String()),
+			})))),
+		)
+	})
+
 	Describe("#ValidateShoot, #ValidateShootUpdate", func() {
 		var (
 			shoot *garden.Shoot
@@ -4217,14 +4667,307 @@ var _ = Describe("validation", func() {
 			Expect(errorList).To(HaveLen(0))
 		})
 
-		Context("AWS specific validation", func() {
-			var (
-				fldPath  = "aws"
-				awsCloud *garden.AWSCloud
-			)
-
+		Context("control plane migration", func() {
 			BeforeEach(func() {
-				awsCloud = &garden.AWSCloud{
+				shoot.Spec.Cloud.Seed = makeStringPointer("first-seed")
+				shoot.Annotations = map[string]string{
+					common.ShootOperation:           common.ShootOperationMigrate,
+					common.ShootMigrationTargetSeed: "another-seed",
+				}
+			})
+
+			It("should allow requesting the migration to another seed", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid requesting the migration without target seed", func() {
+				delete(shoot.Annotations, common.ShootMigrationTargetSeed)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootMigrationTargetSeed)),
+					}))))
+			})
+
+			It("should forbid requesting the migration of a shoot which has not been scheduled", func() {
+				shoot.Spec.Cloud.Seed = nil
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootOperation)),
+					}))))
+			})
+
+			It("should forbid requesting the migration to the current seed", func() {
+				shoot.Annotations[common.ShootMigrationTargetSeed] = "first-seed"
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootMigrationTargetSeed)),
+					}))))
+			})
+
+			It("should allow switching the seed to the migration target", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.Seed = makeStringPointer("another-seed")
+				newShoot.Annotations[common.ShootMigrationSourceSeed] = "first-seed"
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid switching the seed to another seed than the migration target", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Cloud.Seed = makeStringPointer("third-seed")
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.cloud.seed"),
+					}))))
+			})
+		})
+
+		Context("etcd restoration", func() {
+			BeforeEach(func() {
+				shoot.Spec.Cloud.Seed = makeStringPointer("first-seed")
+				shoot.Annotations = map[string]string{
+					common.ShootOperation:         common.ShootOperationRestoreETCD,
+					common.ShootETCDRestoreTarget: "2019-10-01T12:30:00Z",
+				}
+			})
+
+			It("should allow requesting the restoration to a timestamp", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow requesting the restoration to a revision", func() {
+				shoot.Annotations[common.ShootETCDRestoreTarget] = "4711"
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid requesting the restoration without target", func() {
+				delete(shoot.Annotations, common.ShootETCDRestoreTarget)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootETCDRestoreTarget)),
+					}))))
+			})
+
+			It("should forbid requesting the restoration to an invalid target", func() {
+				shoot.Annotations[common.ShootETCDRestoreTarget] = "yesterday"
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootETCDRestoreTarget)),
+					}))))
+			})
+
+			It("should forbid requesting the restoration of a shoot which has not been scheduled", func() {
+				shoot.Spec.Cloud.Seed = nil
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootOperation)),
+					}))))
+			})
+		})
+
+		Context("hibernation", func() {
+			var (
+				enabled         = true
+				disabled        = false
+				modeWorkersOnly = garden.HibernationModeWorkersOnly
+			)
+
+			BeforeEach(func() {
+				shoot.Spec.Hibernation = &garden.Hibernation{Enabled: &enabled}
+			})
+
+			It("should forbid changing the mode while the shoot is hibernated", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Hibernation.Mode = &modeWorkersOnly
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.hibernation.mode"),
+					}))))
+			})
+
+			It("should forbid changing the mode together with disabling the hibernation", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Hibernation.Enabled = &disabled
+				newShoot.Spec.Hibernation.Mode = &modeWorkersOnly
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.hibernation.mode"),
+					}))))
+			})
+
+			It("should allow changing the mode together with enabling the hibernation", func() {
+				shoot.Spec.Hibernation.Enabled = &disabled
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Hibernation.Enabled = &enabled
+				newShoot.Spec.Hibernation.Mode = &modeWorkersOnly
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+		})
+
+		Context("worker pool rolling", func() {
+			BeforeEach(func() {
+				shoot.Annotations = map[string]string{
+					common.ShootOperation:       common.ShootOperationRollWorkerPools,
+					common.ShootRollWorkerPools: "worker-name",
+				}
+			})
+
+			It("should allow rolling existing worker pools", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid rolling without worker pools", func() {
+				shoot.Annotations[common.ShootRollWorkerPools] = " , "
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootRollWorkerPools)),
+					}))))
+			})
+
+			It("should forbid rolling unknown worker pools", func() {
+				shoot.Annotations[common.ShootRollWorkerPools] = "worker-name,unknown"
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeInvalid),
+						"Field":    Equal(fmt.Sprintf("metadata.annotations[%s]", common.ShootRollWorkerPools)),
+						"BadValue": Equal("unknown"),
+					}))))
+			})
+		})
+
+		Context("etcd backup", func() {
+			var maxBackups int32
+
+			BeforeEach(func() {
+				maxBackups = 7
+				limitBased := garden.BackupGarbageCollectionPolicyLimitBased
+				shoot.Spec.Backup = &garden.ShootBackup{
+					Schedule:                makeStringPointer("0 */12 * * *"),
+					DeltaSnapshotPeriod:     makeDurationPointer(5 * time.Minute),
+					GarbageCollectionPolicy: &limitBased,
+					MaxBackups:              &maxBackups,
+				}
+			})
+
+			It("should allow valid backup settings", func() {
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid backup settings", func() {
+				unknown := garden.BackupGarbageCollectionPolicy("Unknown")
+				maxBackups = 0
+				shoot.Spec.Backup.Schedule = makeStringPointer("every day")
+				shoot.Spec.Backup.DeltaSnapshotPeriod = makeDurationPointer(0)
+				shoot.Spec.Backup.GarbageCollectionPolicy = &unknown
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.schedule"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.deltaSnapshotPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.backup.garbageCollectionPolicy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.maxBackups"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.backup.maxBackups"),
+					})),
+				))
+			})
+
+			It("should forbid the retention for the exponential garbage collection policy", func() {
+				exponential := garden.BackupGarbageCollectionPolicyExponential
+				shoot.Spec.Backup.GarbageCollectionPolicy = &exponential
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.backup.maxBackups"),
+					})),
+				))
+			})
+		})
+
+		Context("AWS specific validation", func() {
+			var (
+				fldPath  = "aws"
+				awsCloud *garden.AWSCloud
+			)
+
+			BeforeEach(func() {
+				awsCloud = &garden.AWSCloud{
 					Networks: garden.AWSNetworks{
 						K8SNetworks: k8sNetworks,
 						Internal:    []string{"10.250.1.0/24"},
@@ -6789,6 +7532,112 @@ var _ = Describe("validation", func() {
 
 				Expect(errorList).To(HaveLen(0))
 			})
+
+			It("should forbid specifying a domain for the primary provider", func() {
+				shoot.Spec.DNS.Providers[0].Domain = makeStringPointer("private.example.com")
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dns.providers[0].domain"),
+				}))))
+			})
+
+			It("should allow additional providers with and without an own domain", func() {
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers,
+					garden.DNSProvider{
+						Type: makeStringPointer("some-private-provider"),
+					},
+					garden.DNSProvider{
+						Domain:     makeStringPointer("private.example.com"),
+						SecretName: makeStringPointer("private-dns-secret"),
+						Type:       makeStringPointer("some-private-provider"),
+					},
+				)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(HaveLen(0))
+			})
+
+			It("should forbid invalid additional providers", func() {
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers,
+					garden.DNSProvider{
+						Domain: makeStringPointer("private.example.com"),
+					},
+					garden.DNSProvider{
+						Type: makeStringPointer(garden.DNSUnmanaged),
+					},
+					garden.DNSProvider{
+						Domain: makeStringPointer("foo/bar.baz"),
+						Type:   makeStringPointer("some-private-provider"),
+					},
+				)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.dns.providers[1].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.dns.providers[2].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.dns.providers[3].domain"),
+					})),
+				))
+			})
+
+			It("should forbid additional providers of the same type for the same domain", func() {
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers,
+					garden.DNSProvider{
+						Type: makeStringPointer("some-private-provider"),
+					},
+					garden.DNSProvider{
+						Domain: shoot.Spec.DNS.Domain,
+						Type:   makeStringPointer("some-private-provider"),
+					},
+				)
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.dns.providers[2].domain"),
+				}))))
+			})
+
+			It("should allow adding and removing additional providers", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.DNS.Providers = append(newShoot.Spec.DNS.Providers, garden.DNSProvider{
+					Domain: makeStringPointer("private.example.com"),
+					Type:   makeStringPointer("some-private-provider"),
+				})
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(HaveLen(0))
+
+				newerShoot := prepareShootForUpdate(newShoot)
+				newerShoot.Spec.DNS.Providers = newerShoot.Spec.DNS.Providers[:1]
+
+				Expect(ValidateShootUpdate(newerShoot, newShoot)).To(HaveLen(0))
+			})
+
+			It("should forbid removing the primary provider", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.DNS.Providers = nil
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.dns.providers"),
+				}))))
+			})
 		})
 
 		Context("OIDC validation", func() {
@@ -7222,6 +8071,59 @@ var _ = Describe("validation", func() {
 					"Field": Equal("spec.networking.type"),
 				}))))
 			})
+
+			It("should allow valid control plane egress destinations", func() {
+				var (
+					cidr = "10.250.0.0/16"
+					fqdn = "webhook.example.com"
+				)
+				shoot.Spec.Networking.ControlPlaneEgressDestinations = []garden.EgressDestination{{CIDR: &cidr}, {FQDN: &fqdn}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid control plane egress destinations", func() {
+				var (
+					cidr        = "10.250.0.0/16"
+					invalidCIDR = "10.250.0.0"
+					fqdn        = "Webhook_Example"
+				)
+				shoot.Spec.Networking.ControlPlaneEgressDestinations = []garden.EgressDestination{
+					{CIDR: &cidr},
+					{CIDR: &cidr},
+					{CIDR: &invalidCIDR},
+					{FQDN: &fqdn},
+					{CIDR: &cidr, FQDN: &fqdn},
+					{},
+				}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.networking.controlPlaneEgressDestinations[1].cidr"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.controlPlaneEgressDestinations[2].cidr"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.networking.controlPlaneEgressDestinations[3].fqdn"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.networking.controlPlaneEgressDestinations[4]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.networking.controlPlaneEgressDestinations[5]"),
+					})),
+				))
+			})
 		})
 
 		Context("maintenance section", func() {
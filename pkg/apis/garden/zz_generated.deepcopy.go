@@ -843,6 +843,60 @@ func (in *BackupInfrastructureStatus) DeepCopy() *BackupInfrastructureStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupLimits) DeepCopyInto(out *BackupLimits) {
+	*out = *in
+	if in.MinFullSnapshotInterval != nil {
+		in, out := &in.MinFullSnapshotInterval, &out.MinFullSnapshotInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MinDeltaSnapshotPeriod != nil {
+		in, out := &in.MinDeltaSnapshotPeriod, &out.MinDeltaSnapshotPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupLimits.
+func (in *BackupLimits) DeepCopy() *BackupLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CRI) DeepCopyInto(out *CRI) {
+	*out = *in
+	if in.ContainerRuntimes != nil {
+		in, out := &in.ContainerRuntimes, &out.ContainerRuntimes
+		*out = make([]ContainerRuntime, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CRI.
+func (in *CRI) DeepCopy() *CRI {
+	if in == nil {
+		return nil
+	}
+	out := new(CRI)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Cloud) DeepCopyInto(out *Cloud) {
 	*out = *in
@@ -982,6 +1036,13 @@ func (in *CloudProfileMachineImage) DeepCopyInto(out *CloudProfileMachineImage)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CRI != nil {
+		in, out := &in.CRI, &out.CRI
+		*out = make([]CRI, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1028,6 +1089,11 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 		*out = new(PacketProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupLimits != nil {
+		in, out := &in.BackupLimits, &out.BackupLimits
+		*out = new(BackupLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CABundle != nil {
 		in, out := &in.CABundle, &out.CABundle
 		*out = new(string)
@@ -1149,6 +1215,27 @@ func (in *Condition) DeepCopy() *Condition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerRuntime) DeepCopyInto(out *ContainerRuntime) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(ProviderConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerRuntime.
+func (in *ContainerRuntime) DeepCopy() *ContainerRuntime {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerRuntime)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
@@ -1206,6 +1293,11 @@ func (in *DNSIncludeExclude) DeepCopy() *DNSIncludeExclude {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DNSProvider) DeepCopyInto(out *DNSProvider) {
 	*out = *in
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
 	if in.Domains != nil {
 		in, out := &in.Domains, &out.Domains
 		*out = new(DNSIncludeExclude)
@@ -1255,6 +1347,48 @@ func (in *DNSProviderConstraint) DeepCopy() *DNSProviderConstraint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSProviderStatus) DeepCopyInto(out *DNSProviderStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSProviderStatus.
+func (in *DNSProviderStatus) DeepCopy() *DNSProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EgressDestination) DeepCopyInto(out *EgressDestination) {
+	*out = *in
+	if in.CIDR != nil {
+		in, out := &in.CIDR, &out.CIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.FQDN != nil {
+		in, out := &in.FQDN, &out.FQDN
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressDestination.
+func (in *EgressDestination) DeepCopy() *EgressDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(EgressDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
 	*out = *in
@@ -1503,6 +1637,16 @@ func (in *Hibernation) DeepCopyInto(out *Hibernation) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(HibernationMode)
+		**out = **in
+	}
+	if in.ExcludedWorkerPools != nil {
+		in, out := &in.ExcludedWorkerPools, &out.ExcludedWorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Schedules != nil {
 		in, out := &in.Schedules, &out.Schedules
 		*out = make([]HibernationSchedule, len(*in))
@@ -2369,6 +2513,50 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceBlackoutWindow) DeepCopyInto(out *MaintenanceBlackoutWindow) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceBlackoutWindow.
+func (in *MaintenanceBlackoutWindow) DeepCopy() *MaintenanceBlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceBlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTaskStatus) DeepCopyInto(out *MaintenanceTaskStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTaskStatus.
+func (in *MaintenanceTaskStatus) DeepCopy() *MaintenanceTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -2420,6 +2608,13 @@ func (in *Networking) DeepCopyInto(out *Networking) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ControlPlaneEgressDestinations != nil {
+		in, out := &in.ControlPlaneEgressDestinations, &out.ControlPlaneEgressDestinations
+		*out = make([]EgressDestination, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3039,6 +3234,18 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MaintenanceBlackoutWindows != nil {
+		in, out := &in.MaintenanceBlackoutWindows, &out.MaintenanceBlackoutWindows
+		*out = make([]MaintenanceBlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ShootDeletionProtection != nil {
+		in, out := &in.ShootDeletionProtection, &out.ShootDeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3345,6 +3552,43 @@ func (in *SeedBackup) DeepCopy() *SeedBackup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCapacity) DeepCopyInto(out *SeedCapacity) {
+	*out = *in
+	if in.Allocatable != nil {
+		in, out := &in.Allocatable, &out.Allocatable
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.Requested != nil {
+		in, out := &in.Requested, &out.Requested
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	if in.ExcessCapacityReserved != nil {
+		in, out := &in.ExcessCapacityReserved, &out.ExcessCapacityReserved
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCapacity.
+func (in *SeedCapacity) DeepCopy() *SeedCapacity {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCapacity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedCloud) DeepCopyInto(out *SeedCloud) {
 	*out = *in
@@ -3394,6 +3638,27 @@ func (in *SeedList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedLogging) DeepCopyInto(out *SeedLogging) {
+	*out = *in
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedLogging.
+func (in *SeedLogging) DeepCopy() *SeedLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 	*out = *in
@@ -3455,11 +3720,21 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 		*out = new(SeedBackup)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.BackupLimits != nil {
+		in, out := &in.BackupLimits, &out.BackupLimits
+		*out = new(BackupLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volume != nil {
 		in, out := &in.Volume, &out.Volume
 		*out = new(SeedVolume)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(SeedLogging)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3484,6 +3759,11 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		}
 	}
 	out.Gardener = in.Gardener
+	if in.Capacity != nil {
+		in, out := &in.Capacity, &out.Capacity
+		*out = new(SeedCapacity)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3614,6 +3894,65 @@ func (in *Shoot) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootAutoRepairStatus) DeepCopyInto(out *ShootAutoRepairStatus) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]WorkerPoolAutoRepairStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootAutoRepairStatus.
+func (in *ShootAutoRepairStatus) DeepCopy() *ShootAutoRepairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootAutoRepairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootBackup) DeepCopyInto(out *ShootBackup) {
+	*out = *in
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaSnapshotPeriod != nil {
+		in, out := &in.DeltaSnapshotPeriod, &out.DeltaSnapshotPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.GarbageCollectionPolicy != nil {
+		in, out := &in.GarbageCollectionPolicy, &out.GarbageCollectionPolicy
+		*out = new(BackupGarbageCollectionPolicy)
+		**out = **in
+	}
+	if in.MaxBackups != nil {
+		in, out := &in.MaxBackups, &out.MaxBackups
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootBackup.
+func (in *ShootBackup) DeepCopy() *ShootBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootList) DeepCopyInto(out *ShootList) {
 	*out = *in
@@ -3668,6 +4007,28 @@ func (in *ShootMachineImage) DeepCopy() *ShootMachineImage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMaintenanceStatus) DeepCopyInto(out *ShootMaintenanceStatus) {
+	*out = *in
+	in.LastMaintenanceTime.DeepCopyInto(&out.LastMaintenanceTime)
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make([]MaintenanceTaskStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMaintenanceStatus.
+func (in *ShootMaintenanceStatus) DeepCopy() *ShootMaintenanceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMaintenanceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootNetworks) DeepCopyInto(out *ShootNetworks) {
 	*out = *in
@@ -3702,6 +4063,11 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 		*out = new(Addons)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(ShootBackup)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Cloud.DeepCopyInto(&out.Cloud)
 	if in.DNS != nil {
 		in, out := &in.DNS, &out.DNS
@@ -3781,6 +4147,21 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ShootMaintenanceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AutoRepair != nil {
+		in, out := &in.AutoRepair, &out.AutoRepair
+		*out = new(ShootAutoRepairStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DNSProviders != nil {
+		in, out := &in.DNSProviders, &out.DNSProviders
+		*out = make([]DNSProviderStatus, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3841,11 +4222,21 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 			(*out)[key] = val
 		}
 	}
+	if in.AutoRepair != nil {
+		in, out := &in.AutoRepair, &out.AutoRepair
+		*out = new(WorkerAutoRepair)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.CABundle != nil {
 		in, out := &in.CABundle, &out.CABundle
 		*out = new(string)
 		**out = **in
 	}
+	if in.CRI != nil {
+		in, out := &in.CRI, &out.CRI
+		*out = new(CRI)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Kubernetes != nil {
 		in, out := &in.Kubernetes, &out.Kubernetes
 		*out = new(WorkerKubernetes)
@@ -3859,6 +4250,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		}
 	}
 	in.Machine.DeepCopyInto(&out.Machine)
+	if in.MaxNodeAge != nil {
+		in, out := &in.MaxNodeAge, &out.MaxNodeAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.MaxSurge != nil {
 		in, out := &in.MaxSurge, &out.MaxSurge
 		*out = new(intstr.IntOrString)
@@ -3869,6 +4265,11 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.OperatingSystemConfig != nil {
+		in, out := &in.OperatingSystemConfig, &out.OperatingSystemConfig
+		*out = new(WorkerOperatingSystemConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProviderConfig != nil {
 		in, out := &in.ProviderConfig, &out.ProviderConfig
 		*out = new(ProviderConfig)
@@ -3904,6 +4305,108 @@ func (in *Worker) DeepCopy() *Worker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerAutoRepair) DeepCopyInto(out *WorkerAutoRepair) {
+	*out = *in
+	out.UnhealthyThreshold = in.UnhealthyThreshold
+	if in.MaxConcurrentRepairs != nil {
+		in, out := &in.MaxConcurrentRepairs, &out.MaxConcurrentRepairs
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerAutoRepair.
+func (in *WorkerAutoRepair) DeepCopy() *WorkerAutoRepair {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerAutoRepair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFile) DeepCopyInto(out *WorkerFile) {
+	*out = *in
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = new(int32)
+		**out = **in
+	}
+	in.Content.DeepCopyInto(&out.Content)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFile.
+func (in *WorkerFile) DeepCopy() *WorkerFile {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFileContent) DeepCopyInto(out *WorkerFileContent) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(WorkerFileContentSecretRef)
+		**out = **in
+	}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(WorkerFileContentInline)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFileContent.
+func (in *WorkerFileContent) DeepCopy() *WorkerFileContent {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFileContent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFileContentInline) DeepCopyInto(out *WorkerFileContentInline) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFileContentInline.
+func (in *WorkerFileContentInline) DeepCopy() *WorkerFileContentInline {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFileContentInline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerFileContentSecretRef) DeepCopyInto(out *WorkerFileContentSecretRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerFileContentSecretRef.
+func (in *WorkerFileContentSecretRef) DeepCopy() *WorkerFileContentSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerFileContentSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 	*out = *in
@@ -3912,6 +4415,11 @@ func (in *WorkerKubernetes) DeepCopyInto(out *WorkerKubernetes) {
 		*out = new(KubeletConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -3928,6 +4436,26 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerMigrationData) DeepCopyInto(out *WorkerMigrationData) {
 	*out = *in
+	if in.AutoRepair != nil {
+		in, out := &in.AutoRepair, &out.AutoRepair
+		*out = new(WorkerAutoRepair)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KubernetesVersion != nil {
+		in, out := &in.KubernetesVersion, &out.KubernetesVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxNodeAge != nil {
+		in, out := &in.MaxNodeAge, &out.MaxNodeAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.OperatingSystemConfig != nil {
+		in, out := &in.OperatingSystemConfig, &out.OperatingSystemConfig
+		*out = new(WorkerOperatingSystemConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ProviderConfig != nil {
 		in, out := &in.ProviderConfig, &out.ProviderConfig
 		*out = new(ProviderConfig)
@@ -3978,6 +4506,112 @@ func (in WorkerMigrationInfo) DeepCopy() WorkerMigrationInfo {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerOperatingSystemConfig) DeepCopyInto(out *WorkerOperatingSystemConfig) {
+	*out = *in
+	if in.Units != nil {
+		in, out := &in.Units, &out.Units
+		*out = make([]WorkerUnit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]WorkerFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerOperatingSystemConfig.
+func (in *WorkerOperatingSystemConfig) DeepCopy() *WorkerOperatingSystemConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerOperatingSystemConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolAutoRepairStatus) DeepCopyInto(out *WorkerPoolAutoRepairStatus) {
+	*out = *in
+	in.LastRepairTime.DeepCopyInto(&out.LastRepairTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolAutoRepairStatus.
+func (in *WorkerPoolAutoRepairStatus) DeepCopy() *WorkerPoolAutoRepairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolAutoRepairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerUnit) DeepCopyInto(out *WorkerUnit) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = new(string)
+		**out = **in
+	}
+	if in.Enable != nil {
+		in, out := &in.Enable, &out.Enable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Content != nil {
+		in, out := &in.Content, &out.Content
+		*out = new(string)
+		**out = **in
+	}
+	if in.DropIns != nil {
+		in, out := &in.DropIns, &out.DropIns
+		*out = make([]WorkerUnitDropIn, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerUnit.
+func (in *WorkerUnit) DeepCopy() *WorkerUnit {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerUnit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerUnitDropIn) DeepCopyInto(out *WorkerUnitDropIn) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerUnitDropIn.
+func (in *WorkerUnitDropIn) DeepCopy() *WorkerUnitDropIn {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerUnitDropIn)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Zone) DeepCopyInto(out *Zone) {
 	*out = *in
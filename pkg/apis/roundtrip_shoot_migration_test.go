@@ -75,7 +75,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 		dnsProvider2Type           = "provider2"
 		dnsProvider2ZonesInclude   = []string{"third-zone"}
 		dnsProvider2ZonesExclude   = []string{"fourth-zone"}
-		dnsProviderMigrationJSON   = "[{\"Domains\":{\"Include\":[\"" + dnsProvider2DomainsInclude[0] + "\"],\"Exclude\":[\"" + dnsProvider2DomainsExclude[0] + "\"]},\"SecretName\":\"" + dnsProvider2SecretName + "\",\"Type\":\"" + dnsProvider2Type + "\",\"Zones\":{\"Include\":[\"" + dnsProvider2ZonesInclude[0] + "\"],\"Exclude\":[\"" + dnsProvider2ZonesExclude[0] + "\"]}}]"
+		dnsProviderMigrationJSON   = "[{\"Domain\":null,\"Domains\":{\"Include\":[\"" + dnsProvider2DomainsInclude[0] + "\"],\"Exclude\":[\"" + dnsProvider2DomainsExclude[0] + "\"]},\"SecretName\":\"" + dnsProvider2SecretName + "\",\"Type\":\"" + dnsProvider2Type + "\",\"Zones\":{\"Include\":[\"" + dnsProvider2ZonesInclude[0] + "\"],\"Exclude\":[\"" + dnsProvider2ZonesExclude[0] + "\"]}}]"
 
 		extension1Type           = "random-ext-1"
 		extension1ProviderConfig = "some-provider-specific-data"
@@ -475,7 +475,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 			ObjectMeta: metav1.ObjectMeta{
 				Annotations: map[string]string{
 					garden.MigrationShootDNSProviders: dnsProviderMigrationJSON,
-					garden.MigrationShootWorkers:      "{\"worker1\":{\"ProviderConfig\":\"foo\",\"Volume\":null,\"Zones\":[\"zone1\",\"zone2\"]}}",
+					garden.MigrationShootWorkers:      "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":\"foo\",\"Volume\":null,\"Zones\":[\"zone1\",\"zone2\"]}}",
 				},
 			},
 			Spec: gardenv1beta1.ShootSpec{
@@ -733,7 +733,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultCoreShoot.DeepCopy()
 				expectedOut = defaultGardenShoot.DeepCopy()
@@ -897,7 +897,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{"zone1", "zone2"}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultCoreShoot.DeepCopy()
 				expectedOut = defaultGardenShoot.DeepCopy()
@@ -1060,7 +1060,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultCoreShoot.DeepCopy()
 				expectedOut = defaultGardenShoot.DeepCopy()
@@ -1233,7 +1233,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				controlPlaneConfigJSON, _ = json.Marshal(controlPlaneConfig)
 
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultCoreShoot.DeepCopy()
 				expectedOut = defaultGardenShoot.DeepCopy()
@@ -1407,7 +1407,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultCoreShoot.DeepCopy()
 				expectedOut = defaultGardenShoot.DeepCopy()
@@ -1553,7 +1553,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultCoreShoot.DeepCopy()
 				expectedOut = defaultGardenShoot.DeepCopy()
@@ -1817,7 +1817,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultGardenShoot.DeepCopy()
 				expectedOut = defaultCoreShoot.DeepCopy()
@@ -1985,7 +1985,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{"zone1", "zone2"}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 				in                  = defaultGardenShoot.DeepCopy()
 				expectedOut         = defaultCoreShoot.DeepCopy()
 			)
@@ -2147,7 +2147,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultGardenShoot.DeepCopy()
 				expectedOut = defaultCoreShoot.DeepCopy()
@@ -2324,7 +2324,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				controlPlaneConfigJSON, _ = json.Marshal(controlPlaneConfig)
 
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultGardenShoot.DeepCopy()
 				expectedOut = defaultCoreShoot.DeepCopy()
@@ -2502,7 +2502,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultGardenShoot.DeepCopy()
 				expectedOut = defaultCoreShoot.DeepCopy()
@@ -2654,7 +2654,7 @@ var _ = Describe("roundtripper cloudprofile migration", func() {
 				worker1VolumeSize   = "20Gi"
 				worker1VolumeType   = "voltype"
 				worker1Zones        = []string{zone1Name, zone2Name}
-				workerMigrationJSON = "{\"worker1\":{\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
+				workerMigrationJSON = "{\"worker1\":{\"AutoRepair\":null,\"KubernetesVersion\":null,\"MaxNodeAge\":null,\"OperatingSystemConfig\":null,\"ProviderConfig\":" + worker1ProviderConfig + ",\"Volume\":null,\"Zones\":[\"" + worker1Zones[0] + "\",\"" + worker1Zones[1] + "\"]}}"
 
 				in          = defaultGardenShoot.DeepCopy()
 				expectedOut = defaultCoreShoot.DeepCopy()
@@ -47,8 +47,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&ClusterOpenIDConnectPreset{},
 		&ClusterOpenIDConnectPresetList{},
+		&ClusterShootPreset{},
+		&ClusterShootPresetList{},
 		&OpenIDConnectPreset{},
 		&OpenIDConnectPresetList{},
+		&ShootPolicy{},
+		&ShootPolicyList{},
+		&ShootPreset{},
+		&ShootPresetList{},
 	)
 	return nil
 }
@@ -0,0 +1,80 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package settings
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPreset contains default settings that are applied
+// to Shoots in a namespace at creation time.
+type ShootPreset struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+
+	Spec ShootPresetSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPresetList is a collection of ShootPresets.
+type ShootPresetList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootPresets.
+	Items []ShootPreset
+}
+
+// ShootPresetSpec contains the default settings and the selection criteria of a Shoot preset.
+type ShootPresetSpec struct {
+	// Defaults contains the settings which are applied to matching Shoots.
+	// Settings already specified in the Shoot are not overwritten.
+	Defaults ShootDefaults
+
+	// ShootSelector decides whether to apply the defaults if the
+	// Shoot has matching labels.
+	// Use the selector only if the preset is opt-in, because end
+	// users may skip the admission by setting the labels.
+	// Default to the empty LabelSelector, which matches everything.
+	ShootSelector *metav1.LabelSelector
+
+	// Weight associated with matching the corresponding preset,
+	// in the range 1-100. Presets with a higher weight take precedence.
+	// Required.
+	Weight int32
+}
+
+// ShootDefaults contains the default settings of a Shoot preset.
+type ShootDefaults struct {
+	// Addons contains defaults for the addons of the Shoot.
+	Addons *garden.Addons
+	// AuditConfig contains defaults for the audit settings of the kube-apiserver of the Shoot.
+	AuditConfig *garden.AuditConfig
+	// Extensions contains default extensions of the Shoot. They are merged with the Shoot's extensions by their type.
+	Extensions []garden.Extension
+	// Hibernation contains defaults for the hibernation settings of the Shoot.
+	Hibernation *garden.Hibernation
+	// Kubelet contains defaults for the kubelet configuration of the Shoot.
+	Kubelet *garden.KubeletConfig
+	// Maintenance contains defaults for the maintenance settings of the Shoot.
+	Maintenance *garden.Maintenance
+}
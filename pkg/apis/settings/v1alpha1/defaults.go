@@ -43,6 +43,35 @@ func SetDefaults_ClusterOpenIDConnectPreset(obj *ClusterOpenIDConnectPreset) {
 	setDefaultServerSpec(&obj.Spec.Server)
 }
 
+// SetDefaults_ShootPreset sets default values for ShootPreset objects.
+func SetDefaults_ShootPreset(obj *ShootPreset) {
+	if obj.Spec.ShootSelector == nil {
+		obj.Spec.ShootSelector = &metav1.LabelSelector{}
+	}
+}
+
+// SetDefaults_ClusterShootPreset sets default values for ClusterShootPreset objects.
+func SetDefaults_ClusterShootPreset(obj *ClusterShootPreset) {
+	if obj.Spec.ShootSelector == nil {
+		obj.Spec.ShootSelector = &metav1.LabelSelector{}
+	}
+
+	if obj.Spec.ProjectSelector == nil {
+		obj.Spec.ProjectSelector = &metav1.LabelSelector{}
+	}
+}
+
+// SetDefaults_ShootPolicy sets default values for ShootPolicy objects.
+func SetDefaults_ShootPolicy(obj *ShootPolicy) {
+	if obj.Spec.ProjectSelector == nil {
+		obj.Spec.ProjectSelector = &metav1.LabelSelector{}
+	}
+
+	if len(obj.Spec.EnforcementAction) == 0 {
+		obj.Spec.EnforcementAction = ShootPolicyEnforcementActionDeny
+	}
+}
+
 func setDefaultServerSpec(spec *KubeAPIServerOpenIDConnect) {
 	if len(spec.SigningAlgs) == 0 {
 		spec.SigningAlgs = []string{DefaultSignAlg}
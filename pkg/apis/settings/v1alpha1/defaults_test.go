@@ -64,6 +64,73 @@ var _ = Describe("Defaults", func() {
 
 	})
 
+	Describe("SetDefaults_ShootPreset", func() {
+
+		It("correct defaults are set", func() {
+			given := &v1alpha1.ShootPreset{}
+			expected := &v1alpha1.ShootPreset{
+				Spec: v1alpha1.ShootPresetSpec{
+					ShootSelector: &metav1.LabelSelector{},
+				},
+			}
+
+			v1alpha1.SetDefaults_ShootPreset(given)
+
+			Expect(given).To(BeEquivalentTo(expected))
+		})
+
+	})
+
+	Describe("SetDefaults_ClusterShootPreset", func() {
+
+		It("correct defaults are set", func() {
+			given := &v1alpha1.ClusterShootPreset{}
+			expected := &v1alpha1.ClusterShootPreset{
+				Spec: v1alpha1.ClusterShootPresetSpec{
+					ShootPresetSpec: v1alpha1.ShootPresetSpec{
+						ShootSelector: &metav1.LabelSelector{},
+					},
+					ProjectSelector: &metav1.LabelSelector{},
+				},
+			}
+
+			v1alpha1.SetDefaults_ClusterShootPreset(given)
+
+			Expect(given).To(BeEquivalentTo(expected))
+		})
+
+	})
+
+	Describe("SetDefaults_ShootPolicy", func() {
+
+		It("correct defaults are set", func() {
+			given := &v1alpha1.ShootPolicy{}
+			expected := &v1alpha1.ShootPolicy{
+				Spec: v1alpha1.ShootPolicySpec{
+					ProjectSelector:   &metav1.LabelSelector{},
+					EnforcementAction: "Deny",
+				},
+			}
+
+			v1alpha1.SetDefaults_ShootPolicy(given)
+
+			Expect(given).To(BeEquivalentTo(expected))
+		})
+
+		It("should not overwrite the enforcement action", func() {
+			given := &v1alpha1.ShootPolicy{
+				Spec: v1alpha1.ShootPolicySpec{
+					EnforcementAction: v1alpha1.ShootPolicyEnforcementActionAudit,
+				},
+			}
+
+			v1alpha1.SetDefaults_ShootPolicy(given)
+
+			Expect(given.Spec.EnforcementAction).To(Equal(v1alpha1.ShootPolicyEnforcementActionAudit))
+		})
+
+	})
+
 })
 
 func defaultSpec() v1alpha1.OpenIDConnectPresetSpec {
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPreset contains default settings that are applied
+// to Shoots cluster-wide at creation time.
+type ClusterShootPreset struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of this Shoot preset.
+	Spec ClusterShootPresetSpec `json:"spec"`
+}
+
+// ClusterShootPresetSpec contains the default settings and the selection criteria of a cluster-wide Shoot preset.
+type ClusterShootPresetSpec struct {
+	ShootPresetSpec `json:",inline"`
+
+	// Project decides whether to apply the defaults if the
+	// Shoot is in a specific Project matching the label selector.
+	// Use the selector only if the preset is opt-in, because end
+	// users may skip the admission by setting the labels.
+	// Default to the empty LabelSelector, which matches everything.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ClusterShootPresetList is a collection of ClusterShootPresets.
+type ClusterShootPresetList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of ClusterShootPresets.
+	Items []ClusterShootPreset `json:"items"`
+}
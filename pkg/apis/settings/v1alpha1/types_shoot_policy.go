@@ -0,0 +1,113 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicy contains rules which are evaluated against
+// Shoots when they are created or updated.
+type ShootPolicy struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of this Shoot policy.
+	Spec ShootPolicySpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPolicyList is a collection of ShootPolicies.
+type ShootPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of ShootPolicies.
+	Items []ShootPolicy `json:"items"`
+}
+
+// ShootPolicySpec contains the rules and the selection criteria of a Shoot policy.
+type ShootPolicySpec struct {
+	// ProjectSelector decides whether to evaluate the rules if the
+	// Shoot is in a specific Project matching the label selector.
+	// Default to the empty LabelSelector, which matches everything.
+	// +optional
+	ProjectSelector *metav1.LabelSelector `json:"projectSelector,omitempty"`
+
+	// EnforcementAction decides what happens if a Shoot violates the rules.
+	// Defaults to Deny.
+	// +optional
+	EnforcementAction ShootPolicyEnforcementAction `json:"enforcementAction,omitempty"`
+
+	// Rules is the list of rules which must be fulfilled by the Shoots.
+	Rules []ShootPolicyRule `json:"rules"`
+}
+
+// ShootPolicyEnforcementAction is a string alias.
+type ShootPolicyEnforcementAction string
+
+const (
+	// ShootPolicyEnforcementActionDeny rejects requests for Shoots violating the rules.
+	ShootPolicyEnforcementActionDeny ShootPolicyEnforcementAction = "Deny"
+	// ShootPolicyEnforcementActionAudit admits requests for Shoots violating the rules but records
+	// the violations in the audit log.
+	ShootPolicyEnforcementActionAudit ShootPolicyEnforcementAction = "Audit"
+)
+
+// ShootPolicyRule is a single rule of a Shoot policy.
+type ShootPolicyRule struct {
+	// Name is the name of the rule. It must be unique within the policy.
+	Name string `json:"name"`
+	// Path selects the fields of the Shoot the rule applies to, e.g. `spec.cloud.aws.workers[*].machineType`.
+	// It refers to the garden.sapcloud.io/v1beta1 representation of the Shoot.
+	Path string `json:"path"`
+	// Operator represents the relationship of the selected values to the given values.
+	Operator ShootPolicyRuleOperator `json:"operator"`
+	// Values is a list of values the selected values are compared with. It must be empty
+	// for the Exists and DoesNotExist operators.
+	// +optional
+	Values []string `json:"values,omitempty"`
+	// Message is an optional message which is reported if the rule is violated.
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// ShootPolicyRuleOperator is a string alias.
+type ShootPolicyRuleOperator string
+
+const (
+	// ShootPolicyRuleOperatorIn requires all selected values to be contained in the given values.
+	ShootPolicyRuleOperatorIn ShootPolicyRuleOperator = "In"
+	// ShootPolicyRuleOperatorNotIn requires none of the selected values to be contained in the given values.
+	ShootPolicyRuleOperatorNotIn ShootPolicyRuleOperator = "NotIn"
+	// ShootPolicyRuleOperatorMatches requires all selected values to match one of the given regular expressions.
+	ShootPolicyRuleOperatorMatches ShootPolicyRuleOperator = "Matches"
+	// ShootPolicyRuleOperatorDoesNotMatch requires none of the selected values to match one of the given regular expressions.
+	ShootPolicyRuleOperatorDoesNotMatch ShootPolicyRuleOperator = "DoesNotMatch"
+	// ShootPolicyRuleOperatorGreaterThanOrEqual requires all selected values to be numbers greater than or equal to the given value.
+	ShootPolicyRuleOperatorGreaterThanOrEqual ShootPolicyRuleOperator = "GreaterThanOrEqual"
+	// ShootPolicyRuleOperatorLessThanOrEqual requires all selected values to be numbers less than or equal to the given value.
+	ShootPolicyRuleOperatorLessThanOrEqual ShootPolicyRuleOperator = "LessThanOrEqual"
+	// ShootPolicyRuleOperatorExists requires the path to select at least one value.
+	ShootPolicyRuleOperatorExists ShootPolicyRuleOperator = "Exists"
+	// ShootPolicyRuleOperatorDoesNotExist requires the path to select no value.
+	ShootPolicyRuleOperatorDoesNotExist ShootPolicyRuleOperator = "DoesNotExist"
+)
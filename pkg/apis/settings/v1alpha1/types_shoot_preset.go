@@ -0,0 +1,88 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPreset contains default settings that are applied
+// to Shoots in a namespace at creation time.
+type ShootPreset struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Spec is the specification of this Shoot preset.
+	Spec ShootPresetSpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootPresetList is a collection of ShootPresets.
+type ShootPresetList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is the list of ShootPresets.
+	Items []ShootPreset `json:"items"`
+}
+
+// ShootPresetSpec contains the default settings and the selection criteria of a Shoot preset.
+type ShootPresetSpec struct {
+	// Defaults contains the settings which are applied to matching Shoots.
+	// Settings already specified in the Shoot are not overwritten.
+	Defaults ShootDefaults `json:"defaults"`
+
+	// ShootSelector decides whether to apply the defaults if the
+	// Shoot has matching labels.
+	// Use the selector only if the preset is opt-in, because end
+	// users may skip the admission by setting the labels.
+	// Default to the empty LabelSelector, which matches everything.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
+
+	// Weight associated with matching the corresponding preset,
+	// in the range 1-100. Presets with a higher weight take precedence.
+	// Required.
+	Weight int32 `json:"weight"`
+}
+
+// ShootDefaults contains the default settings of a Shoot preset.
+type ShootDefaults struct {
+	// Addons contains defaults for the addons of the Shoot.
+	// +optional
+	Addons *gardenv1beta1.Addons `json:"addons,omitempty"`
+	// AuditConfig contains defaults for the audit settings of the kube-apiserver of the Shoot.
+	// +optional
+	AuditConfig *gardenv1beta1.AuditConfig `json:"auditConfig,omitempty"`
+	// Extensions contains default extensions of the Shoot. They are merged with the Shoot's extensions by their type.
+	// +optional
+	Extensions []gardenv1beta1.Extension `json:"extensions,omitempty"`
+	// Hibernation contains defaults for the hibernation settings of the Shoot.
+	// +optional
+	Hibernation *gardenv1beta1.Hibernation `json:"hibernation,omitempty"`
+	// Kubelet contains defaults for the kubelet configuration of the Shoot.
+	// +optional
+	Kubelet *gardenv1beta1.KubeletConfig `json:"kubelet,omitempty"`
+	// Maintenance contains defaults for the maintenance settings of the Shoot.
+	// +optional
+	Maintenance *gardenv1beta1.Maintenance `json:"maintenance,omitempty"`
+}
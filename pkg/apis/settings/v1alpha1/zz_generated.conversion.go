@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	unsafe "unsafe"
 
+	garden "github.com/gardener/gardener/pkg/apis/garden"
+	v1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	settings "github.com/gardener/gardener/pkg/apis/settings"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
@@ -50,6 +52,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPreset)(nil), (*settings.ClusterShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(a.(*ClusterShootPreset), b.(*settings.ClusterShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPreset)(nil), (*ClusterShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(a.(*settings.ClusterShootPreset), b.(*ClusterShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPresetList)(nil), (*settings.ClusterShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(a.(*ClusterShootPresetList), b.(*settings.ClusterShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPresetList)(nil), (*ClusterShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(a.(*settings.ClusterShootPresetList), b.(*ClusterShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClusterShootPresetSpec)(nil), (*settings.ClusterShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(a.(*ClusterShootPresetSpec), b.(*settings.ClusterShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ClusterShootPresetSpec)(nil), (*ClusterShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(a.(*settings.ClusterShootPresetSpec), b.(*ClusterShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerOpenIDConnect)(nil), (*settings.KubeAPIServerOpenIDConnect)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(a.(*KubeAPIServerOpenIDConnect), b.(*settings.KubeAPIServerOpenIDConnect), scope)
 	}); err != nil {
@@ -100,6 +132,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootDefaults)(nil), (*settings.ShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(a.(*ShootDefaults), b.(*settings.ShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootDefaults)(nil), (*ShootDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(a.(*settings.ShootDefaults), b.(*ShootDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicy)(nil), (*settings.ShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(a.(*ShootPolicy), b.(*settings.ShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicy)(nil), (*ShootPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(a.(*settings.ShootPolicy), b.(*ShootPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyList)(nil), (*settings.ShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(a.(*ShootPolicyList), b.(*settings.ShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyList)(nil), (*ShootPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(a.(*settings.ShootPolicyList), b.(*ShootPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicyRule)(nil), (*settings.ShootPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(a.(*ShootPolicyRule), b.(*settings.ShootPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicyRule)(nil), (*ShootPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(a.(*settings.ShootPolicyRule), b.(*ShootPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPolicySpec)(nil), (*settings.ShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(a.(*ShootPolicySpec), b.(*settings.ShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPolicySpec)(nil), (*ShootPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(a.(*settings.ShootPolicySpec), b.(*ShootPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPreset)(nil), (*settings.ShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPreset_To_settings_ShootPreset(a.(*ShootPreset), b.(*settings.ShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPreset)(nil), (*ShootPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPreset_To_v1alpha1_ShootPreset(a.(*settings.ShootPreset), b.(*ShootPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPresetList)(nil), (*settings.ShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(a.(*ShootPresetList), b.(*settings.ShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPresetList)(nil), (*ShootPresetList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(a.(*settings.ShootPresetList), b.(*ShootPresetList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPresetSpec)(nil), (*settings.ShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(a.(*ShootPresetSpec), b.(*settings.ShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*settings.ShootPresetSpec)(nil), (*ShootPresetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(a.(*settings.ShootPresetSpec), b.(*ShootPresetSpec), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -177,6 +289,80 @@ func Convert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDCo
 	return autoConvert_settings_ClusterOpenIDConnectPresetSpec_To_v1alpha1_ClusterOpenIDConnectPresetSpec(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(in *ClusterShootPreset, out *settings.ClusterShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(in *ClusterShootPreset, out *settings.ClusterShootPreset, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPreset_To_settings_ClusterShootPreset(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(in *settings.ClusterShootPreset, out *ClusterShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset is an autogenerated conversion function.
+func Convert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(in *settings.ClusterShootPreset, out *ClusterShootPreset, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPreset_To_v1alpha1_ClusterShootPreset(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(in *ClusterShootPresetList, out *settings.ClusterShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ClusterShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(in *ClusterShootPresetList, out *settings.ClusterShootPresetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPresetList_To_settings_ClusterShootPresetList(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(in *settings.ClusterShootPresetList, out *ClusterShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ClusterShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList is an autogenerated conversion function.
+func Convert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(in *settings.ClusterShootPresetList, out *ClusterShootPresetList, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPresetList_To_v1alpha1_ClusterShootPresetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(in *ClusterShootPresetSpec, out *settings.ClusterShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(&in.ShootPresetSpec, &out.ShootPresetSpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(in *ClusterShootPresetSpec, out *settings.ClusterShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterShootPresetSpec_To_settings_ClusterShootPresetSpec(in, out, s)
+}
+
+func autoConvert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(in *settings.ClusterShootPresetSpec, out *ClusterShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(&in.ShootPresetSpec, &out.ShootPresetSpec, s); err != nil {
+		return err
+	}
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	return nil
+}
+
+// Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec is an autogenerated conversion function.
+func Convert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(in *settings.ClusterShootPresetSpec, out *ClusterShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_settings_ClusterShootPresetSpec_To_v1alpha1_ClusterShootPresetSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeAPIServerOpenIDConnect_To_settings_KubeAPIServerOpenIDConnect(in *KubeAPIServerOpenIDConnect, out *settings.KubeAPIServerOpenIDConnect, s conversion.Scope) error {
 	out.CABundle = (*string)(unsafe.Pointer(in.CABundle))
 	out.ClientID = in.ClientID
@@ -312,3 +498,209 @@ func autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPrese
 func Convert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in *settings.OpenIDConnectPresetSpec, out *OpenIDConnectPresetSpec, s conversion.Scope) error {
 	return autoConvert_settings_OpenIDConnectPresetSpec_To_v1alpha1_OpenIDConnectPresetSpec(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(in *ShootDefaults, out *settings.ShootDefaults, s conversion.Scope) error {
+	out.Addons = (*garden.Addons)(unsafe.Pointer(in.Addons))
+	out.AuditConfig = (*garden.AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.Extensions = *(*[]garden.Extension)(unsafe.Pointer(&in.Extensions))
+	out.Hibernation = (*garden.Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.Kubelet = (*garden.KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.Maintenance = (*garden.Maintenance)(unsafe.Pointer(in.Maintenance))
+	return nil
+}
+
+// Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults is an autogenerated conversion function.
+func Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(in *ShootDefaults, out *settings.ShootDefaults, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(in, out, s)
+}
+
+func autoConvert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(in *settings.ShootDefaults, out *ShootDefaults, s conversion.Scope) error {
+	out.Addons = (*v1beta1.Addons)(unsafe.Pointer(in.Addons))
+	out.AuditConfig = (*v1beta1.AuditConfig)(unsafe.Pointer(in.AuditConfig))
+	out.Extensions = *(*[]v1beta1.Extension)(unsafe.Pointer(&in.Extensions))
+	out.Hibernation = (*v1beta1.Hibernation)(unsafe.Pointer(in.Hibernation))
+	out.Kubelet = (*v1beta1.KubeletConfig)(unsafe.Pointer(in.Kubelet))
+	out.Maintenance = (*v1beta1.Maintenance)(unsafe.Pointer(in.Maintenance))
+	return nil
+}
+
+// Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults is an autogenerated conversion function.
+func Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(in *settings.ShootDefaults, out *ShootDefaults, s conversion.Scope) error {
+	return autoConvert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in *ShootPolicy, out *settings.ShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in *ShootPolicy, out *settings.ShootPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicy_To_settings_ShootPolicy(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in *settings.ShootPolicy, out *ShootPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy is an autogenerated conversion function.
+func Convert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in *settings.ShootPolicy, out *ShootPolicy, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicy_To_v1alpha1_ShootPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in *ShootPolicyList, out *settings.ShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in *ShootPolicyList, out *settings.ShootPolicyList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyList_To_settings_ShootPolicyList(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in *settings.ShootPolicyList, out *ShootPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList is an autogenerated conversion function.
+func Convert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in *settings.ShootPolicyList, out *ShootPolicyList, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyList_To_v1alpha1_ShootPolicyList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(in *ShootPolicyRule, out *settings.ShootPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.Operator = settings.ShootPolicyRuleOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(in *ShootPolicyRule, out *settings.ShootPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicyRule_To_settings_ShootPolicyRule(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(in *settings.ShootPolicyRule, out *ShootPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.Operator = ShootPolicyRuleOperator(in.Operator)
+	out.Values = *(*[]string)(unsafe.Pointer(&in.Values))
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule is an autogenerated conversion function.
+func Convert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(in *settings.ShootPolicyRule, out *ShootPolicyRule, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicyRule_To_v1alpha1_ShootPolicyRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in *ShootPolicySpec, out *settings.ShootPolicySpec, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.EnforcementAction = settings.ShootPolicyEnforcementAction(in.EnforcementAction)
+	out.Rules = *(*[]settings.ShootPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in *ShootPolicySpec, out *settings.ShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPolicySpec_To_settings_ShootPolicySpec(in, out, s)
+}
+
+func autoConvert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in *settings.ShootPolicySpec, out *ShootPolicySpec, s conversion.Scope) error {
+	out.ProjectSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ProjectSelector))
+	out.EnforcementAction = ShootPolicyEnforcementAction(in.EnforcementAction)
+	out.Rules = *(*[]ShootPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec is an autogenerated conversion function.
+func Convert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in *settings.ShootPolicySpec, out *ShootPolicySpec, s conversion.Scope) error {
+	return autoConvert_settings_ShootPolicySpec_To_v1alpha1_ShootPolicySpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPreset_To_settings_ShootPreset(in *ShootPreset, out *settings.ShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootPreset_To_settings_ShootPreset is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPreset_To_settings_ShootPreset(in *ShootPreset, out *settings.ShootPreset, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPreset_To_settings_ShootPreset(in, out, s)
+}
+
+func autoConvert_settings_ShootPreset_To_v1alpha1_ShootPreset(in *settings.ShootPreset, out *ShootPreset, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_settings_ShootPreset_To_v1alpha1_ShootPreset is an autogenerated conversion function.
+func Convert_settings_ShootPreset_To_v1alpha1_ShootPreset(in *settings.ShootPreset, out *ShootPreset, s conversion.Scope) error {
+	return autoConvert_settings_ShootPreset_To_v1alpha1_ShootPreset(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(in *ShootPresetList, out *settings.ShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]settings.ShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPresetList_To_settings_ShootPresetList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(in *ShootPresetList, out *settings.ShootPresetList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPresetList_To_settings_ShootPresetList(in, out, s)
+}
+
+func autoConvert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(in *settings.ShootPresetList, out *ShootPresetList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootPreset)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_settings_ShootPresetList_To_v1alpha1_ShootPresetList is an autogenerated conversion function.
+func Convert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(in *settings.ShootPresetList, out *ShootPresetList, s conversion.Scope) error {
+	return autoConvert_settings_ShootPresetList_To_v1alpha1_ShootPresetList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(in *ShootPresetSpec, out *settings.ShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_v1alpha1_ShootDefaults_To_settings_ShootDefaults(&in.Defaults, &out.Defaults, s); err != nil {
+		return err
+	}
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(in *ShootPresetSpec, out *settings.ShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPresetSpec_To_settings_ShootPresetSpec(in, out, s)
+}
+
+func autoConvert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(in *settings.ShootPresetSpec, out *ShootPresetSpec, s conversion.Scope) error {
+	if err := Convert_settings_ShootDefaults_To_v1alpha1_ShootDefaults(&in.Defaults, &out.Defaults, s); err != nil {
+		return err
+	}
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	out.Weight = in.Weight
+	return nil
+}
+
+// Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec is an autogenerated conversion function.
+func Convert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(in *settings.ShootPresetSpec, out *ShootPresetSpec, s conversion.Scope) error {
+	return autoConvert_settings_ShootPresetSpec_To_v1alpha1_ShootPresetSpec(in, out, s)
+}
@@ -16,8 +16,14 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&ClusterOpenIDConnectPresetList{}, func(obj interface{}) {
 		SetObjectDefaults_ClusterOpenIDConnectPresetList(obj.(*ClusterOpenIDConnectPresetList))
 	})
+	scheme.AddTypeDefaultingFunc(&ClusterShootPreset{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPreset(obj.(*ClusterShootPreset)) })
+	scheme.AddTypeDefaultingFunc(&ClusterShootPresetList{}, func(obj interface{}) { SetObjectDefaults_ClusterShootPresetList(obj.(*ClusterShootPresetList)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPreset{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPreset(obj.(*OpenIDConnectPreset)) })
 	scheme.AddTypeDefaultingFunc(&OpenIDConnectPresetList{}, func(obj interface{}) { SetObjectDefaults_OpenIDConnectPresetList(obj.(*OpenIDConnectPresetList)) })
+	scheme.AddTypeDefaultingFunc(&ShootPolicy{}, func(obj interface{}) { SetObjectDefaults_ShootPolicy(obj.(*ShootPolicy)) })
+	scheme.AddTypeDefaultingFunc(&ShootPolicyList{}, func(obj interface{}) { SetObjectDefaults_ShootPolicyList(obj.(*ShootPolicyList)) })
+	scheme.AddTypeDefaultingFunc(&ShootPreset{}, func(obj interface{}) { SetObjectDefaults_ShootPreset(obj.(*ShootPreset)) })
+	scheme.AddTypeDefaultingFunc(&ShootPresetList{}, func(obj interface{}) { SetObjectDefaults_ShootPresetList(obj.(*ShootPresetList)) })
 	return nil
 }
 
@@ -32,6 +38,17 @@ func SetObjectDefaults_ClusterOpenIDConnectPresetList(in *ClusterOpenIDConnectPr
 	}
 }
 
+func SetObjectDefaults_ClusterShootPreset(in *ClusterShootPreset) {
+	SetDefaults_ClusterShootPreset(in)
+}
+
+func SetObjectDefaults_ClusterShootPresetList(in *ClusterShootPresetList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ClusterShootPreset(a)
+	}
+}
+
 func SetObjectDefaults_OpenIDConnectPreset(in *OpenIDConnectPreset) {
 	SetDefaults_OpenIDConnectPreset(in)
 }
@@ -42,3 +59,25 @@ func SetObjectDefaults_OpenIDConnectPresetList(in *OpenIDConnectPresetList) {
 		SetObjectDefaults_OpenIDConnectPreset(a)
 	}
 }
+
+func SetObjectDefaults_ShootPolicy(in *ShootPolicy) {
+	SetDefaults_ShootPolicy(in)
+}
+
+func SetObjectDefaults_ShootPolicyList(in *ShootPolicyList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootPolicy(a)
+	}
+}
+
+func SetObjectDefaults_ShootPreset(in *ShootPreset) {
+	SetDefaults_ShootPreset(in)
+}
+
+func SetObjectDefaults_ShootPresetList(in *ShootPresetList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootPreset(a)
+	}
+}
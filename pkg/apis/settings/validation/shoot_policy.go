@@ -0,0 +1,145 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"regexp"
+	"strconv"
+
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/utils/fieldpath"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	availableShootPolicyEnforcementActions = sets.NewString(
+		string(settings.ShootPolicyEnforcementActionDeny),
+		string(settings.ShootPolicyEnforcementActionAudit),
+	)
+	availableShootPolicyRuleOperators = sets.NewString(
+		string(settings.ShootPolicyRuleOperatorIn),
+		string(settings.ShootPolicyRuleOperatorNotIn),
+		string(settings.ShootPolicyRuleOperatorMatches),
+		string(settings.ShootPolicyRuleOperatorDoesNotMatch),
+		string(settings.ShootPolicyRuleOperatorGreaterThanOrEqual),
+		string(settings.ShootPolicyRuleOperatorLessThanOrEqual),
+		string(settings.ShootPolicyRuleOperatorExists),
+		string(settings.ShootPolicyRuleOperatorDoesNotExist),
+	)
+)
+
+// ValidateShootPolicy validates a ShootPolicy object.
+func ValidateShootPolicy(policy *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&policy.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&policy.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootPolicyUpdate validates a ShootPolicy object before an update.
+func ValidateShootPolicyUpdate(new, old *settings.ShootPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPolicySpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateShootPolicySpec(spec *settings.ShootPolicySpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ProjectSelector, fldPath.Child("projectSelector"))...)
+
+	if !availableShootPolicyEnforcementActions.Has(string(spec.EnforcementAction)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("enforcementAction"), spec.EnforcementAction, availableShootPolicyEnforcementActions.List()))
+	}
+
+	if len(spec.Rules) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("rules"), "must provide at least one rule"))
+	}
+
+	names := sets.NewString()
+	for i, rule := range spec.Rules {
+		idxPath := fldPath.Child("rules").Index(i)
+		if len(rule.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field must not be empty"))
+		} else if names.Has(rule.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		allErrs = append(allErrs, validateShootPolicyRule(rule, idxPath)...)
+	}
+
+	return allErrs
+}
+
+func validateShootPolicyRule(rule settings.ShootPolicyRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(rule.Path) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("path"), "field must not be empty"))
+	} else if _, err := fieldpath.Parse(rule.Path); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("path"), rule.Path, err.Error()))
+	}
+
+	valuesPath := fldPath.Child("values")
+	switch rule.Operator {
+	case settings.ShootPolicyRuleOperatorIn, settings.ShootPolicyRuleOperatorNotIn:
+		if len(rule.Values) == 0 {
+			allErrs = append(allErrs, field.Required(valuesPath, "must be specified when `operator` is 'In' or 'NotIn'"))
+		}
+
+	case settings.ShootPolicyRuleOperatorMatches, settings.ShootPolicyRuleOperatorDoesNotMatch:
+		if len(rule.Values) == 0 {
+			allErrs = append(allErrs, field.Required(valuesPath, "must be specified when `operator` is 'Matches' or 'DoesNotMatch'"))
+		}
+		for i, value := range rule.Values {
+			if _, err := regexp.Compile(value); err != nil {
+				allErrs = append(allErrs, field.Invalid(valuesPath.Index(i), value, err.Error()))
+			}
+		}
+
+	case settings.ShootPolicyRuleOperatorGreaterThanOrEqual, settings.ShootPolicyRuleOperatorLessThanOrEqual:
+		if len(rule.Values) != 1 {
+			allErrs = append(allErrs, field.Invalid(valuesPath, rule.Values, "must have exactly one value when `operator` is 'GreaterThanOrEqual' or 'LessThanOrEqual'"))
+			break
+		}
+		if _, err := strconv.ParseFloat(rule.Values[0], 64); err != nil {
+			allErrs = append(allErrs, field.Invalid(valuesPath.Index(0), rule.Values[0], "must be a number"))
+		}
+
+	case settings.ShootPolicyRuleOperatorExists, settings.ShootPolicyRuleOperatorDoesNotExist:
+		if len(rule.Values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(valuesPath, "may not be specified when `operator` is 'Exists' or 'DoesNotExist'"))
+		}
+
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operator"), rule.Operator, availableShootPolicyRuleOperators.List()))
+	}
+
+	if rule.Message != nil && len(*rule.Message) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("message"), *rule.Message, "must not be empty if specified"))
+	}
+
+	return allErrs
+}
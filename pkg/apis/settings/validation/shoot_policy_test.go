@@ -0,0 +1,159 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settings_validation "github.com/gardener/gardener/pkg/apis/settings/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("ShootPolicy", func() {
+
+	var policy *settings.ShootPolicy
+
+	BeforeEach(func() {
+		policy = &settings.ShootPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test",
+			},
+			Spec: settings.ShootPolicySpec{
+				ProjectSelector:   &metav1.LabelSelector{},
+				EnforcementAction: settings.ShootPolicyEnforcementActionDeny,
+				Rules: []settings.ShootPolicyRule{
+					{
+						Name:     "machine-types",
+						Path:     "spec.cloud.aws.workers[*].machineType",
+						Operator: settings.ShootPolicyRuleOperatorIn,
+						Values:   []string{"m5.large"},
+					},
+					{
+						Name:     "minimum-workers",
+						Path:     "spec.cloud.aws.workers[*].autoScalerMin",
+						Operator: settings.ShootPolicyRuleOperatorGreaterThanOrEqual,
+						Values:   []string{"3"},
+					},
+					{
+						Name:     "names",
+						Path:     "metadata.name",
+						Operator: settings.ShootPolicyRuleOperatorMatches,
+						Values:   []string{"^prod-"},
+					},
+					{
+						Name:     "maintenance",
+						Path:     "spec.maintenance.timeWindow",
+						Operator: settings.ShootPolicyRuleOperatorExists,
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateShootPolicy", func() {
+
+		It("should allow a valid ShootPolicy", func() {
+			Expect(settings_validation.ValidateShootPolicy(policy)).To(BeEmpty())
+		})
+
+		It("should forbid empty ShootPolicy object", func() {
+			policy.ObjectMeta = metav1.ObjectMeta{}
+			policy.Spec = settings.ShootPolicySpec{}
+
+			errorList := settings_validation.ValidateShootPolicy(policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.enforcementAction"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.rules"),
+			})),
+			))
+		})
+
+		It("should forbid invalid rules", func() {
+			message := ""
+			policy.Spec.Rules = []settings.ShootPolicyRule{
+				{Name: "a", Path: "spec..foo", Operator: settings.ShootPolicyRuleOperatorIn},
+				{Name: "a", Path: "spec.foo", Operator: settings.ShootPolicyRuleOperatorMatches, Values: []string{"("}},
+				{Name: "b", Path: "spec.foo", Operator: settings.ShootPolicyRuleOperatorLessThanOrEqual, Values: []string{"three"}},
+				{Name: "c", Path: "spec.foo", Operator: settings.ShootPolicyRuleOperatorGreaterThanOrEqual, Values: []string{"1", "2"}},
+				{Name: "d", Path: "spec.foo", Operator: settings.ShootPolicyRuleOperatorDoesNotExist, Values: []string{"foo"}},
+				{Path: "spec.foo", Operator: "Equals", Message: &message},
+			}
+
+			errorList := settings_validation.ValidateShootPolicy(policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[0].path"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.rules[0].values"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.rules[1].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[1].values[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[2].values[0]"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[3].values"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.rules[4].values"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.rules[5].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.rules[5].operator"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.rules[5].message"),
+			})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootPolicyUpdate", func() {
+
+		It("should forbid update with mutation of objectmeta fields", func() {
+			policy.ObjectMeta.ResourceVersion = "2"
+			newPolicy := policy.DeepCopy()
+			newPolicy.ObjectMeta.Name = "changed-name"
+
+			errorList := settings_validation.ValidateShootPolicyUpdate(newPolicy, policy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("metadata.name"),
+				"Detail": Equal("field is immutable"),
+			})),
+			))
+		})
+	})
+})
@@ -0,0 +1,107 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenvalidation "github.com/gardener/gardener/pkg/apis/garden/validation"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/utils"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateShootPreset validates a ShootPreset object.
+func ValidateShootPreset(preset *settings.ShootPreset) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&preset.ObjectMeta, true, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPresetSpec(&preset.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootPresetUpdate validates a ShootPreset object before an update.
+func ValidateShootPresetUpdate(new, old *settings.ShootPreset) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, validateShootPresetSpec(&new.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func validateShootPresetSpec(spec *settings.ShootPresetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(spec.ShootSelector, fldPath.Child("shootSelector"))...)
+	if spec.Weight <= 0 || spec.Weight > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("weight"), spec.Weight, "must be in the range 1-100"))
+	}
+	allErrs = append(allErrs, validateShootDefaults(&spec.Defaults, fldPath.Child("defaults"))...)
+
+	return allErrs
+}
+
+func validateShootDefaults(defaults *settings.ShootDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if defaults.AuditConfig != nil && defaults.AuditConfig.AuditPolicy != nil && defaults.AuditConfig.AuditPolicy.ConfigMapRef != nil {
+		if len(defaults.AuditConfig.AuditPolicy.ConfigMapRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("auditConfig", "auditPolicy", "configMapRef", "name"), "must provide a name"))
+		}
+	}
+
+	extensionTypes := sets.NewString()
+	for i, extension := range defaults.Extensions {
+		idxPath := fldPath.Child("extensions").Index(i)
+		if len(extension.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "field must not be empty"))
+			continue
+		}
+		if extensionTypes.Has(extension.Type) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("type"), extension.Type))
+		}
+		extensionTypes.Insert(extension.Type)
+	}
+
+	allErrs = append(allErrs, gardenvalidation.ValidateHibernation(defaults.Hibernation, fldPath.Child("hibernation"))...)
+
+	if defaults.Kubelet != nil {
+		allErrs = append(allErrs, gardenvalidation.ValidateKubeletConfig(*defaults.Kubelet, fldPath.Child("kubelet"))...)
+	}
+
+	if defaults.Maintenance != nil {
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(defaults.Maintenance.TimeWindow, fldPath.Child("maintenance", "timeWindow"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceTimeWindow(timeWindow *garden.MaintenanceTimeWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if timeWindow == nil {
+		return allErrs
+	}
+	if _, err := utils.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin/end"), timeWindow, err.Error()))
+	}
+
+	return allErrs
+}
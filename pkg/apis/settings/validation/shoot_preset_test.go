@@ -0,0 +1,156 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settings_validation "github.com/gardener/gardener/pkg/apis/settings/validation"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+)
+
+var _ = Describe("ShootPreset", func() {
+
+	var preset *settings.ShootPreset
+
+	BeforeEach(func() {
+		preset = &settings.ShootPreset{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "test-namespace",
+			},
+			Spec: settings.ShootPresetSpec{
+				Weight: 1,
+				Defaults: settings.ShootDefaults{
+					Extensions: []garden.Extension{{Type: "foo"}},
+					Maintenance: &garden.Maintenance{
+						TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0100", End: "230000+0100"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateShootPreset", func() {
+
+		It("should allow a valid ShootPreset", func() {
+			Expect(settings_validation.ValidateShootPreset(preset)).To(BeEmpty())
+		})
+
+		It("should forbid empty ShootPreset object", func() {
+			preset.ObjectMeta = metav1.ObjectMeta{}
+			preset.Spec = settings.ShootPresetSpec{}
+
+			errorList := settings_validation.ValidateShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.namespace"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.weight"),
+			})),
+			))
+		})
+
+		It("should forbid invalid defaults", func() {
+			preset.Spec.Defaults = settings.ShootDefaults{
+				AuditConfig: &garden.AuditConfig{
+					AuditPolicy: &garden.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{}},
+				},
+				Extensions: []garden.Extension{{Type: "foo"}, {Type: "foo"}, {}},
+				Hibernation: &garden.Hibernation{
+					Schedules: []garden.HibernationSchedule{{}},
+				},
+				Maintenance: &garden.Maintenance{
+					TimeWindow: &garden.MaintenanceTimeWindow{Begin: "foo", End: "bar"},
+				},
+			}
+
+			errorList := settings_validation.ValidateShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.defaults.auditConfig.auditPolicy.configMapRef.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("spec.defaults.extensions[1].type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.defaults.extensions[2].type"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.defaults.hibernation.schedules[0].start/end"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.defaults.maintenance.timeWindow.begin/end"),
+			})),
+			))
+		})
+	})
+
+	Describe("#ValidateShootPresetUpdate", func() {
+
+		It("should forbid update with mutation of objectmeta fields", func() {
+			preset.ObjectMeta.ResourceVersion = "2"
+			newPreset := preset.DeepCopy()
+			newPreset.ObjectMeta.Name = "changed-name"
+
+			errorList := settings_validation.ValidateShootPresetUpdate(newPreset, preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("metadata.name"),
+				"Detail": Equal("field is immutable"),
+			})),
+			))
+		})
+	})
+})
+
+var _ = Describe("ClusterShootPreset", func() {
+
+	Describe("#ValidateClusterShootPreset", func() {
+
+		It("should forbid invalid project selectors", func() {
+			preset := &settings.ClusterShootPreset{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: settings.ClusterShootPresetSpec{
+					ShootPresetSpec: settings.ShootPresetSpec{Weight: 100},
+					ProjectSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}},
+					},
+				},
+			}
+
+			errorList := settings_validation.ValidateClusterShootPreset(preset)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.projectSelector.matchExpressions[0].operator"),
+			})),
+			))
+		})
+	})
+})
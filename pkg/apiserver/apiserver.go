@@ -15,15 +15,20 @@
 package apiserver
 
 import (
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
 	corerest "github.com/gardener/gardener/pkg/registry/core/rest"
 	gardenrest "github.com/gardener/gardener/pkg/registry/garden/rest"
 	settingsrest "github.com/gardener/gardener/pkg/registry/settings/rest"
 
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	"k8s.io/client-go/kubernetes"
 )
 
 type ExtraConfig struct {
-	// Place you custom config here.
+	// KubeClient is a client for the Kubernetes API server hosting the secrets of the garden cluster.
+	KubeClient kubernetes.Interface
 }
 
 type Config struct {
@@ -62,10 +67,15 @@ func (c completedConfig) New() (*GardenerServer, error) {
 		return nil, err
 	}
 
+	coreStorageProvider := corerest.StorageProvider{SnapStore: newETCDBackupSnapStore}
+	if c.ExtraConfig.KubeClient != nil {
+		coreStorageProvider.Secrets = c.ExtraConfig.KubeClient.CoreV1()
+	}
+
 	var (
 		s = &GardenerServer{GenericAPIServer: genericServer}
 
-		coreAPIGroupInfo     = (corerest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
+		coreAPIGroupInfo     = coreStorageProvider.NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		gardenAPIGroupInfo   = (gardenrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 	)
@@ -76,3 +86,8 @@ func (c completedConfig) New() (*GardenerServer, error) {
 
 	return s, nil
 }
+
+// newETCDBackupSnapStore creates the snapstore for the etcd snapshots in a backup bucket of the given provider type.
+func newETCDBackupSnapStore(providerType string, secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
+	return cloudbotanist.NewEtcdBackupSnapstore(gardenv1beta1.CloudProvider(providerType), secretData, prefix)
+}
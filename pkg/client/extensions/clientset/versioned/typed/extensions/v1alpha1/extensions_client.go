@@ -14,7 +14,9 @@ type ExtensionsV1alpha1Interface interface {
 	BackupBucketsGetter
 	BackupEntriesGetter
 	ClustersGetter
+	ContainerRuntimesGetter
 	ControlPlanesGetter
+	DNSRecordsGetter
 	ExtensionsGetter
 	InfrastructuresGetter
 	NetworksGetter
@@ -39,10 +41,18 @@ func (c *ExtensionsV1alpha1Client) Clusters() ClusterInterface {
 	return newClusters(c)
 }
 
+func (c *ExtensionsV1alpha1Client) ContainerRuntimes(namespace string) ContainerRuntimeInterface {
+	return newContainerRuntimes(c, namespace)
+}
+
 func (c *ExtensionsV1alpha1Client) ControlPlanes(namespace string) ControlPlaneInterface {
 	return newControlPlanes(c, namespace)
 }
 
+func (c *ExtensionsV1alpha1Client) DNSRecords(namespace string) DNSRecordInterface {
+	return newDNSRecords(c, namespace)
+}
+
 func (c *ExtensionsV1alpha1Client) Extensions(namespace string) ExtensionInterface {
 	return newExtensions(c, namespace)
 }
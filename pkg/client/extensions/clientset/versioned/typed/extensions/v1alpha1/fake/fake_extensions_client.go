@@ -24,10 +24,18 @@ func (c *FakeExtensionsV1alpha1) Clusters() v1alpha1.ClusterInterface {
 	return &FakeClusters{c}
 }
 
+func (c *FakeExtensionsV1alpha1) ContainerRuntimes(namespace string) v1alpha1.ContainerRuntimeInterface {
+	return &FakeContainerRuntimes{c, namespace}
+}
+
 func (c *FakeExtensionsV1alpha1) ControlPlanes(namespace string) v1alpha1.ControlPlaneInterface {
 	return &FakeControlPlanes{c, namespace}
 }
 
+func (c *FakeExtensionsV1alpha1) DNSRecords(namespace string) v1alpha1.DNSRecordInterface {
+	return &FakeDNSRecords{c, namespace}
+}
+
 func (c *FakeExtensionsV1alpha1) Extensions(namespace string) v1alpha1.ExtensionInterface {
 	return &FakeExtensions{c, namespace}
 }
@@ -8,8 +8,12 @@ type BackupEntryExpansion interface{}
 
 type ClusterExpansion interface{}
 
+type ContainerRuntimeExpansion interface{}
+
 type ControlPlaneExpansion interface{}
 
+type DNSRecordExpansion interface{}
+
 type ExtensionExpansion interface{}
 
 type InfrastructureExpansion interface{}
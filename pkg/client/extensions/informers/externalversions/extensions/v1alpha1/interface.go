@@ -14,8 +14,12 @@ type Interface interface {
 	BackupEntries() BackupEntryInformer
 	// Clusters returns a ClusterInformer.
 	Clusters() ClusterInformer
+	// ContainerRuntimes returns a ContainerRuntimeInformer.
+	ContainerRuntimes() ContainerRuntimeInformer
 	// ControlPlanes returns a ControlPlaneInformer.
 	ControlPlanes() ControlPlaneInformer
+	// DNSRecords returns a DNSRecordInformer.
+	DNSRecords() DNSRecordInformer
 	// Extensions returns a ExtensionInformer.
 	Extensions() ExtensionInformer
 	// Infrastructures returns a InfrastructureInformer.
@@ -54,11 +58,21 @@ func (v *version) Clusters() ClusterInformer {
 	return &clusterInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ContainerRuntimes returns a ContainerRuntimeInformer.
+func (v *version) ContainerRuntimes() ContainerRuntimeInformer {
+	return &containerRuntimeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ControlPlanes returns a ControlPlaneInformer.
 func (v *version) ControlPlanes() ControlPlaneInformer {
 	return &controlPlaneInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DNSRecords returns a DNSRecordInformer.
+func (v *version) DNSRecords() DNSRecordInformer {
+	return &dNSRecordInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Extensions returns a ExtensionInformer.
 func (v *version) Extensions() ExtensionInformer {
 	return &extensionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
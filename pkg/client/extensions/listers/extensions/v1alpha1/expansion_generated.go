@@ -14,6 +14,14 @@ type BackupEntryListerExpansion interface{}
 // ClusterLister.
 type ClusterListerExpansion interface{}
 
+// ContainerRuntimeListerExpansion allows custom methods to be added to
+// ContainerRuntimeLister.
+type ContainerRuntimeListerExpansion interface{}
+
+// ContainerRuntimeNamespaceListerExpansion allows custom methods to be added to
+// ContainerRuntimeNamespaceLister.
+type ContainerRuntimeNamespaceListerExpansion interface{}
+
 // ControlPlaneListerExpansion allows custom methods to be added to
 // ControlPlaneLister.
 type ControlPlaneListerExpansion interface{}
@@ -22,6 +30,14 @@ type ControlPlaneListerExpansion interface{}
 // ControlPlaneNamespaceLister.
 type ControlPlaneNamespaceListerExpansion interface{}
 
+// DNSRecordListerExpansion allows custom methods to be added to
+// DNSRecordLister.
+type DNSRecordListerExpansion interface{}
+
+// DNSRecordNamespaceListerExpansion allows custom methods to be added to
+// DNSRecordNamespaceLister.
+type DNSRecordNamespaceListerExpansion interface{}
+
 // ExtensionListerExpansion allows custom methods to be added to
 // ExtensionLister.
 type ExtensionListerExpansion interface{}
@@ -16,10 +16,22 @@ func (c *FakeSettingsV1alpha1) ClusterOpenIDConnectPresets() v1alpha1.ClusterOpe
 	return &FakeClusterOpenIDConnectPresets{c}
 }
 
+func (c *FakeSettingsV1alpha1) ClusterShootPresets() v1alpha1.ClusterShootPresetInterface {
+	return &FakeClusterShootPresets{c}
+}
+
 func (c *FakeSettingsV1alpha1) OpenIDConnectPresets(namespace string) v1alpha1.OpenIDConnectPresetInterface {
 	return &FakeOpenIDConnectPresets{c, namespace}
 }
 
+func (c *FakeSettingsV1alpha1) ShootPolicies() v1alpha1.ShootPolicyInterface {
+	return &FakeShootPolicies{c}
+}
+
+func (c *FakeSettingsV1alpha1) ShootPresets(namespace string) v1alpha1.ShootPresetInterface {
+	return &FakeShootPresets{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSettingsV1alpha1) RESTClient() rest.Interface {
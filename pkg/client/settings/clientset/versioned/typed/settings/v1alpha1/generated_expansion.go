@@ -4,4 +4,10 @@ package v1alpha1
 
 type ClusterOpenIDConnectPresetExpansion interface{}
 
+type ClusterShootPresetExpansion interface{}
+
 type OpenIDConnectPresetExpansion interface{}
+
+type ShootPolicyExpansion interface{}
+
+type ShootPresetExpansion interface{}
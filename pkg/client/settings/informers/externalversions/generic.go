@@ -39,8 +39,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	// Group=settings.gardener.cloud, Version=v1alpha1
 	case v1alpha1.SchemeGroupVersion.WithResource("clusteropenidconnectpresets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ClusterOpenIDConnectPresets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("clustershootpresets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ClusterShootPresets().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("openidconnectpresets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().OpenIDConnectPresets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("shootpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ShootPolicies().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("shootpresets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Settings().V1alpha1().ShootPresets().Informer()}, nil
 
 	}
 
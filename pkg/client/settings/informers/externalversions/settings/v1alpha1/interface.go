@@ -10,8 +10,14 @@ import (
 type Interface interface {
 	// ClusterOpenIDConnectPresets returns a ClusterOpenIDConnectPresetInformer.
 	ClusterOpenIDConnectPresets() ClusterOpenIDConnectPresetInformer
+	// ClusterShootPresets returns a ClusterShootPresetInformer.
+	ClusterShootPresets() ClusterShootPresetInformer
 	// OpenIDConnectPresets returns a OpenIDConnectPresetInformer.
 	OpenIDConnectPresets() OpenIDConnectPresetInformer
+	// ShootPolicies returns a ShootPolicyInformer.
+	ShootPolicies() ShootPolicyInformer
+	// ShootPresets returns a ShootPresetInformer.
+	ShootPresets() ShootPresetInformer
 }
 
 type version struct {
@@ -30,7 +36,22 @@ func (v *version) ClusterOpenIDConnectPresets() ClusterOpenIDConnectPresetInform
 	return &clusterOpenIDConnectPresetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// ClusterShootPresets returns a ClusterShootPresetInformer.
+func (v *version) ClusterShootPresets() ClusterShootPresetInformer {
+	return &clusterShootPresetInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // OpenIDConnectPresets returns a OpenIDConnectPresetInformer.
 func (v *version) OpenIDConnectPresets() OpenIDConnectPresetInformer {
 	return &openIDConnectPresetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ShootPolicies returns a ShootPolicyInformer.
+func (v *version) ShootPolicies() ShootPolicyInformer {
+	return &shootPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// ShootPresets returns a ShootPresetInformer.
+func (v *version) ShootPresets() ShootPresetInformer {
+	return &shootPresetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -6,6 +6,10 @@ package v1alpha1
 // ClusterOpenIDConnectPresetLister.
 type ClusterOpenIDConnectPresetListerExpansion interface{}
 
+// ClusterShootPresetListerExpansion allows custom methods to be added to
+// ClusterShootPresetLister.
+type ClusterShootPresetListerExpansion interface{}
+
 // OpenIDConnectPresetListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetLister.
 type OpenIDConnectPresetListerExpansion interface{}
@@ -13,3 +17,15 @@ type OpenIDConnectPresetListerExpansion interface{}
 // OpenIDConnectPresetNamespaceListerExpansion allows custom methods to be added to
 // OpenIDConnectPresetNamespaceLister.
 type OpenIDConnectPresetNamespaceListerExpansion interface{}
+
+// ShootPolicyListerExpansion allows custom methods to be added to
+// ShootPolicyLister.
+type ShootPolicyListerExpansion interface{}
+
+// ShootPresetListerExpansion allows custom methods to be added to
+// ShootPresetLister.
+type ShootPresetListerExpansion interface{}
+
+// ShootPresetNamespaceListerExpansion allows custom methods to be added to
+// ShootPresetNamespaceLister.
+type ShootPresetNamespaceListerExpansion interface{}
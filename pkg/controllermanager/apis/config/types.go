@@ -48,6 +48,8 @@ type ControllerManagerConfiguration struct {
 	Server ServerConfiguration
 	// ShootBackup contains configuration settings for the etcd backups.
 	ShootBackup *ShootBackup
+	// Logging contains configuration settings for the logging of the Seed clusters and the Shoot control planes.
+	Logging *LoggingConfiguration
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/features/gardener_features.go".
@@ -214,6 +216,9 @@ type ShootCareControllerConfiguration struct {
 	SyncPeriod metav1.Duration
 	// ConditionThresholds defines the condition threshold per condition type.
 	ConditionThresholds []ConditionThreshold
+	// StaleExtensionHealthCheckThreshold is the duration after which a health condition reported by an extension
+	// is considered outdated if it was not updated in the meantime.
+	StaleExtensionHealthCheckThreshold *metav1.Duration
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -230,6 +235,27 @@ type ShootMaintenanceControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int
+	// Tasks is a map from maintenance task names to a boolean which indicates whether the
+	// task is enabled. Tasks which are not listed here fall back to their default.
+	Tasks map[string]bool
+	// BlackoutWindows is a list of periods of time in which no automatic maintenance operations are
+	// performed for any Shoot of the Garden cluster.
+	BlackoutWindows []MaintenanceBlackoutWindow
+}
+
+// MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.
+type MaintenanceBlackoutWindow struct {
+	// Name is the unique name of the blackout window.
+	Name string
+	// Begin is the point in time when the blackout window starts.
+	Begin metav1.Time
+	// End is the point in time when the blackout window ends.
+	End metav1.Time
+	// Reason is a human-readable explanation why automatic maintenance operations are blocked.
+	Reason *string
+	// ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector
+	// matches all Shoots.
+	ShootSelector *metav1.LabelSelector
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -326,6 +352,25 @@ type ShootBackup struct {
 	Schedule string
 }
 
+// LoggingBackend is a string alias for the logging backends.
+type LoggingBackend string
+
+const (
+	// LoggingBackendElasticsearch is the logging backend deploying an Elasticsearch, Kibana and Curator stack into
+	// each Shoot namespace of the Seed and a central Fluentd to which all logs are forwarded.
+	LoggingBackendElasticsearch LoggingBackend = "elasticsearch"
+	// LoggingBackendExternal is the logging backend shipping all logs of a Seed directly to the external endpoint
+	// configured in the Seed's specification. No logging components are deployed into the Shoot namespaces.
+	LoggingBackendExternal LoggingBackend = "external"
+)
+
+// LoggingConfiguration contains configuration settings for the logging of the Seed clusters and the Shoot control
+// planes. It only takes effect if the Logging feature gate is enabled.
+type LoggingConfiguration struct {
+	// Backend is the logging backend used for all Seeds.
+	Backend LoggingBackend
+}
+
 const (
 	// ControllerManagerDefaultLockObjectNamespace is the default lock namespace for leader election.
 	ControllerManagerDefaultLockObjectNamespace = "garden"
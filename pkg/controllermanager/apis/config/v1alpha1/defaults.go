@@ -111,6 +111,10 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		}
 	}
 
+	if obj.Controllers.ShootCare.StaleExtensionHealthCheckThreshold == nil {
+		obj.Controllers.ShootCare.StaleExtensionHealthCheckThreshold = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+
 	if obj.Controllers.Shoot.RespectSyncPeriodOverwrite == nil {
 		falseVar := false
 		obj.Controllers.Shoot.RespectSyncPeriodOverwrite = &falseVar
@@ -158,6 +162,13 @@ func SetDefaults_ControllerManagerConfiguration(obj *ControllerManagerConfigurat
 		}
 	}
 
+	if obj.Logging == nil {
+		obj.Logging = &LoggingConfiguration{}
+	}
+	if len(obj.Logging.Backend) == 0 {
+		obj.Logging.Backend = LoggingBackendElasticsearch
+	}
+
 	if obj.Discovery.TTL == nil {
 		obj.Discovery.TTL = &metav1.Duration{Duration: DefaultDiscoveryTTL}
 	}
@@ -51,6 +51,9 @@ type ControllerManagerConfiguration struct {
 	// ShootBackup contains configuration settings for the etcd backups.
 	// +optional
 	ShootBackup *ShootBackup `json:"shootBackup,omitempty"`
+	// Logging contains configuration settings for the logging of the Seed clusters and the Shoot control planes.
+	// +optional
+	Logging *LoggingConfiguration `json:"logging,omitempty"`
 	// FeatureGates is a map of feature names to bools that enable or disable alpha/experimental
 	// features. This field modifies piecemeal the built-in default values from
 	// "github.com/gardener/gardener/pkg/features/gardener_features.go".
@@ -216,6 +219,10 @@ type ShootCareControllerConfiguration struct {
 	// ConditionThresholds defines the condition threshold per condition type.
 	// +optional
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
+	// StaleExtensionHealthCheckThreshold is the duration after which a health condition reported by an extension
+	// is considered outdated if it was not updated in the meantime.
+	// +optional
+	StaleExtensionHealthCheckThreshold *metav1.Duration `json:"staleExtensionHealthCheckThreshold,omitempty"`
 }
 
 // ConditionThreshold defines the duration how long a flappy condition stays in progressing state.
@@ -232,6 +239,31 @@ type ShootMaintenanceControllerConfiguration struct {
 	// ConcurrentSyncs is the number of workers used for the controller to work on
 	// events.
 	ConcurrentSyncs int `json:"concurrentSyncs"`
+	// Tasks is a map from maintenance task names to a boolean which indicates whether the
+	// task is enabled. Tasks which are not listed here fall back to their default.
+	// +optional
+	Tasks map[string]bool `json:"tasks,omitempty"`
+	// BlackoutWindows is a list of periods of time in which no automatic maintenance operations are
+	// performed for any Shoot of the Garden cluster.
+	// +optional
+	BlackoutWindows []MaintenanceBlackoutWindow `json:"blackoutWindows,omitempty"`
+}
+
+// MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.
+type MaintenanceBlackoutWindow struct {
+	// Name is the unique name of the blackout window.
+	Name string `json:"name"`
+	// Begin is the point in time when the blackout window starts.
+	Begin metav1.Time `json:"begin"`
+	// End is the point in time when the blackout window ends.
+	End metav1.Time `json:"end"`
+	// Reason is a human-readable explanation why automatic maintenance operations are blocked.
+	// +optional
+	Reason *string `json:"reason,omitempty"`
+	// ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector
+	// matches all Shoots.
+	// +optional
+	ShootSelector *metav1.LabelSelector `json:"shootSelector,omitempty"`
 }
 
 // ShootQuotaControllerConfiguration defines the configuration of the
@@ -351,6 +383,27 @@ type ShootBackup struct {
 	Schedule string `json:"schedule"`
 }
 
+// LoggingBackend is a string alias for the logging backends.
+type LoggingBackend string
+
+const (
+	// LoggingBackendElasticsearch is the logging backend deploying an Elasticsearch, Kibana and Curator stack into
+	// each Shoot namespace of the Seed and a central Fluentd to which all logs are forwarded.
+	LoggingBackendElasticsearch LoggingBackend = "elasticsearch"
+	// LoggingBackendExternal is the logging backend shipping all logs of a Seed directly to the external endpoint
+	// configured in the Seed's specification. No logging components are deployed into the Shoot namespaces.
+	LoggingBackendExternal LoggingBackend = "external"
+)
+
+// LoggingConfiguration contains configuration settings for the logging of the Seed clusters and the Shoot control
+// planes. It only takes effect if the Logging feature gate is enabled.
+type LoggingConfiguration struct {
+	// Backend is the logging backend used for all Seeds. Must be one of [elasticsearch,external].
+	// Default: elasticsearch
+	// +optional
+	Backend LoggingBackend `json:"backend,omitempty"`
+}
+
 const (
 	// ControllerManagerDefaultLockObjectNamespace is the default lock namespace for leader election.
 	ControllerManagerDefaultLockObjectNamespace = "garden"
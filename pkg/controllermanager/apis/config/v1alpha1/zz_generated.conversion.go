@@ -158,6 +158,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoggingConfiguration)(nil), (*config.LoggingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoggingConfiguration_To_config_LoggingConfiguration(a.(*LoggingConfiguration), b.(*config.LoggingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LoggingConfiguration)(nil), (*LoggingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LoggingConfiguration_To_v1alpha1_LoggingConfiguration(a.(*config.LoggingConfiguration), b.(*LoggingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceBlackoutWindow)(nil), (*config.MaintenanceBlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MaintenanceBlackoutWindow_To_config_MaintenanceBlackoutWindow(a.(*MaintenanceBlackoutWindow), b.(*config.MaintenanceBlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.MaintenanceBlackoutWindow)(nil), (*MaintenanceBlackoutWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(a.(*config.MaintenanceBlackoutWindow), b.(*MaintenanceBlackoutWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PlantConfiguration)(nil), (*config.PlantConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PlantConfiguration_To_config_PlantConfiguration(a.(*PlantConfiguration), b.(*config.PlantConfiguration), scope)
 	}); err != nil {
@@ -456,6 +476,7 @@ func autoConvert_v1alpha1_ControllerManagerConfiguration_To_config_ControllerMan
 		return err
 	}
 	out.ShootBackup = (*config.ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.Logging = (*config.LoggingConfiguration)(unsafe.Pointer(in.Logging))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -490,6 +511,7 @@ func autoConvert_config_ControllerManagerConfiguration_To_v1alpha1_ControllerMan
 		return err
 	}
 	out.ShootBackup = (*ShootBackup)(unsafe.Pointer(in.ShootBackup))
+	out.Logging = (*LoggingConfiguration)(unsafe.Pointer(in.Logging))
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
 }
@@ -675,6 +697,54 @@ func Convert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfig
 	return autoConvert_config_LeaderElectionConfiguration_To_v1alpha1_LeaderElectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoggingConfiguration_To_config_LoggingConfiguration(in *LoggingConfiguration, out *config.LoggingConfiguration, s conversion.Scope) error {
+	out.Backend = config.LoggingBackend(in.Backend)
+	return nil
+}
+
+// Convert_v1alpha1_LoggingConfiguration_To_config_LoggingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_LoggingConfiguration_To_config_LoggingConfiguration(in *LoggingConfiguration, out *config.LoggingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoggingConfiguration_To_config_LoggingConfiguration(in, out, s)
+}
+
+func autoConvert_config_LoggingConfiguration_To_v1alpha1_LoggingConfiguration(in *config.LoggingConfiguration, out *LoggingConfiguration, s conversion.Scope) error {
+	out.Backend = LoggingBackend(in.Backend)
+	return nil
+}
+
+// Convert_config_LoggingConfiguration_To_v1alpha1_LoggingConfiguration is an autogenerated conversion function.
+func Convert_config_LoggingConfiguration_To_v1alpha1_LoggingConfiguration(in *config.LoggingConfiguration, out *LoggingConfiguration, s conversion.Scope) error {
+	return autoConvert_config_LoggingConfiguration_To_v1alpha1_LoggingConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_MaintenanceBlackoutWindow_To_config_MaintenanceBlackoutWindow(in *MaintenanceBlackoutWindow, out *config.MaintenanceBlackoutWindow, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_v1alpha1_MaintenanceBlackoutWindow_To_config_MaintenanceBlackoutWindow is an autogenerated conversion function.
+func Convert_v1alpha1_MaintenanceBlackoutWindow_To_config_MaintenanceBlackoutWindow(in *MaintenanceBlackoutWindow, out *config.MaintenanceBlackoutWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MaintenanceBlackoutWindow_To_config_MaintenanceBlackoutWindow(in, out, s)
+}
+
+func autoConvert_config_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(in *config.MaintenanceBlackoutWindow, out *MaintenanceBlackoutWindow, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	out.ShootSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ShootSelector))
+	return nil
+}
+
+// Convert_config_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow is an autogenerated conversion function.
+func Convert_config_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(in *config.MaintenanceBlackoutWindow, out *MaintenanceBlackoutWindow, s conversion.Scope) error {
+	return autoConvert_config_MaintenanceBlackoutWindow_To_v1alpha1_MaintenanceBlackoutWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_PlantConfiguration_To_config_PlantConfiguration(in *PlantConfiguration, out *config.PlantConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
@@ -857,6 +927,7 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.StaleExtensionHealthCheckThreshold = (*v1.Duration)(unsafe.Pointer(in.StaleExtensionHealthCheckThreshold))
 	return nil
 }
 
@@ -869,6 +940,7 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.SyncPeriod = in.SyncPeriod
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
+	out.StaleExtensionHealthCheckThreshold = (*v1.Duration)(unsafe.Pointer(in.StaleExtensionHealthCheckThreshold))
 	return nil
 }
 
@@ -929,6 +1001,8 @@ func Convert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHib
 
 func autoConvert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(in *ShootMaintenanceControllerConfiguration, out *config.ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.Tasks = *(*map[string]bool)(unsafe.Pointer(&in.Tasks))
+	out.BlackoutWindows = *(*[]config.MaintenanceBlackoutWindow)(unsafe.Pointer(&in.BlackoutWindows))
 	return nil
 }
 
@@ -939,6 +1013,8 @@ func Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMai
 
 func autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(in *config.ShootMaintenanceControllerConfiguration, out *ShootMaintenanceControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.Tasks = *(*map[string]bool)(unsafe.Pointer(&in.Tasks))
+	out.BlackoutWindows = *(*[]MaintenanceBlackoutWindow)(unsafe.Pointer(&in.BlackoutWindows))
 	return nil
 }
 
@@ -156,6 +156,11 @@ func (in *ControllerManagerConfiguration) DeepCopyInto(out *ControllerManagerCon
 		*out = new(ShootBackup)
 		**out = **in
 	}
+	if in.Logging != nil {
+		in, out := &in.Logging, &out.Logging
+		*out = new(LoggingConfiguration)
+		**out = **in
+	}
 	if in.FeatureGates != nil {
 		in, out := &in.FeatureGates, &out.FeatureGates
 		*out = make(map[string]bool, len(*in))
@@ -240,7 +245,7 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 	}
 	in.Shoot.DeepCopyInto(&out.Shoot)
 	in.ShootCare.DeepCopyInto(&out.ShootCare)
-	out.ShootMaintenance = in.ShootMaintenance
+	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	out.ShootQuota = in.ShootQuota
 	out.ShootHibernation = in.ShootHibernation
 	return
@@ -338,6 +343,50 @@ func (in *LeaderElectionConfiguration) DeepCopy() *LeaderElectionConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoggingConfiguration) DeepCopyInto(out *LoggingConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoggingConfiguration.
+func (in *LoggingConfiguration) DeepCopy() *LoggingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LoggingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceBlackoutWindow) DeepCopyInto(out *MaintenanceBlackoutWindow) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	if in.Reason != nil {
+		in, out := &in.Reason, &out.Reason
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShootSelector != nil {
+		in, out := &in.ShootSelector, &out.ShootSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceBlackoutWindow.
+func (in *MaintenanceBlackoutWindow) DeepCopy() *MaintenanceBlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceBlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlantConfiguration) DeepCopyInto(out *PlantConfiguration) {
 	*out = *in
@@ -484,6 +533,11 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = make([]ConditionThreshold, len(*in))
 		copy(*out, *in)
 	}
+	if in.StaleExtensionHealthCheckThreshold != nil {
+		in, out := &in.StaleExtensionHealthCheckThreshold, &out.StaleExtensionHealthCheckThreshold
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -549,6 +603,20 @@ func (in *ShootHibernationControllerConfiguration) DeepCopy() *ShootHibernationC
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMaintenanceControllerConfiguration) DeepCopyInto(out *ShootMaintenanceControllerConfiguration) {
 	*out = *in
+	if in.Tasks != nil {
+		in, out := &in.Tasks, &out.Tasks
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]MaintenanceBlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// LoggingBackends are the logging backends supported by the Gardener controller manager.
+var LoggingBackends = []config.LoggingBackend{
+	config.LoggingBackendElasticsearch,
+	config.LoggingBackendExternal,
+}
+
+// ValidateConfiguration validates the configuration.
+func ValidateConfiguration(cfg *config.ControllerManagerConfiguration) error {
+	if err := validateLogging(cfg); err != nil {
+		return err
+	}
+	allErrs := ValidateMaintenanceBlackoutWindows(cfg.Controllers.ShootMaintenance.BlackoutWindows, field.NewPath("controllers", "shootMaintenance", "blackoutWindows"))
+	return allErrs.ToAggregate()
+}
+
+func validateLogging(cfg *config.ControllerManagerConfiguration) error {
+	if cfg.Logging == nil || len(cfg.Logging.Backend) == 0 {
+		return nil
+	}
+	for _, backend := range LoggingBackends {
+		if backend == cfg.Logging.Backend {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown logging backend configured in gardener controller manager. Backend: '%s' does not exist. Valid backends are: %v", cfg.Logging.Backend, LoggingBackends)
+}
+
+// ValidateMaintenanceBlackoutWindows validates the maintenance blackout windows of the Garden cluster.
+func ValidateMaintenanceBlackoutWindows(windows []config.MaintenanceBlackoutWindow, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.NewString()
+	)
+
+	for i, window := range windows {
+		idxPath := fldPath.Index(i)
+
+		if len(window.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(window.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), window.Name))
+		}
+		names.Insert(window.Name)
+
+		switch {
+		case window.Begin.IsZero():
+			allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must provide the begin of the blackout window"))
+		case window.End.IsZero():
+			allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must provide the end of the blackout window"))
+		case !window.End.After(window.Begin.Time):
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), window.End, "end must be after begin"))
+		}
+
+		if window.Reason != nil && len(*window.Reason) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("reason"), "must provide a reason when key is present"))
+		}
+		if window.ShootSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(window.ShootSelector, idxPath.Child("shootSelector"))...)
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+package validation_test
+
+import (
+	"time"
+
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("gardener-controller-manager", func() {
+	Describe("#ValidateMaintenanceBlackoutWindows", func() {
+		var (
+			fldPath = field.NewPath("blackoutWindows")
+			begin   = metav1.NewTime(time.Date(2019, time.December, 20, 0, 0, 0, 0, time.UTC))
+			end     = metav1.NewTime(begin.Add(14 * 24 * time.Hour))
+		)
+
+		It("should allow valid blackout windows", func() {
+			reason := "year-end freeze"
+
+			errorList := ValidateMaintenanceBlackoutWindows([]config.MaintenanceBlackoutWindow{
+				{Name: "year-end", Begin: begin, End: end, Reason: &reason},
+				{Name: "release", Begin: begin, End: end, ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}},
+			}, fldPath)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid blackout windows without begin or end", func() {
+			errorList := ValidateMaintenanceBlackoutWindows([]config.MaintenanceBlackoutWindow{
+				{Name: "no-begin", End: end},
+				{Name: "no-end", Begin: begin},
+			}, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("blackoutWindows[0].begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("blackoutWindows[1].end"),
+				})),
+			))
+		})
+
+		It("should forbid invalid blackout windows", func() {
+			emptyReason := ""
+
+			errorList := ValidateMaintenanceBlackoutWindows([]config.MaintenanceBlackoutWindow{
+				{Name: "year-end", Begin: begin, End: end},
+				{
+					Name:   "year-end",
+					Begin:  end,
+					End:    begin,
+					Reason: &emptyReason,
+					ShootSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "bar"}},
+					},
+				},
+				{Begin: begin, End: begin},
+			}, fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("blackoutWindows[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("blackoutWindows[1].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("blackoutWindows[1].reason"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("blackoutWindows[1].shootSelector.matchExpressions[0].operator"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("blackoutWindows[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("blackoutWindows[2].end"),
+				})),
+			))
+		})
+	})
+
+	Describe("#ValidateConfiguration", func() {
+		var configuration *config.ControllerManagerConfiguration
+
+		BeforeEach(func() {
+			configuration = &config.ControllerManagerConfiguration{}
+		})
+
+		It("should pass because no logging backend is configured", func() {
+			Expect(ValidateConfiguration(configuration)).To(Succeed())
+		})
+
+		It("should pass because the 'external' logging backend is valid", func() {
+			configuration.Logging = &config.LoggingConfiguration{Backend: config.LoggingBackendExternal}
+			Expect(ValidateConfiguration(configuration)).To(Succeed())
+		})
+
+		It("should fail because the logging backend is unknown", func() {
+			configuration.Logging = &config.LoggingConfiguration{Backend: "loki"}
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+
+		It("should fail because of invalid blackout windows", func() {
+			configuration.Controllers.ShootMaintenance.BlackoutWindows = []config.MaintenanceBlackoutWindow{{Name: "foo"}}
+			Expect(ValidateConfiguration(configuration)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,340 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+const (
+	// maxChartSize is the maximum size of a chart tarball which is fetched from a remote location.
+	maxChartSize = 20 << 20
+	// maxCachedCharts is the maximum number of chart tarballs which are kept in the cache.
+	maxCachedCharts = 64
+
+	schemeOCI   = "oci"
+	schemeHTTPS = "https"
+
+	mediaTypeOCIManifest     = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeHelmChartLayer  = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+	digestAlgorithmSHA256    = "sha256"
+	defaultOCIReferenceTag   = "latest"
+	ociDistributionAPIPrefix = "/v2/"
+)
+
+// ChartFetcher fetches Helm chart tarballs which are referenced by a HelmChartRef.
+type ChartFetcher interface {
+	// Fetch returns the chart tarball for the given reference. The returned tarball has been verified against the
+	// digest of the reference.
+	Fetch(ctx context.Context, ref *HelmChartRef) ([]byte, error)
+}
+
+// NewChartFetcher returns a ChartFetcher which uses the given HTTP client to fetch charts from OCI registries and
+// HTTP servers. Fetched charts are cached by their digest, hence, a chart referenced by multiple installations is
+// only downloaded once.
+func NewChartFetcher(client *http.Client) ChartFetcher {
+	return &chartFetcher{
+		client: client,
+		cache:  make(map[string][]byte),
+	}
+}
+
+type chartFetcher struct {
+	client *http.Client
+
+	lock  sync.RWMutex
+	cache map[string][]byte
+}
+
+// Fetch implements ChartFetcher.
+func (f *chartFetcher) Fetch(ctx context.Context, ref *HelmChartRef) ([]byte, error) {
+	if ref == nil {
+		return nil, fmt.Errorf("no chart reference given")
+	}
+	if err := validateDigest(ref.Digest); err != nil {
+		return nil, err
+	}
+
+	f.lock.RLock()
+	chart, ok := f.cache[ref.Digest]
+	f.lock.RUnlock()
+	if ok {
+		return chart, nil
+	}
+
+	u, err := url.Parse(ref.URL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse chart URL %q: %v", ref.URL, err)
+	}
+
+	switch u.Scheme {
+	case schemeOCI:
+		chart, err = f.fetchFromOCIRegistry(ctx, u, ref.Digest)
+	case schemeHTTPS:
+		chart, err = f.get(ctx, u.String(), "")
+	default:
+		return nil, fmt.Errorf("unsupported scheme %q of chart URL %q", u.Scheme, ref.URL)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyDigest(chart, ref.Digest); err != nil {
+		return nil, fmt.Errorf("could not verify chart fetched from %q: %v", ref.URL, err)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if len(f.cache) >= maxCachedCharts {
+		f.cache = make(map[string][]byte)
+	}
+	f.cache[ref.Digest] = chart
+
+	return chart, nil
+}
+
+type ociDescriptor struct {
+	MediaType string `json:"mediaType"`
+	Digest    string `json:"digest"`
+}
+
+type ociManifest struct {
+	Layers []ociDescriptor `json:"layers"`
+}
+
+// fetchFromOCIRegistry fetches the chart layer of the given OCI reference via the OCI distribution API. Only
+// registries which allow anonymous pulls are supported. If the registry requires a bearer token (e.g., Docker Hub or
+// GitHub Container Registry) then an anonymous token is requested from the token service announced in the
+// `WWW-Authenticate` header of the registry's response.
+func (f *chartFetcher) fetchFromOCIRegistry(ctx context.Context, u *url.URL, digest string) ([]byte, error) {
+	repository, reference := parseOCIReference(strings.TrimPrefix(u.Path, "/"))
+	if repository == "" {
+		return nil, fmt.Errorf("chart URL %q does not specify a repository", u.String())
+	}
+
+	var (
+		base  = url.URL{Scheme: schemeHTTPS, Host: u.Host, Path: ociDistributionAPIPrefix + repository}
+		token string
+	)
+
+	get := func(location, accept string) ([]byte, error) {
+		data, err := f.getWithToken(ctx, location, accept, token)
+		challenge, ok := err.(*unauthorizedError)
+		if !ok || token != "" {
+			return data, err
+		}
+
+		if token, err = f.fetchAnonymousToken(ctx, challenge); err != nil {
+			return nil, err
+		}
+		return f.getWithToken(ctx, location, accept, token)
+	}
+
+	data, err := get(base.String()+"/manifests/"+reference, mediaTypeOCIManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &ociManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("could not decode OCI manifest of %q: %v", u.String(), err)
+	}
+
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != mediaTypeHelmChartLayer {
+			continue
+		}
+		if layer.Digest != digest {
+			return nil, fmt.Errorf("digest %q of chart layer of %q does not match expected digest %q", layer.Digest, u.String(), digest)
+		}
+		return get(base.String()+"/blobs/"+layer.Digest, "")
+	}
+
+	return nil, fmt.Errorf("OCI manifest of %q does not contain a layer of media type %q", u.String(), mediaTypeHelmChartLayer)
+}
+
+// unauthorizedError is returned if a registry responds with 401 Unauthorized and announces a bearer token service.
+type unauthorizedError struct {
+	location   string
+	parameters map[string]string
+}
+
+func (e *unauthorizedError) Error() string {
+	return fmt.Sprintf("unexpected status code %d when fetching %q", http.StatusUnauthorized, e.location)
+}
+
+// fetchAnonymousToken requests an anonymous bearer token from the token service announced in the given challenge.
+func (f *chartFetcher) fetchAnonymousToken(ctx context.Context, challenge *unauthorizedError) (string, error) {
+	realm, err := url.Parse(challenge.parameters["realm"])
+	if err != nil || realm.Host == "" || realm.Scheme != schemeHTTPS {
+		return "", fmt.Errorf("invalid token realm %q announced when fetching %q", challenge.parameters["realm"], challenge.location)
+	}
+
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if value, ok := challenge.parameters[key]; ok {
+			query.Set(key, value)
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	data, err := f.get(ctx, realm.String(), "")
+	if err != nil {
+		return "", fmt.Errorf("could not fetch anonymous token for %q: %v", challenge.location, err)
+	}
+
+	response := &struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.Unmarshal(data, response); err != nil {
+		return "", fmt.Errorf("could not decode anonymous token for %q: %v", challenge.location, err)
+	}
+
+	if response.Token != "" {
+		return response.Token, nil
+	}
+	if response.AccessToken != "" {
+		return response.AccessToken, nil
+	}
+	return "", fmt.Errorf("token service did not return an anonymous token for %q", challenge.location)
+}
+
+func (f *chartFetcher) get(ctx context.Context, location, accept string) ([]byte, error) {
+	return f.getWithToken(ctx, location, accept, "")
+}
+
+func (f *chartFetcher) getWithToken(ctx context.Context, location, accept, token string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, location, nil)
+	if err != nil {
+		return nil, err
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := f.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		if parameters, ok := parseBearerChallenge(resp.Header.Get("WWW-Authenticate")); ok {
+			return nil, &unauthorizedError{location: location, parameters: parameters}
+		}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d when fetching %q", resp.StatusCode, location)
+	}
+
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxChartSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxChartSize {
+		return nil, fmt.Errorf("content fetched from %q exceeds the maximum size of %d bytes", location, maxChartSize)
+	}
+	return data, nil
+}
+
+// parseBearerChallenge parses the parameters of the given `WWW-Authenticate` header value if it announces the bearer
+// authentication scheme, e.g. `Bearer realm="https://auth.example.com/token",service="registry.example.com"`.
+func parseBearerChallenge(header string) (map[string]string, bool) {
+	const scheme = "bearer "
+	if len(header) < len(scheme) || !strings.EqualFold(header[:len(scheme)], scheme) {
+		return nil, false
+	}
+
+	var (
+		parameters = make(map[string]string)
+		rest       = strings.TrimSpace(header[len(scheme):])
+	)
+
+	for rest != "" {
+		i := strings.Index(rest, "=")
+		if i <= 0 {
+			return nil, false
+		}
+		key := strings.ToLower(strings.TrimSpace(rest[:i]))
+		rest = strings.TrimSpace(rest[i+1:])
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			// Quoted values may contain commas, e.g. `scope="repository:foo:pull,push"`.
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				return nil, false
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else if end := strings.Index(rest, ","); end >= 0 {
+			value, rest = rest[:end], rest[end:]
+		} else {
+			value, rest = rest, ""
+		}
+
+		parameters[key] = strings.TrimSpace(value)
+		rest = strings.TrimPrefix(strings.TrimSpace(rest), ",")
+		rest = strings.TrimSpace(rest)
+	}
+
+	if parameters["realm"] == "" {
+		return nil, false
+	}
+	return parameters, true
+}
+
+// parseOCIReference splits the given OCI reference into the repository and the tag (or digest).
+func parseOCIReference(ref string) (string, string) {
+	if i := strings.LastIndex(ref, "@"); i >= 0 {
+		return ref[:i], ref[i+1:]
+	}
+	if i := strings.LastIndex(ref, ":"); i > strings.LastIndex(ref, "/") {
+		return ref[:i], ref[i+1:]
+	}
+	return ref, defaultOCIReferenceTag
+}
+
+func validateDigest(digest string) error {
+	parts := strings.SplitN(digest, ":", 2)
+	if len(parts) != 2 || parts[0] != digestAlgorithmSHA256 {
+		return fmt.Errorf("digest %q must be of the form %s:<hex>", digest, digestAlgorithmSHA256)
+	}
+	if _, err := hex.DecodeString(parts[1]); err != nil || len(parts[1]) != 2*sha256.Size {
+		return fmt.Errorf("digest %q does not contain a valid %s checksum", digest, digestAlgorithmSHA256)
+	}
+	return nil
+}
+
+func verifyDigest(data []byte, digest string) error {
+	checksum := sha256.Sum256(data)
+	if actual := digestAlgorithmSHA256 + ":" + hex.EncodeToString(checksum[:]); actual != digest {
+		return fmt.Errorf("digest %q does not match expected digest %q", actual, digest)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	registryService = "registry.example.com"
+	registryScope   = "repository:charts/extension:pull,push"
+)
+
+// registry is a local stand-in for an OCI registry and an HTTP server serving chart tarballs. If a token realm is set
+// then the OCI distribution API requires an anonymous bearer token issued by the token endpoint.
+type registry struct {
+	chart    []byte
+	digest   string
+	requests int
+
+	realm string
+	token string
+}
+
+func (r *registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.requests++
+
+	if req.URL.Path == "/token" {
+		if req.URL.Query().Get("service") != registryService || req.URL.Query().Get("scope") != registryScope {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		fmt.Fprintf(w, `{"token":%q}`, r.token)
+		return
+	}
+
+	if r.realm != "" && strings.HasPrefix(req.URL.Path, "/v2/") && (r.token == "" || req.Header.Get("Authorization") != "Bearer "+r.token) {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm=%q,service=%q,scope=%q`, r.realm, registryService, registryScope))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	switch req.URL.Path {
+	case "/v2/charts/extension/manifests/v1.0.0":
+		if req.Header.Get("Accept") != "application/vnd.oci.image.manifest.v1+json" {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+		fmt.Fprintf(w, `{"schemaVersion":2,"config":{"mediaType":"application/vnd.cncf.helm.config.v1+json","digest":"sha256:0"},"layers":[{"mediaType":"application/vnd.cncf.helm.chart.content.v1.tar+gzip","digest":%q}]}`, r.digest)
+	case "/v2/charts/extension/blobs/" + r.digest, "/charts/extension-v1.0.0.tgz":
+		w.Write(r.chart)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+var _ = Describe("ChartFetcher", func() {
+	var (
+		ctx = context.TODO()
+
+		reg     *registry
+		server  *httptest.Server
+		host    string
+		fetcher ChartFetcher
+	)
+
+	BeforeEach(func() {
+		chart := []byte("chart-tarball")
+		checksum := sha256.Sum256(chart)
+
+		reg = &registry{chart: chart, digest: "sha256:" + hex.EncodeToString(checksum[:])}
+		server = httptest.NewTLSServer(reg)
+		host = strings.TrimPrefix(server.URL, "https://")
+		fetcher = NewChartFetcher(server.Client())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should fetch the chart from an OCI registry", func() {
+		chart, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "oci://" + host + "/charts/extension:v1.0.0", Digest: reg.digest})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart).To(Equal(reg.chart))
+	})
+
+	It("should fetch the chart from an OCI registry requiring an anonymous bearer token", func() {
+		reg.realm = server.URL + "/token"
+		reg.token = "anonymous-token"
+
+		chart, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "oci://" + host + "/charts/extension:v1.0.0", Digest: reg.digest})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart).To(Equal(reg.chart))
+		Expect(reg.requests).To(Equal(4))
+	})
+
+	It("should fail if the token service does not issue an anonymous bearer token", func() {
+		reg.realm = server.URL + "/token"
+
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "oci://" + host + "/charts/extension:v1.0.0", Digest: reg.digest})
+		Expect(err).To(MatchError(ContainSubstring("did not return an anonymous token")))
+	})
+
+	It("should fetch the chart from an HTTP server", func() {
+		chart, err := fetcher.Fetch(ctx, &HelmChartRef{URL: server.URL + "/charts/extension-v1.0.0.tgz", Digest: reg.digest})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart).To(Equal(reg.chart))
+	})
+
+	It("should serve the chart from the cache when it was fetched before", func() {
+		ref := &HelmChartRef{URL: server.URL + "/charts/extension-v1.0.0.tgz", Digest: reg.digest}
+
+		_, err := fetcher.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		chart, err := fetcher.Fetch(ctx, ref)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(chart).To(Equal(reg.chart))
+		Expect(reg.requests).To(Equal(1))
+	})
+
+	It("should fail if the chart does not match the digest", func() {
+		reg.chart = []byte("tampered-chart-tarball")
+
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: server.URL + "/charts/extension-v1.0.0.tgz", Digest: reg.digest})
+		Expect(err).To(MatchError(ContainSubstring("does not match expected digest")))
+	})
+
+	It("should fail if the chart layer of the OCI manifest does not match the digest", func() {
+		digest := "sha256:" + strings.Repeat("0", 64)
+
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "oci://" + host + "/charts/extension:v1.0.0", Digest: digest})
+		Expect(err).To(MatchError(ContainSubstring("does not match expected digest")))
+	})
+
+	It("should fail if the chart cannot be found", func() {
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "oci://" + host + "/charts/unknown:v1.0.0", Digest: reg.digest})
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+	})
+
+	It("should fail if the digest is invalid", func() {
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: server.URL + "/charts/extension-v1.0.0.tgz", Digest: "md5:foo"})
+		Expect(err).To(HaveOccurred())
+		Expect(reg.requests).To(BeZero())
+	})
+
+	It("should fail if the scheme is not supported", func() {
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "ftp://" + host + "/charts/extension-v1.0.0.tgz", Digest: reg.digest})
+		Expect(err).To(MatchError(ContainSubstring("unsupported scheme")))
+	})
+
+	It("should fail if the chart URL does not use HTTPS", func() {
+		_, err := fetcher.Fetch(ctx, &HelmChartRef{URL: "http://" + host + "/charts/extension-v1.0.0.tgz", Digest: reg.digest})
+		Expect(err).To(MatchError(ContainSubstring("unsupported scheme")))
+		Expect(reg.requests).To(BeZero())
+	})
+})
@@ -19,6 +19,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -54,7 +56,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const installationTypeHelm = "helm"
+const (
+	installationTypeHelm     = "helm"
+	installationTypeManifest = "manifest"
+
+	chartFetchTimeout = time.Minute
+)
 
 func (c *Controller) controllerInstallationAdd(obj interface{}) {
 	key, err := cache.MetaNamespaceKeyFunc(obj)
@@ -72,7 +79,7 @@ func (c *Controller) controllerInstallationUpdate(oldObj, newObj interface{}) {
 		return
 	}
 
-	if new.DeletionTimestamp == nil && old.Spec.RegistrationRef.ResourceVersion == new.Spec.RegistrationRef.ResourceVersion && old.Spec.SeedRef.ResourceVersion == new.Spec.SeedRef.ResourceVersion {
+	if new.DeletionTimestamp == nil && old.Spec.RegistrationRef.ResourceVersion == new.Spec.RegistrationRef.ResourceVersion && old.Spec.SeedRef.ResourceVersion == new.Spec.SeedRef.ResourceVersion && equality.Semantic.DeepEqual(old.Spec.Version, new.Spec.Version) {
 		return
 	}
 
@@ -117,7 +124,8 @@ type ControlInterface interface {
 // implements the documented semantics for ControllerInstallations. You should use an instance returned from
 // NewDefaultControllerInstallationControl() for any scenario other than testing.
 func NewDefaultControllerInstallationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister, gardenNamespace *corev1.Namespace) ControlInterface {
-	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace}
+	chartFetcher := NewChartFetcher(&http.Client{Timeout: chartFetchTimeout})
+	return &defaultControllerInstallationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, gardenNamespace, chartFetcher}
 }
 
 type defaultControllerInstallationControl struct {
@@ -130,6 +138,7 @@ type defaultControllerInstallationControl struct {
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
 	gardenNamespace              *corev1.Namespace
+	chartFetcher                 ChartFetcher
 }
 
 func (c *defaultControllerInstallationControl) Reconcile(obj *gardencorev1alpha1.ControllerInstallation) error {
@@ -171,6 +180,12 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		conditionInstalled = newConditions[1]
 	)
 
+	// A new version has to be installed successfully before the installation is considered as installed again. This
+	// also resets the transition time of the condition which is used to compute the soak time of staged rollouts.
+	if !equality.Semantic.DeepEqual(controllerInstallation.Spec.Version, controllerInstallation.Status.DeployedVersion) && conditionInstalled.Status == gardencorev1alpha1.ConditionTrue {
+		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionUnknown, "VersionChanging", "A new version is about to be installed.")
+	}
+
 	defer func() {
 		if _, err := c.updateConditions(controllerInstallation, conditionValid, conditionInstalled); err != nil {
 			logger.Errorf("Failed to update the conditions : %+v", err)
@@ -187,6 +202,12 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
+	providerConfig, err := helper.GetControllerDeploymentProviderConfig(controllerRegistration.Spec.Deployment, controllerInstallation.Spec.Version)
+	if err != nil {
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "VersionNotFound", fmt.Sprintf("Referenced version cannot be found: %+v", err))
+		return err
+	}
+
 	seed, err := c.seedLister.Get(controllerInstallation.Spec.SeedRef.Name)
 	if err != nil {
 		return err
@@ -210,18 +231,6 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		}
 		return err
 	}
-	chartRenderer, err := chartrenderer.NewForConfig(k8sSeedClient.RESTConfig())
-	if err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionUnknown, "ChartRendererCreationFailed", fmt.Sprintf("ChartRenderer cannot be recreated for referenced Seed: %+v", err))
-		return err
-	}
-
-	var helmDeployment HelmDeployment
-	if err := json.Unmarshal(controllerRegistration.Spec.Deployment.ProviderConfig.Raw, &helmDeployment); err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("Chart Information cannot be unmarshalled: %+v", err))
-		return err
-	}
-
 	namespace := getNamespaceForControllerInstallation(controllerInstallation)
 	if err := kutil.CreateOrUpdate(ctx, k8sSeedClient.Client(), namespace, func() error {
 		kutil.SetMetaDataLabel(&namespace.ObjectMeta, v1alpha1constants.GardenRole, v1alpha1constants.GardenRoleExtension)
@@ -251,15 +260,14 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		},
 	}
 
-	release, err := chartRenderer.RenderArchive(helmDeployment.Chart, controllerRegistration.Name, namespace.Name, utils.MergeMaps(helmDeployment.Values, seedValues))
+	manifest, conditionValid, err := c.computeManifest(ctx, controllerRegistration, providerConfig, func() (chartrenderer.Interface, error) {
+		return chartrenderer.NewForConfig(k8sSeedClient.RESTConfig())
+	}, namespace.Name, seedValues, conditionValid)
 	if err != nil {
-		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("Chart rendering process failed: %+v", err))
 		return err
 	}
-	conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", "Chart could be rendered successfully.")
 
 	var (
-		manifest        = release.Manifest()
 		newResources    DeployedResources
 		newResourcesSet = sets.NewString()
 
@@ -317,15 +325,85 @@ func (c *defaultControllerInstallationControl) reconcile(controllerInstallation
 		return err
 	}
 
-	if err := k8sSeedClient.Applier().ApplyManifest(context.TODO(), kubernetes.NewManifestReader(release.Manifest()), kubernetes.DefaultApplierOptions); err != nil {
+	if err := k8sSeedClient.Applier().ApplyManifest(context.TODO(), kubernetes.NewManifestReader(manifest), kubernetes.DefaultApplierOptions); err != nil {
 		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Installation of new resources failed: %+v", err))
 		return err
 	}
 
+	controllerInstallation, err = kutil.TryUpdateControllerInstallationStatusWithEqualFunc(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, controllerInstallation.ObjectMeta,
+		func(controllerInstallation *gardencorev1alpha1.ControllerInstallation) (*gardencorev1alpha1.ControllerInstallation, error) {
+			controllerInstallation.Status.DeployedVersion = controllerInstallation.Spec.Version
+			return controllerInstallation, nil
+		}, func(cur, updated *gardencorev1alpha1.ControllerInstallation) bool {
+			return equality.Semantic.DeepEqual(cur.Status.DeployedVersion, updated.Status.DeployedVersion)
+		},
+	)
+	if err != nil {
+		conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionFalse, "InstallationFailed", fmt.Sprintf("Could not write deployed version: %+v", err))
+		return err
+	}
+
 	conditionInstalled = helper.UpdatedCondition(conditionInstalled, gardencorev1alpha1.ConditionTrue, "InstallationSuccessful", "Installation of new resources succeeded.")
 	return nil
 }
 
+// computeManifest returns the manifest of the deployment of the given ControllerRegistration which shall be applied to
+// the given namespace of the seed cluster, together with the updated Valid condition. Helm charts which are referenced
+// instead of embedded are fetched first, and are rendered with a chart renderer created by the given function.
+func (c *defaultControllerInstallationControl) computeManifest(ctx context.Context, controllerRegistration *gardencorev1alpha1.ControllerRegistration, providerConfig *gardencorev1alpha1.ProviderConfig, newChartRenderer func() (chartrenderer.Interface, error), namespace string, seedValues map[string]interface{}, conditionValid gardencorev1alpha1.Condition) ([]byte, gardencorev1alpha1.Condition, error) {
+	switch controllerRegistration.Spec.Deployment.Type {
+	case installationTypeHelm:
+		var helmDeployment HelmDeployment
+		if err := json.Unmarshal(providerConfig.Raw, &helmDeployment); err != nil {
+			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("Chart Information cannot be unmarshalled: %+v", err))
+			return nil, conditionValid, err
+		}
+
+		var (
+			chart = helmDeployment.Chart
+			err   error
+		)
+		if len(chart) == 0 {
+			if helmDeployment.ChartRef == nil {
+				err := fmt.Errorf("neither chart nor chartRef is specified")
+				conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartInformationInvalid", fmt.Sprintf("Chart Information is invalid: %+v", err))
+				return nil, conditionValid, err
+			}
+
+			chart, err = c.chartFetcher.Fetch(ctx, helmDeployment.ChartRef)
+			if err != nil {
+				conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartCannotBeFetched", fmt.Sprintf("Chart cannot be fetched: %+v", err))
+				return nil, conditionValid, err
+			}
+		}
+
+		chartRenderer, err := newChartRenderer()
+		if err != nil {
+			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionUnknown, "ChartRendererCreationFailed", fmt.Sprintf("ChartRenderer cannot be recreated for referenced Seed: %+v", err))
+			return nil, conditionValid, err
+		}
+
+		release, err := chartRenderer.RenderArchive(chart, controllerRegistration.Name, namespace, utils.MergeMaps(helmDeployment.Values, seedValues))
+		if err != nil {
+			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ChartCannotBeRendered", fmt.Sprintf("Chart rendering process failed: %+v", err))
+			return nil, conditionValid, err
+		}
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", "Chart could be rendered successfully.")
+		return release.Manifest(), conditionValid, nil
+
+	case installationTypeManifest:
+		var manifestDeployment ManifestDeployment
+		if err := json.Unmarshal(providerConfig.Raw, &manifestDeployment); err != nil {
+			conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionFalse, "ManifestInformationInvalid", fmt.Sprintf("Manifest Information cannot be unmarshalled: %+v", err))
+			return nil, conditionValid, err
+		}
+		conditionValid = helper.UpdatedCondition(conditionValid, gardencorev1alpha1.ConditionTrue, "RegistrationValid", "Manifest could be read successfully.")
+		return []byte(manifestDeployment.Manifest), conditionValid, nil
+	}
+
+	return nil, conditionValid, nil
+}
+
 func (c *defaultControllerInstallationControl) delete(controllerInstallation *gardencorev1alpha1.ControllerInstallation, logger logrus.FieldLogger) error {
 	var (
 		ctx                = context.TODO()
@@ -421,7 +499,7 @@ func (c *defaultControllerInstallationControl) isResponsible(controllerInstallat
 	}
 
 	if deployment := controllerRegistration.Spec.Deployment; deployment != nil {
-		return deployment.Type == installationTypeHelm, nil
+		return deployment.Type == installationTypeHelm || deployment.Type == installationTypeManifest, nil
 	}
 	return false, nil
 }
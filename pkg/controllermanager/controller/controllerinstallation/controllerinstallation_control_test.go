@@ -0,0 +1,194 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerinstallation_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerinstallation"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/helm/pkg/chartutil"
+	"k8s.io/helm/pkg/engine"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeChartFetcher returns the given chart (or error) for every reference and remembers the references it was asked for.
+type fakeChartFetcher struct {
+	chart []byte
+	err   error
+	refs  []*HelmChartRef
+}
+
+func (f *fakeChartFetcher) Fetch(_ context.Context, ref *HelmChartRef) ([]byte, error) {
+	f.refs = append(f.refs, ref)
+	return f.chart, f.err
+}
+
+// chartArchive returns a gzipped tarball of a chart with the given name which contains the given templates.
+func chartArchive(name string, templates map[string]string) []byte {
+	files := map[string]string{"Chart.yaml": fmt.Sprintf("apiVersion: v1\nname: %s\nversion: 1.0.0\n", name)}
+	for file, content := range templates {
+		files["templates/"+file] = content
+	}
+
+	var (
+		buf = &bytes.Buffer{}
+		gz  = gzip.NewWriter(buf)
+		tw  = tar.NewWriter(gz)
+	)
+	for file, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: name + "/" + file, Mode: 0644, Size: int64(len(content))})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		Expect(err).NotTo(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	Expect(gz.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("ControllerInstallationControl", func() {
+	Describe("#computeManifest", func() {
+		const (
+			namespace     = "extension-foo-abcde"
+			configMapYAML = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: foo
+  namespace: {{ .Release.Namespace }}
+data:
+  seed: {{ .Values.gardener.seed.identity }}
+  foo: {{ .Values.foo }}
+`
+		)
+
+		var (
+			ctx = context.TODO()
+
+			chart          []byte
+			chartFetcher   *fakeChartFetcher
+			chartRenderer  chartrenderer.Interface
+			seedValues     map[string]interface{}
+			conditionValid gardencorev1alpha1.Condition
+
+			controllerRegistration = func(deploymentType string) *gardencorev1alpha1.ControllerRegistration {
+				return &gardencorev1alpha1.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+					Spec: gardencorev1alpha1.ControllerRegistrationSpec{
+						Deployment: &gardencorev1alpha1.ControllerDeployment{Type: deploymentType},
+					},
+				}
+			}
+			providerConfig = func(obj interface{}) *gardencorev1alpha1.ProviderConfig {
+				raw, err := json.Marshal(obj)
+				Expect(err).NotTo(HaveOccurred())
+				return &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: raw}}
+			}
+		)
+
+		BeforeEach(func() {
+			chart = chartArchive("foo", map[string]string{"configmap.yaml": configMapYAML})
+			chartFetcher = &fakeChartFetcher{chart: chart}
+			chartRenderer = chartrenderer.New(engine.New(), &chartutil.Capabilities{})
+			seedValues = map[string]interface{}{
+				"gardener": map[string]interface{}{
+					"seed": map[string]interface{}{"identity": "seed-1"},
+				},
+			}
+			conditionValid = gardencorev1alpha1.Condition{Type: gardencorev1alpha1.ControllerInstallationValid, Status: gardencorev1alpha1.ConditionUnknown}
+		})
+
+		Context("helm", func() {
+			It("should render the embedded chart", func() {
+				manifest, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("helm"), providerConfig(HelmDeployment{Chart: chart, Values: map[string]interface{}{"foo": "bar"}}), namespace, seedValues, conditionValid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifest)).To(ContainSubstring("namespace: " + namespace))
+				Expect(string(manifest)).To(ContainSubstring("seed: seed-1"))
+				Expect(string(manifest)).To(ContainSubstring("foo: bar"))
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+				Expect(condition.Reason).To(Equal("RegistrationValid"))
+				Expect(chartFetcher.refs).To(BeEmpty())
+			})
+
+			It("should fetch and render the referenced chart", func() {
+				ref := &HelmChartRef{URL: "oci://registry.example.com/charts/foo:v1.0.0", Digest: "sha256:abc"}
+
+				manifest, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("helm"), providerConfig(HelmDeployment{ChartRef: ref, Values: map[string]interface{}{"foo": "bar"}}), namespace, seedValues, conditionValid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifest)).To(ContainSubstring("seed: seed-1"))
+				Expect(string(manifest)).To(ContainSubstring("foo: bar"))
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+				Expect(condition.Reason).To(Equal("RegistrationValid"))
+				Expect(chartFetcher.refs).To(ConsistOf(ref))
+			})
+
+			It("should set the Valid condition to false if the referenced chart cannot be fetched", func() {
+				chartFetcher.err = fmt.Errorf("digest mismatch")
+
+				manifest, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("helm"), providerConfig(HelmDeployment{ChartRef: &HelmChartRef{URL: "https://charts.example.com/foo-1.0.0.tgz", Digest: "sha256:abc"}}), namespace, seedValues, conditionValid)
+				Expect(err).To(MatchError("digest mismatch"))
+				Expect(manifest).To(BeNil())
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("ChartCannotBeFetched"))
+				Expect(condition.Message).To(ContainSubstring("digest mismatch"))
+			})
+
+			It("should set the Valid condition to false if neither chart nor chartRef is specified", func() {
+				_, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("helm"), providerConfig(HelmDeployment{}), namespace, seedValues, conditionValid)
+				Expect(err).To(HaveOccurred())
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("ChartInformationInvalid"))
+				Expect(chartFetcher.refs).To(BeEmpty())
+			})
+
+			It("should set the Valid condition to false if the chart cannot be rendered", func() {
+				chartFetcher.chart = []byte("no-chart-tarball")
+
+				_, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("helm"), providerConfig(HelmDeployment{ChartRef: &HelmChartRef{URL: "https://charts.example.com/foo-1.0.0.tgz", Digest: "sha256:abc"}}), namespace, seedValues, conditionValid)
+				Expect(err).To(HaveOccurred())
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("ChartCannotBeRendered"))
+			})
+		})
+
+		Context("manifest", func() {
+			It("should return the manifest as it is", func() {
+				manifest, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("manifest"), providerConfig(ManifestDeployment{Manifest: configMapYAML}), namespace, seedValues, conditionValid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(manifest)).To(Equal(configMapYAML))
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionTrue))
+				Expect(condition.Reason).To(Equal("RegistrationValid"))
+				Expect(chartFetcher.refs).To(BeEmpty())
+			})
+
+			It("should set the Valid condition to false if the manifest information is invalid", func() {
+				_, condition, err := ExportComputeManifest(ctx, chartFetcher, chartRenderer, controllerRegistration("manifest"), &gardencorev1alpha1.ProviderConfig{RawExtension: runtime.RawExtension{Raw: []byte(`{"manifest":42}`)}}, namespace, seedValues, conditionValid)
+				Expect(err).To(HaveOccurred())
+				Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+				Expect(condition.Reason).To(Equal("ManifestInformationInvalid"))
+			})
+		})
+	})
+})
@@ -22,10 +22,29 @@ import (
 type HelmDeployment struct {
 	// Chart is a Helm chart tarball.
 	Chart []byte `json:"chart,omitempty"`
+	// ChartRef is a reference to a Helm chart tarball which is stored outside of the ControllerRegistration. It must
+	// only be set if Chart is empty.
+	ChartRef *HelmChartRef `json:"chartRef,omitempty"`
 	// Values is a map of values for the given chart.
 	Values map[string]interface{} `json:"values,omitempty"`
 }
 
+// HelmChartRef is a reference to a Helm chart tarball in an OCI registry or on an HTTP server.
+type HelmChartRef struct {
+	// URL is the location of the chart. It is either an OCI reference of the form `oci://<registry>/<repository>:<tag>`
+	// or an `https://` URL pointing to the chart tarball. Plain `http://` URLs are not supported.
+	URL string `json:"url"`
+	// Digest is the digest of the chart tarball of the form `sha256:<hex>`. The fetched chart is verified against it.
+	Digest string `json:"digest"`
+}
+
+// ManifestDeployment is a providerConfig specific type for ControllerInstallation. It contains plain Kubernetes
+// manifests which are applied as they are.
+type ManifestDeployment struct {
+	// Manifest is a (multi-document) YAML or JSON stream of Kubernetes objects.
+	Manifest string `json:"manifest"`
+}
+
 // DeployedResources is a providerStatus specific type for ControllerInstallation.
 type DeployedResources struct {
 	// Resources is a list of objects that have been created.
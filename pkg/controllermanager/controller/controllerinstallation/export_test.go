@@ -0,0 +1,32 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Bridge package to expose internal functions to tests in the controllerinstallation_test package.
+
+package controllerinstallation
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+)
+
+// ExportComputeManifest calls computeManifest of a control using the given chart fetcher and chart renderer.
+func ExportComputeManifest(ctx context.Context, chartFetcher ChartFetcher, chartRenderer chartrenderer.Interface, controllerRegistration *gardencorev1alpha1.ControllerRegistration, providerConfig *gardencorev1alpha1.ProviderConfig, namespace string, seedValues map[string]interface{}, conditionValid gardencorev1alpha1.Condition) ([]byte, gardencorev1alpha1.Condition, error) {
+	c := &defaultControllerInstallationControl{chartFetcher: chartFetcher}
+	return c.computeManifest(ctx, controllerRegistration, providerConfig, func() (chartrenderer.Interface, error) {
+		return chartRenderer, nil
+	}, namespace, seedValues, conditionValid)
+}
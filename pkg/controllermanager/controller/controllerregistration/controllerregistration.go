@@ -35,8 +35,14 @@ import (
 	"k8s.io/client-go/util/workqueue"
 )
 
-// FinalizerName is the name of the ControllerRegistration finalizer.
-const FinalizerName = "core.gardener.cloud/controllerregistration"
+const (
+	// FinalizerName is the name of the ControllerRegistration finalizer.
+	FinalizerName = "core.gardener.cloud/controllerregistration"
+
+	// EventRolloutPaused is the reason of the event which is recorded for a ControllerRegistration whose rollout
+	// cannot proceed because no seed matches the canary seed selector.
+	EventRolloutPaused = "RolloutPaused"
+)
 
 // Controller controls ControllerRegistration.
 type Controller struct {
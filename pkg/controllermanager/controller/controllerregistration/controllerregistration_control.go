@@ -99,7 +99,7 @@ type ControlInterface interface {
 // implements the documented semantics for ControllerRegistrations. You should use an instance returned from
 // NewDefaultControllerRegistrationControl() for any scenario other than testing.
 func NewDefaultControllerRegistrationControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.SharedInformerFactory, k8sGardenCoreInformers gardencoreinformers.SharedInformerFactory, recorder record.EventRecorder, config *config.ControllerManagerConfiguration, seedLister gardenlisters.SeedLister, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister, controllerInstallationLister gardencorelisters.ControllerInstallationLister) ControlInterface {
-	return &defaultControllerRegistrationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister}
+	return &defaultControllerRegistrationControl{k8sGardenClient, k8sGardenInformers, k8sGardenCoreInformers, recorder, config, seedLister, controllerRegistrationLister, controllerInstallationLister, NewPausedRollouts()}
 }
 
 type defaultControllerRegistrationControl struct {
@@ -111,6 +111,7 @@ type defaultControllerRegistrationControl struct {
 	seedLister                   gardenlisters.SeedLister
 	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
 	controllerInstallationLister gardencorelisters.ControllerInstallationLister
+	pausedRollouts               *PausedRollouts
 }
 
 func (c *defaultControllerRegistrationControl) Reconcile(obj *gardencorev1alpha1.ControllerRegistration) error {
@@ -128,9 +129,10 @@ func (c *defaultControllerRegistrationControl) Reconcile(obj *gardencorev1alpha1
 
 func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration *gardencorev1alpha1.ControllerRegistration, logger logrus.FieldLogger) error {
 	var (
-		err              error
-		result           error
-		installationsMap = map[string]string{}
+		err                 error
+		result              error
+		installationsMap    = map[string]string{}
+		installationsBySeed = map[string]*gardencorev1alpha1.ControllerInstallation{}
 
 		mustWriteFinalizer = false
 	)
@@ -168,14 +170,24 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 		return err
 	}
 
-	for _, controllerInstallation := range controllerInstallationList.Items {
+	for i, controllerInstallation := range controllerInstallationList.Items {
 		if controllerInstallation.Spec.RegistrationRef.Name == controllerRegistration.Name {
 			installationsMap[controllerInstallation.Spec.SeedRef.Name] = controllerInstallation.Name
+			installationsBySeed[controllerInstallation.Spec.SeedRef.Name] = &controllerInstallationList.Items[i]
 		}
 	}
 
+	versions, noCanarySeeds, err := ComputeDeploymentVersions(controllerRegistration.Spec.Deployment, seedList, installationsBySeed, time.Now())
+	if err != nil {
+		return err
+	}
+	// The event is only recorded when the rollout gets paused, not on every reconciliation while it stays paused.
+	if c.pausedRollouts.Set(controllerRegistration.Name, noCanarySeeds) {
+		c.recorder.Event(controllerRegistration, corev1.EventTypeWarning, EventRolloutPaused, "No seed matches the canary seed selector, the latest version is not rolled out and all other seeds keep their current version")
+	}
+
 	for _, seed := range seedList {
-		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap); err != nil {
+		if err := c.reconcileSeedInstallations(controllerRegistration, seed, installationsMap, versions[seed.Name]); err != nil {
 			result = multierror.Append(result, err)
 		}
 	}
@@ -183,7 +195,7 @@ func (c *defaultControllerRegistrationControl) reconcile(controllerRegistration
 	return result
 }
 
-func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]string) error {
+func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(controllerRegistration *gardencorev1alpha1.ControllerRegistration, seed *gardenv1beta1.Seed, installationsMap map[string]string, version *string) error {
 	if seed.DeletionTimestamp != nil {
 		if installation, ok := installationsMap[seed.Name]; ok {
 			if seed.Spec.Backup != nil {
@@ -222,6 +234,7 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 			Name:            controllerRegistration.Name,
 			ResourceVersion: controllerRegistration.ResourceVersion,
 		},
+		Version: version,
 	}
 
 	seedSpecMap, err := convertObjToMap(seed.Spec)
@@ -264,6 +277,8 @@ func (c *defaultControllerRegistrationControl) reconcileSeedInstallations(contro
 }
 
 func (c *defaultControllerRegistrationControl) delete(controllerRegistration *gardencorev1alpha1.ControllerRegistration, logger logrus.FieldLogger) error {
+	c.pausedRollouts.Set(controllerRegistration.Name, false)
+
 	var (
 		result error
 		count  int
@@ -0,0 +1,196 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration
+
+import (
+	"sync"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// PausedRollouts keeps track of the ControllerRegistrations whose rollout is paused because no seed matches the canary
+// seed selector. It is safe for concurrent use.
+type PausedRollouts struct {
+	lock  sync.Mutex
+	names sets.String
+}
+
+// NewPausedRollouts returns a new PausedRollouts without any paused rollout.
+func NewPausedRollouts() *PausedRollouts {
+	return &PausedRollouts{names: sets.NewString()}
+}
+
+// Set marks the rollout of the ControllerRegistration with the given name as paused or not paused. It returns true if
+// the rollout is paused now but has not been paused before, i.e., if the pause starts.
+func (p *PausedRollouts) Set(name string, paused bool) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if !paused {
+		p.names.Delete(name)
+		return false
+	}
+	if p.names.Has(name) {
+		return false
+	}
+	p.names.Insert(name)
+	return true
+}
+
+// ComputeDeploymentVersions computes the version of the given controller deployment which shall be installed on each
+// of the given seeds. The result maps seed names to versions, a nil version means that the providerConfig of the
+// deployment shall be installed. Seeds matching the seed selector of a version are pinned to this version. All other
+// seeds get the last version without a seed selector. If a rollout policy is specified then such a version is only
+// rolled out to the canary seeds first. The remaining seeds keep their current version until the new version has been
+// installed successfully on all canary seeds for the soak time. If no (non-deleting) seed matches the canary seed
+// selector then the version can never soak, hence the remaining seeds keep their current version and the returned
+// boolean is true. The given installations are mapped by seed names.
+func ComputeDeploymentVersions(deployment *gardencorev1alpha1.ControllerDeployment, seeds []*gardenv1beta1.Seed, installations map[string]*gardencorev1alpha1.ControllerInstallation, now time.Time) (map[string]*string, bool, error) {
+	versions := make(map[string]*string, len(seeds))
+
+	if deployment == nil || len(deployment.Versions) == 0 {
+		return versions, false, nil
+	}
+
+	var (
+		latestVersion    *string
+		knownVersions    = make(map[string]struct{}, len(deployment.Versions))
+		pinnedSelectors  = make([]labels.Selector, len(deployment.Versions))
+		unpinnedSeeds    []*gardenv1beta1.Seed
+		canarySeeds      []*gardenv1beta1.Seed
+		remainingAllowed = true
+		noCanarySeeds    = true
+	)
+
+	for i, version := range deployment.Versions {
+		knownVersions[version.Version] = struct{}{}
+
+		if version.SeedSelector == nil {
+			latestVersion = &deployment.Versions[i].Version
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(version.SeedSelector)
+		if err != nil {
+			return nil, false, err
+		}
+		pinnedSelectors[i] = selector
+	}
+
+	for _, seed := range seeds {
+		if version := pinnedVersion(deployment.Versions, pinnedSelectors, seed); version != nil {
+			versions[seed.Name] = version
+			continue
+		}
+		unpinnedSeeds = append(unpinnedSeeds, seed)
+	}
+
+	if deployment.Rollout == nil || deployment.Rollout.CanarySeedSelector == nil {
+		for _, seed := range unpinnedSeeds {
+			versions[seed.Name] = latestVersion
+		}
+		return versions, false, nil
+	}
+
+	canarySelector, err := metav1.LabelSelectorAsSelector(deployment.Rollout.CanarySeedSelector)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var soakTime time.Duration
+	if deployment.Rollout.SoakTime != nil {
+		soakTime = deployment.Rollout.SoakTime.Duration
+	}
+
+	for _, seed := range unpinnedSeeds {
+		if canarySelector.Matches(labels.Set(seed.Labels)) {
+			versions[seed.Name] = latestVersion
+			canarySeeds = append(canarySeeds, seed)
+		}
+	}
+
+	for _, seed := range canarySeeds {
+		if seed.DeletionTimestamp != nil {
+			continue
+		}
+		noCanarySeeds = false
+		if !isVersionSoaked(installations[seed.Name], latestVersion, soakTime, now) {
+			remainingAllowed = false
+			break
+		}
+	}
+
+	if noCanarySeeds {
+		remainingAllowed = false
+	}
+
+	for _, seed := range unpinnedSeeds {
+		if _, ok := versions[seed.Name]; ok {
+			continue
+		}
+
+		installation, ok := installations[seed.Name]
+		if remainingAllowed || !ok {
+			versions[seed.Name] = latestVersion
+			continue
+		}
+
+		// Keep the version which is currently assigned to the seed as long as it is still known.
+		currentVersion := installation.Spec.Version
+		if currentVersion == nil && deployment.ProviderConfig == nil {
+			currentVersion = latestVersion
+		}
+		if currentVersion != nil {
+			if _, known := knownVersions[*currentVersion]; !known {
+				currentVersion = latestVersion
+			}
+		}
+		versions[seed.Name] = currentVersion
+	}
+
+	return versions, noCanarySeeds, nil
+}
+
+// pinnedVersion returns the first version whose seed selector matches the labels of the given seed.
+func pinnedVersion(versions []gardencorev1alpha1.ControllerDeploymentVersion, selectors []labels.Selector, seed *gardenv1beta1.Seed) *string {
+	for i, selector := range selectors {
+		if selector != nil && selector.Matches(labels.Set(seed.Labels)) {
+			return &versions[i].Version
+		}
+	}
+	return nil
+}
+
+// isVersionSoaked checks whether the given version has been installed successfully by the given installation for at
+// least the given soak time.
+func isVersionSoaked(installation *gardencorev1alpha1.ControllerInstallation, version *string, soakTime time.Duration, now time.Time) bool {
+	if installation == nil || installation.Status.DeployedVersion == nil || version == nil || *installation.Status.DeployedVersion != *version {
+		return false
+	}
+
+	condition := helper.GetCondition(installation.Status.Conditions, gardencorev1alpha1.ControllerInstallationInstalled)
+	if condition == nil || condition.Status != gardencorev1alpha1.ConditionTrue {
+		return false
+	}
+
+	return !condition.LastTransitionTime.Time.Add(soakTime).After(now)
+}
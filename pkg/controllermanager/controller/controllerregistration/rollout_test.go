@@ -0,0 +1,222 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controllerregistration_test
+
+import (
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/controllerregistration"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rollout", func() {
+	Describe("#ComputeDeploymentVersions", func() {
+		var (
+			now = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+			v1 = "v1"
+			v2 = "v2"
+			v3 = "v3"
+
+			canarySeed  *gardenv1beta1.Seed
+			pinnedSeed  *gardenv1beta1.Seed
+			regularSeed *gardenv1beta1.Seed
+			seeds       []*gardenv1beta1.Seed
+
+			deployment *gardencorev1alpha1.ControllerDeployment
+		)
+
+		newInstallation := func(version, deployedVersion *string, status gardencorev1alpha1.ConditionStatus, lastTransitionTime time.Time) *gardencorev1alpha1.ControllerInstallation {
+			return &gardencorev1alpha1.ControllerInstallation{
+				Spec: gardencorev1alpha1.ControllerInstallationSpec{
+					Version: version,
+				},
+				Status: gardencorev1alpha1.ControllerInstallationStatus{
+					DeployedVersion: deployedVersion,
+					Conditions: []gardencorev1alpha1.Condition{
+						{
+							Type:               gardencorev1alpha1.ControllerInstallationInstalled,
+							Status:             status,
+							LastTransitionTime: metav1.NewTime(lastTransitionTime),
+						},
+					},
+				},
+			}
+		}
+
+		BeforeEach(func() {
+			canarySeed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "canary", Labels: map[string]string{"canary": "true"}}}
+			pinnedSeed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "pinned", Labels: map[string]string{"pinned": "true"}}}
+			regularSeed = &gardenv1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "regular"}}
+			seeds = []*gardenv1beta1.Seed{canarySeed, pinnedSeed, regularSeed}
+
+			deployment = &gardencorev1alpha1.ControllerDeployment{
+				Type: "helm",
+				Versions: []gardencorev1alpha1.ControllerDeploymentVersion{
+					{Version: v1, SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"pinned": "true"}}},
+					{Version: v2},
+					{Version: v3},
+				},
+			}
+		})
+
+		It("should return no versions if the deployment does not specify versions", func() {
+			deployment.Versions = nil
+
+			versions, _, err := ComputeDeploymentVersions(deployment, seeds, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(BeEmpty())
+		})
+
+		It("should assign pinned versions and the latest version to all other seeds", func() {
+			versions, _, err := ComputeDeploymentVersions(deployment, seeds, nil, now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(versions).To(Equal(map[string]*string{
+				canarySeed.Name:  &v3,
+				pinnedSeed.Name:  &v1,
+				regularSeed.Name: &v3,
+			}))
+		})
+
+		Context("rollout", func() {
+			BeforeEach(func() {
+				deployment.Rollout = &gardencorev1alpha1.ControllerDeploymentRollout{
+					CanarySeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "true"}},
+					SoakTime:           &metav1.Duration{Duration: time.Hour},
+				}
+			})
+
+			It("should only roll out the latest version to the canary seeds", func() {
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+					regularSeed.Name: newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, noCanarySeeds, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(noCanarySeeds).To(BeFalse())
+				Expect(versions).To(Equal(map[string]*string{
+					canarySeed.Name:  &v3,
+					pinnedSeed.Name:  &v1,
+					regularSeed.Name: &v2,
+				}))
+			})
+
+			It("should keep the current version of the remaining seeds if no seed matches the canary seed selector", func() {
+				deployment.Rollout.CanarySeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"canary": "false"}}
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+					regularSeed.Name: newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, noCanarySeeds, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(noCanarySeeds).To(BeTrue())
+				Expect(versions).To(Equal(map[string]*string{
+					canarySeed.Name:  &v2,
+					pinnedSeed.Name:  &v1,
+					regularSeed.Name: &v2,
+				}))
+			})
+
+			It("should keep the current version of the remaining seeds if all canary seeds are being deleted", func() {
+				canarySeed.DeletionTimestamp = &metav1.Time{Time: now}
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v3, &v3, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+					regularSeed.Name: newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, noCanarySeeds, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(noCanarySeeds).To(BeTrue())
+				Expect(versions[regularSeed.Name]).To(Equal(&v2))
+			})
+
+			It("should keep the current version of the remaining seeds while the soak time has not passed", func() {
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v3, &v3, gardencorev1alpha1.ConditionTrue, now.Add(-30*time.Minute)),
+					regularSeed.Name: newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, _, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions[regularSeed.Name]).To(Equal(&v2))
+			})
+
+			It("should keep the current version of the remaining seeds if a canary installation is unhealthy", func() {
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v3, &v3, gardencorev1alpha1.ConditionFalse, now.Add(-2*time.Hour)),
+					regularSeed.Name: newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, _, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions[regularSeed.Name]).To(Equal(&v2))
+			})
+
+			It("should roll out the latest version to the remaining seeds after the soak time", func() {
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v3, &v3, gardencorev1alpha1.ConditionTrue, now.Add(-time.Hour)),
+					regularSeed.Name: newInstallation(&v2, &v2, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, _, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions[regularSeed.Name]).To(Equal(&v3))
+			})
+
+			It("should assign the latest version to seeds without installation", func() {
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name: newInstallation(&v3, &v2, gardencorev1alpha1.ConditionUnknown, now),
+				}
+
+				versions, _, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions[regularSeed.Name]).To(Equal(&v3))
+			})
+
+			It("should assign the latest version if the current version is no longer known", func() {
+				unknownVersion := "v0"
+				installations := map[string]*gardencorev1alpha1.ControllerInstallation{
+					canarySeed.Name:  newInstallation(&v3, &v2, gardencorev1alpha1.ConditionUnknown, now),
+					regularSeed.Name: newInstallation(&unknownVersion, &unknownVersion, gardencorev1alpha1.ConditionTrue, now.Add(-2*time.Hour)),
+				}
+
+				versions, _, err := ComputeDeploymentVersions(deployment, seeds, installations, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(versions[regularSeed.Name]).To(Equal(&v3))
+			})
+		})
+	})
+
+	Describe("#PausedRollouts", func() {
+		It("should only report the start of a pause", func() {
+			pausedRollouts := NewPausedRollouts()
+
+			Expect(pausedRollouts.Set("foo", false)).To(BeFalse())
+			Expect(pausedRollouts.Set("foo", true)).To(BeTrue())
+			Expect(pausedRollouts.Set("foo", true)).To(BeFalse())
+			Expect(pausedRollouts.Set("bar", true)).To(BeTrue())
+			Expect(pausedRollouts.Set("foo", false)).To(BeFalse())
+			Expect(pausedRollouts.Set("foo", true)).To(BeTrue())
+		})
+	})
+})
@@ -315,7 +315,16 @@ func (c *defaultControl) ReconcileSeed(obj *gardenv1beta1.Seed, key string) erro
 	}
 
 	conditionSeedAvailable = gardencorev1alpha1helper.UpdatedCondition(conditionSeedAvailable, gardencorev1alpha1.ConditionTrue, "Passed", "all checks passed")
-	c.updateSeedStatus(seed, conditionSeedAvailable)
+
+	// Report the capacity of the Seed cluster and the health of the components deployed into it.
+	capacity, componentConditions, err := seedpkg.CheckHealth(seedObj, c.config, len(associatedShoots), seed.Status.Conditions)
+	if err != nil {
+		seedLogger.Errorf("Could not check the health of the Seed cluster: %s", err.Error())
+		c.updateSeedStatus(seed, conditionSeedAvailable)
+		return err
+	}
+	seed.Status.Capacity = capacity
+	c.updateSeedStatus(seed, append(componentConditions, conditionSeedAvailable)...)
 
 	if seed.Spec.Backup != nil {
 		// This should be post updating the seed is available. Since, scheduler will then mostly use
@@ -334,6 +343,7 @@ func (c *defaultControl) updateSeedStatus(seed *gardenv1beta1.Seed, updateCondit
 		Conditions:         gardencorev1alpha1helper.MergeConditions(seed.Status.Conditions, updateConditions...),
 		ObservedGeneration: seed.Generation,
 		Gardener:           *c.identity,
+		Capacity:           seed.Status.Capacity,
 	}
 
 	if apiequality.Semantic.DeepEqual(seed.Status, newStatus) {
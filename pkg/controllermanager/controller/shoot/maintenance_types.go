@@ -0,0 +1,264 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+const (
+	// MaintenanceTaskMachineImageVersion is the name of the maintenance task updating the machine image versions.
+	MaintenanceTaskMachineImageVersion = "machine-image-version"
+	// MaintenanceTaskKubernetesVersion is the name of the maintenance task updating the Kubernetes patch version.
+	MaintenanceTaskKubernetesVersion = "kubernetes-version"
+	// MaintenanceTaskInfrastructureReconciliation is the name of the maintenance task requesting the redeployment of
+	// the infrastructure resources.
+	MaintenanceTaskInfrastructureReconciliation = "infrastructure-reconciliation"
+)
+
+// MaintenanceFunc applies the changes of a maintenance task to the given Shoot.
+type MaintenanceFunc func(shoot *gardenv1beta1.Shoot)
+
+// MaintenanceTask is a single operation which is executed during the maintenance time window of a Shoot. Each task
+// decides on its own whether it applies to a Shoot and what it changes.
+type MaintenanceTask interface {
+	// Name returns the unique name of the task. It is used to enable or disable the task in the configuration and to
+	// report its result in the Shoot status.
+	Name() string
+	// EnabledByDefault returns whether the task is executed if it is not explicitly configured.
+	EnabledByDefault() bool
+	// Prepare computes the changes of the task for the Shoot of the given operation. It returns a function applying
+	// the changes together with a description of them, or a nil function if the task does not apply to the Shoot.
+	Prepare(o *operation.Operation) (MaintenanceFunc, string, error)
+}
+
+// MaintenanceTaskRegistry is an ordered collection of maintenance tasks. It is not goroutine-safe, i.e., all tasks
+// must be registered before the registry is used by the maintenance control.
+type MaintenanceTaskRegistry interface {
+	// Register appends the given task to the registry. It returns an error if a task with the same name has already
+	// been registered.
+	Register(task MaintenanceTask) error
+	// EnabledTasks returns the registered tasks which are enabled according to the given configuration. The tasks are
+	// returned in the order of their registration.
+	EnabledTasks(config map[string]bool) []MaintenanceTask
+	// Validate returns an error if the given configuration refers to tasks which have not been registered.
+	Validate(config map[string]bool) error
+}
+
+type maintenanceTaskRegistry struct {
+	tasks []MaintenanceTask
+	names sets.String
+}
+
+// NewMaintenanceTaskRegistry instantiates a new, empty MaintenanceTaskRegistry.
+func NewMaintenanceTaskRegistry() MaintenanceTaskRegistry {
+	return &maintenanceTaskRegistry{names: sets.NewString()}
+}
+
+// NewDefaultMaintenanceTaskRegistry instantiates a new MaintenanceTaskRegistry containing the default maintenance
+// tasks of the Gardener.
+func NewDefaultMaintenanceTaskRegistry() MaintenanceTaskRegistry {
+	registry := NewMaintenanceTaskRegistry()
+	for _, task := range []MaintenanceTask{
+		&machineImageVersionTask{},
+		&kubernetesVersionTask{},
+		&infrastructureReconciliationTask{},
+	} {
+		utilruntime.Must(registry.Register(task))
+	}
+	return registry
+}
+
+// Register implements MaintenanceTaskRegistry.
+func (r *maintenanceTaskRegistry) Register(task MaintenanceTask) error {
+	if r.names.Has(task.Name()) {
+		return fmt.Errorf("maintenance task %q is already registered", task.Name())
+	}
+	r.names.Insert(task.Name())
+	r.tasks = append(r.tasks, task)
+	return nil
+}
+
+// EnabledTasks implements MaintenanceTaskRegistry.
+func (r *maintenanceTaskRegistry) EnabledTasks(config map[string]bool) []MaintenanceTask {
+	var tasks []MaintenanceTask
+	for _, task := range r.tasks {
+		enabled, ok := config[task.Name()]
+		if !ok {
+			enabled = task.EnabledByDefault()
+		}
+		if enabled {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// Validate implements MaintenanceTaskRegistry.
+func (r *maintenanceTaskRegistry) Validate(config map[string]bool) error {
+	var unknown []string
+	for name := range config {
+		if !r.names.Has(name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown maintenance tasks %s, supported tasks are %s", strings.Join(unknown, ", "), strings.Join(r.names.List(), ", "))
+	}
+	return nil
+}
+
+type machineImageVersionTask struct{}
+
+// Name implements MaintenanceTask.
+func (t *machineImageVersionTask) Name() string {
+	return MaintenanceTaskMachineImageVersion
+}
+
+// EnabledByDefault implements MaintenanceTask.
+func (t *machineImageVersionTask) EnabledByDefault() bool {
+	return true
+}
+
+// Prepare implements MaintenanceTask.
+func (t *machineImageVersionTask) Prepare(o *operation.Operation) (MaintenanceFunc, string, error) {
+	var (
+		currentDefaultMachineImage = o.Shoot.GetDefaultMachineImage()
+		currentMachineImages       = o.Shoot.GetMachineImages()
+	)
+
+	defaultMachineImage, machineImages, err := MaintainMachineImages(o.Shoot.Info, o.Shoot.CloudProfile, currentDefaultMachineImage, currentMachineImages)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not maintain machine image version: %s", err.Error())
+	}
+
+	if defaultMachineImage != nil && defaultMachineImage.Name == currentDefaultMachineImage.Name && defaultMachineImage.Version == currentDefaultMachineImage.Version {
+		defaultMachineImage = nil
+	}
+
+	var (
+		updatedMachineImages []*gardenv1beta1.ShootMachineImage
+		updates              []string
+	)
+	for _, machineImage := range machineImages {
+		if machineImageChanged(machineImage, currentMachineImages) {
+			updatedMachineImages = append(updatedMachineImages, machineImage)
+			updates = append(updates, fmt.Sprintf("%s:%s", machineImage.Name, machineImage.Version))
+		}
+	}
+
+	if defaultMachineImage == nil && len(updatedMachineImages) == 0 {
+		return nil, "", nil
+	}
+
+	description := fmt.Sprintf("updated machine images to %s", strings.Join(updates, ", "))
+	if defaultMachineImage != nil {
+		description = fmt.Sprintf("updated default machine image to %s:%s", defaultMachineImage.Name, defaultMachineImage.Version)
+		if len(updates) > 0 {
+			description = fmt.Sprintf("%s and worker machine images to %s", description, strings.Join(updates, ", "))
+		}
+	}
+
+	var updateDefaultMachineImage func(s *gardenv1beta1.Cloud)
+	if defaultMachineImage != nil {
+		updateDefaultMachineImage = helper.UpdateDefaultMachineImage(o.Shoot.CloudProvider, defaultMachineImage)
+	}
+
+	var updateWorkerMachineImages func(s *gardenv1beta1.Cloud)
+	if len(updatedMachineImages) > 0 {
+		updateWorkerMachineImages = helper.UpdateMachineImages(o.Shoot.CloudProvider, updatedMachineImages)
+	}
+
+	return func(shoot *gardenv1beta1.Shoot) {
+		if updateDefaultMachineImage != nil {
+			updateDefaultMachineImage(&shoot.Spec.Cloud)
+		}
+		if updateWorkerMachineImages != nil {
+			updateWorkerMachineImages(&shoot.Spec.Cloud)
+		}
+	}, description, nil
+}
+
+// machineImageChanged returns true if at least one of the current machine images with the same name as the given
+// machine image has a different version.
+func machineImageChanged(machineImage *gardenv1beta1.ShootMachineImage, currentMachineImages []*gardenv1beta1.ShootMachineImage) bool {
+	for _, current := range currentMachineImages {
+		if current.Name == machineImage.Name && current.Version != machineImage.Version {
+			return true
+		}
+	}
+	return false
+}
+
+type kubernetesVersionTask struct{}
+
+// Name implements MaintenanceTask.
+func (t *kubernetesVersionTask) Name() string {
+	return MaintenanceTaskKubernetesVersion
+}
+
+// EnabledByDefault implements MaintenanceTask.
+func (t *kubernetesVersionTask) EnabledByDefault() bool {
+	return true
+}
+
+// Prepare implements MaintenanceTask.
+func (t *kubernetesVersionTask) Prepare(o *operation.Operation) (MaintenanceFunc, string, error) {
+	updatedVersion, err := MaintainKubernetesVersion(o.Shoot.Info, o.Shoot.CloudProfile)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not maintain kubernetes version: %s", err.Error())
+	}
+
+	// Check if the CloudProfile contains a newer Kubernetes patch version.
+	if updatedVersion == nil {
+		return nil, "", nil
+	}
+
+	description := fmt.Sprintf("updated Kubernetes version from %s to %s", o.Shoot.Info.Spec.Kubernetes.Version, *updatedVersion)
+	return func(shoot *gardenv1beta1.Shoot) {
+		shoot.Spec.Kubernetes.Version = *updatedVersion
+	}, description, nil
+}
+
+type infrastructureReconciliationTask struct{}
+
+// Name implements MaintenanceTask.
+func (t *infrastructureReconciliationTask) Name() string {
+	return MaintenanceTaskInfrastructureReconciliation
+}
+
+// EnabledByDefault implements MaintenanceTask.
+func (t *infrastructureReconciliationTask) EnabledByDefault() bool {
+	return true
+}
+
+// Prepare implements MaintenanceTask.
+func (t *infrastructureReconciliationTask) Prepare(o *operation.Operation) (MaintenanceFunc, string, error) {
+	return func(shoot *gardenv1beta1.Shoot) {
+		controllerutils.AddTasks(shoot.Annotations, common.ShootTaskDeployInfrastructure, common.ShootTaskDeployKube2IAMResource)
+	}, "requested the redeployment of the infrastructure resources", nil
+}
@@ -128,7 +128,7 @@ func NewShootController(k8sGardenClient kubernetes.Interface, k8sGardenInformers
 		config:                        config,
 		identity:                      identity,
 		careControl:                   NewDefaultCareControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, config),
-		maintenanceControl:            NewDefaultMaintenanceControl(k8sGardenClient, gardenV1beta1Informer, secrets, imageVector, identity, recorder),
+		maintenanceControl:            NewDefaultMaintenanceControl(k8sGardenClient, gardenV1beta1Informer, NewDefaultMaintenanceTaskRegistry(), secrets, imageVector, identity, &config.Controllers.ShootMaintenance, recorder),
 		quotaControl:                  NewDefaultQuotaControl(k8sGardenClient, gardenV1beta1Informer),
 		controllerInstallationControl: NewDefaultControllerInstallationControl(k8sGardenClient, gardenV1beta1Informer, gardenCoreV1alpha1Informer, recorder),
 		recorder:                      recorder,
@@ -161,12 +161,18 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 	conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy = botanist.HealthChecks(
 		initializeShootClients,
 		c.conditionThresholdsToProgressingMapping(),
+		c.config.Controllers.ShootCare.StaleExtensionHealthCheckThreshold,
 		conditionAPIServerAvailable,
 		conditionControlPlaneHealthy,
 		conditionEveryNodeReady,
 		conditionSystemComponentsHealthy,
 	)
 
+	// Replace the machines of nodes which have been unhealthy for too long
+	if err := botanist.RepairUnhealthyNodes(initializeShootClients); err != nil {
+		botanist.Logger.Errorf("Could not repair unhealthy nodes: %+v", err)
+	}
+
 	// Update Shoot status
 	shoot, err = c.updateShootConditions(shoot, conditionAPIServerAvailable, conditionControlPlaneHealthy, conditionEveryNodeReady, conditionSystemComponentsHealthy)
 	if err != nil {
@@ -195,7 +201,7 @@ func (c *defaultCareControl) Care(shootObj *gardenv1beta1.Shoot, key string) err
 func (c *defaultCareControl) updateShootConditions(shoot *gardenv1beta1.Shoot, conditions ...gardencorev1alpha1.Condition) (*gardenv1beta1.Shoot, error) {
 	newShoot, err := kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta,
 		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
-			shoot.Status.Conditions = conditions
+			shoot.Status.Conditions = gardencorev1alpha1helper.MergeConditions(shoot.Status.Conditions, conditions...)
 			return shoot, nil
 		})
 
@@ -0,0 +1,70 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenfake "github.com/gardener/gardener/pkg/client/garden/clientset/versioned/fake"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	mockkubernetes "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Shoot Care Control", func() {
+	var (
+		ctrl            *gomock.Controller
+		k8sGardenClient *mockkubernetes.MockInterface
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		k8sGardenClient = mockkubernetes.NewMockInterface(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#updateShootConditions", func() {
+		It("should only replace the conditions managed by the care control", func() {
+			var (
+				apiServerUnavailable = gardencorev1alpha1.Condition{Type: gardenv1beta1.ShootAPIServerAvailable, Status: gardencorev1alpha1.ConditionFalse}
+				apiServerAvailable   = gardencorev1alpha1.Condition{Type: gardenv1beta1.ShootAPIServerAvailable, Status: gardencorev1alpha1.ConditionTrue}
+				etcdRestored         = gardencorev1alpha1.Condition{Type: gardenv1beta1.ShootETCDRestored, Status: gardencorev1alpha1.ConditionTrue, Reason: "ETCDRestored"}
+
+				shoot = &gardenv1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+					Status: gardenv1beta1.ShootStatus{
+						Conditions: []gardencorev1alpha1.Condition{apiServerUnavailable, etcdRestored},
+					},
+				}
+			)
+
+			k8sGardenClient.EXPECT().Garden().Return(gardenfake.NewSimpleClientset(shoot)).AnyTimes()
+			careControl := NewDefaultCareControl(k8sGardenClient, nil, nil, nil, nil, &config.ControllerManagerConfiguration{})
+
+			updatedShoot, err := ExportUpdateShootConditions(careControl, shoot, apiServerAvailable)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedShoot.Status.Conditions).To(Equal([]gardencorev1alpha1.Condition{apiServerAvailable, etcdRestored}))
+		})
+	})
+})
@@ -133,6 +133,12 @@ func (c *Controller) reconcileShootRequest(req reconcile.Request) (reconcile.Res
 	if shoot.DeletionTimestamp != nil {
 		return c.deleteShoot(shoot, o)
 	}
+	if isShootMigrating(shoot) {
+		return c.migrateShoot(shoot, o)
+	}
+	if isETCDRestoreRequested(shoot) {
+		return c.restoreShootETCD(shoot, o)
+	}
 	return c.reconcileShoot(shoot, o)
 }
 
@@ -240,8 +246,28 @@ func (c *Controller) reconcileShoot(shoot *gardenv1beta1.Shoot, o *operation.Ope
 		reconcileInMaintenanceOnly                 = c.reconcileInMaintenanceOnly()
 		isUpToDate                                 = common.IsObservedAtLatestGenerationAndSucceeded(shoot)
 		isNowInEffectiveShootMaintenanceTimeWindow = common.IsNowInEffectiveShootMaintenanceTimeWindow(shoot)
-		reconcileAllowed                           = !reconcileInMaintenanceOnly || !isUpToDate || isNowInEffectiveShootMaintenanceTimeWindow
-		allowedToUpdate                            = !failedOrIgnored && reconcileAllowed
+	)
+
+	// Reconciliations which are only done because of the maintenance time window (i.e., the Shoot is already up-to-date)
+	// must not happen during a maintenance blackout window. The Shoot is requeued until its next maintenance time window
+	// so that the skipped reconciliation is only reported once.
+	var skippedInBlackoutWindow bool
+	if !failedOrIgnored && reconcileInMaintenanceOnly && isUpToDate && isNowInEffectiveShootMaintenanceTimeWindow {
+		blackoutWindow, err := c.activeMaintenanceBlackoutWindow(shoot)
+		if err != nil {
+			o.Logger.WithError(err).Error("Could not determine the maintenance blackout windows")
+		}
+		if blackoutWindow != nil {
+			message := fmt.Sprintf("Skipped reconciliation in maintenance time window because of blackout window %q (%s)", blackoutWindow.Name, maintenanceBlackoutWindowReason(blackoutWindow))
+			c.recorder.Event(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMaintenanceSkipped, message)
+			isNowInEffectiveShootMaintenanceTimeWindow = false
+			skippedInBlackoutWindow = true
+		}
+	}
+
+	var (
+		reconcileAllowed = !reconcileInMaintenanceOnly || !isUpToDate || isNowInEffectiveShootMaintenanceTimeWindow
+		allowedToUpdate  = !failedOrIgnored && reconcileAllowed
 	)
 	o.Logger.WithFields(logrus.Fields{
 		"operationType":              operationType,
@@ -287,6 +313,9 @@ func (c *Controller) reconcileShoot(shoot *gardenv1beta1.Shoot, o *operation.Ope
 
 	if !reconcileAllowed {
 		durationUntilNextSync := c.durationUntilNextShootSync(shoot)
+		if skippedInBlackoutWindow {
+			durationUntilNextSync = common.EffectiveShootMaintenanceTimeWindow(shoot).RandomDurationUntilNext(time.Now())
+		}
 		message := fmt.Sprintf("Scheduled next queuing time for Shoot in %s (%s)", durationUntilNextSync, time.Now().UTC().Add(durationUntilNextSync))
 		c.recorder.Event(shoot, corev1.EventTypeNormal, "ScheduledNextSync", message)
 		return reconcile.Result{RequeueAfter: durationUntilNextSync}, nil
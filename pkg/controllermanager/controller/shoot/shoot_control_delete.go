@@ -24,6 +24,7 @@ import (
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
 	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
@@ -157,6 +158,7 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 	var (
 		nonTerminatingNamespace = namespace.Status.Phase != corev1.NamespaceTerminating
 		cleanupShootResources   = nonTerminatingNamespace && kubeAPIServerDeploymentFound
+		controlPlaneHibernated  = (o.Shoot.Info.Status.IsHibernated != nil && *o.Shoot.Info.Status.IsHibernated || o.Shoot.Info.Status.IsHibernated == nil && o.Shoot.HibernationEnabled) && gardenv1beta1helper.GetHibernationMode(o.Shoot.Info) == gardenv1beta1.HibernationModeFull
 		defaultInterval         = 5 * time.Second
 		defaultTimeout          = 30 * time.Second
 
@@ -195,7 +197,7 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 		})
 		wakeUpControlPlane = g.Add(flow.Task{
 			Name:         "Waking up control plane to ensure proper cleanup of resources",
-			Fn:           flow.TaskFn(botanist.WakeUpControlPlane).DoIf((o.Shoot.Info.Status.IsHibernated != nil && *o.Shoot.Info.Status.IsHibernated || o.Shoot.Info.Status.IsHibernated == nil && o.Shoot.HibernationEnabled) && cleanupShootResources),
+			Fn:           flow.TaskFn(botanist.WakeUpControlPlane).DoIf(controlPlaneHibernated && cleanupShootResources),
 			Dependencies: flow.NewTaskIDs(syncClusterResourceToSeed, waitUntilControlPlaneReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
@@ -283,10 +285,26 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			Fn:           flow.TaskFn(botanist.WaitUntilNetworkIsDeleted),
 			Dependencies: flow.NewTaskIDs(destroyNetwork),
 		})
+
+		// Extension resources are deleted by lifecycle phase in reverse order. The deleted tasks of all phases are
+		// collected so that extension resources are deleted only after the extension resources depending on them.
+		extensionResourcesDeleted                     = map[string]flow.TaskID{}
+		waitUntilExtensionResourcesAfterWorkerDeleted = addDeleteExtensionResourcesTasks(g, botanist, gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterWorker, flow.NewTaskIDs(syncPointReadyForCleanup), extensionResourcesDeleted, defaultInterval, defaultTimeout)
+
+		deleteContainerRuntimeResources = g.Add(flow.Task{
+			Name:         "Deleting container runtime resources",
+			Fn:           flow.TaskFn(botanist.DeleteAllContainerRuntimeResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(cleanShootNamespaces),
+		})
+		waitUntilContainerRuntimeResourcesDeleted = g.Add(flow.Task{
+			Name:         "Waiting until container runtime resources have been deleted",
+			Fn:           flow.TaskFn(botanist.WaitUntilContainerRuntimeResourcesDeleted),
+			Dependencies: flow.NewTaskIDs(deleteContainerRuntimeResources),
+		})
 		destroyWorker = g.Add(flow.Task{
 			Name:         "Destroying Shoot workers",
 			Fn:           flow.TaskFn(botanist.DestroyWorker).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(cleanShootNamespaces),
+			Dependencies: flow.NewTaskIDs(cleanShootNamespaces, waitUntilExtensionResourcesAfterWorkerDeleted, waitUntilContainerRuntimeResourcesDeleted),
 		})
 		waitUntilWorkerDeleted = g.Add(flow.Task{
 			Name:         "Waiting until shoot worker nodes have been terminated",
@@ -303,15 +321,18 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			Fn:           flow.TaskFn(botanist.WaitUntilManagedResourcesDeleted).DoIf(cleanupShootResources).Timeout(10 * time.Minute),
 			Dependencies: flow.NewTaskIDs(deleteManagedResources),
 		})
-		deleteExtensionResources = g.Add(flow.Task{
-			Name:         "Deleting extension resources",
-			Fn:           flow.TaskFn(botanist.DeleteExtensionResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+
+		waitUntilExtensionResourcesAfterKubeAPIServerDeleted = addDeleteExtensionResourcesTasks(g, botanist, gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer, flow.NewTaskIDs(cleanKubernetesResources, waitUntilManagedResourcesDeleted), extensionResourcesDeleted, defaultInterval, defaultTimeout)
+
+		deleteStaleExtensionResources = g.Add(flow.Task{
+			Name:         "Deleting stale extension resources",
+			Fn:           flow.TaskFn(botanist.DeleteStaleExtensionResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(cleanKubernetesResources, waitUntilManagedResourcesDeleted),
 		})
-		waitUntilExtensionResourcesDeleted = g.Add(flow.Task{
-			Name:         "Waiting until extension resources have been deleted",
+		waitUntilStaleExtensionResourcesDeleted = g.Add(flow.Task{
+			Name:         "Waiting until stale extension resources have been deleted",
 			Fn:           botanist.WaitUntilExtensionResourcesDeleted,
-			Dependencies: flow.NewTaskIDs(deleteExtensionResources),
+			Dependencies: flow.NewTaskIDs(deleteStaleExtensionResources),
 		})
 
 		// Services (and other objects that have a footprint in the infrastructure) still don't have finalizers yet. There is no way to
@@ -337,12 +358,14 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			cleanExtendedAPIs,
 			cleanKubernetesResources,
 			cleanShootNamespaces,
+			waitUntilContainerRuntimeResourcesDeleted,
 			waitUntilWorkerDeleted,
 			waitUntilManagedResourcesDeleted,
 			timeForInfrastructureResourceCleanup,
 			destroyNetwork,
 			waitUntilNetworkIsDestroyed,
-			waitUntilExtensionResourcesDeleted,
+			waitUntilExtensionResourcesAfterKubeAPIServerDeleted,
+			waitUntilStaleExtensionResourcesDeleted,
 		)
 		destroyControlPlane = g.Add(flow.Task{
 			Name:         "Destroying Shoot control plane",
@@ -360,6 +383,9 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			Fn:           flow.TaskFn(botanist.DeleteKubeAPIServer).Retry(defaultInterval),
 			Dependencies: flow.NewTaskIDs(syncPointCleaned, waitUntilControlPlaneDeleted),
 		})
+
+		waitUntilExtensionResourcesBeforeKubeAPIServerDeleted = addDeleteExtensionResourcesTasks(g, botanist, gardencorev1alpha1.ControllerResourceLifecyclePhaseBeforeKubeAPIServer, flow.NewTaskIDs(deleteKubeAPIServer), extensionResourcesDeleted, defaultInterval, defaultTimeout)
+
 		deleteBackupInfrastructure = g.Add(flow.Task{
 			Name:         "Delete backup infrastructure resource",
 			Fn:           flow.SimpleTaskFn(botanist.DeleteBackupInfrastructure),
@@ -408,6 +434,11 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			Fn:           botanist.DestroyExternalDomainDNSRecord,
 			Dependencies: flow.NewTaskIDs(syncPointCleaned),
 		})
+		destroyAdditionalDomainDNSRecords = g.Add(flow.Task{
+			Name:         "Destroying additional domain DNS records",
+			Fn:           botanist.DestroyAdditionalDomainDNSRecords,
+			Dependencies: flow.NewTaskIDs(syncPointCleaned),
+		})
 
 		syncPoint = flow.NewTaskIDs(
 			deleteSeedMonitoring,
@@ -417,7 +448,9 @@ func (c *Controller) runDeleteShootFlow(o *operation.Operation) *gardencorev1alp
 			destroyNginxIngressDNSRecord,
 			destroyKube2IAMResources,
 			destroyExternalDomainDNSRecord,
+			destroyAdditionalDomainDNSRecords,
 			waitUntilInfrastructureDeleted,
+			waitUntilExtensionResourcesBeforeKubeAPIServerDeleted,
 		)
 
 		destroyInternalDomainDNSRecord = g.Add(flow.Task{
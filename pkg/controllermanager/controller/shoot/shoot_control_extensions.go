@@ -0,0 +1,118 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// addDeployExtensionResourcesTasks adds tasks to the given graph which deploy the extension resources of the given
+// lifecycle phase and wait until they are ready. An extension resource is deployed after the given dependencies and
+// after the extension resources it depends on are ready. The IDs of the added wait tasks are recorded in <readyTasks>
+// and returned.
+func addDeployExtensionResourcesTasks(g *flow.Graph, botanist *botanistpkg.Botanist, phase gardencorev1alpha1.ControllerResourceLifecyclePhase, dependencies flow.TaskIDs, readyTasks map[string]flow.TaskID, retryInterval, retryTimeout time.Duration) flow.TaskIDs {
+	// The dependencies have already been validated when the extensions were computed.
+	extensions, _ := shootpkg.SortExtensions(botanist.Shoot.Extensions, phase)
+
+	waitTasks := flow.NewTaskIDs()
+	for _, extension := range extensions {
+		var (
+			extensionType      = extension.Spec.Type
+			deployDependencies = dependencies.Copy()
+		)
+
+		for _, dependencyType := range extension.DependsOn {
+			if readyTask, ok := readyTasks[dependencyType]; ok {
+				deployDependencies.Insert(readyTask)
+			}
+		}
+
+		deployExtensionResource := g.Add(flow.Task{
+			Name: fmt.Sprintf("Deploying extension resource %q", extensionType),
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.DeployExtensionResource(ctx, extensionType)
+			}).RetryUntilTimeout(retryInterval, retryTimeout),
+			Dependencies: deployDependencies,
+		})
+		waitUntilExtensionResourceReady := g.Add(flow.Task{
+			Name: fmt.Sprintf("Waiting until extension resource %q is ready", extensionType),
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.WaitUntilExtensionResourceReady(ctx, extensionType)
+			}),
+			Dependencies: flow.NewTaskIDs(deployExtensionResource),
+		})
+
+		readyTasks[extensionType] = waitUntilExtensionResourceReady
+		waitTasks.Insert(waitUntilExtensionResourceReady)
+	}
+
+	return waitTasks
+}
+
+// addDeleteExtensionResourcesTasks adds tasks to the given graph which delete the extension resources of the given
+// lifecycle phase and wait until they are gone. An extension resource is deleted after the given dependencies and
+// after the extension resources depending on it are gone. The IDs of the added wait tasks are recorded in
+// <deletedTasks> and returned.
+func addDeleteExtensionResourcesTasks(g *flow.Graph, botanist *botanistpkg.Botanist, phase gardencorev1alpha1.ControllerResourceLifecyclePhase, dependencies flow.TaskIDs, deletedTasks map[string]flow.TaskID, retryInterval, retryTimeout time.Duration) flow.TaskIDs {
+	// The dependencies have already been validated when the extensions were computed.
+	extensions, _ := shootpkg.SortExtensions(botanist.Shoot.Extensions, phase)
+
+	waitTasks := flow.NewTaskIDs()
+	for i := len(extensions) - 1; i >= 0; i-- {
+		var (
+			extensionType      = extensions[i].Spec.Type
+			deleteDependencies = dependencies.Copy()
+		)
+
+		for dependentType, dependent := range botanist.Shoot.Extensions {
+			deletedTask, ok := deletedTasks[dependentType]
+			if !ok {
+				continue
+			}
+			for _, dependencyType := range dependent.DependsOn {
+				if dependencyType == extensionType {
+					deleteDependencies.Insert(deletedTask)
+				}
+			}
+		}
+
+		deleteExtensionResource := g.Add(flow.Task{
+			Name: fmt.Sprintf("Deleting extension resource %q", extensionType),
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.DeleteExtensionResource(ctx, extensionType)
+			}).RetryUntilTimeout(retryInterval, retryTimeout),
+			Dependencies: deleteDependencies,
+		})
+		waitUntilExtensionResourceDeleted := g.Add(flow.Task{
+			Name: fmt.Sprintf("Waiting until extension resource %q has been deleted", extensionType),
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.WaitUntilExtensionResourceDeleted(ctx, extensionType)
+			}),
+			Dependencies: flow.NewTaskIDs(deleteExtensionResource),
+		})
+
+		deletedTasks[extensionType] = waitUntilExtensionResourceDeleted
+		waitTasks.Insert(waitUntilExtensionResourceDeleted)
+	}
+
+	return waitTasks
+}
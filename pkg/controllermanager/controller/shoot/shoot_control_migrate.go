@@ -0,0 +1,482 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	botanistpkg "github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
+	"github.com/gardener/gardener/pkg/utils"
+	utilerrors "github.com/gardener/gardener/pkg/utils/errors"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	utilretry "github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// isShootMigrating returns true if the control plane of the given Shoot shall be migrated or if a started migration
+// has not been completed yet.
+func isShootMigrating(shoot *gardenv1beta1.Shoot) bool {
+	if _, ok := shoot.Annotations[common.ShootMigrationSourceSeed]; ok {
+		return true
+	}
+	return shoot.Annotations[common.ShootOperation] == common.ShootOperationMigrate
+}
+
+// migrationSeeds returns the names of the source and target Seed of the control plane migration of the given Shoot,
+// and whether the Shoot has already been switched to the target Seed.
+func migrationSeeds(shoot *gardenv1beta1.Shoot) (string, string, bool) {
+	if sourceSeed, ok := shoot.Annotations[common.ShootMigrationSourceSeed]; ok {
+		return sourceSeed, *shoot.Spec.Cloud.Seed, true
+	}
+	return *shoot.Spec.Cloud.Seed, shoot.Annotations[common.ShootMigrationTargetSeed], false
+}
+
+func (c *Controller) migrateShoot(shoot *gardenv1beta1.Shoot, o *operation.Operation) (reconcile.Result, error) {
+	if shoot.Spec.Cloud.Seed == nil {
+		return reconcile.Result{}, fmt.Errorf("shoot %s/%s has not yet been scheduled on a Seed", shoot.Namespace, shoot.Name)
+	}
+
+	if common.ShouldIgnoreShoot(c.respectSyncPeriodOverwrite(), shoot) {
+		o.Logger.Info("Shoot is being ignored")
+		return reconcile.Result{}, nil
+	}
+
+	sourceSeed, targetSeed, switched := migrationSeeds(shoot)
+	if common.IsShootFailed(shoot) && !switched {
+		o.Logger.Info("Shoot is failed")
+		return reconcile.Result{}, nil
+	}
+
+	if !switched {
+		if err := c.checkMigrationTargetSeed(o, targetSeed); err != nil {
+			message := fmt.Sprintf("Cannot migrate Shoot control plane to seed %s: %v", targetSeed, err)
+			c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, message)
+			return reconcile.Result{}, utilerrors.WithSuppressed(err, c.updateShootStatusMigrateError(o, gardencorev1alpha1helper.LastError(message), true))
+		}
+
+		c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMigrating, "Migrating Shoot control plane from seed %s to seed %s", sourceSeed, targetSeed)
+		if err := c.updateShootStatusMigrateStart(o, fmt.Sprintf("Migration of Shoot control plane from seed %s to seed %s in progress. The migration can be rolled back until the Shoot has been switched to the target seed.", sourceSeed, targetSeed)); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := c.runMigrateShootFlow(o, targetSeed); err != nil {
+			c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, err.Description)
+			return reconcile.Result{}, utilerrors.WithSuppressed(errors.New(err.Description), c.updateShootStatusMigrateError(o, err, true))
+		}
+
+		newShoot, err := kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+			func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+				shoot.Spec.Cloud.Seed = &targetSeed
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootMigrationSourceSeed, sourceSeed)
+				return shoot, nil
+			})
+		if err != nil {
+			lastErr := gardencorev1alpha1helper.LastError(fmt.Sprintf("Could not switch Shoot to seed %s: %v", targetSeed, err))
+			c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, lastErr.Description)
+			return reconcile.Result{}, utilerrors.WithSuppressed(err, c.updateShootStatusMigrateError(o, lastErr, true))
+		}
+		shoot = newShoot
+	}
+
+	targetOperation, err := operation.New(shoot, c.config, o.Logger, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector, c.config.ShootBackup)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	sourceShoot := shoot.DeepCopy()
+	sourceShoot.Spec.Cloud.Seed = &sourceSeed
+	sourceOperation, err := operation.New(sourceShoot, c.config, o.Logger, c.k8sGardenClient, c.k8sGardenInformers.Garden().V1beta1(), c.identity, c.secrets, c.imageVector, c.config.ShootBackup)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := c.checkSeedAndSyncClusterResource(shoot, targetOperation); err != nil {
+		lastErr := gardencorev1alpha1helper.LastError(fmt.Sprintf("Could not check and sync Shoot with seed %s: %v", targetSeed, err))
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, lastErr.Description)
+		return reconcile.Result{}, utilerrors.WithSuppressed(err, c.updateShootStatusMigrateError(targetOperation, lastErr, false))
+	}
+
+	if err := c.updateShootStatusMigrateStart(targetOperation, fmt.Sprintf("Restoration of Shoot control plane on seed %s in progress. The Shoot has been switched to the target seed, the migration can no longer be rolled back.", targetSeed)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := c.runRestoreShootFlow(targetOperation, sourceOperation); err != nil {
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, err.Description)
+		return reconcile.Result{}, utilerrors.WithSuppressed(errors.New(err.Description), c.updateShootStatusMigrateError(targetOperation, err, false))
+	}
+
+	if err := c.runReconcileShootFlow(targetOperation, gardencorev1alpha1.LastOperationTypeMigrate); err != nil {
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, err.Description)
+		return reconcile.Result{}, utilerrors.WithSuppressed(errors.New(err.Description), c.updateShootStatusMigrateError(targetOperation, err, false))
+	}
+
+	if err := c.runReleaseSourceSeedFlow(sourceOperation); err != nil {
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventMigrateError, err.Description)
+		return reconcile.Result{}, utilerrors.WithSuppressed(errors.New(err.Description), c.updateShootStatusMigrateError(targetOperation, err, false))
+	}
+
+	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMigrated, "Migrated Shoot control plane from seed %s to seed %s", sourceSeed, targetSeed)
+	if err := c.updateShootStatusMigrateSuccess(targetOperation, sourceSeed); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	durationUntilNextSync := c.durationUntilNextShootSync(shoot)
+	message := fmt.Sprintf("Scheduled next queuing time for Shoot in %s (%s)", durationUntilNextSync, time.Now().UTC().Add(durationUntilNextSync))
+	c.recorder.Event(shoot, corev1.EventTypeNormal, "ScheduledNextSync", message)
+	return reconcile.Result{RequeueAfter: durationUntilNextSync}, nil
+}
+
+// checkMigrationTargetSeed checks whether the control plane of the Shoot of the given operation can be migrated to
+// the given Seed. The target Seed must pass the same checks the scheduler applies when it drains a Seed (taints,
+// availability, provider and region, backup provider, network disjointedness and the seed selector of the
+// CloudProfile), and it must be ready for this Gardener. The check runs before anything is changed on the source Seed.
+func (c *Controller) checkMigrationTargetSeed(o *operation.Operation, targetSeedName string) error {
+	targetSeed, err := c.seedLister.Get(targetSeedName)
+	if err != nil {
+		return fmt.Errorf("could not find seed %s: %v", targetSeedName, err)
+	}
+	if err := health.CheckSeed(targetSeed, c.identity); err != nil {
+		return fmt.Errorf("seed %s is not yet ready: %v", targetSeedName, err)
+	}
+
+	coreClient := c.k8sGardenClient.GardenCore().CoreV1alpha1()
+	shoot, err := coreClient.Shoots(o.Shoot.Info.Namespace).Get(o.Shoot.Info.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	cloudProfile, err := coreClient.CloudProfiles().Get(shoot.Spec.CloudProfileName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	sourceSeed, err := coreClient.Seeds().Get(o.Seed.Info.Name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	coreTargetSeed, err := coreClient.Seeds().Get(targetSeedName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	return schedulerutils.ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, coreTargetSeed)
+}
+
+// runMigrateShootFlow prepares the migration of the control plane of the Shoot of the given operation on its source
+// Seed. After a successful run the Shoot can be switched to the target Seed.
+func (c *Controller) runMigrateShootFlow(o *operation.Operation, targetSeed string) *gardencorev1alpha1.LastError {
+	botanist, lastErr := newBotanist(o)
+	if lastErr != nil {
+		return lastErr
+	}
+
+	var (
+		defaultTimeout  = 30 * time.Second
+		defaultInterval = 5 * time.Second
+
+		g                         = flow.NewGraph("Shoot control plane migration")
+		syncClusterResourceToSeed = g.Add(flow.Task{
+			Name: "Syncing shoot cluster information to seed",
+			Fn:   flow.TaskFn(botanist.SyncClusterResourceToSeed).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		scaleDownControlPlane = g.Add(flow.Task{
+			Name:         "Scaling down control plane",
+			Fn:           flow.TaskFn(botanist.ScaleDownControlPlane).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+			Dependencies: flow.NewTaskIDs(syncClusterResourceToSeed),
+		})
+		takeFinalETCDSnapshot = g.Add(flow.Task{
+			Name:         "Taking final etcd snapshot",
+			Fn:           flow.TaskFn(botanist.TakeFinalETCDSnapshot).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+			Dependencies: flow.NewTaskIDs(scaleDownControlPlane),
+		})
+		scaleDownETCD = g.Add(flow.Task{
+			Name:         "Scaling down etcd",
+			Fn:           flow.TaskFn(botanist.ScaleDownETCD),
+			Dependencies: flow.NewTaskIDs(takeFinalETCDSnapshot),
+		})
+		destroyDNSRecords = g.Add(flow.Task{
+			Name:         "Destroying DNS records pointing to the source seed",
+			Fn:           flow.TaskFn(botanist.DestroyDNSRecordsForMigration),
+			Dependencies: flow.NewTaskIDs(scaleDownControlPlane),
+		})
+		migrateExtensionResources = g.Add(flow.Task{
+			Name:         "Migrating extension resources",
+			Fn:           flow.TaskFn(botanist.MigrateExtensionResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(scaleDownETCD),
+		})
+		waitUntilExtensionResourcesMigrated = g.Add(flow.Task{
+			Name:         "Waiting until extension resources have exported their state",
+			Fn:           flow.TaskFn(botanist.WaitUntilExtensionResourcesMigrated),
+			Dependencies: flow.NewTaskIDs(migrateExtensionResources),
+		})
+		moveBackupEntry = g.Add(flow.Task{
+			Name: "Assigning backup entry to the target seed",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.MoveBackupEntryToSeed(ctx, targetSeed)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(scaleDownETCD),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until backup entry has been reconciled on the target seed",
+			Fn:           flow.TaskFn(botanist.WaitUntilBackupEntryInGardenReconciled),
+			Dependencies: flow.NewTaskIDs(moveBackupEntry, waitUntilExtensionResourcesMigrated, destroyDNSRecords),
+		})
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress}); err != nil {
+		o.Logger.Errorf("Failed to migrate Shoot %q: %+v", o.Shoot.Info.Name, err)
+		return gardencorev1alpha1helper.LastError(gardencorev1alpha1helper.FormatLastErrDescription(err), gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err))...)
+	}
+
+	o.Logger.Infof("Successfully prepared the migration of Shoot %q to seed %s", o.Shoot.Info.Name, targetSeed)
+	return nil
+}
+
+// runRestoreShootFlow restores the state of the control plane of the Shoot from the source Seed on the target Seed.
+// It must be followed by the regular reconciliation flow on the target Seed.
+func (c *Controller) runRestoreShootFlow(targetOperation, sourceOperation *operation.Operation) *gardencorev1alpha1.LastError {
+	botanist, lastErr := newBotanist(targetOperation)
+	if lastErr != nil {
+		return lastErr
+	}
+	sourceClient := sourceOperation.K8sSeedClient.Client()
+
+	var (
+		defaultTimeout  = 30 * time.Second
+		defaultInterval = 5 * time.Second
+
+		g               = flow.NewGraph("Shoot control plane restoration")
+		deployNamespace = g.Add(flow.Task{
+			Name: "Deploying Shoot namespace in target seed",
+			Fn:   flow.TaskFn(botanist.DeployNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+		})
+		copyNamespaceResources = g.Add(flow.Task{
+			Name: "Copying Shoot certificates, keys and configuration from source seed",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.CopyNamespaceResourcesFromSeed(ctx, sourceClient)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Restoring extension resources from source seed",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.RestoreExtensionResourcesFromSeed(ctx, sourceClient)
+			}).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(copyNamespaceResources),
+		})
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Logger: targetOperation.Logger, ProgressReporter: targetOperation.ReportShootProgress}); err != nil {
+		targetOperation.Logger.Errorf("Failed to restore Shoot %q: %+v", targetOperation.Shoot.Info.Name, err)
+		return gardencorev1alpha1helper.LastError(gardencorev1alpha1helper.FormatLastErrDescription(err), gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err))...)
+	}
+	return nil
+}
+
+// runReleaseSourceSeedFlow removes the remaining control plane of the Shoot from the source Seed once it has been
+// restored on the target Seed. No infrastructure resources of the Shoot are deleted.
+func (c *Controller) runReleaseSourceSeedFlow(o *operation.Operation) *gardencorev1alpha1.LastError {
+	botanist, lastErr := newBotanist(o)
+	if lastErr != nil {
+		return lastErr
+	}
+
+	var (
+		defaultTimeout  = 30 * time.Second
+		defaultInterval = 5 * time.Second
+
+		g                                 = flow.NewGraph("Shoot control plane release")
+		releaseMigratedExtensionResources = g.Add(flow.Task{
+			Name: "Releasing migrated extension resources in source seed",
+			Fn:   flow.TaskFn(botanist.ReleaseMigratedExtensionResources),
+		})
+		releaseBackupEntryExtension = g.Add(flow.Task{
+			Name: "Releasing backup entry in source seed",
+			Fn:   flow.TaskFn(botanist.ReleaseBackupEntryExtension),
+		})
+		deleteNamespace = g.Add(flow.Task{
+			Name:         "Deleting Shoot namespace in source seed",
+			Fn:           flow.TaskFn(botanist.DeleteNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(releaseMigratedExtensionResources, releaseBackupEntryExtension),
+		})
+		waitUntilNamespaceDeleted = g.Add(flow.Task{
+			Name:         "Waiting until Shoot namespace in source seed has been deleted",
+			Fn:           flow.TaskFn(botanist.WaitUntilSeedNamespaceDeleted),
+			Dependencies: flow.NewTaskIDs(deleteNamespace),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deleting shoot cluster information from source seed",
+			Fn:           flow.TaskFn(o.DeleteClusterResourceFromSeed).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilNamespaceDeleted),
+		})
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Logger: o.Logger}); err != nil {
+		o.Logger.Errorf("Failed to release source seed of Shoot %q: %+v", o.Shoot.Info.Name, err)
+		return gardencorev1alpha1helper.LastError(gardencorev1alpha1helper.FormatLastErrDescription(err), gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err))...)
+	}
+	return nil
+}
+
+func newBotanist(o *operation.Operation) (*botanistpkg.Botanist, *gardencorev1alpha1.LastError) {
+	var botanist *botanistpkg.Botanist
+	if err := utilretry.UntilTimeout(context.TODO(), 10*time.Second, 10*time.Minute, func(context.Context) (done bool, err error) {
+		botanist, err = botanistpkg.New(o)
+		if err != nil {
+			return utilretry.MinorError(err)
+		}
+		return utilretry.Ok()
+	}); err != nil {
+		return nil, gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to create a Botanist (%s)", err.Error()))
+	}
+
+	if err := botanist.RequiredExtensionsExist(); err != nil {
+		return nil, gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to check whether all required extensions exist (%s)", err.Error()))
+	}
+	return botanist, nil
+}
+
+func (c *Controller) updateShootStatusMigrateStart(o *operation.Operation, description string) error {
+	var (
+		now                 = metav1.NewTime(time.Now().UTC())
+		lastOperation       = o.Shoot.Info.Status.LastOperation
+		retryCycleStartTime = o.Shoot.Info.Status.RetryCycleStartTime
+	)
+
+	if retryCycleStartTime == nil || lastOperation == nil || lastOperation.Type != gardencorev1alpha1.LastOperationTypeMigrate || o.Shoot.Info.Generation != o.Shoot.Info.Status.ObservedGeneration {
+		retryCycleStartTime = &now
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			shoot.Status.RetryCycleStartTime = retryCycleStartTime
+			shoot.Status.Gardener = *o.GardenerInfo
+			shoot.Status.ObservedGeneration = o.Shoot.Info.Generation
+			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+				Type:           gardencorev1alpha1.LastOperationTypeMigrate,
+				State:          gardencorev1alpha1.LastOperationStateProcessing,
+				Progress:       1,
+				Description:    description,
+				LastUpdateTime: now,
+			}
+			return shoot, nil
+		})
+	if err == nil {
+		o.Shoot.Info = newShoot
+	}
+	return err
+}
+
+func (c *Controller) updateShootStatusMigrateSuccess(o *operation.Operation, sourceSeed string) error {
+	newShoot, err := kutil.TryUpdateShootAnnotations(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			if shoot.Annotations[common.ShootOperation] == common.ShootOperationMigrate {
+				delete(shoot.Annotations, common.ShootOperation)
+			}
+			delete(shoot.Annotations, common.ShootMigrationTargetSeed)
+			delete(shoot.Annotations, common.ShootMigrationSourceSeed)
+			return shoot, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	newShoot, err = kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, newShoot.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			shoot.Status.RetryCycleStartTime = nil
+			shoot.Status.Seed = o.Seed.Info.Name
+			shoot.Status.IsHibernated = &o.Shoot.HibernationEnabled
+			shoot.Status.LastError = nil
+			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+				Type:           gardencorev1alpha1.LastOperationTypeMigrate,
+				State:          gardencorev1alpha1.LastOperationStateSucceeded,
+				Progress:       100,
+				Description:    fmt.Sprintf("Shoot control plane has been successfully migrated from seed %s to seed %s.", sourceSeed, o.Seed.Info.Name),
+				LastUpdateTime: metav1.Now(),
+			}
+			return shoot, nil
+		})
+	if err == nil {
+		o.Shoot.Info = newShoot
+	}
+	return err
+}
+
+// updateShootStatusMigrateError reports the given error of the control plane migration. If <rollbackPossible> is true
+// then the Shoot has not yet been switched to the target Seed and the description explains how to roll back.
+func (c *Controller) updateShootStatusMigrateError(o *operation.Operation, lastError *gardencorev1alpha1.LastError, rollbackPossible bool) error {
+	var (
+		state       = gardencorev1alpha1.LastOperationStateFailed
+		description = lastError.Description
+		progress    = 1
+		willRetry   = !utils.TimeElapsed(o.Shoot.Info.Status.RetryCycleStartTime, c.config.Controllers.Shoot.RetryDuration.Duration)
+	)
+
+	if rollbackPossible {
+		description += fmt.Sprintf(" The migration can be rolled back by annotating the Shoot with %s=%s.", common.ShootOperation, common.ShootOperationReconcile)
+	} else {
+		description += " The Shoot has already been switched to the target seed, the migration can no longer be rolled back."
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			if willRetry {
+				description += " Operation will be retried."
+				state = gardencorev1alpha1.LastOperationStateError
+			} else {
+				shoot.Status.RetryCycleStartTime = nil
+			}
+
+			if lastOperation := shoot.Status.LastOperation; lastOperation != nil {
+				progress = lastOperation.Progress
+			}
+
+			shoot.Status.LastError = lastError
+			shoot.Status.LastOperation = &gardencorev1alpha1.LastOperation{
+				Type:           gardencorev1alpha1.LastOperationTypeMigrate,
+				State:          state,
+				Progress:       progress,
+				Description:    description,
+				LastUpdateTime: metav1.Now(),
+			}
+			shoot.Status.Gardener = *o.GardenerInfo
+			return shoot, nil
+		})
+	if err == nil {
+		o.Shoot.Info = newShoot
+	}
+	o.Logger.Error(description)
+
+	newShootAfterLabel, err := kutil.TryUpdateShootLabels(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta, StatusLabelTransform(StatusUnhealthy))
+	if err == nil {
+		o.Shoot.Info = newShootAfterLabel
+	}
+	return err
+}
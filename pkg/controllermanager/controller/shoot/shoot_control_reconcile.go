@@ -128,6 +128,11 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(botanist.DeployExternalDomainDNSRecord).DoIf(managedExternalDNS),
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying additional domain DNS records",
+			Fn:           flow.TaskFn(botanist.DeployAdditionalDomainDNSRecords),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		deployInfrastructure = g.Add(flow.Task{
 			Name:         "Deploying Shoot infrastructure",
 			Fn:           flow.TaskFn(botanist.DeployInfrastructure).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -154,7 +159,7 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		})
 		waitUntilEtcdReady = g.Add(flow.Task{
 			Name:         "Waiting until main and event etcd report readiness",
-			Fn:           flow.TaskFn(botanist.WaitUntilEtcdReady).SkipIf(o.Shoot.HibernationEnabled),
+			Fn:           flow.TaskFn(botanist.WaitUntilEtcdReady).SkipIf(o.Shoot.ControlPlaneHibernationEnabled),
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
 		deleteBackupInfrastructure = g.Add(flow.Task{
@@ -182,14 +187,20 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(botanist.ApplyEncryptionConfiguration).DoIf(enableEtcdEncryption),
 			Dependencies: flow.NewTaskIDs(deployNamespace),
 		})
+
+		// Extension resources are deployed by lifecycle phase. The ready tasks of all phases are collected so that
+		// extension resources can depend on extension resources of earlier phases.
+		extensionResourcesReady                             = map[string]flow.TaskID{}
+		waitUntilExtensionResourcesBeforeKubeAPIServerReady = addDeployExtensionResourcesTasks(g, botanist, gardencorev1alpha1.ControllerResourceLifecyclePhaseBeforeKubeAPIServer, flow.NewTaskIDs(deploySecrets, deployCloudProviderSecret), extensionResourcesReady, defaultInterval, defaultTimeout)
+
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name:         "Deploying Kubernetes API server",
 			Fn:           flow.SimpleTaskFn(hybridBotanist.DeployKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(deploySecrets, deployETCD, waitUntilEtcdReady, waitUntilKubeAPIServerServiceIsReady, waitUntilControlPlaneReady, createOrUpdateEtcdEncryptionConfiguration),
+			Dependencies: flow.NewTaskIDs(deploySecrets, deployETCD, waitUntilEtcdReady, waitUntilKubeAPIServerServiceIsReady, waitUntilControlPlaneReady, createOrUpdateEtcdEncryptionConfiguration, waitUntilExtensionResourcesBeforeKubeAPIServerReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
 			Name:         "Waiting until Kubernetes API server reports readiness",
-			Fn:           flow.TaskFn(botanist.WaitUntilKubeAPIServerReady).SkipIf(o.Shoot.HibernationEnabled),
+			Fn:           flow.TaskFn(botanist.WaitUntilKubeAPIServerReady).SkipIf(o.Shoot.ControlPlaneHibernationEnabled),
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
 		})
 		deployControlPlaneExposure = g.Add(flow.Task{
@@ -209,7 +220,7 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Rewriting Shoot secrets if EncryptionConfiguration has changed",
-			Fn:           flow.TaskFn(botanist.RewriteShootSecretsIfEncryptionConfigurationChanged).DoIf(enableEtcdEncryption && !o.Shoot.HibernationEnabled).RetryUntilTimeout(defaultInterval, 15*time.Minute),
+			Fn:           flow.TaskFn(botanist.RewriteShootSecretsIfEncryptionConfigurationChanged).DoIf(enableEtcdEncryption && !o.Shoot.ControlPlaneHibernationEnabled).RetryUntilTimeout(defaultInterval, 15*time.Minute),
 			Dependencies: flow.NewTaskIDs(initializeShootClients, createOrUpdateEtcdEncryptionConfiguration),
 		})
 		_ = g.Add(flow.Task{
@@ -249,7 +260,7 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		})
 		deployManagedResources = g.Add(flow.Task{
 			Name:         "Deploying managed resources",
-			Fn:           flow.TaskFn(botanist.DeployManagedResources).RetryUntilTimeout(defaultInterval, defaultTimeout).SkipIf(o.Shoot.HibernationEnabled),
+			Fn:           flow.TaskFn(botanist.DeployManagedResources).RetryUntilTimeout(defaultInterval, defaultTimeout).SkipIf(o.Shoot.ControlPlaneHibernationEnabled),
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, computeShootOSConfig),
 		})
 		deployWorker = g.Add(flow.Task{
@@ -262,6 +273,31 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 			Fn:           flow.TaskFn(botanist.WaitUntilWorkerReady),
 			Dependencies: flow.NewTaskIDs(deployWorker),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Removing the roll-worker-pools operation annotations",
+			Fn:           flow.TaskFn(botanist.RemoveRollWorkerPoolsAnnotations).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilWorkerReady),
+		})
+		deployContainerRuntimeResources = g.Add(flow.Task{
+			Name:         "Deploying container runtime resources",
+			Fn:           flow.TaskFn(botanist.DeployContainerRuntimeResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(initializeShootClients, waitUntilWorkerReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until container runtime resources are ready",
+			Fn:           flow.TaskFn(botanist.WaitUntilContainerRuntimeResourcesReady),
+			Dependencies: flow.NewTaskIDs(deployContainerRuntimeResources),
+		})
+		deleteStaleContainerRuntimeResources = g.Add(flow.Task{
+			Name:         "Deleting stale container runtime resources",
+			Fn:           flow.TaskFn(botanist.DeleteStaleContainerRuntimeResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(initializeShootClients),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until stale container runtime resources are deleted",
+			Fn:           flow.TaskFn(botanist.WaitUntilContainerRuntimeResourcesDeleted),
+			Dependencies: flow.NewTaskIDs(deleteStaleContainerRuntimeResources),
+		})
 		// kube2iam is deprecated and is kept here only for backwards compatibility reasons because some end-users may depend
 		// on it. It will be removed very soon in the future.
 		_ = g.Add(flow.Task{
@@ -276,7 +312,7 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		})
 		waitUntilVPNConnectionExists = g.Add(flow.Task{
 			Name:         "Waiting until the Kubernetes API server can connect to the Shoot workers",
-			Fn:           flow.TaskFn(botanist.WaitUntilVPNConnectionExists).SkipIf(o.Shoot.HibernationEnabled),
+			Fn:           flow.TaskFn(botanist.WaitUntilVPNConnectionExists).SkipIf(o.Shoot.AllWorkerPoolsHibernated()),
 			Dependencies: flow.NewTaskIDs(deployManagedResources, waitUntilNetworkIsReady, waitUntilWorkerReady),
 		})
 		deploySeedMonitoring = g.Add(flow.Task{
@@ -301,19 +337,12 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Hibernating control plane",
-			Fn:           flow.TaskFn(botanist.HibernateControlPlane).RetryUntilTimeout(defaultInterval, 2*time.Minute).DoIf(o.Shoot.HibernationEnabled),
+			Fn:           flow.TaskFn(botanist.HibernateControlPlane).RetryUntilTimeout(defaultInterval, 2*time.Minute).DoIf(o.Shoot.ControlPlaneHibernationEnabled),
 			Dependencies: flow.NewTaskIDs(initializeShootClients, deploySeedMonitoring, deploySeedLogging, deployClusterAutoscaler),
 		})
-		deployExtensionResources = g.Add(flow.Task{
-			Name:         "Deploying extension resources",
-			Fn:           flow.TaskFn(botanist.DeployExtensionResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(initializeShootClients),
-		})
-		_ = g.Add(flow.Task{
-			Name:         "Waiting until extension resources are ready",
-			Fn:           flow.TaskFn(botanist.WaitUntilExtensionResourcesReady),
-			Dependencies: flow.NewTaskIDs(deployExtensionResources),
-		})
+		_ = addDeployExtensionResourcesTasks(g, botanist, gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer, flow.NewTaskIDs(initializeShootClients), extensionResourcesReady, defaultInterval, defaultTimeout)
+		_ = addDeployExtensionResourcesTasks(g, botanist, gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterWorker, flow.NewTaskIDs(initializeShootClients, waitUntilWorkerReady), extensionResourcesReady, defaultInterval, defaultTimeout)
+
 		deleteStaleExtensionResources = g.Add(flow.Task{
 			Name:         "Delete stale extension resources",
 			Fn:           flow.TaskFn(botanist.DeleteStaleExtensionResources).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -321,7 +350,7 @@ func (c *Controller) runReconcileShootFlow(o *operation.Operation, operationType
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until stale extension resources are deleted",
-			Fn:           flow.TaskFn(botanist.WaitUntilExtensionResourcesDeleted).SkipIf(o.Shoot.HibernationEnabled),
+			Fn:           flow.TaskFn(botanist.WaitUntilExtensionResourcesDeleted).SkipIf(o.Shoot.ControlPlaneHibernationEnabled),
 			Dependencies: flow.NewTaskIDs(deleteStaleExtensionResources),
 		})
 		f = g.Compile()
@@ -0,0 +1,228 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation"
+	cloudbotanistpkg "github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	hybridbotanistpkg "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+	utilerrors "github.com/gardener/gardener/pkg/utils/errors"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// isETCDRestoreRequested returns true if the main etcd of the given Shoot shall be restored to an earlier point in time.
+func isETCDRestoreRequested(shoot *gardenv1beta1.Shoot) bool {
+	return shoot.Annotations[common.ShootOperation] == common.ShootOperationRestoreETCD
+}
+
+// restoreShootETCD restores the main etcd of the Shoot to the point in time given in its annotations. The control plane
+// is scaled down while the etcd is restored and brought back by the regular reconciliation flow afterwards. The result
+// is recorded in the ETCDRestored condition of the Shoot.
+func (c *Controller) restoreShootETCD(shoot *gardenv1beta1.Shoot, o *operation.Operation) (reconcile.Result, error) {
+	if shoot.Spec.Cloud.Seed == nil {
+		return reconcile.Result{}, fmt.Errorf("shoot %s/%s has not yet been scheduled on a Seed", shoot.Namespace, shoot.Name)
+	}
+
+	if common.ShouldIgnoreShoot(c.respectSyncPeriodOverwrite(), shoot) {
+		o.Logger.Info("Shoot is being ignored")
+		return reconcile.Result{}, nil
+	}
+	if common.IsShootFailed(shoot) {
+		o.Logger.Info("Shoot is failed")
+		return reconcile.Result{}, nil
+	}
+
+	target, err := common.ParseETCDRestoreTarget(shoot.Annotations[common.ShootETCDRestoreTarget])
+	if err != nil {
+		return reconcile.Result{}, c.rejectETCDRestore(o, err.Error())
+	}
+	if o.Shoot.HibernationEnabled {
+		return reconcile.Result{}, c.rejectETCDRestore(o, "the etcd of a hibernated Shoot cannot be restored")
+	}
+	if o.Seed.Info.Spec.Backup == nil {
+		return reconcile.Result{}, c.rejectETCDRestore(o, fmt.Sprintf("seed %s does not have backups enabled", o.Seed.Info.Name))
+	}
+
+	if err := c.checkSeedAndSyncClusterResource(shoot, o); err != nil {
+		message := fmt.Sprintf("Shoot cannot be synced with Seed: %v", err)
+		c.recorder.Event(shoot, corev1.EventTypeNormal, gardenv1beta1.EventOperationPending, message)
+		return reconcile.Result{}, utilerrors.WithSuppressed(err, c.updateShootStatusProcessing(shoot, message))
+	}
+
+	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventETCDRestoring, "Restoring main etcd to %s", target)
+	if err := c.updateShootStatusReconcileStart(o, gardencorev1alpha1.LastOperationTypeReconcile); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	revision, lastErr := c.runRestoreETCDFlow(o, target)
+	if lastErr != nil {
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.ShootEventETCDRestoreError, lastErr.Description)
+		return reconcile.Result{}, utilerrors.WithSuppressed(errors.New(lastErr.Description), c.updateShootStatusReconcileError(o, gardencorev1alpha1.LastOperationTypeReconcile, lastErr))
+	}
+
+	// The annotations are removed right after the etcd has been restored, otherwise a failure in the following
+	// reconciliation would restore the etcd again and discard all changes made in the meantime.
+	message := fmt.Sprintf("Main etcd has been restored to revision %d (requested: %s). Newer snapshots have been moved to %q in the backup bucket.", revision, target, common.GenerateBackupEntryETCDMainFencedPrefix(common.GenerateBackupEntryName(o.Shoot.SeedNamespace, o.Shoot.Info.Status.UID), revision))
+	if err := c.finishETCDRestore(o, gardencorev1alpha1.ConditionTrue, "ETCDRestored", message); err != nil {
+		return reconcile.Result{}, err
+	}
+	c.recorder.Event(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventETCDRestored, message)
+
+	if err := c.runReconcileShootFlow(o, gardencorev1alpha1.LastOperationTypeReconcile); err != nil {
+		c.recorder.Event(shoot, corev1.EventTypeWarning, gardenv1beta1.EventReconcileError, err.Description)
+		return reconcile.Result{}, utilerrors.WithSuppressed(errors.New(err.Description), c.updateShootStatusReconcileError(o, gardencorev1alpha1.LastOperationTypeReconcile, err))
+	}
+
+	c.recorder.Event(shoot, corev1.EventTypeNormal, gardenv1beta1.EventReconciled, "Reconciled Shoot cluster state")
+	if err := c.updateShootStatusReconcileSuccess(o, gardencorev1alpha1.LastOperationTypeReconcile); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	durationUntilNextSync := c.durationUntilNextShootSync(shoot)
+	c.recorder.Event(shoot, corev1.EventTypeNormal, "ScheduledNextSync", fmt.Sprintf("Scheduled next queuing time for Shoot in %s (%s)", durationUntilNextSync, time.Now().UTC().Add(durationUntilNextSync)))
+	return reconcile.Result{RequeueAfter: durationUntilNextSync}, nil
+}
+
+// runRestoreETCDFlow restores the main etcd of the Shoot of the given operation to the given target and returns the
+// revision it has been restored to. The control plane remains scaled down.
+func (c *Controller) runRestoreETCDFlow(o *operation.Operation, target *common.ETCDRestoreTarget) (int64, *gardencorev1alpha1.LastError) {
+	botanist, lastErr := newBotanist(o)
+	if lastErr != nil {
+		return 0, lastErr
+	}
+	seedCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeSeed)
+	if err != nil {
+		return 0, gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to create a Seed CloudBotanist (%s)", err.Error()))
+	}
+	shootCloudBotanist, err := cloudbotanistpkg.New(o, common.CloudPurposeShoot)
+	if err != nil {
+		return 0, gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to create a Shoot CloudBotanist (%s)", err.Error()))
+	}
+	hybridBotanist, err := hybridbotanistpkg.New(o, botanist, seedCloudBotanist, shootCloudBotanist)
+	if err != nil {
+		return 0, gardencorev1alpha1helper.LastError(fmt.Sprintf("Failed to create a HybridBotanist (%s)", err.Error()))
+	}
+
+	var (
+		revision        int64
+		defaultInterval = 5 * time.Second
+
+		g                 = flow.NewGraph("Shoot etcd restoration")
+		determineRevision = g.Add(flow.Task{
+			Name: "Determining etcd snapshots to restore",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				var err error
+				revision, err = hybridBotanist.DetermineETCDRestoreRevision(ctx, target)
+				return err
+			}),
+		})
+		scaleDownControlPlane = g.Add(flow.Task{
+			Name:         "Scaling down control plane",
+			Fn:           flow.TaskFn(botanist.ScaleDownControlPlane).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+			Dependencies: flow.NewTaskIDs(determineRevision),
+		})
+		scaleDownETCD = g.Add(flow.Task{
+			Name:         "Scaling down etcd",
+			Fn:           flow.TaskFn(botanist.ScaleDownETCD),
+			Dependencies: flow.NewTaskIDs(scaleDownControlPlane),
+		})
+		fenceETCDMainSnapshots = g.Add(flow.Task{
+			Name: "Fencing etcd snapshots newer than the restored revision",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return hybridBotanist.FenceETCDMainSnapshots(ctx, revision)
+			}),
+			Dependencies: flow.NewTaskIDs(scaleDownETCD),
+		})
+		deleteETCDMainVolume = g.Add(flow.Task{
+			Name:         "Deleting volume of main etcd",
+			Fn:           flow.TaskFn(botanist.DeleteETCDMainVolume),
+			Dependencies: flow.NewTaskIDs(fenceETCDMainSnapshots),
+		})
+		deployETCD = g.Add(flow.Task{
+			Name:         "Restoring main etcd from snapshots",
+			Fn:           flow.TaskFn(hybridBotanist.DeployETCD),
+			Dependencies: flow.NewTaskIDs(deleteETCDMainVolume),
+		})
+		waitUntilETCDMainReady = g.Add(flow.Task{
+			Name:         "Waiting until main etcd has been restored",
+			Fn:           flow.TaskFn(botanist.WaitUntilEtcdMainReady),
+			Dependencies: flow.NewTaskIDs(deployETCD),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Verifying the revision of the restored main etcd",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return hybridBotanist.VerifyETCDMainRevision(ctx, revision)
+			}).RetryUntilTimeout(defaultInterval, 2*time.Minute),
+			Dependencies: flow.NewTaskIDs(waitUntilETCDMainReady),
+		})
+		f = g.Compile()
+	)
+
+	if err := f.Run(flow.Opts{Logger: o.Logger, ProgressReporter: o.ReportShootProgress}); err != nil {
+		o.Logger.Errorf("Failed to restore etcd of Shoot %q: %+v", o.Shoot.Info.Name, err)
+		return 0, gardencorev1alpha1helper.LastError(gardencorev1alpha1helper.FormatLastErrDescription(err), gardencorev1alpha1helper.ExtractErrorCodes(flow.Causes(err))...)
+	}
+
+	o.Logger.Infof("Successfully restored etcd of Shoot %q to revision %d", o.Shoot.Info.Name, revision)
+	return revision, nil
+}
+
+// rejectETCDRestore records that the requested etcd restoration cannot be performed. The Shoot is left untouched.
+func (c *Controller) rejectETCDRestore(o *operation.Operation, message string) error {
+	c.recorder.Event(o.Shoot.Info, corev1.EventTypeWarning, gardenv1beta1.ShootEventETCDRestoreError, message)
+	return c.finishETCDRestore(o, gardencorev1alpha1.ConditionFalse, "ETCDRestoreRejected", message)
+}
+
+// finishETCDRestore removes the etcd restore annotations from the Shoot and records the result in its ETCDRestored
+// condition.
+func (c *Controller) finishETCDRestore(o *operation.Operation, status gardencorev1alpha1.ConditionStatus, reason, message string) error {
+	newShoot, err := kutil.TryUpdateShootAnnotations(c.k8sGardenClient.Garden(), retry.DefaultRetry, o.Shoot.Info.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			if isETCDRestoreRequested(shoot) {
+				delete(shoot.Annotations, common.ShootOperation)
+			}
+			delete(shoot.Annotations, common.ShootETCDRestoreTarget)
+			return shoot, nil
+		})
+	if err != nil {
+		return err
+	}
+
+	newShoot, err = kutil.TryUpdateShootConditions(c.k8sGardenClient.Garden(), retry.DefaultRetry, newShoot.ObjectMeta,
+		func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			condition := gardencorev1alpha1helper.GetOrInitCondition(shoot.Status.Conditions, gardenv1beta1.ShootETCDRestored)
+			condition = gardencorev1alpha1helper.UpdatedCondition(condition, status, reason, message)
+			shoot.Status.Conditions = gardencorev1alpha1helper.MergeConditions(shoot.Status.Conditions, condition)
+			return shoot, nil
+		})
+	if err == nil {
+		o.Shoot.Info = newShoot
+	}
+	return err
+}
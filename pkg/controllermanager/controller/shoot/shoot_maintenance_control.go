@@ -31,7 +31,6 @@ import (
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/externalversions/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
-	controllerutils "github.com/gardener/gardener/pkg/controllermanager/controller/utils"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -100,9 +99,61 @@ func (c *Controller) reconcileShootMaintenanceKey(key string) error {
 		return nil
 	}
 
+	if !hasMaintainNowAnnotation(shoot) {
+		blackoutWindow, err := c.activeMaintenanceBlackoutWindow(shoot)
+		if err != nil {
+			log.WithError(err).Error("[SHOOT MAINTENANCE] - unable to determine the maintenance blackout windows")
+			return nil
+		}
+		if blackoutWindow != nil {
+			msg := fmt.Sprintf("Skipped automatic maintenance because of blackout window %q (%s)", blackoutWindow.Name, maintenanceBlackoutWindowReason(blackoutWindow))
+			logger.Logger.Infof("[SHOOT MAINTENANCE] %s - %s", key, msg)
+			c.recorder.Event(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMaintenanceSkipped, msg)
+			return nil
+		}
+	}
+
 	return c.maintenanceControl.Maintain(shoot, key)
 }
 
+// activeMaintenanceBlackoutWindow returns the maintenance blackout window which currently applies to the given Shoot,
+// if any. Blackout windows are either defined for the whole Garden cluster or in the Project of the Shoot.
+func (c *Controller) activeMaintenanceBlackoutWindow(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.MaintenanceBlackoutWindow, error) {
+	project, err := common.ProjectForNamespace(c.projectLister, shoot.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return helper.ActiveMaintenanceBlackoutWindow(shoot, MaintenanceBlackoutWindows(&c.config.Controllers.ShootMaintenance, project), time.Now())
+}
+
+// MaintenanceBlackoutWindows returns the maintenance blackout windows which apply to the Shoots of the given Project,
+// i.e., the blackout windows of the Garden cluster followed by the ones of the Project. The Project may be nil.
+func MaintenanceBlackoutWindows(config *config.ShootMaintenanceControllerConfiguration, project *gardenv1beta1.Project) []gardenv1beta1.MaintenanceBlackoutWindow {
+	var windows []gardenv1beta1.MaintenanceBlackoutWindow
+	for _, window := range config.BlackoutWindows {
+		windows = append(windows, gardenv1beta1.MaintenanceBlackoutWindow{
+			Name:          window.Name,
+			Begin:         window.Begin,
+			End:           window.End,
+			Reason:        window.Reason,
+			ShootSelector: window.ShootSelector,
+		})
+	}
+
+	if project != nil {
+		windows = append(windows, project.Spec.MaintenanceBlackoutWindows...)
+	}
+	return windows
+}
+
+func maintenanceBlackoutWindowReason(window *gardenv1beta1.MaintenanceBlackoutWindow) string {
+	if window.Reason == nil {
+		return fmt.Sprintf("until %s", window.End.UTC())
+	}
+	return fmt.Sprintf("%s, until %s", *window.Reason, window.End.UTC())
+}
+
 // newRandomTimeWindow computes a new random time window either for today or the next day (depending on <today>).
 func (c *Controller) shootMaintenanceRequeue(key string, shoot *gardenv1beta1.Shoot) {
 	var (
@@ -122,16 +173,18 @@ type MaintenanceControlInterface interface {
 // NewDefaultMaintenanceControl returns a new instance of the default implementation MaintenanceControlInterface that
 // implements the documented semantics for maintaining Shoots. You should use an instance returned from
 // NewDefaultMaintenanceControl() for any scenario other than testing.
-func NewDefaultMaintenanceControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, recorder record.EventRecorder) MaintenanceControlInterface {
-	return &defaultMaintenanceControl{k8sGardenClient, k8sGardenInformers, secrets, imageVector, identity, recorder}
+func NewDefaultMaintenanceControl(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformers.Interface, taskRegistry MaintenanceTaskRegistry, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, identity *gardenv1beta1.Gardener, config *config.ShootMaintenanceControllerConfiguration, recorder record.EventRecorder) MaintenanceControlInterface {
+	return &defaultMaintenanceControl{k8sGardenClient, k8sGardenInformers, taskRegistry, secrets, imageVector, identity, config, recorder}
 }
 
 type defaultMaintenanceControl struct {
 	k8sGardenClient    kubernetes.Interface
 	k8sGardenInformers gardeninformers.Interface
+	taskRegistry       MaintenanceTaskRegistry
 	secrets            map[string]*corev1.Secret
 	imageVector        imagevector.ImageVector
 	identity           *gardenv1beta1.Gardener
+	config             *config.ShootMaintenanceControllerConfiguration
 	recorder           record.EventRecorder
 }
 
@@ -158,60 +211,71 @@ func (c *defaultMaintenanceControl) Maintain(shootObj *gardenv1beta1.Shoot, key
 		return nil
 	}
 
-	defaultMachineImage, machineImages, err := MaintainMachineImages(operation.Shoot.Info, operation.Shoot.CloudProfile, operation.Shoot.GetDefaultMachineImage(), operation.Shoot.GetMachineImages())
-	if err != nil {
-		// continue execution to allow the kubernetes version update
-		handleError(fmt.Sprintf("Could not maintain machine image version: %s", err.Error()))
-	}
-
-	var updateDefaultMachineImage func(s *gardenv1beta1.Cloud)
-	if defaultMachineImage != nil {
-		updateDefaultMachineImage = helper.UpdateDefaultMachineImage(operation.Shoot.CloudProvider, defaultMachineImage)
-	}
-
-	var updateWorkerMachineImages func(s *gardenv1beta1.Cloud)
-	if len(machineImages) > 0 {
-		updateWorkerMachineImages = helper.UpdateMachineImages(operation.Shoot.CloudProvider, machineImages)
-	}
-
-	updatedVersion, err := MaintainKubernetesVersion(operation.Shoot.Info, operation.Shoot.CloudProfile)
-	if err != nil {
-		// continue execution to allow the kubernetes version update
-		handleError(fmt.Sprintf("Could not maintain kubernetes version: %s", err.Error()))
-	}
+	var (
+		tasks            = c.taskRegistry.EnabledTasks(c.config.Tasks)
+		taskStatuses     = make([]gardenv1beta1.MaintenanceTaskStatus, 0, len(tasks))
+		maintenanceFuncs []MaintenanceFunc
+	)
 
-	// Check if the CloudProfile contains a newer Kubernetes patch version.
-	var updateKubernetesVersion func(s *gardenv1beta1.Kubernetes)
-	if updatedVersion != nil {
-		updateKubernetesVersion = func(s *gardenv1beta1.Kubernetes) { s.Version = *updatedVersion }
+	for _, task := range tasks {
+		maintenanceFunc, description, err := task.Prepare(operation)
+		switch {
+		case err != nil:
+			// continue execution to allow the remaining tasks to maintain the Shoot
+			handleError(fmt.Sprintf("Maintenance task %q failed: %s", task.Name(), err.Error()))
+			taskStatuses = append(taskStatuses, gardenv1beta1.MaintenanceTaskStatus{Name: task.Name(), State: gardenv1beta1.MaintenanceTaskStateFailed, Description: err.Error()})
+		case maintenanceFunc == nil:
+			taskStatuses = append(taskStatuses, gardenv1beta1.MaintenanceTaskStatus{Name: task.Name(), State: gardenv1beta1.MaintenanceTaskStateSkipped})
+		default:
+			shootLogger.Infof("[SHOOT MAINTENANCE] Task %q: %s", task.Name(), description)
+			maintenanceFuncs = append(maintenanceFuncs, maintenanceFunc)
+			taskStatuses = append(taskStatuses, gardenv1beta1.MaintenanceTaskStatus{Name: task.Name(), State: gardenv1beta1.MaintenanceTaskStateSucceeded, Description: description})
+		}
 	}
 
 	// Update the Shoot resource object.
-	_, err = kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+	_, updateErr := kutil.TryUpdateShoot(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
 		if !apiequality.Semantic.DeepEqual(shootObj.Spec.Maintenance.AutoUpdate, s.Spec.Maintenance.AutoUpdate) {
 			return nil, fmt.Errorf("auto update section of Shoot %s/%s changed mid-air", s.Namespace, s.Name)
 		}
 
+		if s.Annotations == nil {
+			s.Annotations = make(map[string]string)
+		}
 		delete(s.Annotations, common.ShootOperation)
 
-		controllerutils.AddTasks(s.Annotations, common.ShootTaskDeployInfrastructure, common.ShootTaskDeployKube2IAMResource)
-		s.Annotations[common.ShootOperation] = common.ShootOperationReconcile
-
-		if updateDefaultMachineImage != nil {
-			updateDefaultMachineImage(&s.Spec.Cloud)
+		for _, maintenanceFunc := range maintenanceFuncs {
+			maintenanceFunc(s)
 		}
-		if updateWorkerMachineImages != nil {
-			updateWorkerMachineImages(&s.Spec.Cloud)
+
+		s.Annotations[common.ShootOperation] = common.ShootOperationReconcile
+		return s, nil
+	})
+	if updateErr != nil {
+		handleError(fmt.Sprintf("Could not update the Shoot specification: %s", updateErr.Error()))
+		for i, taskStatus := range taskStatuses {
+			if taskStatus.State == gardenv1beta1.MaintenanceTaskStateSucceeded {
+				taskStatuses[i].State = gardenv1beta1.MaintenanceTaskStateFailed
+				taskStatuses[i].Description = fmt.Sprintf("could not update the Shoot specification: %s", updateErr.Error())
+			}
 		}
-		if updateKubernetesVersion != nil {
-			updateKubernetesVersion(&s.Spec.Kubernetes)
+	}
+
+	if _, err := kutil.TryUpdateShootStatus(c.k8sGardenClient.Garden(), retry.DefaultBackoff, shoot.ObjectMeta, func(s *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+		s.Status.Maintenance = &gardenv1beta1.ShootMaintenanceStatus{
+			LastMaintenanceTime: metav1.Now(),
+			Tasks:               taskStatuses,
 		}
 		return s, nil
-	})
-	if err != nil {
-		handleError(fmt.Sprintf("Could not update the Shoot specification: %s", err.Error()))
+	}); err != nil {
+		handleError(fmt.Sprintf("Could not update the Shoot maintenance status: %s", err.Error()))
 		return nil
 	}
+
+	if updateErr != nil {
+		return nil
+	}
+
 	msg := "Completed; updated the Shoot specification successfully."
 	shootLogger.Infof("[SHOOT MAINTENANCE] %s", msg)
 	c.recorder.Eventf(shoot, corev1.EventTypeNormal, gardenv1beta1.ShootEventMaintenanceDone, "[%s] %s", operationID, msg)
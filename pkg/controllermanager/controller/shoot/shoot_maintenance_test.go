@@ -24,6 +24,9 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/operation"
 )
 
 var _ = Describe("Shoot Maintenance", func() {
@@ -313,4 +316,178 @@ var _ = Describe("Shoot Maintenance", func() {
 		})
 
 	})
+
+	Context("Maintenance Blackout Windows", func() {
+		var (
+			now    = time.Date(2019, time.December, 24, 12, 0, 0, 0, time.UTC)
+			shoot  *gardenv1beta1.Shoot
+			cfg    *config.ShootMaintenanceControllerConfiguration
+			reason = "year-end freeze"
+		)
+
+		BeforeEach(func() {
+			shoot = &gardenv1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: "garden-dev",
+					Labels:    map[string]string{"purpose": "production"},
+				},
+			}
+			cfg = &config.ShootMaintenanceControllerConfiguration{
+				BlackoutWindows: []config.MaintenanceBlackoutWindow{
+					{
+						Name:   "garden",
+						Begin:  metav1.NewTime(now.Add(time.Hour)),
+						End:    metav1.NewTime(now.Add(2 * time.Hour)),
+						Reason: &reason,
+					},
+				},
+			}
+		})
+
+		It("should return the blackout windows of the garden cluster if there is no project", func() {
+			windows := MaintenanceBlackoutWindows(cfg, nil)
+
+			Expect(windows).To(Equal([]gardenv1beta1.MaintenanceBlackoutWindow{
+				{
+					Name:   "garden",
+					Begin:  metav1.NewTime(now.Add(time.Hour)),
+					End:    metav1.NewTime(now.Add(2 * time.Hour)),
+					Reason: &reason,
+				},
+			}))
+		})
+
+		It("should return the blackout windows of the garden cluster followed by the ones of the project", func() {
+			project := &gardenv1beta1.Project{
+				Spec: gardenv1beta1.ProjectSpec{
+					MaintenanceBlackoutWindows: []gardenv1beta1.MaintenanceBlackoutWindow{
+						{Name: "project", Begin: metav1.NewTime(now), End: metav1.NewTime(now.Add(time.Hour))},
+					},
+				},
+			}
+
+			windows := MaintenanceBlackoutWindows(cfg, project)
+
+			Expect(windows).To(HaveLen(2))
+			Expect(windows[0].Name).To(Equal("garden"))
+			Expect(windows[1].Name).To(Equal("project"))
+		})
+
+		It("should only consider the windows which contain the current time", func() {
+			window, err := helper.ActiveMaintenanceBlackoutWindow(shoot, MaintenanceBlackoutWindows(cfg, nil), now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).To(BeNil())
+
+			window, err = helper.ActiveMaintenanceBlackoutWindow(shoot, MaintenanceBlackoutWindows(cfg, nil), now.Add(90*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).NotTo(BeNil())
+			Expect(window.Name).To(Equal("garden"))
+		})
+
+		It("should only consider the project windows whose shoot selector matches the shoot", func() {
+			project := &gardenv1beta1.Project{
+				Spec: gardenv1beta1.ProjectSpec{
+					MaintenanceBlackoutWindows: []gardenv1beta1.MaintenanceBlackoutWindow{
+						{
+							Name:          "development",
+							Begin:         metav1.NewTime(now),
+							End:           metav1.NewTime(now.Add(time.Hour)),
+							ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "development"}},
+						},
+						{
+							Name:          "production",
+							Begin:         metav1.NewTime(now),
+							End:           metav1.NewTime(now.Add(time.Hour)),
+							ShootSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "production"}},
+						},
+					},
+				},
+			}
+
+			window, err := helper.ActiveMaintenanceBlackoutWindow(shoot, MaintenanceBlackoutWindows(cfg, project), now)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).NotTo(BeNil())
+			Expect(window.Name).To(Equal("production"))
+		})
+	})
+
+	Context("Maintenance Task Registry", func() {
+		It("should return the default tasks in the order of their registration", func() {
+			tasks := NewDefaultMaintenanceTaskRegistry().EnabledTasks(nil)
+
+			Expect(taskNames(tasks)).To(Equal([]string{
+				MaintenanceTaskMachineImageVersion,
+				MaintenanceTaskKubernetesVersion,
+				MaintenanceTaskInfrastructureReconciliation,
+			}))
+		})
+
+		It("should not return tasks which are disabled in the configuration", func() {
+			tasks := NewDefaultMaintenanceTaskRegistry().EnabledTasks(map[string]bool{
+				MaintenanceTaskKubernetesVersion: false,
+				"unknown-task":                   true,
+			})
+
+			Expect(taskNames(tasks)).To(Equal([]string{
+				MaintenanceTaskMachineImageVersion,
+				MaintenanceTaskInfrastructureReconciliation,
+			}))
+		})
+
+		It("should return tasks which are disabled by default only if they are enabled in the configuration", func() {
+			registry := NewMaintenanceTaskRegistry()
+			Expect(registry.Register(&fakeMaintenanceTask{name: "foo"})).To(Succeed())
+
+			Expect(registry.EnabledTasks(nil)).To(BeEmpty())
+			Expect(taskNames(registry.EnabledTasks(map[string]bool{"foo": true}))).To(Equal([]string{"foo"}))
+		})
+
+		It("should accept a configuration which only refers to registered tasks", func() {
+			Expect(NewDefaultMaintenanceTaskRegistry().Validate(map[string]bool{
+				MaintenanceTaskKubernetesVersion:            false,
+				MaintenanceTaskInfrastructureReconciliation: true,
+			})).To(Succeed())
+		})
+
+		It("should reject a configuration which refers to unknown tasks", func() {
+			err := NewDefaultMaintenanceTaskRegistry().Validate(map[string]bool{
+				MaintenanceTaskKubernetesVersion: false,
+				"unknown-task":                   true,
+			})
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown-task"))
+		})
+
+		It("should forbid registering a task twice", func() {
+			registry := NewMaintenanceTaskRegistry()
+			Expect(registry.Register(&fakeMaintenanceTask{name: "foo"})).To(Succeed())
+			Expect(registry.Register(&fakeMaintenanceTask{name: "foo"})).NotTo(Succeed())
+		})
+	})
 })
+
+type fakeMaintenanceTask struct {
+	name string
+}
+
+func (t *fakeMaintenanceTask) Name() string {
+	return t.name
+}
+
+func (t *fakeMaintenanceTask) EnabledByDefault() bool {
+	return false
+}
+
+func (t *fakeMaintenanceTask) Prepare(o *operation.Operation) (MaintenanceFunc, string, error) {
+	return nil, "", nil
+}
+
+func taskNames(tasks []MaintenanceTask) []string {
+	var names []string
+	for _, task := range tasks {
+		names = append(names, task.Name())
+	}
+	return names
+}
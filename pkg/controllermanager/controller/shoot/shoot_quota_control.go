@@ -1,6 +1,7 @@
 package shoot
 
 import (
+	"strconv"
 	"time"
 
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
@@ -125,6 +126,11 @@ func (c *defaultQuotaControl) CheckQuota(shootObj *gardenv1beta1.Shoot, key stri
 	}
 
 	if time.Now().After(expirationTimeParsed) {
+		if protected, _ := strconv.ParseBool(shoot.Annotations[common.DeletionProtection]); protected {
+			shootLogger.Infof("[SHOOT QUOTA] Shoot cluster lifetime expired, but the Shoot is protected against deletion (%q annotation).", common.DeletionProtection)
+			return nil
+		}
+
 		shootLogger.Info("[SHOOT QUOTA] Shoot cluster lifetime expired. Shoot will be deleted.")
 
 		// We have to annotate the Shoot to confirm the deletion.
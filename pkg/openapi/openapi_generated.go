@@ -49,6 +49,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupEntryList":                       schema_pkg_apis_core_v1alpha1_BackupEntryList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupEntrySpec":                       schema_pkg_apis_core_v1alpha1_BackupEntrySpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupEntryStatus":                     schema_pkg_apis_core_v1alpha1_BackupEntryStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupLimits":                          schema_pkg_apis_core_v1alpha1_BackupLimits(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI":                                   schema_pkg_apis_core_v1alpha1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudInfo":                             schema_pkg_apis_core_v1alpha1_CloudInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudProfile":                          schema_pkg_apis_core_v1alpha1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CloudProfileList":                      schema_pkg_apis_core_v1alpha1_CloudProfileList(ref),
@@ -56,7 +58,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterAutoscaler":                     schema_pkg_apis_core_v1alpha1_ClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ClusterInfo":                           schema_pkg_apis_core_v1alpha1_ClusterInfo(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition":                             schema_pkg_apis_core_v1alpha1_Condition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ContainerRuntime":                      schema_pkg_apis_core_v1alpha1_ContainerRuntime(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeployment":                  schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentRollout":           schema_pkg_apis_core_v1alpha1_ControllerDeploymentRollout(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentVersion":           schema_pkg_apis_core_v1alpha1_ControllerDeploymentVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallation":                schema_pkg_apis_core_v1alpha1_ControllerInstallation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationList":            schema_pkg_apis_core_v1alpha1_ControllerInstallationList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerInstallationSpec":            schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref),
@@ -68,6 +73,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS":                                   schema_pkg_apis_core_v1alpha1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSIncludeExclude":                     schema_pkg_apis_core_v1alpha1_DNSIncludeExclude(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSProvider":                           schema_pkg_apis_core_v1alpha1_DNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSProviderStatus":                     schema_pkg_apis_core_v1alpha1_DNSProviderStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDSnapshot":                          schema_pkg_apis_core_v1alpha1_ETCDSnapshot(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.EgressDestination":                     schema_pkg_apis_core_v1alpha1_EgressDestination(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Endpoint":                              schema_pkg_apis_core_v1alpha1_Endpoint(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ExpirableVersion":                      schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension":                             schema_pkg_apis_core_v1alpha1_Extension(ref),
@@ -97,6 +105,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MachineTypeStorage":                    schema_pkg_apis_core_v1alpha1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance":                           schema_pkg_apis_core_v1alpha1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceAutoUpdate":                 schema_pkg_apis_core_v1alpha1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceBlackoutWindow":             schema_pkg_apis_core_v1alpha1_MaintenanceBlackoutWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceTaskStatus":                 schema_pkg_apis_core_v1alpha1_MaintenanceTaskStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceTimeWindow":                 schema_pkg_apis_core_v1alpha1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking":                            schema_pkg_apis_core_v1alpha1_Networking(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.NginxIngress":                          schema_pkg_apis_core_v1alpha1_NginxIngress(ref),
@@ -121,8 +131,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SecretBindingList":                     schema_pkg_apis_core_v1alpha1_SecretBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Seed":                                  schema_pkg_apis_core_v1alpha1_Seed(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedBackup":                            schema_pkg_apis_core_v1alpha1_SeedBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedCapacity":                          schema_pkg_apis_core_v1alpha1_SeedCapacity(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedDNS":                               schema_pkg_apis_core_v1alpha1_SeedDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedList":                              schema_pkg_apis_core_v1alpha1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedLogging":                           schema_pkg_apis_core_v1alpha1_SeedLogging(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedNetworks":                          schema_pkg_apis_core_v1alpha1_SeedNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedProvider":                          schema_pkg_apis_core_v1alpha1_SeedProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedSpec":                              schema_pkg_apis_core_v1alpha1_SeedSpec(ref),
@@ -132,15 +144,30 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedVolumeProvider":                    schema_pkg_apis_core_v1alpha1_SeedVolumeProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ServiceAccountConfig":                  schema_pkg_apis_core_v1alpha1_ServiceAccountConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Shoot":                                 schema_pkg_apis_core_v1alpha1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootAutoRepairStatus":                 schema_pkg_apis_core_v1alpha1_ShootAutoRepairStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackup":                           schema_pkg_apis_core_v1alpha1_ShootBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackupCatalog":                    schema_pkg_apis_core_v1alpha1_ShootBackupCatalog(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootClone":                            schema_pkg_apis_core_v1alpha1_ShootClone(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCloneSpec":                        schema_pkg_apis_core_v1alpha1_ShootCloneSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootList":                             schema_pkg_apis_core_v1alpha1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootMachineImage":                     schema_pkg_apis_core_v1alpha1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootMaintenanceStatus":                schema_pkg_apis_core_v1alpha1_ShootMaintenanceStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootNetworks":                         schema_pkg_apis_core_v1alpha1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootSpec":                             schema_pkg_apis_core_v1alpha1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootStatus":                           schema_pkg_apis_core_v1alpha1_ShootStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume":                                schema_pkg_apis_core_v1alpha1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.VolumeType":                            schema_pkg_apis_core_v1alpha1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Worker":                                schema_pkg_apis_core_v1alpha1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerAutoRepair":                      schema_pkg_apis_core_v1alpha1_WorkerAutoRepair(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFile":                            schema_pkg_apis_core_v1alpha1_WorkerFile(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContent":                     schema_pkg_apis_core_v1alpha1_WorkerFileContent(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContentInline":               schema_pkg_apis_core_v1alpha1_WorkerFileContentInline(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContentSecretRef":            schema_pkg_apis_core_v1alpha1_WorkerFileContentSecretRef(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes":                      schema_pkg_apis_core_v1alpha1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerOperatingSystemConfig":           schema_pkg_apis_core_v1alpha1_WorkerOperatingSystemConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerPoolAutoRepairStatus":            schema_pkg_apis_core_v1alpha1_WorkerPoolAutoRepairStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerUnit":                            schema_pkg_apis_core_v1alpha1_WorkerUnit(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerUnitDropIn":                      schema_pkg_apis_core_v1alpha1_WorkerUnitDropIn(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud":                             schema_pkg_apis_garden_v1beta1_AWSCloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints":                       schema_pkg_apis_garden_v1beta1_AWSConstraints(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks":                          schema_pkg_apis_garden_v1beta1_AWSNetworks(ref),
@@ -173,15 +200,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureList":             schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec":             schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus":           schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupLimits":                         schema_pkg_apis_garden_v1beta1_BackupLimits(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupProfile":                        schema_pkg_apis_garden_v1beta1_BackupProfile(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI":                                  schema_pkg_apis_garden_v1beta1_CRI(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud":                                schema_pkg_apis_garden_v1beta1_Cloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig":         schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile":                         schema_pkg_apis_garden_v1beta1_CloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileList":                     schema_pkg_apis_garden_v1beta1_CloudProfileList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec":                     schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler":                    schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ContainerRuntime":                     schema_pkg_apis_garden_v1beta1_ContainerRuntime(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS":                                  schema_pkg_apis_garden_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint":                schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderStatus":                    schema_pkg_apis_garden_v1beta1_DNSProviderStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.EgressDestination":                    schema_pkg_apis_garden_v1beta1_EgressDestination(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension":                            schema_pkg_apis_garden_v1beta1_Extension(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud":                             schema_pkg_apis_garden_v1beta1_GCPCloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints":                       schema_pkg_apis_garden_v1beta1_GCPConstraints(ref),
@@ -219,6 +251,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage":                   schema_pkg_apis_garden_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance":                          schema_pkg_apis_garden_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate":                schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceBlackoutWindow":            schema_pkg_apis_garden_v1beta1_MaintenanceBlackoutWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTaskStatus":                schema_pkg_apis_garden_v1beta1_MaintenanceTaskStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow":                schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular":                            schema_pkg_apis_garden_v1beta1_Monocular(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking":                           schema_pkg_apis_garden_v1beta1_Networking(ref),
@@ -250,29 +284,46 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding":                        schema_pkg_apis_garden_v1beta1_SecretBinding(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBindingList":                    schema_pkg_apis_garden_v1beta1_SecretBindingList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed":                                 schema_pkg_apis_garden_v1beta1_Seed(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCapacity":                         schema_pkg_apis_garden_v1beta1_SeedCapacity(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud":                            schema_pkg_apis_garden_v1beta1_SeedCloud(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedList":                             schema_pkg_apis_garden_v1beta1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedLogging":                          schema_pkg_apis_garden_v1beta1_SeedLogging(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks":                         schema_pkg_apis_garden_v1beta1_SeedNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec":                             schema_pkg_apis_garden_v1beta1_SeedSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus":                           schema_pkg_apis_garden_v1beta1_SeedStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig":                 schema_pkg_apis_garden_v1beta1_ServiceAccountConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot":                                schema_pkg_apis_garden_v1beta1_Shoot(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAutoRepairStatus":                schema_pkg_apis_garden_v1beta1_ShootAutoRepairStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootBackup":                          schema_pkg_apis_garden_v1beta1_ShootBackup(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootList":                            schema_pkg_apis_garden_v1beta1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage":                    schema_pkg_apis_garden_v1beta1_ShootMachineImage(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMaintenanceStatus":               schema_pkg_apis_garden_v1beta1_ShootMaintenanceStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks":                        schema_pkg_apis_garden_v1beta1_ShootNetworks(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec":                            schema_pkg_apis_garden_v1beta1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus":                          schema_pkg_apis_garden_v1beta1_ShootStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType":                           schema_pkg_apis_garden_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Worker":                               schema_pkg_apis_garden_v1beta1_Worker(ref),
+		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerPoolAutoRepairStatus":           schema_pkg_apis_garden_v1beta1_WorkerPoolAutoRepairStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone":                                 schema_pkg_apis_garden_v1beta1_Zone(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset":        schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetList":    schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec":    schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPreset":                schema_pkg_apis_settings_v1alpha1_ClusterShootPreset(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPresetList":            schema_pkg_apis_settings_v1alpha1_ClusterShootPresetList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPresetSpec":            schema_pkg_apis_settings_v1alpha1_ClusterShootPresetSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect":        schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication": schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset":               schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetList":           schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref),
 		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec":           schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults":                     schema_pkg_apis_settings_v1alpha1_ShootDefaults(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicy":                       schema_pkg_apis_settings_v1alpha1_ShootPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyList":                   schema_pkg_apis_settings_v1alpha1_ShootPolicyList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule":                   schema_pkg_apis_settings_v1alpha1_ShootPolicyRule(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicySpec":                   schema_pkg_apis_settings_v1alpha1_ShootPolicySpec(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPreset":                       schema_pkg_apis_settings_v1alpha1_ShootPreset(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPresetList":                   schema_pkg_apis_settings_v1alpha1_ShootPresetList(ref),
+		"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPresetSpec":                   schema_pkg_apis_settings_v1alpha1_ShootPresetSpec(ref),
 		"k8s.io/api/core/v1.AWSElasticBlockStoreVolumeSource":                                       schema_k8sio_api_core_v1_AWSElasticBlockStoreVolumeSource(ref),
 		"k8s.io/api/core/v1.Affinity":                                                               schema_k8sio_api_core_v1_Affinity(ref),
 		"k8s.io/api/core/v1.AttachedVolume":                                                         schema_k8sio_api_core_v1_AttachedVolume(ref),
@@ -1060,6 +1111,76 @@ func schema_pkg_apis_core_v1alpha1_BackupEntryStatus(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_BackupLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupLimits contains limits for the etcd backup settings in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minFullSnapshotInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinFullSnapshotInterval is the minimum interval between two full snapshots of the etcd a Shoot may configure.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"minDeltaSnapshotPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinDeltaSnapshotPeriod is the minimum period between two delta snapshots of the etcd a Shoot may configure.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the maximum number of full snapshots of the etcd a Shoot may retain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_CRI(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CRI contains information about the Container Runtimes.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the CRI library.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"containerRuntimes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ContainerRuntimes is the list of the required container runtimes supported for a worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ContainerRuntime"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ContainerRuntime"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_CloudInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1185,6 +1306,12 @@ func schema_pkg_apis_core_v1alpha1_CloudProfileSpec(ref common.ReferenceCallback
 				Description: "CloudProfileSpec is the specification of a CloudProfile. It must contain exactly one of its defined keys.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"backupLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupLimits contains limits for the etcd backup settings in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupLimits"),
+						},
+					},
 					"caBundle": {
 						SchemaProps: spec.SchemaProps{
 							Description: "CABundle is a certificate bundle which will be installed onto every host machine of shoot cluster targetting this profile.",
@@ -1274,7 +1401,7 @@ func schema_pkg_apis_core_v1alpha1_CloudProfileSpec(ref common.ReferenceCallback
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Region", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.VolumeType", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupLimits", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Region", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.VolumeType", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -1414,6 +1541,35 @@ func schema_pkg_apis_core_v1alpha1_Condition(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_ContainerRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ContainerRuntime contains information about worker's available container runtime.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the Container Runtime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to the ContainerRuntime resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1430,16 +1586,98 @@ func schema_pkg_apis_core_v1alpha1_ControllerDeployment(ref common.ReferenceCall
 					},
 					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig contains type-specific configuration.",
+							Description: "ProviderConfig contains type-specific configuration. It is deployed to all seeds if no versions are specified.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
+					"versions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Versions is a list of versions of this controller. Versions with a seed selector are pinned to the matching seeds, all other seeds get the last version without a seed selector according to the rollout policy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentVersion"),
+									},
+								},
+							},
+						},
+					},
+					"rollout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rollout defines how a new version is rolled out to the seeds which are not pinned to a version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentRollout"),
+						},
+					},
 				},
 				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentRollout", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ControllerDeploymentVersion", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ControllerDeploymentRollout(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeploymentRollout defines how a new version of a controller is rolled out to the seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"canarySeedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CanarySeedSelector selects the seeds to which a new version is rolled out first.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"soakTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SoakTime is the duration for which a new version must have been installed successfully on all canary seeds before it is rolled out to the remaining seeds. Defaults to 1h.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"canarySeedSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ControllerDeploymentVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControllerDeploymentVersion is a version of a controller with its type-specific configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the name of this version, e.g. \"v1.2.0\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig contains type-specific configuration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+					"seedSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedSelector pins this version to the seeds matching the label selector.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -1558,6 +1796,13 @@ func schema_pkg_apis_core_v1alpha1_ControllerInstallationSpec(ref common.Referen
 							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
 						},
 					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the deployment of the referenced ControllerRegistration which shall be installed. If not set then the providerConfig of the deployment is installed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"registrationRef", "seedRef"},
 			},
@@ -1593,6 +1838,13 @@ func schema_pkg_apis_core_v1alpha1_ControllerInstallationStatus(ref common.Refer
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
+					"deployedVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeployedVersion is the version of the deployment of the referenced ControllerRegistration which has been installed successfully.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1760,6 +2012,27 @@ func schema_pkg_apis_core_v1alpha1_ControllerResource(ref common.ReferenceCallba
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
+					"lifecyclePhase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LifecyclePhase is the phase of the Shoot flows in which resources of kind \"Extension\" are reconciled and deleted. If not set, the resources are reconciled in the \"AfterKubeAPIServer\" phase.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dependsOn": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DependsOn is a list of types of other resources of kind \"Extension\" which must be ready before resources of kind \"Extension\" of this type are reconciled. They are deleted only after resources of this type have been deleted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kind", "type"},
 			},
@@ -1851,6 +2124,13 @@ func schema_pkg_apis_core_v1alpha1_DNSProvider(ref common.ReferenceCallback) com
 				Description: "DNSProvider contains information about a DNS provider.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domain is the domain for which this provider shall create a DNS record pointing to the API server of the shoot cluster. It is only relevant for additional providers, i.e., all providers except the first one. If it is not set then the external domain of the shoot is used, e.g., to create the same record in a private hosted zone.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"domains": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Domains contains information about which domains shall be included/excluded for this provider.",
@@ -1885,63 +2165,91 @@ func schema_pkg_apis_core_v1alpha1_DNSProvider(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Endpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_DNSProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Endpoint is an endpoint for monitoring, logging and other services around the plant.",
+				Description: "DNSProviderStatus contains the status of the DNS record of an additional DNS provider of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the endpoint",
+							Description: "Type is the type of the DNS provider.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"url": {
+					"domain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URL is the url of the endpoint",
+							Description: "Domain is the domain for which the DNS record is managed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"purpose": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is the purpose of the endpoint",
+							Description: "State is the state of the DNS record.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable message describing why the DNS record is not ready.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "url", "purpose"},
+				Required: []string{"type", "domain", "state"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ETCDSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExpirableVersion contains a version and an expiration date.",
+				Description: "ETCDSnapshot describes a snapshot of the etcd of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version identifier.",
+							Description: "Name is the name of the snapshot in the object store.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"expirationDate": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationDate defines the time at which this version expires.",
+							Description: "Kind is the kind of the snapshot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"creationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreationTimestamp is the time the snapshot has been taken.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"startRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartRevision is the first etcd revision contained in the snapshot.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"lastRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRevision is the last etcd revision contained in the snapshot.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"version"},
+				Required: []string{"name", "kind", "creationTimestamp", "startRevision", "lastRevision"},
 			},
 		},
 		Dependencies: []string{
@@ -1949,65 +2257,156 @@ func schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref common.ReferenceCallback
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_EgressDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Extension contains type and provider information for Shoot extensions.",
+				Description: "EgressDestination is a destination which the control plane components of a Shoot are allowed to reach. Exactly one of the fields must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the extension resource.",
+							Description: "CIDR is a network block in CIDR notation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
+					"fqdn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to extension resource.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "FQDN is a fully qualified domain name. It is resolved to its IPv4 addresses whenever the Shoot is reconciled.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_Endpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Description: "Endpoint is an endpoint for monitoring, logging and other services around the plant.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the Docker container id of the Gardener which last acted on a resource.",
+							Description: "Name is the name of the endpoint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the hostname (pod name) of the Gardener which last acted on a resource.",
+							Description: "URL is the url of the endpoint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"purpose": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the Gardener which last acted on a resource.",
+							Description: "Purpose is the purpose of the endpoint",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"id", "name", "version"},
+				Required: []string{"name", "url", "purpose"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ExpirableVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExpirableVersion contains a version and an expiration date.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version identifier.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationDate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationDate defines the time at which this version expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Extension contains type and provider information for Shoot extensions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the extension resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to extension resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the Docker container id of the Gardener which last acted on a resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the hostname (pod name) of the Gardener which last acted on a resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the Gardener which last acted on a resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "name", "version"},
 			},
 		},
 	}
@@ -2039,6 +2438,27 @@ func schema_pkg_apis_core_v1alpha1_Hibernation(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode determines which parts of the Shoot are hibernated. Defaults to `Full` if not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"excludedWorkerPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludedWorkerPools is a list of names of worker pools which keep running while the Shoot is hibernated. It may only be set if the mode is `WorkersOnly`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
 					"schedules": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Schedules determine the hibernation schedules.",
@@ -2908,12 +3328,25 @@ func schema_pkg_apis_core_v1alpha1_MachineImage(ref common.ReferenceCallback) co
 							},
 						},
 					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI is the list of container runtime interfaces and container runtimes supported by the machine image. If it is empty then only the docker CRI is supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "versions"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ExpirableVersion"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ExpirableVersion"},
 	}
 }
 
@@ -3055,6 +3488,89 @@ func schema_pkg_apis_core_v1alpha1_MaintenanceAutoUpdate(ref common.ReferenceCal
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_MaintenanceBlackoutWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the unique name of the blackout window.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the point in time when the blackout window starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the point in time when the blackout window ends.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a human-readable explanation why automatic maintenance operations are blocked.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shootSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector matches all Shoots.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+				Required: []string{"name", "begin", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_MaintenanceTaskStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceTaskStatus contains the result of a single maintenance task.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the maintenance task.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the result of the maintenance task.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable message describing what the maintenance task changed or why it failed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "state"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3124,12 +3640,25 @@ func schema_pkg_apis_core_v1alpha1_Networking(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"controlPlaneEgressDestinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneEgressDestinations is a list of additional destinations which the control plane components of the Shoot (e.g., the kube-apiserver calling webhooks) are allowed to reach.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.EgressDestination"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"type", "nodes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.EgressDestination", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
@@ -3671,11 +4200,31 @@ func schema_pkg_apis_core_v1alpha1_ProjectSpec(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"maintenanceBlackoutWindows": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceBlackoutWindows is a list of periods of time in which no automatic maintenance operations are performed for the Shoots of this project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceBlackoutWindow"),
+									},
+								},
+							},
+						},
+					},
+					"shootDeletionProtection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootDeletionProtection defines whether Shoots created in this project are protected against deletion by default.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProjectMember", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceBlackoutWindow", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProjectMember", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -4120,44 +4669,109 @@ func schema_pkg_apis_core_v1alpha1_SeedBackup(ref common.ReferenceCallback) comm
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_SeedDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedDNS contains DNS-relevant information about this seed cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ingressDomain": {
-						SchemaProps: spec.SchemaProps{
-							Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-				},
-				Required: []string{"ingressDomain"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1alpha1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_SeedCapacity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedList is a collection of Seeds.",
+				Description: "SeedCapacity contains information about the resources of a Seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"allocatable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Allocatable is the sum of the allocatable CPU and memory of all nodes of the Seed cluster.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"requested": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Requested is the sum of the CPU and memory requests of all pods of the Seed cluster which are not terminated.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"excessCapacityReserved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcessCapacityReserved is the CPU and memory reserved for new shoot control planes. It is empty if no excess capacity is reserved.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"shootControlPlanes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootControlPlanes is the number of shoot control planes hosted by the Seed cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"shootControlPlanes"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_SeedDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedDNS contains DNS-relevant information about this seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingressDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"ingressDomain"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedList is a collection of Seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -4190,6 +4804,26 @@ func schema_pkg_apis_core_v1alpha1_SeedList(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1alpha1_SeedLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedLogging contains settings for the logging of the seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint is the URL of an external HTTP(S) endpoint the logs of the seed cluster are shipped to. It is only used if the external logging backend is configured for the Gardener controller manager.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1alpha1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4274,6 +4908,12 @@ func schema_pkg_apis_core_v1alpha1_SeedSpec(ref common.ReferenceCallback) common
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedBackup"),
 						},
 					},
+					"backupLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupLimits contains limits for the etcd backup settings of shoots whose control plane runs in this seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupLimits"),
+						},
+					},
 					"blockCIDRs": {
 						SchemaProps: spec.SchemaProps{
 							Description: "BlockCIDRs is a list of network addresses tha should be blocked for shoot control plane components running in the seed cluster.",
@@ -4294,6 +4934,12 @@ func schema_pkg_apis_core_v1alpha1_SeedSpec(ref common.ReferenceCallback) common
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedDNS"),
 						},
 					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging contains settings for the logging of the seed cluster and the shoot control planes running in it.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedLogging"),
+						},
+					},
 					"networks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
@@ -4336,7 +4982,7 @@ func schema_pkg_apis_core_v1alpha1_SeedSpec(ref common.ReferenceCallback) common
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedBackup", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedDNS", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedProvider", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedTaint", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedVolume", "k8s.io/api/core/v1.SecretReference"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.BackupLimits", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedBackup", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedDNS", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedLogging", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedProvider", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedTaint", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedVolume", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
@@ -4373,11 +5019,17 @@ func schema_pkg_apis_core_v1alpha1_SeedStatus(ref common.ReferenceCallback) comm
 							Format:      "int64",
 						},
 					},
+					"capacity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Capacity contains the allocatable and requested resources of the Seed cluster and the number of hosted shoot control planes.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedCapacity"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.SeedCapacity"},
 	}
 }
 
@@ -4545,733 +5197,785 @@ func schema_pkg_apis_core_v1alpha1_Shoot(ref common.ReferenceCallback) common.Op
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootAutoRepairStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootList is a list of Shoot objects.",
+				Description: "ShootAutoRepairStatus contains information about the auto repair of the worker pools of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"pools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Shoots.",
+							Description: "Pools contains the auto repair status of the worker pools whose machines have been replaced.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Shoot"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerPoolAutoRepairStatus"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerPoolAutoRepairStatus"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
+				Description: "ShootBackup contains settings for the backups of the etcd of the Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
+							Description: "Schedule is the cron schedule in which full snapshots of the etcd are taken.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
+					"deltaSnapshotPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "DeltaSnapshotPeriod is the period after which delta snapshots of the etcd are taken.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"version": {
+					"garbageCollectionPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the shoot's image.",
+							Description: "GarbageCollectionPolicy is the policy used to garbage collect old snapshots of the etcd.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the number of full snapshots of the etcd which are retained. It is only used for the `LimitBased` garbage collection policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"name", "version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootBackupCatalog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootNetworks contains the default networks CIDRs for shoots.",
+				Description: "ShootBackupCatalog lists the snapshots of the etcd of a Shoot. It is served read-only by the `shoots/backups` subresource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"pods": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"services": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1alpha1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ShootSpec is the specification of a Shoot.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"addons": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Addons contains information about enabled/disabled addons and their configuration.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons"),
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"cloudProfileName": {
+					"backupEntryName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CloudProfileName is a name of a CloudProfile object.",
+							Description: "BackupEntryName is the name of the BackupEntry holding the snapshots.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dns": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DNS contains information about the DNS settings of the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS"),
-						},
-					},
-					"extensions": {
+					"snapshots": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extensions contain type and provider information for Shoot extensions.",
+							Description: "Snapshots is the list of snapshots of the etcd, ordered by their revisions.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDSnapshot"),
 									},
 								},
 							},
 						},
 					},
-					"hibernation": {
+				},
+				Required: []string{"backupEntryName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ETCDSnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootClone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootClone requests a new Shoot which is generated from an existing Shoot. It is posted to the `shoots/clone` subresource of the existing Shoot which responds with the generated Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hibernation contains information whether the Shoot is suspended or not.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubernetes": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networking": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networking contains information about cluster networking such as CNI Plugin type, CIDRs, ...etc.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking"),
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"maintenance": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance"),
+							Description: "Spec contains the settings of the new Shoot which differ from the cloned Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCloneSpec"),
 						},
 					},
-					"provider": {
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootCloneSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootCloneSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCloneSpec contains the settings of the new Shoot which differ from the cloned Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider contains all provider-specific and provider-relevant information.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Provider"),
+							Description: "Name is the name of the new Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"region": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a name of a region.",
+							Description: "Namespace is the namespace of the new Shoot. Defaults to the namespace of the cloned Shoot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretBindingName": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretBindingName is the name of the a SecretBinding that has a reference to the provider secret. The credentials inside the provider secret will be used to create the shoot in the respective account.",
+							Description: "Region is the region of the new Shoot. Defaults to the region of the cloned Shoot. The availability zones are mapped to the zones of the new region in the order they are listed in the CloudProfile.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"seedName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SeedName is the name of the seed cluster that runs the control plane of the Shoot.",
+							Description: "SeedName is the name of the Seed the new Shoot shall be scheduled to. If the new Shoot is created in another region, it defaults to nil, i.e., the Seed will be determined by the scheduler. Otherwise, it defaults to the Seed of the cloned Shoot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"cloudProfileName", "kubernetes", "networking", "provider", "region", "secretBindingName"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Provider"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+				Description: "ShootList is a list of Shoot objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Shoots's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
-									},
-								},
-							},
-						},
-					},
-					"gardener": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener"),
-						},
-					},
-					"hibernated": {
-						SchemaProps: spec.SchemaProps{
-							Description: "IsHibernated indicates whether the Shoot is currently hibernated.",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastOperation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation holds information about the last operation on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
-						},
-					},
-					"lastError": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError holds information about the last occurred error during an operation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"retryCycleStartTime": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Items is the list of Shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Shoot"),
+									},
+								},
+							},
 						},
 					},
-					"seed": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Description: "Name is the name of the image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"technicalID": {
+					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
-					"uid": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+							Description: "Version is the version of the shoot's image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
+				Required: []string{"name", "version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootMaintenanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Volume contains information about the volume type and size.",
+				Description: "ShootMaintenanceStatus contains information about the last maintenance operation of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"lastMaintenanceTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the machine type of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastMaintenanceTime is the point in time when the last maintenance operation was executed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"size": {
+					"tasks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Size is the size of the root volume.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Tasks contains the results of the maintenance tasks which were executed during the last maintenance operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceTaskStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"type", "size"},
+				Required: []string{"lastMaintenanceTime"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.MaintenanceTaskStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_VolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeType contains certain properties of a volume type.",
+				Description: "ShootNetworks contains the default networks CIDRs for shoots.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"class": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Class is the class of the volume type.",
+							Description: "Pods is the CIDR of the pod network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the volume type.",
+							Description: "Services is the CIDR of the service network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"usable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the volume type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"class", "name"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Worker is the base definition of a worker group.",
+				Description: "ShootSpec is the specification of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"annotations": {
+					"addons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Addons contains information about enabled/disabled addons and their configuration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons"),
 						},
 					},
-					"caBundle": {
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Description: "Backup contains settings for the backups of the etcd of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackup"),
+						},
+					},
+					"cloudProfileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudProfileName is a name of a CloudProfile object.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kubernetes": {
+					"dns": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains configuration for Kubernetes components related to this worker pool.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes"),
+							Description: "DNS contains information about the DNS settings of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS"),
 						},
 					},
-					"labels": {
+					"extensions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Extensions contain type and provider information for Shoot extensions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension"),
 									},
 								},
 							},
 						},
 					},
-					"name": {
+					"hibernation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Hibernation contains information whether the Shoot is suspended or not.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation"),
 						},
 					},
-					"machine": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Machine contains information about the machine type and image.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Machine"),
+							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes"),
 						},
 					},
-					"maximum": {
+					"networking": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maximum is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Networking contains information about cluster networking such as CNI Plugin type, CIDRs, ...etc.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking"),
 						},
 					},
-					"minimum": {
+					"maintenance": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Minimum is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance"),
 						},
 					},
-					"maxSurge": {
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Provider contains all provider-specific and provider-relevant information.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Provider"),
 						},
 					},
-					"maxUnavailable": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Region is a name of a region.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"providerConfig": {
+					"secretBindingName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the provider-specific configuration for this worker pool.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "SecretBindingName is the name of the a SecretBinding that has a reference to the provider secret. The credentials inside the provider secret will be used to create the shoot in the respective account.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"taints": {
+					"seedName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "SeedName is the name of the seed cluster that runs the control plane of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"cloudProfileName", "kubernetes", "networking", "provider", "region", "secretBindingName"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootBackup"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Shoots's current state.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"volume": {
+					"gardener": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volume contains information about the volume type and size.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume"),
+							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener"),
 						},
 					},
-					"zones": {
+					"hibernated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones that are used to evenly distribute this worker pool. Optional as not every provider may support availability zones.",
+							Description: "IsHibernated indicates whether the Shoot is currently hibernated.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"lastOperation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastOperation holds information about the last operation on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
+						},
+					},
+					"lastError": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastError holds information about the last occurred error during an operation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"retryCycleStartTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"seed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"technicalID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"uid": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance holds information about the last maintenance operation of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootMaintenanceStatus"),
+						},
+					},
+					"autoRepair": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRepair holds information about the machines which were replaced because their nodes stayed unhealthy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootAutoRepairStatus"),
+						},
+					},
+					"dnsProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSProviders contains the status of the DNS records of the additional DNS providers of the Shoot.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSProviderStatus"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name", "machine", "maximum", "minimum"},
+				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.DNSProviderStatus", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootAutoRepairStatus", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ShootMaintenanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1alpha1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_Volume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Description: "Volume contains information about the volume type and size.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubelet": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for all kubelets of this worker pool.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfig"),
+							Description: "Type is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Size is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type", "size"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AWSCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_VolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AWSCloud contains the Shoot specification for AWS.",
+				Description: "VolumeType contains certain properties of a volume type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"networks": {
+					"class": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks"),
+							Description: "Class is the class of the volume type.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workers": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker"),
-									},
-								},
-							},
+							Description: "Name is the name of the volume type.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"zones": {
+					"usable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Usable defines if the volume type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"networks", "workers", "zones"},
+				Required: []string{"class", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AWSConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AWSConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Description: "Worker is the base definition of a worker group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dnsProviders": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"kubernetes": {
+					"autoRepair": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+							Description: "AutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerAutoRepair"),
 						},
 					},
-					"machineImages": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
-									},
-								},
-							},
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machineTypes": {
+					"cri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
-									},
-								},
-							},
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI"),
 						},
 					},
-					"volumeTypes": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
-									},
-								},
-							},
+							Description: "Kubernetes contains configuration for Kubernetes components related to this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes"),
 						},
 					},
-					"zones": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_AWSNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AWSNetworks holds information about the Kubernetes and infrastructure networks.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"nodes": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
+							Description: "Name is the name of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pods": {
+					"machine": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Machine contains information about the machine type and image.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Machine"),
 						},
 					},
-					"services": {
+					"maxNodeAge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxNodeAge is the maximum age of the nodes of this worker pool. Once it is exceeded, the nodes are rolled.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"vpc": {
+					"maximum": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VPC indicates whether to use an existing VPC or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC"),
+							Description: "Maximum is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"internal": {
+					"minimum": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Internal is a list of private subnets to create (used for internal load balancers).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Minimum is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"public": {
+					"maxSurge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Public is a list of public subnets to create (used for bastion and load balancers).",
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"operatingSystemConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperatingSystemConfig contains additional systemd units, files and kernel settings which are added to the operating system configuration of every machine in this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerOperatingSystemConfig"),
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the provider-specific configuration for this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("k8s.io/api/core/v1.Taint"),
 									},
 								},
 							},
 						},
 					},
-					"workers": {
+					"volume": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker subnets (private) to create (used for the VMs).",
+							Description: "Volume contains information about the volume type and size.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume"),
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones that are used to evenly distribute this worker pool. Optional as not every provider may support availability zones.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5284,134 +5988,226 @@ func schema_pkg_apis_garden_v1beta1_AWSNetworks(ref common.ReferenceCallback) co
 						},
 					},
 				},
-				Required: []string{"vpc", "internal", "public", "workers"},
+				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerAutoRepair", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerOperatingSystemConfig", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AWSProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_WorkerAutoRepair(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AWSProfile defines certain constraints and definitions for the AWS cloud.",
+				Description: "WorkerAutoRepair contains the policy for replacing the machines of nodes which stay unhealthy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"unhealthyThreshold": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints"),
+							Description: "UnhealthyThreshold is the duration a node must be continuously unhealthy before its machine is replaced.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxConcurrentRepairs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxConcurrentRepairs is the maximum number of machines of this worker pool which are replaced at the same time because their nodes are unhealthy. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"constraints"},
+				Required: []string{"unhealthyThreshold"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AWSVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_WorkerFile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AWSVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).",
+				Description: "WorkerFile is an additional file that should get written to the host's file system. The content can either be inlined or referenced from a secret in the namespace of the Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the AWS VPC id of an existing VPC.",
+							Description: "Path is the path of the file system where the file should get written to.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cidr": {
+					"permissions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CIDR is a CIDR range for a new VPC.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Permissions describes with which permissions the file should get written to the file system. Defaults to octal 0644.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content describes the file's content.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContent"),
 						},
 					},
 				},
+				Required: []string{"path", "content"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContent"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_WorkerFileContent(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AWSWorker is the definition of a worker group.",
+				Description: "WorkerFileContent can either reference a secret or contain inline configuration.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "SecretRef is a reference to a key of a secret in the namespace of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContentSecretRef"),
+						},
+					},
+					"inline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Inline contains the inlined data of the file.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContentInline"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContentInline", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFileContentSecretRef"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkerFileContentInline(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerFileContentInline contains keys for inlining a file content's data and encoding.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"encoding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Encoding is the file's encoding (empty or b64).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"data": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "Data is the file's data.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineImage": {
+				},
+				Required: []string{"data"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkerFileContentSecretRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerFileContentSecretRef contains keys for referencing a file content's data from a secret in the namespace of the Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+							Description: "Name is the name of the secret.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"autoScalerMin": {
+					"dataKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "DataKey is the key in the secret's `.data` field that should be read.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"autoScalerMax": {
+				},
+				Required: []string{"name", "dataKey"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkerKubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubelet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kubelet contains configuration settings for all kubelets of this worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfig"),
 						},
 					},
-					"maxSurge": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Version is the semantic Kubernetes version to use for the kubelets of this worker pool. It must not be newer than the version of the control plane and at most two minor versions older. Defaults to the control plane version.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxUnavailable": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.KubeletConfig"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkerOperatingSystemConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerOperatingSystemConfig contains additional systemd units, files and kernel settings for the machines of a worker pool. Units and files owned by Gardener must not be overwritten.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"units": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Units is a list of additional systemd units.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerUnit"),
+									},
+								},
+							},
 						},
 					},
-					"annotations": {
+					"files": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Files is a list of additional files that should get written to the host's file system.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFile"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"sysctls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Description: "Sysctls is a map of kernel parameters to their values. They take precedence over the kernel settings configured by Gardener.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5424,220 +6220,1582 @@ func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) comm
 							},
 						},
 					},
-					"taints": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerFile", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerUnit"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkerPoolAutoRepairStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolAutoRepairStatus contains information about the last auto repair of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
-									},
-								},
-							},
+							Description: "Name is the name of the worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubelet": {
+					"lastRepairTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+							Description: "LastRepairTime is the point in time when machines of the worker pool were replaced the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"caBundle": {
+				},
+				Required: []string{"name", "lastRepairTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1alpha1_WorkerUnit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerUnit is an additional systemd unit for the machines of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Description: "Name is the name of the unit.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeType": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
+							Description: "Command is the command which is executed for the unit (start, restart or stop).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
+					"enable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
+							Description: "Enable describes whether the unit is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the unit's content.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"dropIns": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DropIns is a list of drop-ins for this unit.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerUnitDropIn"),
+									},
+								},
+							},
+						},
+					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.WorkerUnitDropIn"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Addon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1alpha1_WorkerUnitDropIn(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Addon also enabling or disabling a specific addon and is used to derive from.",
+				Description: "WorkerUnitDropIn is a drop-in configuration for a systemd unit.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
+							Description: "Name is the name of the drop-in.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"content": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Content is the content of the drop-in.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "content"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSCloud contains the Shoot specification for AWS.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networks", "workers", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dnsProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+						},
+					},
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
+									},
+								},
+							},
+						},
+					},
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSNetworks holds information about the Kubernetes and infrastructure networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vpc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VPC indicates whether to use an existing VPC or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC"),
+						},
+					},
+					"internal": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Internal is a list of private subnets to create (used for internal load balancers).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"public": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Public is a list of public subnets to create (used for bastion and load balancers).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker subnets (private) to create (used for the VMs).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"vpc", "internal", "public", "workers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSVPC"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSProfile defines certain constraints and definitions for the AWS cloud.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints"),
+						},
+					},
+				},
+				Required: []string{"constraints"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSConstraints"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the AWS VPC id of an existing VPC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is a CIDR range for a new VPC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AWSWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AWSWorker is the definition of a worker group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+						},
+					},
+					"autoScalerMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoScalerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Addon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Addon also enabling or disabling a specific addon and is used to derive from.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AddonClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAutoscaler describes configuration values for the cluster-autoscaler addon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Addons(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Addons is a collection of configuration for specific addons which are managed by the Gardener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetes-dashboard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard"),
+						},
+					},
+					"nginx-ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NginxIngress holds configuration settings for the nginx-ingress addon. DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"),
+						},
+					},
+					"cluster-autoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClusterAutoscaler holds configuration settings for the cluster autoscaler addon. DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler"),
+						},
+					},
+					"heapster": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Heapster holds configuration settings for the heapster addon. DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster"),
+						},
+					},
+					"kube2iam": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kube2IAM holds configuration settings for the kube2iam addon (only AWS). DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM"),
+						},
+					},
+					"kube-lego": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeLego holds configuration settings for the kube-lego addon. DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego"),
+						},
+					},
+					"monocular": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Monocular holds configuration settings for the monocular addon. DEPRECATED: This field will be removed in a future version.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the plugin.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the configuration of the plugin.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Alicloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Alicloud contains the Shoot specification for Alibaba cloud",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networks", "workers", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudConstraints is an object containing constraints for certain values in the Shoot specification",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dnsProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+						},
+					},
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
+									},
+								},
+							},
+						},
+					},
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudMachineType defines certain machine types and zone constraints.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usable defines if the machine type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the number of CPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"gpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPU is the number of GPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage is the amount of storage associated with the root volume of this machine type.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of memory for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "cpu", "gpu", "memory", "zones"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudNetworks holds information about the Kubernetes and infrastructure networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vpc": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VPC indicates whether to use an existing VPC or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a CIDR of a worker subnet (private) to create (used for the VMs).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"vpc", "workers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudProfile defines constraints and definitions in Alibaba Cloud environment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"constraints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints"),
+						},
+					},
+				},
+				Required: []string{"constraints"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the Alicloud VPC id of an existing VPC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cidr": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CIDR is a CIDR range for a new VPC.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudVolumeType defines certain volume types and zone constraints.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the volume type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"usable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usable defines if the volume type can be used for shoot clusters.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the class of the volume type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "class", "zones"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AlicloudWorker is the definition of a worker group.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineImage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+						},
+					},
+					"autoScalerMin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"autoScalerMax": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuditConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditConfig contains settings for audit of the api server",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"auditPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditPolicy contains configuration settings for audit policy of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditPolicy contains audit policy for kube-apiserver",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"configMapRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapRef is a reference to a ConfigMap object in the same namespace, which contains the audit policy for the kube-apiserver.",
+							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+						},
+					},
 				},
-				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ObjectReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AddonClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_AzureCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAutoscaler describes configuration values for the cluster-autoscaler addon.",
+				Description: "AzureCloud contains the Shoot specification for Azure.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks"),
+						},
+					},
+					"resourceGroup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ResourceGroup indicates whether to use an existing resource group or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"networks", "workers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AzureConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dnsProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+						},
+					},
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
+									},
+								},
+							},
+						},
+					},
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureDomainCount defines the region and the count for this domain count value.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"region": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Region is a region in Azure.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the count value for the respective domain count.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"region", "count"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Addons(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_AzureNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Addons is a collection of configuration for specific addons which are managed by the Gardener.",
+				Description: "AzureNetworks holds information about the Kubernetes and infrastructure networks.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubernetes-dashboard": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard"),
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nginx-ingress": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NginxIngress holds configuration settings for the nginx-ingress addon. DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"),
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cluster-autoscaler": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterAutoscaler holds configuration settings for the cluster autoscaler addon. DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler"),
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"heapster": {
+					"vnet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Heapster holds configuration settings for the heapster addon. DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster"),
+							Description: "VNet indicates whether to use an existing VNet or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet"),
 						},
 					},
-					"kube2iam": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kube2IAM holds configuration settings for the kube2iam addon (only AWS). DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM"),
+							Description: "Workers is a CIDR of a worker subnet (private) to create (used for the VMs).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kube-lego": {
+				},
+				Required: []string{"vnet", "workers"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AzureProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureProfile defines certain constraints and definitions for the Azure cloud.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"constraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeLego holds configuration settings for the kube-lego addon. DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego"),
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints"),
 						},
 					},
-					"monocular": {
+					"countUpdateDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CountUpdateDomains is list of Azure update domain counts for each region.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount"),
+									},
+								},
+							},
+						},
+					},
+					"countFaultDomains": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CountFaultDomains is list of Azure fault domain counts for each region.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"constraints", "countUpdateDomains", "countFaultDomains"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AzureResourceGroup indicates whether to use an existing resource group or create a new one.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Monocular holds configuration settings for the monocular addon. DEPRECATED: This field will be removed in a future version.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular"),
+							Description: "Name is the name of an existing resource group.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AddonClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Heapster", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAM", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeLego", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Monocular", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.NginxIngress"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AdmissionPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_AzureVNet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+				Description: "AzureVNet indicates whether to use an existing VNet or create a new one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the plugin.",
+							Description: "Name is the AWS VNet name of an existing VNet.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config is the configuration of the plugin.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "CIDR is a CIDR range for a new VNet.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Alicloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Alicloud contains the Shoot specification for Alibaba cloud",
+				Description: "AzureWorker is the definition of a worker group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"machineType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"machineImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
 						},
 					},
-					"networks": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks"),
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"workers": {
+					"autoScalerMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxSurge": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"zones": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -5647,754 +7805,772 @@ func schema_pkg_apis_garden_v1beta1_Alicloud(ref common.ReferenceCallback) commo
 							},
 						},
 					},
-				},
-				Required: []string{"networks", "workers", "zones"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_AlicloudConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudConstraints is an object containing constraints for certain values in the Shoot specification",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"dnsProviders": {
+					"taints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+										Ref: ref("k8s.io/api/core/v1.Taint"),
 									},
 								},
 							},
 						},
 					},
-					"kubernetes": {
+					"kubelet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
 						},
 					},
-					"machineImages": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
-									},
-								},
-							},
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machineTypes": {
+					"cri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType"),
-									},
-								},
-							},
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
 						},
 					},
-					"volumeTypes": {
+					"volumeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType"),
-									},
-								},
-							},
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"zones": {
+					"volumeSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
-									},
-								},
-							},
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudMachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AlicloudMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudMachineType defines certain machine types and zone constraints.",
+				Description: "BackupInfrastructure holds details about backup infrastructure",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the machine type.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"usable": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the machine type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cpu": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPU is the number of CPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"gpu": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GPU is the number of GPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Specification of the Backup Infrastructure.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec"),
 						},
 					},
-					"storage": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Storage is the amount of storage associated with the root volume of this machine type.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage"),
+							Description: "Most recently observed status of the Backup Infrastructure.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus"),
 						},
 					},
-					"memory": {
+				},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.seed,STATUS:.status.lastOperation.state",
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupInfrastructureList is a list of BackupInfrastructure objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Memory is the amount of memory for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"zones": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of BackupInfrastructure.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name", "cpu", "gpu", "memory", "zones"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AlicloudNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "BackupInfrastructureSpec is the specification of a Backup Infrastructure.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"seed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
+							Description: "Seed is the name of a Seed object.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pods": {
+					"shootUID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
+							Description: "ShootUID is a unique identifier for the Shoot cluster for which the BackupInfrastructure object is created.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"services": {
+				},
+				Required: []string{"seed", "shootUID"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupInfrastructureStatus holds the most recently observed status of the Backup Infrastructure.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastOperation holds information about the last operation on the BackupInfrastructure.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
 						},
 					},
-					"vpc": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VPC indicates whether to use an existing VPC or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC"),
+							Description: "LastError holds information about the last occurred error during an operation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
 						},
 					},
-					"workers": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a CIDR of a worker subnet (private) to create (used for the VMs).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "ObservedGeneration is the most recent generation observed for this BackupInfrastructure. It corresponds to the BackupInfrastructure's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"vpc", "workers"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudVPC"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AlicloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_BackupLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudProfile defines constraints and definitions in Alibaba Cloud environment.",
+				Description: "BackupLimits contains limits for the etcd backup settings in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"minFullSnapshotInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints"),
+							Description: "MinFullSnapshotInterval is the minimum interval between two full snapshots of the etcd a Shoot may configure.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"minDeltaSnapshotPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinDeltaSnapshotPeriod is the minimum period between two delta snapshots of the etcd a Shoot may configure.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the maximum number of full snapshots of the etcd a Shoot may retain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"constraints"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudConstraints"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AlicloudVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_BackupProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudVPC contains either an id (of an existing VPC) or the CIDR (for a VPC to be created).",
+				Description: "BackupProfile contains the object store configuration for backups for shoot(currently only etcd).",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"provider": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the Alicloud VPC id of an existing VPC.",
+							Description: "Provider is a provider name.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cidr": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CIDR is a CIDR range for a new VPC.",
+							Description: "Region is a region name.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
 				},
+				Required: []string{"provider", "secretRef"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AlicloudVolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_CRI(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudVolumeType defines certain volume types and zone constraints.",
+				Description: "CRI contains information about the Container Runtimes.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the volume type.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"usable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the volume type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"class": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Class is the class of the volume type.",
+							Description: "Name is the name of the CRI library.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"zones": {
+					"containerRuntimes": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "ContainerRuntimes is the list of the required container runtimes supported for a worker pool.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ContainerRuntime"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name", "class", "zones"},
+				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ContainerRuntime"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AlicloudWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Cloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AlicloudWorker is the definition of a worker group.",
+				Description: "Cloud contains information about the cloud environment and their specific settings. It must contain exactly one key of the below cloud providers.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"profile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "Profile is a name of a CloudProfile object.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "Region is a name of a cloud provider region.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxSurge": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
-						},
-					},
-					"maxUnavailable": {
+					"secretBindingRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "SecretBindingRef is a reference to a SecretBinding object.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
-					"annotations": {
+					"seed": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Seed is the name of a Seed object.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"aws": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "AWS contains the Shoot specification for the Amazon Web Services cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud"),
 						},
 					},
-					"taints": {
+					"azure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
-									},
-								},
-							},
+							Description: "Azure contains the Shoot specification for the Microsoft Azure cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud"),
 						},
 					},
-					"kubelet": {
+					"gcp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+							Description: "GCP contains the Shoot specification for the Google Cloud Platform cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud"),
 						},
 					},
-					"caBundle": {
+					"openstack": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "OpenStack contains the Shoot specification for the OpenStack cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud"),
 						},
 					},
-					"volumeType": {
+					"alicloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Alicloud contains the Shoot specification for the Alibaba cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud"),
 						},
 					},
-					"volumeSize": {
+					"packet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Packet contains the Shoot specification for the Packet cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud"),
 						},
 					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+				Required: []string{"profile", "region", "secretBindingRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud", "k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AuditConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AuditConfig contains settings for audit of the api server",
+				Description: "CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"auditPolicy": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuditPolicy contains configuration settings for audit policy of the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"),
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditPolicy"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AuditPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AuditPolicy contains audit policy for kube-apiserver",
+				Description: "CloudProfile represents certain properties about a cloud environment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"configMapRef": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMapRef is a reference to a ConfigMap object in the same namespace, which contains the audit policy for the kube-apiserver.",
-							Ref:         ref("k8s.io/api/core/v1.ObjectReference"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the cloud environment properties.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_CloudProfileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureCloud contains the Shoot specification for Azure.",
+				Description: "CloudProfileList is a collection of CloudProfiles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineImage": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networks": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resourceGroup": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ResourceGroup indicates whether to use an existing resource group or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup"),
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"workers": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
+							Description: "Items is the list of CloudProfiles.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"networks", "workers"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureResourceGroup", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Description: "CloudProfileSpec is the specification of a CloudProfile. It must contain exactly one of its defined keys.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dnsProviders": {
+					"aws": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
-									},
-								},
-							},
+							Description: "AWS is the profile specification for the Amazon Web Services cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile"),
 						},
 					},
-					"kubernetes": {
+					"azure": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+							Description: "Azure is the profile specification for the Microsoft Azure cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile"),
 						},
 					},
-					"machineImages": {
+					"gcp": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
-									},
-								},
-							},
+							Description: "GCP is the profile specification for the Google Cloud Platform cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile"),
 						},
 					},
-					"machineTypes": {
+					"openstack": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
-									},
-								},
-							},
+							Description: "OpenStack is the profile specification for the OpenStack cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile"),
 						},
 					},
-					"volumeTypes": {
+					"alicloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
-									},
-								},
-							},
+							Description: "Alicloud is the profile specification for the Alibaba cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile"),
+						},
+					},
+					"packet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Packet is the profile specification for the Packet cloud.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"),
+						},
+					},
+					"backupLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupLimits contains limits for the etcd backup settings in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupLimits"),
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupLimits", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureDomainCount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureDomainCount defines the region and the count for this domain count value.",
+				Description: "ClusterAutoscaler contains the configration flags for the Kubernetes cluster autoscaler.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"region": {
+					"scaleDownUtilizationThreshold": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a region in Azure.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ScaleDownUtilizationThreshold defines the threshold in % under which a node is being removed",
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
-					"count": {
+					"scaleDownUnneededTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Count is the count value for the respective domain count.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down (default: 10 mins).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownDelayAfterAdd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 10 mins).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownDelayAfterFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelayAfterFailure how long after scale down failure that scale down evaluation resumes (default: 3 mins).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownDelayAfterDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelayAfterDelete how long after node deletion that scale down evaluation resumes, defaults to scanInterval (defaults to ScanInterval).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scanInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScanInterval how often cluster is reevaluated for scale up or down (default: 10 secs).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"region", "count"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ContainerRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "ContainerRuntime contains information about worker's available container runtime.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"pods": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"services": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
+							Description: "Type is the type of the Container Runtime.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"vnet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VNet indicates whether to use an existing VNet or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet"),
-						},
-					},
-					"workers": {
+					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a CIDR of a worker subnet (private) to create (used for the VMs).",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ProviderConfig is the configuration passed to the ContainerRuntime resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
 				},
-				Required: []string{"vnet", "workers"},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureVNet"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureProfile defines certain constraints and definitions for the Azure cloud.",
+				Description: "DNS holds information about the provider, the hosted zone id and the domain.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"domain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Domain is the external available domain of the Shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecretName is a name of a secret containing credentials for the stated domain and the provider. When not specified, the Gardener will use the cloud provider credentials referenced by the Shoot and try to find respective credentials there. Specifying this field may override this behavior, i.e. forcing the Gardener to only look into the given secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Provider is the DNS provider type for the Shoot.  Only relevant if not the default domain is used for this shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"includeDomains": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints"),
+							Description: "IncludeDomains is a list of domains that shall be included. Only relevant if not the default domain is used for this shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"countUpdateDomains": {
+					"excludeDomains": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CountUpdateDomains is list of Azure update domain counts for each region.",
+							Description: "ExcludeDomains is a list of domains that shall be excluded. Only relevant if not the default domain is used for this shoot.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"countFaultDomains": {
+					"includeZones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CountFaultDomains is list of Azure fault domain counts for each region.",
+							Description: "IncludeZones is a list of hosted zone IDs that shall be included. Only relevant if not the default domain is used for this shoot.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount"),
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"excludeZones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExcludeZones is a list of hosted zone IDs that shall be excluded. Only relevant if not the default domain is used for this shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"constraints", "countUpdateDomains", "countFaultDomains"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureDomainCount"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureResourceGroup indicates whether to use an existing resource group or create a new one.",
+				Description: "DNSProviderConstraint contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of an existing resource group.",
+							Description: "Name is the name of the DNS provider.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -6406,702 +8582,604 @@ func schema_pkg_apis_garden_v1beta1_AzureResourceGroup(ref common.ReferenceCallb
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureVNet(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_DNSProviderStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureVNet indicates whether to use an existing VNet or create a new one.",
+				Description: "DNSProviderStatus contains the status of the DNS record of an additional DNS provider of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the AWS VNet name of an existing VNet.",
+							Description: "Type is the type of the DNS provider.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cidr": {
+					"domain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CIDR is a CIDR range for a new VNet.",
+							Description: "Domain is the domain for which the DNS record is managed.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the DNS record.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable message describing why the DNS record is not ready.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type", "domain", "state"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_AzureWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_EgressDestination(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AzureWorker is the definition of a worker group.",
+				Description: "EgressDestination is a destination which the control plane components of a Shoot are allowed to reach. Exactly one of the fields must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "CIDR is a network block in CIDR notation.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"fqdn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "FQDN is a fully qualified domain name. It is resolved to its IPv4 addresses whenever the Shoot is reconciled.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Extension contains type and provider information for Shoot extensions.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Type is the type of the extension resource.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxSurge": {
+					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "ProviderConfig is the configuration passed to extension resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
-					"maxUnavailable": {
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_GCPCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GCPCloud contains the Shoot specification for GCP.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
 						},
 					},
-					"annotations": {
+					"networks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks"),
 						},
 					},
-					"labels": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Workers is a list of worker groups.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker"),
 									},
 								},
 							},
 						},
 					},
-					"taints": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"kubelet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
-						},
-					},
-					"caBundle": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"volumeType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"volumeSize": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+				Required: []string{"networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_BackupInfrastructure(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BackupInfrastructure holds details about backup infrastructure",
+				Description: "GCPConstraints is an object containing constraints for certain values in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"dnsProviders": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
 						},
 					},
-					"apiVersion": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
 						},
 					},
-					"metadata": {
+					"machineImages": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
+									},
+								},
+							},
 						},
 					},
-					"spec": {
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+									},
+								},
+							},
+						},
+					},
+					"volumeTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Backup Infrastructure.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec"),
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Backup Infrastructure.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus"),
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+									},
+								},
+							},
 						},
 					},
 				},
-			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.seed,STATUS:.status.lastOperation.state",
-				},
+				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructureStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_BackupInfrastructureList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BackupInfrastructureList is a list of BackupInfrastructure objects.",
+				Description: "GCPNetworks holds information about the Kubernetes and infrastructure networks.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Nodes is the CIDR of the node network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Pods is the CIDR of the pod network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"vpc": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of BackupInfrastructure.",
+							Description: "VPC indicates whether to use an existing VPC or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupInfrastructure", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_BackupInfrastructureSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BackupInfrastructureSpec is the specification of a Backup Infrastructure.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"seed": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Seed is the name of a Seed object.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"shootUID": {
+					"internal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootUID is a unique identifier for the Shoot cluster for which the BackupInfrastructure object is created.",
+							Description: "Internal is a private subnet (used for internal load balancers).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"seed", "shootUID"},
+				Required: []string{"workers"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_BackupInfrastructureStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BackupInfrastructureStatus holds the most recently observed status of the Backup Infrastructure.",
+				Description: "GCPProfile defines certain constraints and definitions for the GCP cloud.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"lastOperation": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation holds information about the last operation on the BackupInfrastructure.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
-						},
-					},
-					"lastError": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastError holds information about the last occurred error during an operation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
-						},
-					},
-					"observedGeneration": {
+					"constraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this BackupInfrastructure. It corresponds to the BackupInfrastructure's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"),
 						},
 					},
 				},
+				Required: []string{"constraints"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_BackupProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BackupProfile contains the object store configuration for backups for shoot(currently only etcd).",
+				Description: "GCPVPC indicates whether to use an existing VPC or create a new one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"provider": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Provider is a provider name.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"region": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a region name.",
+							Description: "Name is the name of an existing GCP VPC.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing the cloud provider credentials for the object store where backups should be stored. It should have enough privileges to manipulate the objects as well as buckets.",
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
-						},
-					},
 				},
-				Required: []string{"provider", "secretRef"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Cloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GCPWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Cloud contains information about the cloud environment and their specific settings. It must contain exactly one key of the below cloud providers.",
+				Description: "GCPWorker is the definition of a worker group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"profile": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Profile is a name of a CloudProfile object.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"region": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a name of a cloud provider region.",
+							Description: "Name is the name of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secretBindingRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SecretBindingRef is a reference to a SecretBinding object.",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
-						},
-					},
-					"seed": {
+					"machineType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Seed is the name of a Seed object.",
+							Description: "MachineType is the machine type of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"aws": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AWS contains the Shoot specification for the Amazon Web Services cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud"),
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
 						},
 					},
-					"azure": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Azure contains the Shoot specification for the Microsoft Azure cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud"),
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"gcp": {
+					"autoScalerMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GCP contains the Shoot specification for the Google Cloud Platform cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud"),
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"openstack": {
+					"maxSurge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OpenStack contains the Shoot specification for the OpenStack cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud"),
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"alicloud": {
+					"maxUnavailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Alicloud contains the Shoot specification for the Alibaba cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud"),
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"packet": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Packet contains the Shoot specification for the Packet cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud"),
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-				},
-				Required: []string{"profile", "region", "secretBindingRef"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Alicloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketCloud", "k8s.io/api/core/v1.LocalObjectReference"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_CloudControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
+										Type:   []string{"string"},
 										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_CloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "CloudProfile represents certain properties about a cloud environment.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"cri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
 						},
 					},
-					"metadata": {
+					"volumeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "VolumeType is the type of the root volumes.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"volumeSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the cloud environment properties.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec"),
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfileSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_CloudProfileList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CloudProfileList is a collection of CloudProfiles.",
+				Description: "Gardener holds the information about the Gardener",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "ID is the Docker container id of the Gardener which last acted on a Shoot cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Name is the hostname (pod name) of the Gardener which last acted on a Shoot cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of CloudProfiles.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile"),
-									},
-								},
-							},
+							Description: "Version is the version of the Gardener which last acted on a Shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"id", "name", "version"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudProfile", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_CloudProfileSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_GardenerDuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CloudProfileSpec is the specification of a CloudProfile. It must contain exactly one of its defined keys.",
+				Description: "GardenerDuration is a workaround for missing OpenAPI functions on metav1.Duration struct.",
+				Type:        v1beta1.GardenerDuration{}.OpenAPISchemaType(),
+				Format:      v1beta1.GardenerDuration{}.OpenAPISchemaFormat(),
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Heapster(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Heapster describes configuration values for the heapster addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"aws": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AWS is the profile specification for the Amazon Web Services cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile"),
-						},
-					},
-					"azure": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Azure is the profile specification for the Microsoft Azure cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile"),
-						},
-					},
-					"gcp": {
-						SchemaProps: spec.SchemaProps{
-							Description: "GCP is the profile specification for the Google Cloud Platform cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile"),
-						},
-					},
-					"openstack": {
-						SchemaProps: spec.SchemaProps{
-							Description: "OpenStack is the profile specification for the OpenStack cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile"),
-						},
-					},
-					"alicloud": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Alicloud is the profile specification for the Alibaba cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile"),
-						},
-					},
-					"packet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Packet is the profile specification for the Packet cloud.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"),
-						},
-					},
-					"caBundle": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every host machine of the Shoot cluster.",
-							Type:        []string{"string"},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AWSProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AlicloudProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AzureProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketProfile"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_HelmTiller(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAutoscaler contains the configration flags for the Kubernetes cluster autoscaler.",
+				Description: "HelmTiller describes configuration values for the helm-tiller addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"scaleDownUtilizationThreshold": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownUtilizationThreshold defines the threshold in % under which a node is being removed",
-							Type:        []string{"number"},
-							Format:      "double",
-						},
-					},
-					"scaleDownUnneededTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down (default: 10 mins).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"scaleDownDelayAfterAdd": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 10 mins).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"scaleDownDelayAfterFailure": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownDelayAfterFailure how long after scale down failure that scale down evaluation resumes (default: 3 mins).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"scaleDownDelayAfterDelete": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownDelayAfterDelete how long after node deletion that scale down evaluation resumes, defaults to scanInterval (defaults to ScanInterval).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"scanInterval": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScanInterval how often cluster is reevaluated for scale up or down (default: 10 secs).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Hibernation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DNS holds information about the provider, the hosted zone id and the domain.",
+				Description: "Hibernation contains information whether the Shoot is suspended or not.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"domain": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Domain is the external available domain of the Shoot cluster.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"secretName": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretName is a name of a secret containing credentials for the stated domain and the provider. When not specified, the Gardener will use the cloud provider credentials referenced by the Shoot and try to find respective credentials there. Specifying this field may override this behavior, i.e. forcing the Gardener to only look into the given secret.",
-							Type:        []string{"string"},
+							Description: "Enabled is true if the Shoot's desired state is hibernated, false otherwise.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"provider": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Provider is the DNS provider type for the Shoot.  Only relevant if not the default domain is used for this shoot.",
+							Description: "Mode determines which parts of the Shoot are hibernated. Defaults to `Full` if not specified.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"includeDomains": {
+					"excludedWorkerPools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IncludeDomains is a list of domains that shall be included. Only relevant if not the default domain is used for this shoot.",
+							Description: "ExcludedWorkerPools is a list of names of worker pools which keep running while the Shoot is hibernated. It may only be set if the mode is `WorkersOnly`.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -7113,46 +9191,53 @@ func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.Ope
 							},
 						},
 					},
-					"excludeDomains": {
+					"schedules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExcludeDomains is a list of domains that shall be excluded. Only relevant if not the default domain is used for this shoot.",
+							Description: "Schedules determine the hibernation schedules.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"),
 									},
 								},
 							},
 						},
 					},
-					"includeZones": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationSchedule determines the hibernation schedule of a Shoot. A Shoot will be regularly hibernated at each start time and will be woken up at each end time. Start or End can be omitted, though at least one of each has to be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IncludeZones is a list of hosted zone IDs that shall be included. Only relevant if not the default domain is used for this shoot.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Start is a Cron spec at which time a Shoot will be hibernated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"excludeZones": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExcludeZones is a list of hosted zone IDs that shall be excluded. Only relevant if not the default domain is used for this shoot.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "End is a Cron spec at which time a Shoot will be woken up.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the time location in which both start and and shall be evaluated.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
@@ -7161,585 +9246,533 @@ func schema_pkg_apis_garden_v1beta1_DNS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_DNSProviderConstraint(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DNSProviderConstraint contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+				Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager. Note: Descriptions were taken from the Kubernetes documentation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"downscaleDelay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the DNS provider.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "The period since last downscale, before another downscale can be performed in horizontal pod autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+						},
+					},
+					"syncPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The period for syncing the number of pods in horizontal pod autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+						},
+					},
+					"tolerance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The minimum change (from 1.0) in the desired-to-actual metrics ratio for the horizontal pod autoscaler to consider scaling.",
+							Type:        []string{"number"},
+							Format:      "double",
+						},
+					},
+					"upscaleDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The period since last upscale, before another upscale can be performed in horizontal pod autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+						},
+					},
+					"downscaleStabilization": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The configurable window at which the controller will choose the highest recommendation for autoscaling.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+						},
+					},
+					"initialReadinessDelay": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The configurable period at which the horizontal pod autoscaler considers a Pod “not yet ready” given that it’s unready and it has  transitioned to unready during that time.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+						},
+					},
+					"cpuInitializationPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The period after which a ready pod transition is considered to be the first.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Extension(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_K8SNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Extension contains type and provider information for Shoot extensions.",
+				Description: "K8SNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the extension resource.",
+							Description: "Nodes is the CIDR of the node network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to extension resource.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"services": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Kube2IAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPCloud contains the Shoot specification for GCP.",
+				Description: "Kube2IAM describes configuration values for the kube2iam addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"networks": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks"),
-						},
-					},
-					"workers": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker"),
-									},
-								},
-							},
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"zones": {
+					"roles": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
+							Description: "Roles is list of AWS IAM roles which should be created by the Gardener.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"networks", "workers", "zones"},
+				Required: []string{"enabled"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Description: "Kube2IAMRole allows passing AWS IAM policies which will result in IAM roles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dnsProviders": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
-									},
-								},
-							},
+							Description: "Name is the name of the IAM role. Will be extended by the Shoot name.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubernetes": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+							Description: "Description is a human readable message indiciating what this IAM role can be used for.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machineImages": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
-									},
-								},
-							},
+							Description: "Policy is an AWS IAM policy document.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machineTypes": {
+				},
+				Required: []string{"name", "description", "policy"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeAPIServerConfig contains configuration settings for the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
+										Type:   []string{"boolean"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"volumeTypes": {
+					"admissionPlugins": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
+							Description: "AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener), and, if desired, the corresponding configuration.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin"),
 									},
 								},
 							},
 						},
 					},
-					"zones": {
+					"apiAudiences": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Description: "APIAudiences are the identifiers of the API. The service account token authenticator will validate that tokens used against the API are bound to at least one of these audiences. If `serviceAccountConfig.issuer` is configured and this is not, this defaults to a single element list containing the issuer URL.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_GCPNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GCPNetworks holds information about the Kubernetes and infrastructure networks.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"nodes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"pods": {
+					"auditConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AuditConfig contains configuration settings for the audit of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig"),
 						},
 					},
-					"services": {
+					"enableBasicAuthentication": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
+							Description: "EnableBasicAuthentication defines whether basic authentication should be enabled for this cluster or not.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"vpc": {
+					"oidcConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VPC indicates whether to use an existing VPC or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"),
+							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"),
 						},
 					},
-					"workers": {
+					"runtimeConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "RuntimeConfig contains information about enabled or disabled APIs.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
+										Type:   []string{"boolean"},
 										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"internal": {
+					"serviceAccountConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Internal is a private subnet (used for internal load balancers).",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ServiceAccountConfig contains configuration settings for the service account handling of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig"),
 						},
 					},
 				},
-				Required: []string{"workers"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPVPC"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPProfile defines certain constraints and definitions for the GCP cloud.",
+				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"),
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"boolean"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"horizontalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"),
+						},
+					},
+					"nodeCIDRMaskSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeCIDRMaskSize defines the mask size for node cidr in cluster (default is 24)",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"constraints"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GCPConstraints"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPVPC(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeLego(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPVPC indicates whether to use an existing VPC or create a new one.",
+				Description: "KubeLego describes configuration values for the kube-lego addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of an existing GCP VPC.",
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mail is the email address to register at Let's Encrypt.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"enabled"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_GCPWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GCPWorker is the definition of a worker group.",
+				Description: "KubeProxyConfig contains configuration settings for the kube-proxy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"machineType": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"maxSurge": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
-						},
-					},
-					"maxUnavailable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
-						},
-					},
-					"annotations": {
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Description: "FeatureGates contains information about enabled feature gates.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
+										Type:   []string{"boolean"},
 										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Description: "Mode specifies which proxy mode to use. defaults to IPTables.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeSchedulerConfig contains configuration settings for the kube-scheduler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
+										Type:   []string{"boolean"},
 										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"taints": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletConfig contains configuration settings for the kubelet.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Type:   []string{"boolean"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"kubelet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
-						},
-					},
-					"caBundle": {
+					"podPidsLimit": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PodPIDsLimit is the maximum number of process IDs per pod allowed by the kubelet.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"volumeType": {
+					"cpuCFSQuota": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
-							Type:        []string{"string"},
+							Description: "CPUCFSQuota allows you to disable/enable CPU throttling for Pods.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
+					"cpuManagerPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
+							Description: "CPUManagerPolicy allows to set alternative CPU management policies (default: none).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Gardener holds the information about the Gardener",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"id": {
+					"maxPods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the Docker container id of the Gardener which last acted on a Shoot cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxPods is the maximum number of Pods that are allowed by the Kubelet. Default: 110",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"name": {
+					"evictionHard": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the hostname (pod name) of the Gardener which last acted on a Shoot cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "EvictionHard describes a set of eviction thresholds (e.g. memory.available<1Gi) that if met would trigger a Pod eviction. Default:\n  memory.available:   \"100Mi/1Gi/5%\"\n  nodefs.available:   \"5%\"\n  nodefs.inodesFree:  \"5%\"\n  imagefs.available:  \"5%\"\n  imagefs.inodesFree: \"5%\"",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction"),
 						},
 					},
-					"version": {
+					"evictionSoft": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the Gardener which last acted on a Shoot cluster.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "EvictionSoft describes a set of eviction thresholds (e.g. memory.available<1.5Gi) that if met over a corresponding grace period would trigger a Pod eviction. Default:\n  memory.available:   \"200Mi/1.5Gi/10%\"\n  nodefs.available:   \"10%\"\n  nodefs.inodesFree:  \"10%\"\n  imagefs.available:  \"10%\"\n  imagefs.inodesFree: \"10%\"",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction"),
 						},
 					},
-				},
-				Required: []string{"id", "name", "version"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_GardenerDuration(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "GardenerDuration is a workaround for missing OpenAPI functions on metav1.Duration struct.",
-				Type:        v1beta1.GardenerDuration{}.OpenAPISchemaType(),
-				Format:      v1beta1.GardenerDuration{}.OpenAPISchemaFormat(),
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Heapster(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Heapster describes configuration values for the heapster addon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"evictionSoftGracePeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "EvictionSoftGracePeriod describes a set of eviction grace periods (e.g. memory.available=1m30s) that correspond to how long a soft eviction threshold must hold before triggering a Pod eviction. Default:\n  memory.available:   1m30s\n  nodefs.available:   1m30s\n  nodefs.inodesFree:  1m30s\n  imagefs.available:  1m30s\n  imagefs.inodesFree: 1m30s",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod"),
 						},
 					},
-				},
-				Required: []string{"enabled"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_HelmTiller(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "HelmTiller describes configuration values for the helm-tiller addon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"evictionMinimumReclaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "EvictionMinimumReclaim configures the amount of resources below the configured eviction threshold that the kubelet attempts to reclaim whenever the kubelet observes resource pressure. Default: 0 for each resource",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionMinimumReclaim"),
 						},
 					},
-				},
-				Required: []string{"enabled"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Hibernation(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Hibernation contains information whether the Shoot is suspended or not.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"evictionPressureTransitionPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled is true if the Shoot's desired state is hibernated, false otherwise.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "EvictionPressureTransitionPeriod is the duration for which the kubelet has to wait before transitioning out of an eviction pressure condition. Default: 4m0s",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"schedules": {
+					"evictionMaxPodGracePeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schedules determine the hibernation schedules.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"),
-									},
-								},
-							},
+							Description: "EvictionMaxPodGracePeriod describes the maximum allowed grace period (in seconds) to use when terminating pods in response to a soft eviction threshold being met. Default: 90",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HibernationSchedule"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionMinimumReclaim", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeletConfigEviction(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HibernationSchedule determines the hibernation schedule of a Shoot. A Shoot will be regularly hibernated at each start time and will be woken up at each end time. Start or End can be omitted, though at least one of each has to be specified.",
+				Description: "KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"start": {
+					"memoryAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Start is a Cron spec at which time a Shoot will be hibernated.",
+							Description: "MemoryAvailable is the threshold for the free memory on the host server.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"imageFSAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End is a Cron spec at which time a Shoot will be woken up.",
+							Description: "ImageFSAvailable is the threshold for the free disk space in the imagefs filesystem (docker images and container writable layers).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"location": {
+					"imageFSInodesFree": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Location is the time location in which both start and and shall be evaluated.",
+							Description: "ImageFSInodesFree is the threshold for the available inodes in the imagefs filesystem.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeFSAvailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeFSAvailable is the threshold for the free disk space in the nodefs filesystem (docker volumes, logs, etc).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeFSInodesFree": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeFSInodesFree is the threshold for the available inodes in the nodefs filesystem.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -7750,174 +9783,173 @@ func schema_pkg_apis_garden_v1beta1_HibernationSchedule(ref common.ReferenceCall
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_HorizontalPodAutoscalerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionMinimumReclaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager. Note: Descriptions were taken from the Kubernetes documentation.",
+				Description: "KubeletConfigEviction contains configuration for the kubelet eviction minimum reclaim.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"downscaleDelay": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The period since last downscale, before another downscale can be performed in horizontal pod autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"syncPeriod": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The period for syncing the number of pods in horizontal pod autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
-						},
-					},
-					"tolerance": {
+					"memoryAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The minimum change (from 1.0) in the desired-to-actual metrics ratio for the horizontal pod autoscaler to consider scaling.",
-							Type:        []string{"number"},
-							Format:      "double",
+							Description: "MemoryAvailable is the threshold for the memory reclaim on the host server.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"upscaleDelay": {
+					"imageFSAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The period since last upscale, before another upscale can be performed in horizontal pod autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+							Description: "ImageFSAvailable is the threshold for the disk space reclaim in the imagefs filesystem (docker images and container writable layers).",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"downscaleStabilization": {
+					"imageFSInodesFree": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The configurable window at which the controller will choose the highest recommendation for autoscaling.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+							Description: "ImageFSInodesFree is the threshold for the inodes reclaim in the imagefs filesystem.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"initialReadinessDelay": {
+					"nodeFSAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The configurable period at which the horizontal pod autoscaler considers a Pod “not yet ready” given that it’s unready and it has  transitioned to unready during that time.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+							Description: "NodeFSAvailable is the threshold for the disk space reclaim in the nodefs filesystem (docker volumes, logs, etc).",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"cpuInitializationPeriod": {
+					"nodeFSInodesFree": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The period after which a ready pod transition is considered to be the first.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"),
+							Description: "NodeFSInodesFree is the threshold for the inodes reclaim in the nodefs filesystem.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.GardenerDuration"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_K8SNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "K8SNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
+				Description: "KubeletConfigEvictionSoftGracePeriod contains grace periods for kubelet eviction thresholds.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"memoryAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MemoryAvailable is the grace period for the MemoryAvailable eviction threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"pods": {
+					"imageFSAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ImageFSAvailable is the grace period for the ImageFSAvailable eviction threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"services": {
+					"imageFSInodesFree": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ImageFSInodesFree is the grace period for the ImageFSInodesFree eviction threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Kube2IAM(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Kube2IAM describes configuration values for the kube2iam addon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"nodeFSAvailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "NodeFSAvailable is the grace period for the NodeFSAvailable eviction threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"roles": {
+					"nodeFSInodesFree": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Roles is list of AWS IAM roles which should be created by the Gardener.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"),
-									},
-								},
-							},
+							Description: "NodeFSInodesFree is the grace period for the NodeFSInodesFree eviction threshold.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
 				},
-				Required: []string{"enabled"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kube2IAMRole"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Kube2IAMRole(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Kube2IAMRole allows passing AWS IAM policies which will result in IAM roles.",
+				Description: "Kubernetes contains the version and configuration variables for the Shoot control plane.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"allowPrivilegedContainers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the IAM role. Will be extended by the Shoot name.",
-							Type:        []string{"string"},
+							Description: "AllowPrivilegedContainers indicates whether privileged containers are allowed in the Shoot (default: true).",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"description": {
+					"kubeAPIServer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a human readable message indiciating what this IAM role can be used for.",
+							Description: "KubeAPIServer contains configuration settings for the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig"),
+						},
+					},
+					"cloudControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CloudControllerManager contains configuration settings for the cloud-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig"),
+						},
+					},
+					"kubeControllerManager": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeControllerManager contains configuration settings for the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig"),
+						},
+					},
+					"kubeScheduler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeScheduler contains configuration settings for the kube-scheduler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig"),
+						},
+					},
+					"kubeProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeProxy contains configuration settings for the kube-proxy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig"),
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the semantic Kubernetes version to use for the Shoot cluster.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"policy": {
+					"clusterAutoscaler": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Policy is an AWS IAM policy document.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ClusterAutoscaler contains the configration flags for the Kubernetes cluster autoscaler.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler"),
 						},
 					},
 				},
-				Required: []string{"name", "description", "policy"},
+				Required: []string{"version"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeAPIServerConfig contains configuration settings for the kube-apiserver.",
+				Description: "KubernetesConfig contains common configuration fields for the control plane components.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"featureGates": {
@@ -7935,536 +9967,673 @@ func schema_pkg_apis_garden_v1beta1_KubeAPIServerConfig(ref common.ReferenceCall
 							},
 						},
 					},
-					"admissionPlugins": {
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubernetesConstraints contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"versions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AdmissionPlugins contains the list of user-defined admission plugins (additional to those managed by Gardener), and, if desired, the corresponding configuration.",
+							Description: "Versions is the list of allowed Kubernetes versions for Shoot clusters (e.g., 1.13.1).",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"apiAudiences": {
+					"offeredVersions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIAudiences are the identifiers of the API. The service account token authenticator will validate that tokens used against the API are bound to at least one of these audiences. If `serviceAccountConfig.issuer` is configured and this is not, this defaults to a single element list containing the issuer URL.",
+							Description: "OfferedVersions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesVersion"),
 									},
 								},
 							},
 						},
 					},
-					"auditConfig": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AuditConfig contains configuration settings for the audit of the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig"),
-						},
-					},
-					"enableBasicAuthentication": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesVersion"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubernetesDashboard describes configuration values for the kubernetes-dashboard addon.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EnableBasicAuthentication defines whether basic authentication should be enabled for this cluster or not.",
+							Description: "Enabled indicates whether the addon is enabled or not.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"oidcConfig": {
+					"authenticationMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OIDCConfig contains configuration settings for the OIDC provider.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig"),
+							Description: "AuthenticationMode defines the authentication mode for the kubernetes-dashboard.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"runtimeConfig": {
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_KubernetesVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubernetesVersion contains the version code and optional expiration date for a kubernetes version",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeConfig contains information about enabled or disabled APIs.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Version is the kubernetes version",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"serviceAccountConfig": {
+					"expirationDate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountConfig contains configuration settings for the service account handling of the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig"),
+							Description: "ExpirationDate defines the time at which this kubernetes version is not supported any more. This has the following implications: 1) A shoot that opted out of automatic kubernetes system updates and that is running this kubernetes version will be forcefully updated to the latest kubernetes patch version for the current minor version 2) Shoot's with this kubernetes version cannot be created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ServiceAccountConfig"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
+				Description: "MachineImage defines the name and multiple versions of the machine image in any environment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Name is the name of the image.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DEPRECATED: This field will be removed in a future version.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"versions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Versions contains versions and expiration dates of the machine image",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVersion"),
 									},
 								},
 							},
 						},
 					},
-					"horizontalPodAutoscaler": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVersion"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_MachineImageVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineImageVersion contains a version and an expiration date of a machine image",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"),
+							Description: "Version is the version of the image.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeCIDRMaskSize": {
+					"expirationDate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeCIDRMaskSize defines the mask size for node cidr in cluster (default is 24)",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ExpirationDate defines the time at which a shoot that opted out of automatic operating system updates and that is running this image version will be forcefully updated to the latest version specified in the referenced cloud profile.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.HorizontalPodAutoscalerConfig"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeLego(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeLego describes configuration values for the kube-lego addon.",
+				Description: "MachineType contains certain properties of a machine type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
+							Description: "Name is the name of the machine type.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"email": {
+					"usable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mail is the email address to register at Let's Encrypt.",
-							Type:        []string{"string"},
+							Description: "Usable defines if the machine type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU is the number of CPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"gpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GPU is the number of GPUs for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Storage is the amount of storage associated with the root volume of this machine type.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of memory for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"name", "cpu", "gpu", "memory"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeProxyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MachineTypeStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeProxyConfig contains configuration settings for the kube-proxy.",
+				Description: "MachineTypeStorage is the amount of storage associated with the root volume of this machine type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"size": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Size is the storage size.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"mode": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mode specifies which proxy mode to use. defaults to IPTables.",
+							Description: "Type is the type of the storage.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"size", "type"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeSchedulerConfig contains configuration settings for the kube-scheduler.",
+				Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"autoUpdate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoUpdate contains information about which constraints should be automatically updated.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
+						},
+					},
+					"timeWindow": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
+							Description: "TimeWindow contains information about the time window for maintenance operations.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeletConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeletConfig contains configuration settings for the kubelet.",
+				Description: "MaintenanceAutoUpdate contains information about which constraints should be automatically updated.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
-					"podPidsLimit": {
+					"kubernetesVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodPIDsLimit is the maximum number of process IDs per pod allowed by the kubelet.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"cpuCFSQuota": {
+					"machineImageVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPUCFSQuota allows you to disable/enable CPU throttling for Pods.",
+							Description: "MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"cpuManagerPolicy": {
+				},
+				Required: []string{"kubernetesVersion"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_MaintenanceBlackoutWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceBlackoutWindow is a period of time in which no automatic maintenance operations are performed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPUManagerPolicy allows to set alternative CPU management policies (default: none).",
+							Description: "Name is the unique name of the blackout window.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"maxPods": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MaxPods is the maximum number of Pods that are allowed by the Kubelet. Default: 110",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"evictionHard": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EvictionHard describes a set of eviction thresholds (e.g. memory.available<1Gi) that if met would trigger a Pod eviction. Default:\n  memory.available:   \"100Mi/1Gi/5%\"\n  nodefs.available:   \"5%\"\n  nodefs.inodesFree:  \"5%\"\n  imagefs.available:  \"5%\"\n  imagefs.inodesFree: \"5%\"",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction"),
-						},
-					},
-					"evictionSoft": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EvictionSoft describes a set of eviction thresholds (e.g. memory.available<1.5Gi) that if met over a corresponding grace period would trigger a Pod eviction. Default:\n  memory.available:   \"200Mi/1.5Gi/10%\"\n  nodefs.available:   \"10%\"\n  nodefs.inodesFree:  \"10%\"\n  imagefs.available:  \"10%\"\n  imagefs.inodesFree: \"10%\"",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction"),
-						},
-					},
-					"evictionSoftGracePeriod": {
+					"begin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionSoftGracePeriod describes a set of eviction grace periods (e.g. memory.available=1m30s) that correspond to how long a soft eviction threshold must hold before triggering a Pod eviction. Default:\n  memory.available:   1m30s\n  nodefs.available:   1m30s\n  nodefs.inodesFree:  1m30s\n  imagefs.available:  1m30s\n  imagefs.inodesFree: 1m30s",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod"),
+							Description: "Begin is the point in time when the blackout window starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"evictionMinimumReclaim": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionMinimumReclaim configures the amount of resources below the configured eviction threshold that the kubelet attempts to reclaim whenever the kubelet observes resource pressure. Default: 0 for each resource",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionMinimumReclaim"),
+							Description: "End is the point in time when the blackout window ends.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"evictionPressureTransitionPeriod": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionPressureTransitionPeriod is the duration for which the kubelet has to wait before transitioning out of an eviction pressure condition. Default: 4m0s",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Reason is a human-readable explanation why automatic maintenance operations are blocked.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"evictionMaxPodGracePeriod": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionMaxPodGracePeriod describes the maximum allowed grace period (in seconds) to use when terminating pods in response to a soft eviction threshold being met. Default: 90",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ShootSelector restricts the blackout window to the Shoots matching the selector. A nil selector matches all Shoots.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 				},
+				Required: []string{"name", "begin", "end"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionMinimumReclaim", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfigEvictionSoftGracePeriod", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeletConfigEviction(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_MaintenanceTaskStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.",
+				Description: "MaintenanceTaskStatus contains the result of a single maintenance task.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"memoryAvailable": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryAvailable is the threshold for the free memory on the host server.",
+							Description: "Name is the name of the maintenance task.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageFSAvailable": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageFSAvailable is the threshold for the free disk space in the imagefs filesystem (docker images and container writable layers).",
+							Description: "State is the result of the maintenance task.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageFSInodesFree": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageFSInodesFree is the threshold for the available inodes in the imagefs filesystem.",
+							Description: "Description is a human-readable message describing what the maintenance task changed or why it failed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"nodeFSAvailable": {
+				},
+				Required: []string{"name", "state"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeFSAvailable is the threshold for the free disk space in the nodefs filesystem (docker volumes, logs, etc).",
+							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"nodeFSInodesFree": {
+					"end": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeFSInodesFree is the threshold for the available inodes in the nodefs filesystem.",
+							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
+				Required: []string{"begin", "end"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionMinimumReclaim(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Monocular(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeletConfigEviction contains configuration for the kubelet eviction minimum reclaim.",
+				Description: "Monocular describes configuration values for the monocular addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"memoryAvailable": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MemoryAvailable is the threshold for the memory reclaim on the host server.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"imageFSAvailable": {
+				},
+				Required: []string{"enabled"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_Networking(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Networking defines networking parameters for the shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageFSAvailable is the threshold for the disk space reclaim in the imagefs filesystem (docker images and container writable layers).",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"imageFSInodesFree": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageFSInodesFree is the threshold for the inodes reclaim in the imagefs filesystem.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeFSAvailable": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeFSAvailable is the threshold for the disk space reclaim in the nodefs filesystem (docker volumes, logs, etc).",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeFSInodesFree": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeFSInodesFree is the threshold for the inodes reclaim in the nodefs filesystem.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "Type identifies the type of the networking plugin",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to network resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+						},
+					},
+					"controlPlaneEgressDestinations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneEgressDestinations is a list of additional destinations which the control plane components of the Shoot (e.g., the kube-apiserver calling webhooks) are allowed to reach.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.EgressDestination"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.EgressDestination"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_NginxIngress(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeletConfigEvictionSoftGracePeriod contains grace periods for kubelet eviction thresholds.",
+				Description: "NginxIngress describes configuration values for the nginx-ingress addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"memoryAvailable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "MemoryAvailable is the grace period for the MemoryAvailable eviction threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"imageFSAvailable": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImageFSAvailable is the grace period for the ImageFSAvailable eviction threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"imageFSInodesFree": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ImageFSInodesFree is the grace period for the ImageFSInodesFree eviction threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"nodeFSAvailable": {
+					"enabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeFSAvailable is the grace period for the NodeFSAvailable eviction threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Enabled indicates whether the addon is enabled or not.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"nodeFSInodesFree": {
+					"loadBalancerSourceRanges": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeFSInodesFree is the grace period for the NodeFSInodesFree eviction threshold.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "LoadBalancerSourceRanges is list of whitelist IP sources for NginxIngress",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"enabled"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Kubernetes contains the version and configuration variables for the Shoot control plane.",
+				Description: "OIDCConfig contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allowPrivilegedContainers": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AllowPrivilegedContainers indicates whether privileged containers are allowed in the Shoot (default: true).",
-							Type:        []string{"boolean"},
+							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"kubeAPIServer": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeAPIServer contains configuration settings for the kube-apiserver.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig"),
+							Description: "The client ID for the OpenID Connect client, must be set if oidc-issuer-url is set.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cloudControllerManager": {
+					"groupsClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CloudControllerManager contains configuration settings for the cloud-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig"),
+							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This flag is experimental, please see the authentication documentation for further details.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubeControllerManager": {
+					"groupsPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeControllerManager contains configuration settings for the kube-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig"),
+							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubeScheduler": {
+					"issuerURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeScheduler contains configuration settings for the kube-scheduler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig"),
+							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT).",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubeProxy": {
+					"requiredClaims": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeProxy contains configuration settings for the kube-proxy.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig"),
+							Description: "ATTENTION: Only meaningful for Kubernetes >= 1.11 key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"kubelet": {
+					"signingAlgs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"version": {
+					"usernameClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the semantic Kubernetes version to use for the Shoot cluster.",
+							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This flag is experimental, please see the authentication documentation for further details. (default \"sub\")",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"clusterAutoscaler": {
+					"usernamePrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClusterAutoscaler contains the configration flags for the Kubernetes cluster autoscaler.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler"),
+							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"clientAuthentication": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClientAuthentication can optionally contain client configuration used for kubeconfig generation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenIDConnectClientAuthentication"),
 						},
 					},
 				},
-				Required: []string{"version"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CloudControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenIDConnectClientAuthentication"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenIDConnectClientAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesConfig contains common configuration fields for the control plane components.",
+				Description: "OpenIDConnectClientAuthentication contains configuration for OIDC clients.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
+							Description: "The client Secret for the OpenID Connect client.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"extraConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extra configuration added to kubeconfig's auth-provider. Must not be any of idp-issuer-url, client-id, client-secret, idp-certificate-authority, idp-certificate-authority-data, id-token or refresh-token",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"boolean"},
+										Type:   []string{"string"},
 										Format: "",
 									},
 								},
@@ -8477,182 +10646,292 @@ func schema_pkg_apis_garden_v1beta1_KubernetesConfig(ref common.ReferenceCallbac
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubernetesConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesConstraints contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+				Description: "OpenStackCloud contains the Shoot specification for OpenStack.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"versions": {
+					"floatingPoolName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Versions is the list of allowed Kubernetes versions for Shoot clusters (e.g., 1.13.1).",
+							Description: "FloatingPoolName is the name of the floating pool to get FIPs from.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"loadBalancerProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerProvider is the name of the load balancer provider in the OpenStack environment.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"loadBalancerClasses": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerClasses available for a dedicated Shoot.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass"),
 									},
 								},
 							},
 						},
 					},
-					"offeredVersions": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OfferedVersions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.",
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+						},
+					},
+					"networks": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks"),
+						},
+					},
+					"workers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is a list of worker groups.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesVersion"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"floatingPoolName", "loadBalancerProvider", "networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesVersion"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubernetesDashboard(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesDashboard describes configuration values for the kubernetes-dashboard addon.",
+				Description: "OpenStackConstraints is an object containing constraints for certain values in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"dnsProviders": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
+									},
+								},
+							},
 						},
 					},
-					"authenticationMode": {
+					"floatingPools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AuthenticationMode defines the authentication mode for the kubernetes-dashboard.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FloatingPools contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool"),
+									},
+								},
+							},
+						},
+					},
+					"kubernetes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
+						},
+					},
+					"loadBalancerProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider"),
+									},
+								},
+							},
+						},
+					},
+					"machineImages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
+									},
+								},
+							},
+						},
+					},
+					"machineTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType"),
+									},
+								},
+							},
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"floatingPools", "kubernetes", "loadBalancerProviders", "machineImages", "machineTypes", "zones"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_KubernetesVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubernetesVersion contains the version code and optional expiration date for a kubernetes version",
+				Description: "OpenStackFloatingPool contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the kubernetes version",
+							Description: "Name is the name of the floating pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"expirationDate": {
+					"loadBalancerClasses": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationDate defines the time at which this kubernetes version is not supported any more. This has the following implications: 1) A shoot that opted out of automatic kubernetes system updates and that is running this kubernetes version will be forcefully updated to the latest kubernetes patch version for the current minor version 2) Shoot's with this kubernetes version cannot be created",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "LoadBalancerClasses contains a list of supported labeled load balancer network settings.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"version"},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineImage defines the name and multiple versions of the machine image in any environment.",
+				Description: "OpenStackLoadBalancerClass defines a restricted network setting for generic LoadBalancer classes usable in CloudProfiles.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
+							Description: "Name is the name of the LB class",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"floatingSubnetID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DEPRECATED: This field will be removed in a future version.",
+							Description: "FloatingSubnetID is the subnetwork ID of a dedicated subnet in floating network pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"versions": {
+					"floatingNetworkID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Versions contains versions and expiration dates of the machine image",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVersion"),
-									},
-								},
-							},
+							Description: "FloatingNetworkID is the network ID of the floating network pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subnetID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SubnetID is the ID of a local subnet used for LoadBalancer provisioning. Only usable if no FloatingPool configuration is done.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImageVersion"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MachineImageVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineImageVersion contains a version and an expiration date of a machine image",
+				Description: "OpenStackLoadBalancerProvider contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the image.",
+							Description: "Name is the name of the load balancer provider.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"expirationDate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationDate defines the time at which a shoot that opted out of automatic operating system updates and that is running this image version will be forcefully updated to the latest version specified in the referenced cloud profile.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
 				},
-				Required: []string{"version"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MachineType contains certain properties of a machine type.",
+				Description: "OpenStackMachineType contains certain properties of a machine type in OpenStack",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -8693,278 +10972,219 @@ func schema_pkg_apis_garden_v1beta1_MachineType(ref common.ReferenceCallback) co
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-				},
-				Required: []string{"name", "cpu", "gpu", "memory"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_MachineTypeStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MachineTypeStorage is the amount of storage associated with the root volume of this machine type.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"size": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Size is the storage size.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-						},
-					},
-					"type": {
+					"volumeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type is the type of the storage.",
+							Description: "VolumeType is the type of that volume.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"size", "type"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Maintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"autoUpdate": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoUpdate contains information about which constraints should be automatically updated.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate"),
-						},
-					},
-					"timeWindow": {
+					"volumeSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TimeWindow contains information about the time window for maintenance operations.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"),
+							Description: "VolumeSize is the amount of disk storage for this machine type.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
 				},
+				Required: []string{"name", "cpu", "gpu", "memory", "volumeType", "volumeSize"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTimeWindow"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceAutoUpdate contains information about which constraints should be automatically updated.",
+				Description: "OpenStackNetworks holds information about the Kubernetes and infrastructure networks.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubernetesVersion": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated.",
-							Type:        []string{"boolean"},
+							Description: "Nodes is the CIDR of the node network.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineImageVersion": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImageVersion indicates whether the machine image version may be automatically updated (default: true).",
-							Type:        []string{"boolean"},
+							Description: "Pods is the CIDR of the pod network.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"kubernetesVersion"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MaintenanceTimeWindow contains information about the time window for maintenance operations.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"begin": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Begin is the beginning of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, a random value will be computed.",
+							Description: "Services is the CIDR of the service network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"router": {
 						SchemaProps: spec.SchemaProps{
-							Description: "End is the end of the time window in the format HHMMSS+ZONE, e.g. \"220000+0100\". If not present, the value will be computed based on the \"Begin\" value.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Router indicates whether to use an existing router or create a new one.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"),
 						},
 					},
-				},
-				Required: []string{"begin", "end"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_Monocular(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Monocular describes configuration values for the monocular addon.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"enabled": {
+					"workers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"workers"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Networking(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Networking defines networking parameters for the shoot cluster.",
+				Description: "OpenStackProfile defines certain constraints and definitions for the OpenStack cloud.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"constraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"),
 						},
 					},
-					"pods": {
+					"keystoneURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
+							Description: "KeyStoneURL is the URL for auth{n,z} in OpenStack (pointing to KeyStone).",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"services": {
+					"dnsServers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DNSServers is a list of IPs of DNS servers used while creating subnets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"type": {
+					"dhcpDomain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type identifies the type of the networking plugin",
+							Description: "DHCPDomain is the dhcp domain of the OpenStack system configured in nova.conf. Only meaningful for Kubernetes 1.10.1+. See https://github.com/kubernetes/kubernetes/pull/61890 for details.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
+					"requestTimeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the configuration passed to network resource.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "RequestTimeout specifies the HTTP timeout against the OpenStack API.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"type"},
+				Required: []string{"constraints", "keystoneURL"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_NginxIngress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NginxIngress describes configuration values for the nginx-ingress addon.",
+				Description: "OpenStackRouter indicates whether to use an existing router or create a new one.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether the addon is enabled or not.",
-							Type:        []string{"boolean"},
+							Description: "ID is the router id of an existing OpenStack router.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"loadBalancerSourceRanges": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerSourceRanges is list of whitelist IP sources for NginxIngress",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"enabled"},
+				Required: []string{"id"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OIDCConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OIDCConfig contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
+				Description: "OpenStackWorker is the definition of a worker group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"caBundle": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
+							Description: "Name is the name of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"clientID": {
+					"machineType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The client ID for the OpenID Connect client, must be set if oidc-issuer-url is set.",
+							Description: "MachineType is the machine type of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"groupsClaim": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This flag is experimental, please see the authentication documentation for further details.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
 						},
 					},
-					"groupsPrefix": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"issuerURL": {
+					"autoScalerMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT).",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"requiredClaims": {
+					"maxSurge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ATTENTION: Only meaningful for Kubernetes >= 1.11 key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value.",
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -8977,11 +11197,12 @@ func schema_pkg_apis_garden_v1beta1_OIDCConfig(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"signingAlgs": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -8991,103 +11212,54 @@ func schema_pkg_apis_garden_v1beta1_OIDCConfig(ref common.ReferenceCallback) com
 							},
 						},
 					},
-					"usernameClaim": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This flag is experimental, please see the authentication documentation for further details. (default \"sub\")",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"usernamePrefix": {
+					"taints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
 						},
 					},
-					"clientAuthentication": {
+					"kubelet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClientAuthentication can optionally contain client configuration used for kubeconfig generation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenIDConnectClientAuthentication"),
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenIDConnectClientAuthentication"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_OpenIDConnectClientAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectClientAuthentication contains configuration for OIDC clients.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"secret": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The client Secret for the OpenID Connect client.",
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"extraConfig": {
+					"cri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extra configuration added to kubeconfig's auth-provider. Must not be any of idp-issuer-url, client-id, client-secret, idp-certificate-authority, idp-certificate-authority-data, id-token or refresh-token",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
 						},
 					},
 				},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_PacketCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackCloud contains the Shoot specification for OpenStack.",
+				Description: "PacketCloud contains the Shoot specification for Packet cloud",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"floatingPoolName": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FloatingPoolName is the name of the floating pool to get FIPs from.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"loadBalancerProvider": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerProvider is the name of the load balancer provider in the OpenStack environment.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"loadBalancerClasses": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerClasses available for a dedicated Shoot.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass"),
-									},
-								},
-							},
-						},
-					},
 					"machineImage": {
 						SchemaProps: spec.SchemaProps{
 							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
@@ -9097,7 +11269,7 @@ func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback)
 					"networks": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks"),
 						},
 					},
 					"workers": {
@@ -9107,7 +11279,7 @@ func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback)
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"),
 									},
 								},
 							},
@@ -9115,7 +11287,7 @@ func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback)
 					},
 					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to.",
+							Description: "Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9128,19 +11300,19 @@ func schema_pkg_apis_garden_v1beta1_OpenStackCloud(ref common.ReferenceCallback)
 						},
 					},
 				},
-				Required: []string{"floatingPoolName", "loadBalancerProvider", "networks", "workers", "zones"},
+				Required: []string{"networks", "workers", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackConstraints is an object containing constraints for certain values in the Shoot specification.",
+				Description: "PacketConstraints is an object containing constraints for certain values in the Shoot specification",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"dnsProviders": {
@@ -9156,38 +11328,12 @@ func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCal
 							},
 						},
 					},
-					"floatingPools": {
-						SchemaProps: spec.SchemaProps{
-							Description: "FloatingPools contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool"),
-									},
-								},
-							},
-						},
-					},
 					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
 						},
 					},
-					"loadBalancerProviders": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerProviders contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider"),
-									},
-								},
-							},
-						},
-					},
 					"machineImages": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
@@ -9208,788 +11354,844 @@ func schema_pkg_apis_garden_v1beta1_OpenStackConstraints(ref common.ReferenceCal
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
 									},
 								},
 							},
 						},
 					},
-					"zones": {
+					"volumeTypes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"floatingPools", "kubernetes", "loadBalancerProviders", "machineImages", "machineTypes", "zones"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackFloatingPool", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerProvider", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackMachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_OpenStackFloatingPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackFloatingPool contains constraints regarding allowed values of the 'floatingPoolName' block in the Shoot specification.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the floating pool.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"loadBalancerClasses": {
+					"zones": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoadBalancerClasses contains a list of supported labeled load balancer network settings.",
+							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackLoadBalancerClass"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_PacketNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackLoadBalancerClass defines a restricted network setting for generic LoadBalancer classes usable in CloudProfiles.",
+				Description: "PacketNetworks holds information about the Kubernetes and infrastructure networks.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the LB class",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"floatingSubnetID": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FloatingSubnetID is the subnetwork ID of a dedicated subnet in floating network pool.",
+							Description: "Nodes is the CIDR of the node network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"floatingNetworkID": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FloatingNetworkID is the network ID of the floating network pool.",
+							Description: "Pods is the CIDR of the pod network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"subnetID": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SubnetID is the ID of a local subnet used for LoadBalancer provisioning. Only usable if no FloatingPool configuration is done.",
+							Description: "Services is the CIDR of the service network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackLoadBalancerProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_PacketProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackLoadBalancerProvider contains constraints regarding allowed values of the 'loadBalancerProvider' block in the Shoot specification.",
+				Description: "PacketProfile defines constraints and definitions in Packet Cloud environment.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"constraints": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the load balancer provider.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"),
 						},
 					},
 				},
-				Required: []string{"name"},
+				Required: []string{"constraints"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackMachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackMachineType contains certain properties of a machine type in OpenStack",
+				Description: "PacketWorker is the definition of a worker group.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the machine type.",
+							Description: "Name is the name of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"usable": {
+					"machineType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the machine type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
+							Description: "MachineType is the machine type of the worker group.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cpu": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPU is the number of CPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
 						},
 					},
-					"gpu": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "GPU is the number of GPUs for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"storage": {
+					"autoScalerMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Storage is the amount of storage associated with the root volume of this machine type.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage"),
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"memory": {
+					"maxSurge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Memory is the amount of memory for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"maxUnavailable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/core/v1.Taint"),
+									},
+								},
+							},
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"caBundle": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cri": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
 						},
 					},
 					"volumeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of that volume.",
+							Description: "VolumeType is the type of the root volumes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"volumeSize": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the amount of disk storage for this machine type.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "VolumeSize is the size of the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "cpu", "gpu", "memory", "volumeType", "volumeSize"},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "Project holds certain properties about a Gardener project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pods": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"services": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the project properties.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Project.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList is a collection of Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"router": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Router indicates whether to use an existing router or create a new one.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"workers": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of CIDRs of worker subnets (private) to create (used for the VMs).",
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Projects.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"workers"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackRouter"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackProfile defines certain constraints and definitions for the OpenStack cloud.",
+				Description: "ProjectSpec is the specification of a Project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"constraints": {
+					"createdBy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CreatedBy is a subject representing a user name, an email address, or any other identifier of a user who created the project.",
+							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable description of what the project is used for.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Owner is a subject representing a user name, an email address, or any other identifier of a user owning the project.",
+							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+						},
+					},
+					"purpose": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Purpose is a human-readable explanation of the project's purpose.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"members": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"),
+							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with full permissions to manage it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+									},
+								},
+							},
 						},
 					},
-					"keystoneURL": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KeyStoneURL is the URL for auth{n,z} in OpenStack (pointing to KeyStone).",
+							Description: "Namespace is the name of the namespace that has been created for the Project object. A nil value means that Gardener will determine the name of the namespace.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dnsServers": {
+					"viewers": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNSServers is a list of IPs of DNS servers used while creating subnets.",
+							Description: "Viewers is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with limited permissions to only view some resources.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("k8s.io/api/rbac/v1.Subject"),
 									},
 								},
 							},
 						},
 					},
-					"dhcpDomain": {
+					"maintenanceBlackoutWindows": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DHCPDomain is the dhcp domain of the OpenStack system configured in nova.conf. Only meaningful for Kubernetes 1.10.1+. See https://github.com/kubernetes/kubernetes/pull/61890 for details.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaintenanceBlackoutWindows is a list of periods of time in which no automatic maintenance operations are performed for the Shoots of this project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceBlackoutWindow"),
+									},
+								},
+							},
 						},
 					},
-					"requestTimeout": {
+					"shootDeletionProtection": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RequestTimeout specifies the HTTP timeout against the OpenStack API.",
-							Type:        []string{"string"},
+							Description: "ShootDeletionProtection defines whether Shoots created in this project are protected against deletion by default.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"constraints", "keystoneURL"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.OpenStackConstraints"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceBlackoutWindow", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackRouter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackRouter indicates whether to use an existing router or create a new one.",
+				Description: "ProjectStatus holds the most recently observed status of the project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the router id of an existing OpenStack router.",
+							Description: "ObservedGeneration is the most recent generation observed for this project.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the current phase of the project.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"id"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_OpenStackWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackWorker is the definition of a worker group.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"maxSurge": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Spec defines the Quota constraints.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
 						},
 					},
-					"maxUnavailable": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_QuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaList is a collection of Quotas.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"taints": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "Items is the list of Quotas.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota"),
 									},
 								},
 							},
 						},
 					},
-					"kubelet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
-						},
-					},
-					"caBundle": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketCloud contains the Shoot specification for Packet cloud",
+				Description: "QuotaSpec is the specification of a Quota.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"networks": {
+					"clusterLifetimeDays": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks holds information about the Kubernetes and infrastructure networks.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks"),
+							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"workers": {
+					"metrics": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Workers is a list of worker groups.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Metrics is a list of resources which will be put under constraints.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker"),
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"zones": {
+					"scope": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones is a list of availability zones to deploy the Shoot cluster to, currently, only one is supported.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"networks", "workers", "zones"},
+				Required: []string{"metrics", "scope"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketNetworks", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketWorker", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketConstraints(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketConstraints is an object containing constraints for certain values in the Shoot specification",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dnsProviders": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DNSProviders contains constraints regarding allowed values of the 'dns.provider' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint"),
-									},
-								},
-							},
-						},
-					},
-					"kubernetes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints"),
-						},
-					},
-					"machineImages": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineImages contains constraints regarding allowed values for machine images in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage"),
-									},
-								},
-							},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"machineTypes": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineTypes contains constraints regarding allowed values for machine types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"volumeTypes": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeTypes contains constraints regarding allowed values for volume types in the 'workers' block in the Shoot specification.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType"),
-									},
-								},
-							},
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"zones": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Zones contains constraints regarding allowed values for 'zones' block in the Shoot specification.",
+							Description: "SecretRef is a reference to a secret object in the same or another namespace.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+						},
+					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas is a list of references to Quota objects in the same or another namespace.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"),
+										Ref: ref("k8s.io/api/core/v1.ObjectReference"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"kubernetes", "machineImages", "machineTypes", "volumeTypes", "zones"},
+				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderConstraint", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubernetesConstraints", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.VolumeType", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Zone"},
+			"k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SecretBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketNetworks holds information about the Kubernetes and infrastructure networks.",
+				Description: "SecretBindingList is a collection of SecretBindings.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"nodes": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"pods": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"services": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_PacketProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "PacketProfile defines constraints and definitions in Packet Cloud environment.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"constraints": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Constraints is an object containing constraints for certain values in the Shoot specification.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"),
+							Description: "Items is the list of SecretBindings.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"constraints"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.PacketConstraints"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_PacketWorker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PacketWorker is the definition of a worker group.",
+				Description: "Seed holds certain properties about a Seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineType": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"machineImage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
-						},
-					},
-					"autoScalerMin": {
-						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"autoScalerMax": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"maxSurge": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Spec defines the Seed cluster properties.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec"),
 						},
 					},
-					"maxUnavailable": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "Most recently observed status of the Seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus"),
 						},
 					},
-					"annotations": {
+				},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,DOMAIN:.spec.ingressDomain,CLOUDPROFILE:.spec.cloud.profile,REGION:.spec.cloud.profile,READY:.status.conditions[?(@.type == 'Available')].status",
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedCapacity(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCapacity contains information about the resources of a Seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allocatable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Description: "Allocatable is the sum of the allocatable CPU and memory of all nodes of the Seed cluster.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+					"requested": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Description: "Requested is the sum of the CPU and memory requests of all pods of the Seed cluster which are not terminated.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"taints": {
+					"excessCapacityReserved": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "ExcessCapacityReserved is the CPU and memory reserved for new shoot control planes. It is empty if no excess capacity is reserved.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 									},
 								},
 							},
 						},
 					},
-					"kubelet": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
-						},
-					},
-					"caBundle": {
+					"shootControlPlanes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ShootControlPlanes is the number of shoot control planes hosted by the Seed cluster.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"volumeType": {
+				},
+				Required: []string{"shootControlPlanes"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCloud defines the cloud profile and the region this Seed cluster belongs to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"profile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the type of the root volumes.",
+							Description: "Profile is the name of a cloud profile.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeSize": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSize is the size of the root volume.",
+							Description: "Region is a name of a region.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax", "volumeType", "volumeSize"},
+				Required: []string{"profile", "region"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project holds certain properties about a Gardener project.",
+				Description: "SeedList is a collection of Seeds.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10008,226 +12210,262 @@ func schema_pkg_apis_garden_v1beta1_Project(ref common.ReferenceCallback) common
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"spec": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the project properties.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec"),
+							Description: "Items is the list of Seeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed"),
+									},
+								},
+							},
 						},
 					},
-					"status": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_SeedLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedLogging contains settings for the logging of the Seed cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Project.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus"),
+							Description: "Endpoint is the URL of an external HTTP(S) endpoint the logs of the Seed cluster are shipped to. It is only used if the external logging backend is configured for the Gardener controller manager.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is a collection of Projects.",
+				Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"nodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Nodes is the CIDR of the node network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Pods is the CIDR of the pod network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Services is the CIDR of the service network.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"items": {
+					"shootDefaults": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Projects.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project"),
-									},
-								},
-							},
+							Description: "ShootDefaults contains the default networks CIDRs for shoots.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"),
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"nodes", "pods", "services"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Project", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectSpec is the specification of a Project.",
+				Description: "SeedSpec is the specification of a Seed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"createdBy": {
+					"cloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CreatedBy is a subject representing a user name, an email address, or any other identifier of a user who created the project.",
-							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+							Description: "Cloud defines the cloud profile and the region this Seed cluster belongs to.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud"),
 						},
 					},
-					"description": {
+					"ingressDomain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Description is a human-readable description of what the project is used for.",
+							Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"owner": {
+					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Owner is a subject representing a user name, an email address, or any other identifier of a user owning the project.",
-							Ref:         ref("k8s.io/api/rbac/v1.Subject"),
+							Description: "SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for the account the Seed cluster has been deployed to.",
+							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
-					"purpose": {
+					"networks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is a human-readable explanation of the project's purpose.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks"),
 						},
 					},
-					"members": {
+					"blockCIDRs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Members is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with full permissions to manage it.",
+							Description: "BlockCIDRs is a list of network addresses tha should be blocked for shoot control plane components running in the seed cluster.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/rbac/v1.Subject"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"namespace": {
+					"visible": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace is the name of the namespace that has been created for the Project object. A nil value means that Gardener will determine the name of the namespace.",
-							Type:        []string{"string"},
+							Description: "Visible labels the Seed cluster as selectable for the seedfinder admission controller.",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"viewers": {
+					"protected": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Viewers is a list of subjects representing a user name, an email address, or any other identifier of a user that should be part of this project with limited permissions to only view some resources.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/rbac/v1.Subject"),
-									},
-								},
-							},
+							Description: "Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"backup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backup holds the object store configuration for the backups of shoot(currently only etcd). If it is not specified, then there won't be any backups taken for Shoots associated with this Seed. If backup field is present in Seed, then backups of the etcd from Shoot controlplane will be stored under the configured object store.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupProfile"),
+						},
+					},
+					"backupLimits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BackupLimits contains limits for the etcd backup settings of Shoots whose control plane runs in this Seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupLimits"),
+						},
+					},
+					"logging": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Logging contains settings for the logging of the Seed cluster and the Shoot control planes running in it.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedLogging"),
 						},
 					},
 				},
+				Required: []string{"cloud", "ingressDomain", "secretRef", "networks"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupLimits", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedLogging", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks", "k8s.io/api/core/v1.SecretReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ProjectStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_SeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectStatus holds the most recently observed status of the project.",
+				Description: "SeedStatus holds the most recently observed status of the Seed cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"gardener": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Seed's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
 					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this project.",
+							Description: "ObservedGeneration is the most recent generation observed for this Seed. It corresponds to the Seed's generation, which is updated on mutation by the API Server.",
 							Type:        []string{"integer"},
 							Format:      "int64",
 						},
 					},
-					"phase": {
+					"capacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the project.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Capacity contains the allocatable and requested resources of the Seed cluster and the number of hosted shoot control planes.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCapacity"),
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCapacity"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Quota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ServiceAccountConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ServiceAccountConfig is the kube-apiserver configuration for service accounts.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"issuer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Issuer is the identifier of the service account token issuer. The issuer will assert this identifier in \"iss\" claim of issued tokens. This value is a string or URI.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"spec": {
+					"signingKeySecretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the Quota constraints.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec"),
+							Description: "SigningKeySecret is a reference to a secret that contains the current private key of the service account token issuer. The issuer will sign issued ID tokens with this private key. (Requires the 'TokenRequest' feature gate.)",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_QuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaList is a collection of Quotas.",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
 						SchemaProps: spec.SchemaProps{
@@ -10245,135 +12483,110 @@ func schema_pkg_apis_garden_v1beta1_QuotaList(ref common.ReferenceCallback) comm
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"items": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Quotas.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota"),
-									},
-								},
-							},
+							Description: "Specification of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus"),
 						},
 					},
 				},
-				Required: []string{"items"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state",
+				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Quota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_QuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootAutoRepairStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "QuotaSpec is the specification of a Quota.",
+				Description: "ShootAutoRepairStatus contains information about the auto repair of the worker pools of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"clusterLifetimeDays": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ClusterLifetimeDays is the lifetime of a Shoot cluster in days before it will be terminated automatically.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"metrics": {
+					"pools": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Metrics is a list of resources which will be put under constraints.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Pools contains the auto repair status of the worker pools whose machines have been replaced.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerPoolAutoRepairStatus"),
 									},
 								},
 							},
 						},
 					},
-					"scope": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Scope is the scope of the Quota object, either 'project' or 'secret'.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
-				Required: []string{"metrics", "scope"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.WorkerPoolAutoRepairStatus"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SecretBinding(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "ShootBackup contains settings for the backups of the etcd of the Shoot.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Schedule is the cron schedule in which full snapshots of the etcd are taken.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
-						},
-					},
-					"secretRef": {
+					"deltaSnapshotPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a secret object in the same or another namespace.",
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "DeltaSnapshotPeriod is the period after which delta snapshots of the etcd are taken.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"quotas": {
+					"garbageCollectionPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Quotas is a list of references to Quota objects in the same or another namespace.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.ObjectReference"),
-									},
-								},
-							},
+							Description: "GarbageCollectionPolicy is the policy used to garbage collect old snapshots of the etcd.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxBackups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxBackups is the number of full snapshots of the etcd which are retained. It is only used for the `LimitBased` garbage collection policy.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.ObjectReference", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SecretBindingList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SecretBindingList is a collection of SecretBindings.",
+				Description: "ShootList is a list of Shoot objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10398,12 +12611,12 @@ func schema_pkg_apis_garden_v1beta1_SecretBindingList(ref common.ReferenceCallba
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of SecretBindings.",
+							Description: "Items is the list of Shoots.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot"),
 									},
 								},
 							},
@@ -10414,754 +12627,836 @@ func schema_pkg_apis_garden_v1beta1_SecretBindingList(ref common.ReferenceCallba
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SecretBinding", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Seed(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Seed holds certain properties about a Seed cluster.",
+				Description: "MachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Name is the name of the image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "Version is the version of the shoot's image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"providerConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
 						},
 					},
-					"spec": {
+				},
+				Required: []string{"name", "version"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+	}
+}
+
+func schema_pkg_apis_garden_v1beta1_ShootMaintenanceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootMaintenanceStatus contains information about the last maintenance operation of a Shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastMaintenanceTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the Seed cluster properties.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec"),
+							Description: "LastMaintenanceTime is the point in time when the last maintenance operation was executed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"status": {
+					"tasks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Seed cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus"),
+							Description: "Tasks contains the results of the maintenance tasks which were executed during the last maintenance operation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTaskStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
-			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-print-columns": "custom-columns=NAME:.metadata.name,DOMAIN:.spec.ingressDomain,CLOUDPROFILE:.spec.cloud.profile,REGION:.spec.cloud.profile,READY:.status.conditions[?(@.type == 'Available')].status",
-				},
+				Required: []string{"lastMaintenanceTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.MaintenanceTaskStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedCloud defines the cloud profile and the region this Seed cluster belongs to.",
+				Description: "ShootNetworks contains the default networks CIDRs for shoots.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"profile": {
+					"pods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Profile is the name of a cloud profile.",
+							Description: "Pods is the CIDR of the pod network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"region": {
+					"services": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a name of a region.",
+							Description: "Services is the CIDR of the service network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"profile", "region"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedList is a collection of Seeds.",
+				Description: "ShootSpec is the specification of a Shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"addons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Addons contains information about enabled/disabled addons and their configuration.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
 						},
 					},
-					"apiVersion": {
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Backup contains settings for the backups of the etcd of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootBackup"),
 						},
 					},
-					"metadata": {
+					"cloud": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Cloud contains information about the cloud environment and their specific settings.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
 						},
 					},
-					"items": {
+					"dns": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Seeds.",
+							Description: "DNS contains information about the DNS settings of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions contain type and provider information for Shoot extensions.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Seed", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"nodes": {
+					"hibernation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Nodes is the CIDR of the node network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Hibernation contains information whether the Shoot is suspended or not.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
 						},
 					},
-					"pods": {
+					"kubernetes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes"),
 						},
 					},
-					"services": {
+					"networking": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Networking contains information about cluster networking such as CNI Plugin type, CIDRs, ...etc.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking"),
 						},
 					},
-					"shootDefaults": {
+					"maintenance": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootDefaults contains the default networks CIDRs for shoots.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"),
+							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
 						},
 					},
 				},
-				Required: []string{"nodes", "pods", "services"},
+				Required: []string{"cloud", "dns", "kubernetes"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootNetworks"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootBackup"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_SeedSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedSpec is the specification of a Seed.",
+				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cloud": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cloud defines the cloud profile and the region this Seed cluster belongs to.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud"),
+							Description: "Conditions represents the latest available observations of a Shoots's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+									},
+								},
+							},
 						},
 					},
-					"ingressDomain": {
+					"gardener": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IngressDomain is the domain of the Seed cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot clusters.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
 						},
 					},
-					"secretRef": {
+					"lastOperation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecretRef is a reference to a Secret object containing the Kubeconfig and the cloud provider credentials for the account the Seed cluster has been deployed to.",
-							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
+							Description: "LastOperation holds information about the last operation on the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
 						},
 					},
-					"networks": {
+					"lastError": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networks defines the pod, service and worker network of the Seed cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks"),
+							Description: "LastError holds information about the last occurred error during an operation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
 						},
 					},
-					"blockCIDRs": {
+					"observedGeneration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "BlockCIDRs is a list of network addresses tha should be blocked for shoot control plane components running in the seed cluster.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
-					"visible": {
+					"retryCycleStartTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Visible labels the Seed cluster as selectable for the seedfinder admission controller.",
-							Type:        []string{"boolean"},
+							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"seed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"protected": {
+					"hibernated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Protected prevent that the Seed Cluster can be used for regular Shoot cluster control planes.",
+							Description: "IsHibernated indicates whether the Shoot is currently hibernated.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"backup": {
+					"technicalID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Backup holds the object store configuration for the backups of shoot(currently only etcd). If it is not specified, then there won't be any backups taken for Shoots associated with this Seed. If backup field is present in Seed, then backups of the etcd from Shoot controlplane will be stored under the configured object store.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupProfile"),
+							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
-					},
-				},
-				Required: []string{"cloud", "ingressDomain", "secretRef", "networks"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.BackupProfile", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedCloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.SeedNetworks", "k8s.io/api/core/v1.SecretReference"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_SeedStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "SeedStatus holds the most recently observed status of the Seed cluster.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"gardener": {
+					},
+					"uid": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
+					"maintenance": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Seed's current state.",
+							Description: "Maintenance holds information about the last maintenance operation of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMaintenanceStatus"),
+						},
+					},
+					"autoRepair": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoRepair holds information about the machines which were replaced because their nodes stayed unhealthy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAutoRepairStatus"),
+						},
+					},
+					"dnsProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNSProviders contains the status of the DNS records of the additional DNS providers of the Shoot.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderStatus"),
 									},
 								},
 							},
 						},
 					},
-					"observedGeneration": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Seed. It corresponds to the Seed's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
-						},
-					},
 				},
+				Required: []string{"gardener", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"},
+			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNSProviderStatus", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootAutoRepairStatus", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMaintenanceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ServiceAccountConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_VolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ServiceAccountConfig is the kube-apiserver configuration for service accounts.",
+				Description: "VolumeType contains certain properties of a volume type.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"issuer": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Issuer is the identifier of the service account token issuer. The issuer will assert this identifier in \"iss\" claim of issued tokens. This value is a string or URI.",
+							Description: "Name is the name of the volume type.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"signingKeySecretName": {
+					"usable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SigningKeySecret is a reference to a secret that contains the current private key of the service account token issuer. The issuer will sign issued ID tokens with this private key. (Requires the 'TokenRequest' feature gate.)",
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Usable defines if the volume type can be used for shoot clusters.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"class": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Class is the class of the volume type.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "class"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.LocalObjectReference"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Shoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "Worker is the base definition of a worker group.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Description: "Name is the name of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"machineType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Description: "MachineType is the machine type of the worker group.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"machineImage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
 						},
 					},
-					"spec": {
+					"autoScalerMin": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec"),
+							Description: "AutoScalerMin is the minimum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"autoScalerMax": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Shoot cluster.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus"),
+							Description: "AutoScalerMin is the maximum number of VMs to create.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
-			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-print-columns": "custom-columns=NAMESPACE:.metadata.namespace,NAME:.metadata.name,SEED:.spec.cloud.seed,DOMAIN:.spec.dns.domain,VERSION:.spec.kubernetes.version,CONTROL:.status.conditions[?(@.type == 'ControlPlaneHealthy')].status,NODES:.status.conditions[?(@.type == 'EveryNodeReady')].status,SYSTEM:.status.conditions[?(@.type == 'SystemComponentsHealthy')].status,LATEST:.status.lastOperation.state",
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootSpec", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_ShootList(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "ShootList is a list of Shoot objects.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"maxSurge": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxSurge is maximum number of VMs that are created during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"apiVersion": {
+					"maxUnavailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"metadata": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Shoots.",
+							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"taints": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot"),
+										Ref: ref("k8s.io/api/core/v1.Taint"),
 									},
 								},
 							},
 						},
 					},
-				},
-				Required: []string{"items"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Shoot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
-	}
-}
-
-func schema_pkg_apis_garden_v1beta1_ShootMachineImage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "MachineImage defines the name and the version of the shoot's machine image in any environment. Has to be defined in the respective CloudProfile.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"kubelet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the image.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Kubelet contains configuration settings for the kubelet.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
 						},
 					},
-					"version": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the shoot's image.",
+							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"providerConfig": {
+					"cri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderConfig is the shoot's individual configuration passed to an extension resource.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"),
+							Description: "CRI contains configurations of CRI support of every machine in the worker pool.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI"),
 						},
 					},
 				},
-				Required: []string{"name", "version"},
+				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.ProviderConfig"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ShootNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_WorkerPoolAutoRepairStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootNetworks contains the default networks CIDRs for shoots.",
+				Description: "WorkerPoolAutoRepairStatus contains information about the last auto repair of a worker pool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"pods": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pods is the CIDR of the pod network.",
+							Description: "Name is the name of the worker pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"services": {
+					"lastRepairTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Services is the CIDR of the service network.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastRepairTime is the point in time when machines of the worker pool were replaced the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"name", "lastRepairTime"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ShootSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_garden_v1beta1_Zone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootSpec is the specification of a Shoot.",
+				Description: "Zone contains certain properties of an availability zone.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"addons": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Addons contains information about enabled/disabled addons and their configuration.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
-						},
-					},
-					"cloud": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Cloud contains information about the cloud environment and their specific settings.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud"),
-						},
-					},
-					"dns": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DNS contains information about the DNS settings of the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS"),
+							Description: "Region is a region name.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"extensions": {
+					"names": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extensions contain type and provider information for Shoot extensions.",
+							Description: "Names is a list of availability zone names in this region.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"hibernation": {
+				},
+				Required: []string{"region", "names"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot objects cluster-wide.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Hibernation contains information whether the Shoot is suspended or not.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubernetes": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubernetes contains the version and configuration settings of the control plane components.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"networking": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Networking contains information about cluster networking such as CNI Plugin type, CIDRs, ...etc.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking"),
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"maintenance": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Maintenance contains information about the time window for maintenance operations and which operations should be performed.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
+							Description: "Spec is the specification of this OpenIDConnect preset.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec"),
 						},
 					},
 				},
-				Required: []string{"cloud", "dns", "kubernetes"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Cloud", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Networking"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_ShootStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootStatus holds the most recently observed status of the Shoot cluster.",
+				Description: "ClusterOpenIDConnectPresetList is a collection of ClusterOpenIDConnectPresets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"conditions": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Shoots's current state.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ClusterOpenIDConnectPresets.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset"),
 									},
 								},
 							},
 						},
 					},
-					"gardener": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterOpenIDConnectPresetSpec contains the OpenIDConnect specification and project selector matching Shoots in Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"server": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Gardener holds information about the Gardener which last acted on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener"),
+							Description: "Server contains the kube-apiserver's OpenID Connect configuration. This configuration is not overwritting any existing OpenID Connect configuration already set on the Shoot object.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect"),
 						},
 					},
-					"lastOperation": {
+					"client": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastOperation holds information about the last operation on the Shoot.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation"),
+							Description: "Client contains the configuration used for client OIDC authentication of Shoot clusters. This configuration is not overwritting any existing OpenID Connect client authentication already set on the Shoot object.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication"),
 						},
 					},
-					"lastError": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastError holds information about the last occurred error during an operation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError"),
+							Description: "ShootSelector decides whether to apply the configuration if the Shoot has matching labels. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"observedGeneration": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Shoot. It corresponds to the Shoot's generation, which is updated on mutation by the API Server.",
+							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Required.",
 							Type:        []string{"integer"},
-							Format:      "int64",
+							Format:      "int32",
 						},
 					},
-					"retryCycleStartTime": {
+					"projectSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "RetryCycleStartTime is the start time of the last retry cycle (used to determine how often an operation must be retried until we give up).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Project decides whether to apply the configuration if the Shoot is in a specific Project mathching the label selector. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"seed": {
+				},
+				Required: []string{"server", "weight"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterShootPreset contains default settings that are applied to Shoots cluster-wide at creation time.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Seed is the name of the seed cluster that runs the control plane of the Shoot. This value is only written after a successful create/reconcile operation. It will be used when control planes are moved between Seeds.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"hibernated": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IsHibernated indicates whether the Shoot is currently hibernated.",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"technicalID": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TechnicalID is the name that is used for creating the Seed namespace, the infrastructure resources, and basically everything that is related to this particular Shoot.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"uid": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UID is a unique identifier for the Shoot cluster to avoid portability between Kubernetes clusters. It is used to compute unique hashes.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Spec is the specification of this Shoot preset.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPresetSpec"),
 						},
 					},
 				},
-				Required: []string{"gardener", "technicalID", "uid"},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1alpha1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1alpha1.LastOperation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Gardener", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_VolumeType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeType contains certain properties of a volume type.",
+				Description: "ClusterShootPresetList is a collection of ClusterShootPresets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the volume type.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"usable": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Usable defines if the volume type can be used for shoot clusters.",
-							Type:        []string{"boolean"},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"class": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Class is the class of the volume type.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ClusterShootPresets.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPreset"),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "class"},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterShootPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Worker(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ClusterShootPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Worker is the base definition of a worker group.",
+				Description: "ClusterShootPresetSpec contains the default settings and the selection criteria of a cluster-wide Shoot preset.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"defaults": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Defaults contains the settings which are applied to matching Shoots. Settings already specified in the Shoot are not overwritten.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults"),
 						},
 					},
-					"machineType": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MachineType is the machine type of the worker group.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ShootSelector decides whether to apply the defaults if the Shoot has matching labels. Use the selector only if the preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"machineImage": {
+					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootMachineImage holds information about the machine image to use for all workers. It will default to the latest version of the first image stated in the referenced CloudProfile if no value has been provided.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage"),
+							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Presets with a higher weight take precedence. Required.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"autoScalerMin": {
+					"projectSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the minimum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Project decides whether to apply the defaults if the Shoot is in a specific Project matching the label selector. Use the selector only if the preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"autoScalerMax": {
+				},
+				Required: []string{"defaults", "weight"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeAPIServerOpenIDConnect contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoScalerMin is the maximum number of VMs to create.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxSurge": {
+					"clientID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxSurge is maximum number of VMs that are created during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "The client ID for the OpenID Connect client. Required.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxUnavailable": {
+					"groupsClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxUnavailable is the maximum number of VMs that can be unavailable during an update.",
-							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This field is experimental, please see the authentication documentation for further details.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"annotations": {
+					"groupsPrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations is a map of key/value pairs for annotations for all the `Node` objects in this worker pool.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
-									},
-								},
-							},
+							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"issuerURL": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels is a map of key/value pairs for labels for all the `Node` objects in this worker pool.",
+							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT). Required.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"requiredClaims": {
+						SchemaProps: spec.SchemaProps{
+							Description: "key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value. Only applied when the Kubernetes version of the Shoot is >= 1.11",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -11174,60 +13469,61 @@ func schema_pkg_apis_garden_v1beta1_Worker(ref common.ReferenceCallback) common.
 							},
 						},
 					},
-					"taints": {
+					"signingAlgs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Taints is a list of taints for all the `Node` objects in this worker pool.",
+							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1 Defaults to [RS256]",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/api/core/v1.Taint"),
+										Type:   []string{"string"},
+										Format: "",
 									},
 								},
 							},
 						},
 					},
-					"kubelet": {
+					"usernameClaim": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubelet contains configuration settings for the kubelet.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This field is experimental, please see the authentication documentation for further details. Defaults to \"sub\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"caBundle": {
+					"usernamePrefix": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CABundle is a certificate bundle which will be installed onto every machine of this worker pool.",
+							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "machineType", "autoScalerMin", "autoScalerMax"},
+				Required: []string{"clientID", "issuerURL"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.ShootMachineImage", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
-func schema_pkg_apis_garden_v1beta1_Zone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Zone contains certain properties of an availability zone.",
+				Description: "OpenIDConnectClientAuthentication contains configuration for OIDC clients.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"region": {
+					"secret": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Region is a region name.",
+							Description: "The client Secret for the OpenID Connect client.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"names": {
+					"extraConfig": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Names is a list of availability zone names in this region.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "Extra configuration added to kubeconfig's auth-provider. Must not be any of idp-issuer-url, client-id, client-secret, idp-certificate-authority, idp-certificate-authority-data, id-token or refresh-token",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Type:   []string{"string"},
@@ -11238,17 +13534,16 @@ func schema_pkg_apis_garden_v1beta1_Zone(ref common.ReferenceCallback) common.Op
 						},
 					},
 				},
-				Required: []string{"region", "names"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot objects cluster-wide.",
+				Description: "OpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot in a namespace.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11274,7 +13569,7 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.Ref
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Spec is the specification of this OpenIDConnect preset.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec"),
 						},
 					},
 				},
@@ -11282,15 +13577,15 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPreset(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOpenIDConnectPresetList is a collection of ClusterOpenIDConnectPresets.",
+				Description: "OpenIDConnectPresetList is a collection of OpenIDConnectPresets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11315,12 +13610,12 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of ClusterOpenIDConnectPresets.",
+							Description: "Items is the list of OpenIDConnectPresets.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset"),
 									},
 								},
 							},
@@ -11331,15 +13626,15 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetList(ref common
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ClusterOpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterOpenIDConnectPresetSpec contains the OpenIDConnect specification and project selector matching Shoots in Projects.",
+				Description: "OpenIDConnectPresetSpec contains the Shoot selector for which a specific OpenID Connect configuration is applied.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"server": {
@@ -11367,81 +13662,195 @@ func schema_pkg_apis_settings_v1alpha1_ClusterOpenIDConnectPresetSpec(ref common
 							Format:      "int32",
 						},
 					},
-					"projectSelector": {
+				},
+				Required: []string{"server", "weight"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootDefaults contains the default settings of a Shoot preset.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"addons": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Project decides whether to apply the configuration if the Shoot is in a specific Project mathching the label selector. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+							Description: "Addons contains defaults for the addons of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons"),
+						},
+					},
+					"auditConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AuditConfig contains defaults for the audit settings of the kube-apiserver of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig"),
+						},
+					},
+					"extensions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Extensions contains default extensions of the Shoot. They are merged with the Shoot's extensions by their type.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension"),
+									},
+								},
+							},
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation contains defaults for the hibernation settings of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation"),
+						},
+					},
+					"kubelet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubelet contains defaults for the kubelet configuration of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig"),
+						},
+					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains defaults for the maintenance settings of the Shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"),
 						},
 					},
 				},
-				Required: []string{"server", "weight"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/garden/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/garden/v1beta1.Maintenance"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeAPIServerOpenIDConnect contains configuration settings for the OIDC provider. Note: Descriptions were taken from the Kubernetes documentation.",
+				Description: "ShootPolicy contains rules which are evaluated against Shoots when they are created or updated.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"caBundle": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If set, the OpenID server's certificate will be verified by one of the authorities in the oidc-ca-file, otherwise the host's root CA set will be used.",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"clientID": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The client ID for the OpenID Connect client. Required.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"groupsClaim": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, the name of a custom OpenID Connect claim for specifying user groups. The claim value is expected to be a string or array of strings. This field is experimental, please see the authentication documentation for further details.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Standard object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"groupsPrefix": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all groups will be prefixed with this value to prevent conflicts with other authentication strategies.",
+							Description: "Spec is the specification of this Shoot policy.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicySpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyList is a collection of ShootPolicies.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"issuerURL": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The URL of the OpenID issuer, only HTTPS scheme will be accepted. If set, it will be used to verify the OIDC JSON Web Token (JWT). Required.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"requiredClaims": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "key=value pairs that describes a required claim in the ID Token. If set, the claim is verified to be present in the ID Token with a matching value. Only applied when the Kubernetes version of the Shoot is >= 1.11",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Standard list object metadata.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of ShootPolicies.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicy"),
 									},
 								},
 							},
 						},
 					},
-					"signingAlgs": {
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_settings_v1alpha1_ShootPolicyRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootPolicyRule is a single rule of a Shoot policy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "List of allowed JOSE asymmetric signing algorithms. JWTs with a 'alg' header value not in this list will be rejected. Values are defined by RFC 7518 https://tools.ietf.org/html/rfc7518#section-3.1 Defaults to [RS256]",
+							Description: "Name is the name of the rule. It must be unique within the policy.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path selects the fields of the Shoot the rule applies to, e.g. `spec.cloud.aws.workers[*].machineType`. It refers to the garden.sapcloud.io/v1beta1 representation of the Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operator": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operator represents the relationship of the selected values to the given values.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values is a list of values the selected values are compared with. It must be empty for the Exists and DoesNotExist operators.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -11453,67 +13862,67 @@ func schema_pkg_apis_settings_v1alpha1_KubeAPIServerOpenIDConnect(ref common.Ref
 							},
 						},
 					},
-					"usernameClaim": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The OpenID claim to use as the user name. Note that claims other than the default ('sub') is not guaranteed to be unique and immutable. This field is experimental, please see the authentication documentation for further details. Defaults to \"sub\".",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"usernamePrefix": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If provided, all usernames will be prefixed with this value. If not provided, username claims other than 'email' are prefixed by the issuer URL to avoid clashes. To skip any prefixing, provide the value '-'.",
+							Description: "Message is an optional message which is reported if the rule is violated.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"clientID", "issuerURL"},
+				Required: []string{"name", "path", "operator"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectClientAuthentication(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectClientAuthentication contains configuration for OIDC clients.",
+				Description: "ShootPolicySpec contains the rules and the selection criteria of a Shoot policy.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"secret": {
+					"projectSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The client Secret for the OpenID Connect client.",
+							Description: "ProjectSelector decides whether to evaluate the rules if the Shoot is in a specific Project matching the label selector. Default to the empty LabelSelector, which matches everything.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"enforcementAction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforcementAction decides what happens if a Shoot violates the rules. Defaults to Deny.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"extraConfig": {
+					"rules": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Extra configuration added to kubeconfig's auth-provider. Must not be any of idp-issuer-url, client-id, client-secret, idp-certificate-authority, idp-certificate-authority-data, id-token or refresh-token",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Rules is the list of rules which must be fulfilled by the Shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Type:   []string{"string"},
-										Format: "",
+										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"rules"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPolicyRule", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPreset(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectPreset is a OpenID Connect configuration that is applied to a Shoot in a namespace.",
+				Description: "ShootPreset contains default settings that are applied to Shoots in a namespace at creation time.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11538,8 +13947,8 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceC
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec is the specification of this OpenIDConnect preset.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec"),
+							Description: "Spec is the specification of this Shoot preset.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPresetSpec"),
 						},
 					},
 				},
@@ -11547,15 +13956,15 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPreset(ref common.ReferenceC
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPresetSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPresetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectPresetList is a collection of OpenIDConnectPresets.",
+				Description: "ShootPresetList is a collection of ShootPresets.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -11580,12 +13989,12 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.Refere
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of OpenIDConnectPresets.",
+							Description: "Items is the list of ShootPresets.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset"),
+										Ref: ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPreset"),
 									},
 								},
 							},
@@ -11596,48 +14005,42 @@ func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetList(ref common.Refere
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootPreset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_settings_v1alpha1_OpenIDConnectPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_settings_v1alpha1_ShootPresetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenIDConnectPresetSpec contains the Shoot selector for which a specific OpenID Connect configuration is applied.",
+				Description: "ShootPresetSpec contains the default settings and the selection criteria of a Shoot preset.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"server": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Server contains the kube-apiserver's OpenID Connect configuration. This configuration is not overwritting any existing OpenID Connect configuration already set on the Shoot object.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect"),
-						},
-					},
-					"client": {
+					"defaults": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Client contains the configuration used for client OIDC authentication of Shoot clusters. This configuration is not overwritting any existing OpenID Connect client authentication already set on the Shoot object.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication"),
+							Description: "Defaults contains the settings which are applied to matching Shoots. Settings already specified in the Shoot are not overwritten.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults"),
 						},
 					},
 					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootSelector decides whether to apply the configuration if the Shoot has matching labels. Use the selector only if the OIDC Preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
+							Description: "ShootSelector decides whether to apply the defaults if the Shoot has matching labels. Use the selector only if the preset is opt-in, because end users may skip the admission by setting the labels. Default to the empty LabelSelector, which matches everything.",
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
 					"weight": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Required.",
+							Description: "Weight associated with matching the corresponding preset, in the range 1-100. Presets with a higher weight take precedence. Required.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"server", "weight"},
+				Required: []string{"defaults", "weight"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.KubeAPIServerOpenIDConnect", "github.com/gardener/gardener/pkg/apis/settings/v1alpha1.OpenIDConnectClientAuthentication", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/settings/v1alpha1.ShootDefaults", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
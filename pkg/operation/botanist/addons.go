@@ -33,6 +33,7 @@ import (
 	"github.com/gardener/gardener-resource-manager/pkg/manager"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -41,7 +42,7 @@ const DNSPurposeIngress = "ingress"
 
 // EnsureIngressDNSRecord creates the respective wildcard DNS record for the nginx-ingress-controller.
 func (b *Botanist) EnsureIngressDNSRecord(ctx context.Context) error {
-	if !b.Shoot.NginxIngressEnabled() || b.Shoot.HibernationEnabled {
+	if !b.Shoot.NginxIngressEnabled() || b.Shoot.ControlPlaneHibernationEnabled || b.Shoot.ExternalDomain == nil {
 		return b.DestroyIngressDNSRecord(ctx)
 	}
 
@@ -50,20 +51,18 @@ func (b *Botanist) EnsureIngressDNSRecord(ctx context.Context) error {
 		return err
 	}
 
-	if err := b.waitUntilDNSProviderReady(ctx, DNSPurposeExternal); err != nil {
+	if err := b.deployDNSRecord(ctx, DNSPurposeIngress, b.Shoot.ExternalDomain, b.Shoot.GetIngressFQDN("*"), loadBalancerIngress); err != nil {
 		return err
 	}
-
-	if err := b.deployDNSEntry(ctx, DNSPurposeIngress, b.Shoot.GetIngressFQDN("*"), loadBalancerIngress); err != nil {
-		return err
-	}
-
-	return b.deleteLegacyTerraformDNSResources(ctx, common.TerraformerPurposeIngressDNSDeprecated)
+	return b.handOverLegacyDNSResources(ctx, DNSPurposeIngress)
 }
 
-// DestroyIngressDNSRecord destroys the nginx-ingress resources created by Terraform.
+// DestroyIngressDNSRecord destroys the wildcard DNS record for the nginx-ingress-controller.
 func (b *Botanist) DestroyIngressDNSRecord(ctx context.Context) error {
-	return b.deleteDNSEntry(ctx, DNSPurposeIngress)
+	if err := b.deleteLegacyDNSResources(ctx, DNSPurposeIngress); err != nil {
+		return err
+	}
+	return b.deleteDNSRecord(ctx, DNSPurposeIngress)
 }
 
 // GenerateKubernetesDashboardConfig generates the values which are required to render the chart of
@@ -255,8 +254,21 @@ func (b *Botanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart, erro
 		}
 		nodeExporterConfig     = map[string]interface{}{}
 		blackboxExporterConfig = map[string]interface{}{}
+		containerRuntimeTypes  = sets.NewString()
 	)
 
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.CRI == nil {
+			continue
+		}
+		for _, containerRuntime := range worker.CRI.ContainerRuntimes {
+			containerRuntimeTypes.Insert(containerRuntime.Type)
+		}
+	}
+	runtimeClassesConfig := map[string]interface{}{
+		"containerRuntimes": containerRuntimeTypes.List(),
+	}
+
 	proxyConfig := b.Shoot.Info.Spec.Kubernetes.KubeProxy
 	if proxyConfig != nil {
 		kubeProxyConfig["featureGates"] = proxyConfig.FeatureGates
@@ -313,6 +325,7 @@ func (b *Botanist) generateCoreAddonsChart() (*chartrenderer.RenderedChart, erro
 		"global":              global,
 		"cluster-autoscaler":  clusterAutoscaler,
 		"podsecuritypolicies": podsecuritypolicies,
+		"runtimeclasses":      runtimeClassesConfig,
 		"coredns":             coreDNS,
 		"kube-proxy":          kubeProxy,
 		"vpn-shoot":           vpnShoot,
@@ -31,7 +31,6 @@ import (
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
-	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -285,17 +284,24 @@ func (b *Botanist) computeRequiredExtensions() map[string]sets.String {
 	requiredExtensions[extensionsv1alpha1.OperatingSystemConfigResource] = machineImagesSet
 
 	if b.Garden.InternalDomain.Provider != gardenv1beta1.DNSUnmanaged {
-		if requiredExtensions[dnsv1alpha1.DNSProviderKind] == nil {
-			requiredExtensions[dnsv1alpha1.DNSProviderKind] = sets.NewString()
+		if requiredExtensions[extensionsv1alpha1.DNSRecordResource] == nil {
+			requiredExtensions[extensionsv1alpha1.DNSRecordResource] = sets.NewString()
 		}
-		requiredExtensions[dnsv1alpha1.DNSProviderKind].Insert(b.Garden.InternalDomain.Provider)
+		requiredExtensions[extensionsv1alpha1.DNSRecordResource].Insert(b.Garden.InternalDomain.Provider)
 	}
 
 	if b.Shoot.ExternalDomain != nil && b.Shoot.ExternalDomain.Provider != gardenv1beta1.DNSUnmanaged {
-		if requiredExtensions[dnsv1alpha1.DNSProviderKind] == nil {
-			requiredExtensions[dnsv1alpha1.DNSProviderKind] = sets.NewString()
+		if requiredExtensions[extensionsv1alpha1.DNSRecordResource] == nil {
+			requiredExtensions[extensionsv1alpha1.DNSRecordResource] = sets.NewString()
 		}
-		requiredExtensions[dnsv1alpha1.DNSProviderKind].Insert(b.Shoot.ExternalDomain.Provider)
+		requiredExtensions[extensionsv1alpha1.DNSRecordResource].Insert(b.Shoot.ExternalDomain.Provider)
+	}
+
+	for _, additionalDomain := range b.Shoot.AdditionalDomains {
+		if requiredExtensions[extensionsv1alpha1.DNSRecordResource] == nil {
+			requiredExtensions[extensionsv1alpha1.DNSRecordResource] = sets.NewString()
+		}
+		requiredExtensions[extensionsv1alpha1.DNSRecordResource].Insert(additionalDomain.Provider)
 	}
 
 	for extensionType := range b.Shoot.Extensions {
@@ -305,6 +311,18 @@ func (b *Botanist) computeRequiredExtensions() map[string]sets.String {
 		requiredExtensions[extensionsv1alpha1.ExtensionResource].Insert(extensionType)
 	}
 
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.CRI == nil {
+			continue
+		}
+		for _, containerRuntime := range worker.CRI.ContainerRuntimes {
+			if requiredExtensions[extensionsv1alpha1.ContainerRuntimeResource] == nil {
+				requiredExtensions[extensionsv1alpha1.ContainerRuntimeResource] = sets.NewString()
+			}
+			requiredExtensions[extensionsv1alpha1.ContainerRuntimeResource].Insert(containerRuntime.Type)
+		}
+	}
+
 	requiredExtensions[extensionsv1alpha1.ControlPlaneResource] = sets.NewString(string(b.Shoot.CloudProvider))
 	requiredExtensions[extensionsv1alpha1.InfrastructureResource] = sets.NewString(string(b.Shoot.CloudProvider))
 	requiredExtensions[extensionsv1alpha1.NetworkResource] = sets.NewString(b.Shoot.Info.Spec.Networking.Type)
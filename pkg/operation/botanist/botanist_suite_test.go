@@ -310,7 +310,7 @@ var _ = Describe("health check", func() {
 				deploymentLister        = constDeploymentLister(deployments)
 				statefulSetLister       = constStatefulSetLister(statefulSets)
 				machineDeploymentLister = constMachineDeploymentLister(machineDeployments)
-				checker                 = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker                 = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
 			exitCondition, err := checker.CheckControlPlane(shoot, seedNamespace, condition, deploymentLister, statefulSetLister, machineDeploymentLister)
@@ -422,7 +422,7 @@ var _ = Describe("health check", func() {
 			var (
 				deploymentLister = constDeploymentLister(deployments)
 				daemonSetLister  = constDaemonSetLister(daemonSets)
-				checker          = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker          = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
 			exitCondition, err := checker.CheckSystemComponents(shootNamespace, condition, deploymentLister, daemonSetLister)
@@ -472,7 +472,7 @@ var _ = Describe("health check", func() {
 			var (
 				nodeLister              = constNodeLister(nodes)
 				machineDeploymentLister = constMachineDeploymentLister(machineDeployments)
-				checker                 = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker                 = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
 			exitCondition, err := checker.CheckClusterNodes(seedNamespace, condition, nodeLister, machineDeploymentLister)
@@ -515,7 +515,7 @@ var _ = Describe("health check", func() {
 		func(daemonSets []*appsv1.DaemonSet, conditionMatcher types.GomegaMatcher) {
 			var (
 				daemonSetLister = constDaemonSetLister(daemonSets)
-				checker         = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker         = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
 			exitCondition, err := checker.CheckMonitoringSystemComponents(shootNamespace, condition, daemonSetLister)
@@ -538,7 +538,7 @@ var _ = Describe("health check", func() {
 			var (
 				deploymentLister  = constDeploymentLister(deployments)
 				statefulSetLister = constStatefulSetLister(statefulSets)
-				checker           = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker           = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
 			exitCondition, err := checker.CheckMonitoringControlPlane(seedNamespace, wantsAlertmanager, condition, deploymentLister, statefulSetLister)
@@ -590,7 +590,7 @@ var _ = Describe("health check", func() {
 			var (
 				deploymentLister = constDeploymentLister(deployments)
 				daemonSetLister  = constDaemonSetLister(daemonSets)
-				checker          = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker          = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
 			exitCondition, err := checker.CheckOptionalAddonsSystemComponents(shootNamespace, condition, deploymentLister, daemonSetLister)
@@ -612,44 +612,54 @@ var _ = Describe("health check", func() {
 	)
 
 	DescribeTable("#CheckLoggingControlPlane",
-		func(deployments []*appsv1.Deployment, statefulSets []*appsv1.StatefulSet, conditionMatcher types.GomegaMatcher) {
+		func(backend botanist.LoggingBackend, deployments []*appsv1.Deployment, statefulSets []*appsv1.StatefulSet, conditionMatcher types.GomegaMatcher) {
 			var (
 				deploymentLister  = constDeploymentLister(deployments)
 				statefulSetLister = constStatefulSetLister(statefulSets)
-				checker           = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{})
+				checker           = botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, nil)
 			)
 
-			exitCondition, err := checker.CheckLoggingControlPlane(seedNamespace, condition, deploymentLister, statefulSetLister)
+			exitCondition, err := checker.CheckLoggingControlPlane(seedNamespace, backend, condition, deploymentLister, statefulSetLister)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(exitCondition).To(conditionMatcher)
 		},
 		Entry("all healthy",
+			botanist.NewElasticsearchLoggingBackend(nil),
 			requiredLoggingControlPlaneDeployments,
 			requiredLoggingControlPlaneStatefulSets,
 			BeNil()),
 		Entry("required deployment missing",
+			botanist.NewElasticsearchLoggingBackend(nil),
 			nil,
 			requiredLoggingControlPlaneStatefulSets,
 			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
 		Entry("required stateful set missing",
+			botanist.NewElasticsearchLoggingBackend(nil),
 			requiredLoggingControlPlaneDeployments,
 			nil,
 			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
 		Entry("deployment unhealthy",
+			botanist.NewElasticsearchLoggingBackend(nil),
 			[]*appsv1.Deployment{newDeployment(kibanaDeployment.Namespace, kibanaDeployment.Name, roleOf(kibanaDeployment), false)},
 			requiredLoggingControlPlaneStatefulSets,
 			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
 		Entry("stateful set unhealthy",
+			botanist.NewElasticsearchLoggingBackend(nil),
 			requiredLoggingControlPlaneDeployments,
 			[]*appsv1.StatefulSet{
 				newStatefulSet(elasticSearchStatefulSet.Namespace, elasticSearchStatefulSet.Name, roleOf(elasticSearchStatefulSet), false),
 			},
 			beConditionWithStatus(gardencorev1alpha1.ConditionFalse)),
+		Entry("external backend without components",
+			botanist.NewExternalLoggingBackend(nil),
+			nil,
+			nil,
+			BeNil()),
 	)
 
 	DescribeTable("#FailedCondition",
 		func(thresholds map[gardencorev1alpha1.ConditionType]time.Duration, transitionTime metav1.Time, now time.Time, condition gardencorev1alpha1.Condition, expected types.GomegaMatcher) {
-			checker := botanist.NewHealthChecker(thresholds)
+			checker := botanist.NewHealthChecker(thresholds, nil)
 			tmp1, tmp2 := botanist.Now, helper.Now
 			defer func() {
 				botanist.Now, helper.Now = tmp1, tmp2
@@ -724,4 +734,84 @@ var _ = Describe("health check", func() {
 				"Status": Equal(gardencorev1alpha1.ConditionFalse),
 			})),
 	)
+
+	DescribeTable("#CheckExtensionCondition",
+		func(staleExtensionHealthCheckThreshold *metav1.Duration, now time.Time, extensionConditions []botanist.ExtensionCondition, conditionMatcher types.GomegaMatcher) {
+			checker := botanist.NewHealthChecker(map[gardencorev1alpha1.ConditionType]time.Duration{}, staleExtensionHealthCheckThreshold)
+			tmp := botanist.Now
+			defer func() {
+				botanist.Now = tmp
+			}()
+			botanist.Now = func() time.Time {
+				return now
+			}
+
+			Expect(checker.CheckExtensionCondition(condition, extensionConditions)).To(conditionMatcher)
+		},
+		Entry("no extension conditions",
+			&metav1.Duration{Duration: time.Minute},
+			zeroTime,
+			nil,
+			BeNil()),
+		Entry("healthy extension condition",
+			&metav1.Duration{Duration: time.Minute},
+			zeroTime.Add(30*time.Second),
+			[]botanist.ExtensionCondition{
+				{
+					Condition: gardencorev1alpha1.Condition{
+						Type:           gardenv1beta1.ShootControlPlaneHealthy,
+						Status:         gardencorev1alpha1.ConditionTrue,
+						LastUpdateTime: zeroMetaTime,
+					},
+				},
+			},
+			BeNil()),
+		Entry("unhealthy extension condition",
+			&metav1.Duration{Duration: time.Minute},
+			zeroTime,
+			[]botanist.ExtensionCondition{
+				{
+					Condition: gardencorev1alpha1.Condition{
+						Type:           gardenv1beta1.ShootControlPlaneHealthy,
+						Status:         gardencorev1alpha1.ConditionFalse,
+						LastUpdateTime: zeroMetaTime,
+					},
+					ExtensionKind: "Extension",
+				},
+			},
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason": Equal("ExtensionUnhealthyReport"),
+			}))),
+		Entry("stale extension condition",
+			&metav1.Duration{Duration: time.Minute},
+			zeroTime.Add(time.Minute+time.Second),
+			[]botanist.ExtensionCondition{
+				{
+					Condition: gardencorev1alpha1.Condition{
+						Type:           gardenv1beta1.ShootControlPlaneHealthy,
+						Status:         gardencorev1alpha1.ConditionTrue,
+						LastUpdateTime: zeroMetaTime,
+					},
+					ExtensionKind: "Extension",
+				},
+			},
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(gardencorev1alpha1.ConditionFalse),
+				"Reason": Equal("ExtensionOutdatedHealthCheckReport"),
+			}))),
+		Entry("stale extension condition without threshold",
+			nil,
+			zeroTime.Add(time.Hour),
+			[]botanist.ExtensionCondition{
+				{
+					Condition: gardencorev1alpha1.Condition{
+						Type:           gardenv1beta1.ShootControlPlaneHealthy,
+						Status:         gardencorev1alpha1.ConditionTrue,
+						LastUpdateTime: zeroMetaTime,
+					},
+				},
+			},
+			BeNil()),
+	)
 })
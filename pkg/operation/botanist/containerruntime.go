@@ -0,0 +1,243 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ContainerRuntimeDefaultTimeout is the default timeout and defines how long Gardener should wait
+	// for a successful reconciliation of a container runtime resource.
+	ContainerRuntimeDefaultTimeout = 3 * time.Minute
+
+	// ContainerRuntimesBinaryPath is the path on the worker machines where the container runtime extensions
+	// should copy the binaries to.
+	ContainerRuntimesBinaryPath = "/var/bin/containerruntimes"
+)
+
+// ContainerRuntimeResourceName returns the name of the ContainerRuntime resource for the given worker pool and
+// container runtime type. The name is suffixed with a hash of both as the type and the name of the worker pool may
+// contain dashes, e.g. the names for the type `foo-bar` in the pool `baz` and the type `foo` in the pool `bar-baz`
+// would collide otherwise.
+func ContainerRuntimeResourceName(workerName, containerRuntimeType string) string {
+	return fmt.Sprintf("%s-%s-%s", containerRuntimeType, workerName, utils.ComputeSHA256Hex([]byte(containerRuntimeType + "/" + workerName))[:8])
+}
+
+// DeployContainerRuntimeResources creates a `ContainerRuntime` extension resource in the shoot namespace in the seed
+// cluster for each additional container runtime of each worker pool. Gardener waits until an external controller did
+// reconcile the resources successfully.
+func (b *Botanist) DeployContainerRuntimeResources(ctx context.Context) error {
+	var fns []flow.TaskFn
+
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.CRI == nil {
+			continue
+		}
+
+		for _, containerRuntime := range worker.CRI.ContainerRuntimes {
+			var (
+				workerName           = worker.Name
+				containerRuntimeType = containerRuntime.Type
+				providerConfig       = containerRuntime.ProviderConfig
+			)
+
+			fns = append(fns, func(ctx context.Context) error {
+				toApply := &extensionsv1alpha1.ContainerRuntime{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      ContainerRuntimeResourceName(workerName, containerRuntimeType),
+						Namespace: b.Shoot.SeedNamespace,
+					},
+				}
+
+				return kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), toApply, func() error {
+					metav1.SetMetaDataAnnotation(&toApply.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
+
+					toApply.Spec = extensionsv1alpha1.ContainerRuntimeSpec{
+						BinaryPath: ContainerRuntimesBinaryPath,
+						WorkerPool: extensionsv1alpha1.ContainerRuntimeWorkerPool{
+							Name: workerName,
+							Selector: metav1.LabelSelector{
+								MatchLabels: map[string]string{v1alpha1constants.LabelWorkerPool: workerName},
+							},
+						},
+						DefaultSpec: extensionsv1alpha1.DefaultSpec{
+							Type: containerRuntimeType,
+						},
+					}
+
+					if providerConfig != nil {
+						toApply.Spec.ProviderConfig = &providerConfig.RawExtension
+					}
+
+					return nil
+				})
+			})
+		}
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+// DeleteStaleContainerRuntimeResources deletes the `ContainerRuntime` extension resources which are no longer
+// required by any worker pool from the shoot namespace in the seed cluster.
+func (b *Botanist) DeleteStaleContainerRuntimeResources(ctx context.Context) error {
+	wantedContainerRuntimes := sets.NewString()
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.CRI == nil {
+			continue
+		}
+		for _, containerRuntime := range worker.CRI.ContainerRuntimes {
+			wantedContainerRuntimes.Insert(ContainerRuntimeResourceName(worker.Name, containerRuntime.Type))
+		}
+	}
+
+	return b.deleteContainerRuntimeResources(ctx, wantedContainerRuntimes)
+}
+
+// DeleteAllContainerRuntimeResources deletes all `ContainerRuntime` extension resources from the shoot namespace in
+// the seed cluster.
+func (b *Botanist) DeleteAllContainerRuntimeResources(ctx context.Context) error {
+	return b.deleteContainerRuntimeResources(ctx, sets.NewString())
+}
+
+func (b *Botanist) deleteContainerRuntimeResources(ctx context.Context, wantedContainerRuntimes sets.String) error {
+	deployedContainerRuntimes := &extensionsv1alpha1.ContainerRuntimeList{}
+	if err := b.K8sSeedClient.Client().List(ctx, deployedContainerRuntimes, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return err
+	}
+
+	fns := make([]flow.TaskFn, 0, len(deployedContainerRuntimes.Items))
+	for _, deployedContainerRuntime := range deployedContainerRuntimes.Items {
+		if wantedContainerRuntimes.Has(deployedContainerRuntime.Name) {
+			continue
+		}
+
+		toDelete := &extensionsv1alpha1.ContainerRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      deployedContainerRuntime.Name,
+				Namespace: deployedContainerRuntime.Namespace,
+			},
+		}
+		fns = append(fns, func(ctx context.Context) error {
+			return client.IgnoreNotFound(b.K8sSeedClient.Client().Delete(ctx, toDelete, kubernetes.DefaultDeleteOptionFuncs...))
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+// WaitUntilContainerRuntimeResourcesReady waits until all `ContainerRuntime` extension resources report `Succeeded`
+// in their last operation state.
+func (b *Botanist) WaitUntilContainerRuntimeResourcesReady(ctx context.Context) error {
+	var fns []flow.TaskFn
+
+	for _, worker := range b.Shoot.GetWorkers() {
+		if worker.CRI == nil {
+			continue
+		}
+
+		for _, containerRuntime := range worker.CRI.ContainerRuntimes {
+			name := ContainerRuntimeResourceName(worker.Name, containerRuntime.Type)
+
+			fns = append(fns, func(ctx context.Context) error {
+				if err := retry.UntilTimeout(ctx, DefaultInterval, ContainerRuntimeDefaultTimeout, func(ctx context.Context) (bool, error) {
+					req := &extensionsv1alpha1.ContainerRuntime{}
+					if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, name), req); err != nil {
+						return retry.SevereError(err)
+					}
+
+					if err := health.CheckExtensionObject(req); err != nil {
+						b.Logger.WithError(err).Errorf("Container runtime %s/%s did not get ready yet", b.Shoot.SeedNamespace, name)
+						return retry.MinorError(err)
+					}
+
+					return retry.Ok()
+				}); err != nil {
+					return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed waiting for container runtime %s to be ready: %v", name, err))
+				}
+				return nil
+			})
+		}
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+// WaitUntilContainerRuntimeResourcesDeleted waits until all `ContainerRuntime` extension resources which are marked
+// for deletion are gone.
+func (b *Botanist) WaitUntilContainerRuntimeResourcesDeleted(ctx context.Context) error {
+	containerRuntimes := &extensionsv1alpha1.ContainerRuntimeList{}
+	if err := b.K8sSeedClient.Client().List(ctx, containerRuntimes, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return err
+	}
+
+	fns := make([]flow.TaskFn, 0, len(containerRuntimes.Items))
+	for _, containerRuntime := range containerRuntimes.Items {
+		if containerRuntime.GetDeletionTimestamp() == nil {
+			continue
+		}
+
+		name := containerRuntime.Name
+		fns = append(fns, func(ctx context.Context) error {
+			var lastError *gardencorev1alpha1.LastError
+
+			if err := retry.UntilTimeout(ctx, DefaultInterval, ContainerRuntimeDefaultTimeout, func(ctx context.Context) (bool, error) {
+				req := &extensionsv1alpha1.ContainerRuntime{}
+				if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, name), req); err != nil {
+					if apierrors.IsNotFound(err) {
+						return retry.Ok()
+					}
+					return retry.SevereError(err)
+				}
+
+				if lastErr := req.Status.LastError; lastErr != nil {
+					b.Logger.Errorf("Container runtime %s did not get deleted yet, lastError is: %s", name, lastErr.Description)
+					lastError = lastErr
+				}
+
+				return retry.MinorError(common.WrapWithLastError(fmt.Errorf("container runtime %s is still present", name), lastError))
+			}); err != nil {
+				message := fmt.Sprintf("Failed waiting for container runtime %s to be deleted", name)
+				if lastError != nil {
+					return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, lastError.Description))
+				}
+				return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, err.Error()))
+			}
+			return nil
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("containerruntime", func() {
+	Describe("#ContainerRuntimeResourceName", func() {
+		It("should contain the type and the name of the worker pool", func() {
+			Expect(botanist.ContainerRuntimeResourceName("cpu-worker", "gvisor")).To(Equal("gvisor-cpu-worker-dc4c8698"))
+		})
+
+		It("should not collide for types and worker pools containing dashes", func() {
+			Expect(botanist.ContainerRuntimeResourceName("baz", "foo-bar")).NotTo(Equal(botanist.ContainerRuntimeResourceName("bar-baz", "foo")))
+		})
+	})
+
+	Describe("#ContainerRuntime resources", func() {
+		const namespace = "shoot--foo--bar"
+
+		var (
+			ctrl *gomock.Controller
+			c    client.Client
+			b    *botanist.Botanist
+
+			ctx = context.TODO()
+
+			gvisorCPUWorker = botanist.ContainerRuntimeResourceName("cpu-worker", "gvisor")
+			kataCPUWorker   = botanist.ContainerRuntimeResourceName("cpu-worker", "kata-containers")
+			gvisorGPUWorker = botanist.ContainerRuntimeResourceName("gpu-worker", "gvisor")
+
+			containerRuntimeNames = func() []string {
+				list := &extensionsv1alpha1.ContainerRuntimeList{}
+				Expect(c.List(ctx, list, client.InNamespace(namespace))).To(Succeed())
+				var names []string
+				for _, containerRuntime := range list.Items {
+					names = append(names, containerRuntime.Name)
+				}
+				return names
+			}
+			readyContainerRuntime = func(name string) *extensionsv1alpha1.ContainerRuntime {
+				return &extensionsv1alpha1.ContainerRuntime{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+					Status: extensionsv1alpha1.ContainerRuntimeStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastOperation: &gardencorev1alpha1.LastOperation{State: gardencorev1alpha1.LastOperationStateSucceeded},
+						},
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			c = fake.NewFakeClientWithScheme(scheme)
+
+			k8sSeedClient := mock.NewMockInterface(ctrl)
+			k8sSeedClient.EXPECT().Client().Return(c).AnyTimes()
+
+			b = &botanist.Botanist{Operation: &operation.Operation{
+				K8sSeedClient: k8sSeedClient,
+				Logger:        utils.NewNopLogger().WithField("test", "containerruntime"),
+				Shoot: &shoot.Shoot{
+					SeedNamespace: namespace,
+					CloudProvider: gardenv1beta1.CloudProviderAWS,
+					Info: &gardenv1beta1.Shoot{
+						Spec: gardenv1beta1.ShootSpec{
+							Cloud: gardenv1beta1.Cloud{
+								AWS: &gardenv1beta1.AWSCloud{
+									Workers: []gardenv1beta1.AWSWorker{
+										{Worker: gardenv1beta1.Worker{
+											Name: "cpu-worker",
+											CRI: &gardenv1beta1.CRI{
+												Name:              gardenv1beta1.CRINameContainerD,
+												ContainerRuntimes: []gardenv1beta1.ContainerRuntime{{Type: "gvisor"}, {Type: "kata-containers"}},
+											},
+										}},
+										{Worker: gardenv1beta1.Worker{
+											Name: "gpu-worker",
+											CRI: &gardenv1beta1.CRI{
+												Name:              gardenv1beta1.CRINameContainerD,
+												ContainerRuntimes: []gardenv1beta1.ContainerRuntime{{Type: "gvisor"}},
+											},
+										}},
+										{Worker: gardenv1beta1.Worker{Name: "docker-worker"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		Describe("#DeployContainerRuntimeResources", func() {
+			It("should create a resource for each container runtime of each worker pool", func() {
+				Expect(b.DeployContainerRuntimeResources(ctx)).To(Succeed())
+				Expect(containerRuntimeNames()).To(ConsistOf(gvisorCPUWorker, kataCPUWorker, gvisorGPUWorker))
+
+				containerRuntime := &extensionsv1alpha1.ContainerRuntime{}
+				Expect(c.Get(ctx, kutil.Key(namespace, kataCPUWorker), containerRuntime)).To(Succeed())
+				Expect(containerRuntime.Annotations).To(HaveKeyWithValue(v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile))
+				Expect(containerRuntime.Spec).To(Equal(extensionsv1alpha1.ContainerRuntimeSpec{
+					BinaryPath: botanist.ContainerRuntimesBinaryPath,
+					WorkerPool: extensionsv1alpha1.ContainerRuntimeWorkerPool{
+						Name: "cpu-worker",
+						Selector: metav1.LabelSelector{
+							MatchLabels: map[string]string{v1alpha1constants.LabelWorkerPool: "cpu-worker"},
+						},
+					},
+					DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "kata-containers"},
+				}))
+			})
+
+			It("should update existing resources", func() {
+				existing := readyContainerRuntime(gvisorGPUWorker)
+				existing.Spec.BinaryPath = "/foo"
+				Expect(c.Create(ctx, existing)).To(Succeed())
+
+				Expect(b.DeployContainerRuntimeResources(ctx)).To(Succeed())
+
+				containerRuntime := &extensionsv1alpha1.ContainerRuntime{}
+				Expect(c.Get(ctx, kutil.Key(namespace, gvisorGPUWorker), containerRuntime)).To(Succeed())
+				Expect(containerRuntime.Spec.BinaryPath).To(Equal(botanist.ContainerRuntimesBinaryPath))
+				Expect(containerRuntime.Annotations).To(HaveKeyWithValue(v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile))
+			})
+		})
+
+		Describe("#WaitUntilContainerRuntimeResourcesReady", func() {
+			It("should succeed if all resources are ready", func() {
+				for _, name := range []string{gvisorCPUWorker, kataCPUWorker, gvisorGPUWorker} {
+					Expect(c.Create(ctx, readyContainerRuntime(name))).To(Succeed())
+				}
+
+				Expect(b.WaitUntilContainerRuntimeResourcesReady(ctx)).To(Succeed())
+			})
+
+			It("should fail if a resource has not been reconciled yet", func() {
+				Expect(b.DeployContainerRuntimeResources(ctx)).To(Succeed())
+
+				timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+				defer cancel()
+				Expect(b.WaitUntilContainerRuntimeResourcesReady(timeoutCtx)).NotTo(Succeed())
+			})
+		})
+
+		Describe("#DeleteStaleContainerRuntimeResources", func() {
+			It("should delete the resources of worker pools whose container runtime was removed", func() {
+				Expect(b.DeployContainerRuntimeResources(ctx)).To(Succeed())
+
+				b.Shoot.Info.Spec.Cloud.AWS.Workers[0].CRI.ContainerRuntimes = []gardenv1beta1.ContainerRuntime{{Type: "gvisor"}}
+				b.Shoot.Info.Spec.Cloud.AWS.Workers[1].CRI = nil
+
+				Expect(b.DeleteStaleContainerRuntimeResources(ctx)).To(Succeed())
+				Expect(containerRuntimeNames()).To(ConsistOf(gvisorCPUWorker))
+				Expect(b.WaitUntilContainerRuntimeResourcesDeleted(ctx)).To(Succeed())
+			})
+
+			It("should delete the resources of removed worker pools", func() {
+				Expect(b.DeployContainerRuntimeResources(ctx)).To(Succeed())
+
+				b.Shoot.Info.Spec.Cloud.AWS.Workers = b.Shoot.Info.Spec.Cloud.AWS.Workers[1:]
+
+				Expect(b.DeleteStaleContainerRuntimeResources(ctx)).To(Succeed())
+				Expect(containerRuntimeNames()).To(ConsistOf(gvisorGPUWorker))
+			})
+		})
+
+		Describe("#DeleteAllContainerRuntimeResources", func() {
+			It("should delete all resources", func() {
+				Expect(b.DeployContainerRuntimeResources(ctx)).To(Succeed())
+
+				Expect(b.DeleteAllContainerRuntimeResources(ctx)).To(Succeed())
+				Expect(containerRuntimeNames()).To(BeEmpty())
+				Expect(b.WaitUntilContainerRuntimeResourcesDeleted(ctx)).To(Succeed())
+			})
+		})
+	})
+})
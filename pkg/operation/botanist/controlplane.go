@@ -63,6 +63,7 @@ func (b *Botanist) DeployNamespace(ctx context.Context) error {
 			common.GardenRole:                         common.GardenRoleShoot,
 			v1alpha1constants.GardenRole:              common.GardenRoleShoot,
 			common.ShootHibernated:                    strconv.FormatBool(b.Shoot.HibernationEnabled),
+			common.ShootDisableLogging:                strconv.FormatBool(!b.Shoot.WantsLogging),
 			v1alpha1constants.LabelSeedProvider:       string(b.Seed.CloudProvider),
 			v1alpha1constants.LabelShootProvider:      string(b.Shoot.CloudProvider),
 			v1alpha1constants.LabelNetworkingProvider: string(b.Shoot.Info.Spec.Networking.Type),
@@ -177,6 +178,13 @@ func (b *Botanist) DeployClusterAutoscaler(ctx context.Context) error {
 		})
 	}
 
+	// The cluster-autoscaler must not scale up the worker pools again while they are hibernated, hence, it is
+	// scaled down even if the control plane of the shoot keeps running.
+	replicas := b.Shoot.GetReplicas(1)
+	if b.Shoot.HibernationEnabled {
+		replicas = 0
+	}
+
 	defaultValues := map[string]interface{}{
 		"podAnnotations": map[string]interface{}{
 			"checksum/secret-cluster-autoscaler": b.CheckSums[v1alpha1constants.DeploymentNameClusterAutoscaler],
@@ -184,7 +192,7 @@ func (b *Botanist) DeployClusterAutoscaler(ctx context.Context) error {
 		"namespace": map[string]interface{}{
 			"uid": b.SeedNamespaceObject.UID,
 		},
-		"replicas":    b.Shoot.GetReplicas(1),
+		"replicas":    replicas,
 		"workerPools": workerPools,
 	}
 
@@ -531,7 +539,8 @@ func (b *Botanist) DeployBackupEntryInGarden(ctx context.Context) error {
 		seedName = &b.Seed.Info.Name
 	} else {
 		bucketName = backupEntry.Spec.BucketName
-		seedName = backupEntry.Spec.Seed
+		// The BackupEntry always follows the Seed of the Shoot, e.g. if a control plane migration has been rolled back.
+		seedName = &b.Seed.Info.Name
 	}
 	ownerRef := metav1.NewControllerRef(b.Shoot.Info, gardenv1beta1.SchemeGroupVersion.WithKind("Shoot"))
 	blockOwnerDeletion := false
@@ -0,0 +1,406 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/api/extensions"
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
+	hvpav1alpha1 "github.com/gardener/hvpa-controller/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// MigratedExtensionKinds are the kinds of the namespaced extension resources which are migrated together with the
+// control plane of a Shoot.
+var MigratedExtensionKinds = []string{
+	extensionsv1alpha1.ContainerRuntimeResource,
+	extensionsv1alpha1.ControlPlaneResource,
+	extensionsv1alpha1.ExtensionResource,
+	extensionsv1alpha1.InfrastructureResource,
+	extensionsv1alpha1.NetworkResource,
+	extensionsv1alpha1.OperatingSystemConfigResource,
+	extensionsv1alpha1.WorkerResource,
+}
+
+// ControlPlaneMigrationTimeout is the timeout used while waiting for the control plane components and the extension
+// resources to be migrated.
+const ControlPlaneMigrationTimeout = 10 * time.Minute
+
+// ScaleDownControlPlane scales down all control plane components accessing the etcd of the Shoot such that no further
+// changes happen before the final etcd snapshot is taken or the etcd is restored.
+func (b *Botanist) ScaleDownControlPlane(ctx context.Context) error {
+	c := b.K8sSeedClient.Client()
+
+	if err := c.Delete(ctx, &hvpav1alpha1.Hvpa{ObjectMeta: metav1.ObjectMeta{Name: v1alpha1constants.DeploymentNameKubeAPIServer, Namespace: b.Shoot.SeedNamespace}}, kubernetes.DefaultDeleteOptionFuncs...); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	for _, deployment := range []string{
+		v1alpha1constants.DeploymentNameGardenerResourceManager,
+		v1alpha1constants.DeploymentNameClusterAutoscaler,
+		v1alpha1constants.DeploymentNameKubeControllerManager,
+		v1alpha1constants.DeploymentNameKubeScheduler,
+		v1alpha1constants.DeploymentNameKubeAPIServer,
+	} {
+		if err := kubernetes.ScaleDeployment(ctx, c, kutil.Key(b.Shoot.SeedNamespace, deployment), 0); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return b.waitUntilDeploymentScaledDown(ctx, v1alpha1constants.DeploymentNameKubeAPIServer)
+}
+
+// TakeFinalETCDSnapshot triggers a full snapshot of the main etcd via its backup sidecar. The snapshot is stored in
+// the bucket of the Shoot's BackupEntry from which the etcd on the migration target is restored. If the etcd has
+// already been scaled down then the final snapshot has been taken in a previous run.
+func (b *Botanist) TakeFinalETCDSnapshot(ctx context.Context) error {
+	statefulSet := &appsv1.StatefulSet{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, v1alpha1constants.StatefulSetNameETCDMain), statefulSet); err != nil {
+		return err
+	}
+	if statefulSet.Spec.Replicas != nil && *statefulSet.Spec.Replicas == 0 {
+		b.Logger.Info("Main etcd has already been scaled down, final snapshot has been taken before")
+		return nil
+	}
+
+	body, err := b.K8sSeedClient.Kubernetes().CoreV1().Services(b.Shoot.SeedNamespace).ProxyGet("http", fmt.Sprintf("%s-client", v1alpha1constants.StatefulSetNameETCDMain), "backuprestore", "/snapshot/full", nil).DoRaw()
+	if err != nil {
+		return fmt.Errorf("could not take full snapshot of main etcd: %v", err)
+	}
+
+	snapshot := &snapstore.Snapshot{}
+	if err := json.Unmarshal(body, snapshot); err != nil {
+		return fmt.Errorf("could not decode full snapshot of main etcd: %v", err)
+	}
+	b.Logger.Infof("Took final full snapshot of main etcd with revision %d", snapshot.LastRevision)
+	return nil
+}
+
+// ScaleDownETCD scales down both etcd clusters of the Shoot and waits until they are gone, such that their backup
+// sidecars do not write into the bucket anymore once the etcd is restored.
+func (b *Botanist) ScaleDownETCD(ctx context.Context) error {
+	c := b.K8sSeedClient.Client()
+
+	for _, statefulSet := range []string{v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDMain} {
+		if err := kubernetes.ScaleStatefulSet(ctx, c, kutil.Key(b.Shoot.SeedNamespace, statefulSet), 0); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return retry.UntilTimeout(ctx, DefaultInterval, ControlPlaneMigrationTimeout, func(ctx context.Context) (bool, error) {
+		for _, name := range []string{v1alpha1constants.StatefulSetNameETCDEvents, v1alpha1constants.StatefulSetNameETCDMain} {
+			statefulSet := &appsv1.StatefulSet{}
+			if err := c.Get(ctx, kutil.Key(b.Shoot.SeedNamespace, name), statefulSet); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return retry.SevereError(err)
+			}
+			if statefulSet.Status.Replicas > 0 {
+				b.Logger.Infof("Waiting until stateful set %s has been scaled down...", name)
+				return retry.MinorError(fmt.Errorf("stateful set %s still has %d replicas", name, statefulSet.Status.Replicas))
+			}
+		}
+		return retry.Ok()
+	})
+}
+
+func (b *Botanist) waitUntilDeploymentScaledDown(ctx context.Context, name string) error {
+	return retry.UntilTimeout(ctx, DefaultInterval, ControlPlaneMigrationTimeout, func(ctx context.Context) (bool, error) {
+		deployment := &appsv1.Deployment{}
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, name), deployment); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
+		}
+		if deployment.Status.Replicas > 0 {
+			b.Logger.Infof("Waiting until deployment %s has been scaled down...", name)
+			return retry.MinorError(fmt.Errorf("deployment %s still has %d replicas", name, deployment.Status.Replicas))
+		}
+		return retry.Ok()
+	})
+}
+
+// DestroyDNSRecordsForMigration destroys the DNS records of the Shoot which point to the load balancers in the source
+// Seed. They are re-created pointing to the load balancers in the target Seed when the Shoot is reconciled there.
+func (b *Botanist) DestroyDNSRecordsForMigration(ctx context.Context) error {
+	return flow.Parallel(
+		b.DestroyIngressDNSRecord,
+		b.DestroyExternalDomainDNSRecord,
+		b.DestroyAdditionalDomainDNSRecords,
+		b.DestroyInternalDomainDNSRecord,
+	)(ctx)
+}
+
+// MigrateExtensionResources annotates all extension resources of the Shoot with the migrate operation. Extension
+// controllers export their state into the `.status.state` field of the resources and stop acting on them.
+func (b *Botanist) MigrateExtensionResources(ctx context.Context) error {
+	return b.forEachExtensionResource(ctx, b.K8sSeedClient.Client(), func(ctx context.Context, obj *unstructured.Unstructured) error {
+		if health.CheckExtensionObjectMigrated(extensions.UnstructuredAccessor(obj)) == nil {
+			return nil
+		}
+		setGardenerOperation(obj, v1alpha1constants.GardenerOperationMigrate)
+		return b.K8sSeedClient.Client().Update(ctx, obj)
+	})
+}
+
+// WaitUntilExtensionResourcesMigrated waits until all extension resources of the Shoot report a succeeded migration.
+func (b *Botanist) WaitUntilExtensionResourcesMigrated(ctx context.Context) error {
+	return b.forEachExtensionResource(ctx, b.K8sSeedClient.Client(), func(ctx context.Context, obj *unstructured.Unstructured) error {
+		var (
+			kind = obj.GetKind()
+			name = obj.GetName()
+		)
+
+		if err := retry.UntilTimeout(ctx, DefaultInterval, ControlPlaneMigrationTimeout, func(ctx context.Context) (bool, error) {
+			if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, name), obj); err != nil {
+				return retry.SevereError(err)
+			}
+
+			if err := health.CheckExtensionObjectMigrated(extensions.UnstructuredAccessor(obj)); err != nil {
+				b.Logger.WithError(err).Errorf("%s %s/%s did not get migrated yet", kind, b.Shoot.SeedNamespace, name)
+				return retry.MinorError(err)
+			}
+			return retry.Ok()
+		}); err != nil {
+			return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed waiting for %s %s to be migrated: %v", kind, name, err))
+		}
+		return nil
+	})
+}
+
+// MoveBackupEntryToSeed assigns the BackupEntry of the Shoot to the given Seed. The bucket of the BackupEntry is kept
+// such that the etcd on the given Seed is restored from the final snapshot taken on the source Seed.
+func (b *Botanist) MoveBackupEntryToSeed(ctx context.Context, seedName string) error {
+	backupEntry := &gardencorev1alpha1.BackupEntry{}
+	if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(b.Shoot.Info.Namespace, common.GenerateBackupEntryName(b.Shoot.Info.Status.TechnicalID, b.Shoot.Info.Status.UID)), backupEntry); err != nil {
+		return err
+	}
+
+	return kutil.CreateOrUpdate(ctx, b.K8sGardenClient.Client(), backupEntry, func() error {
+		metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
+		backupEntry.Spec.Seed = &seedName
+		return nil
+	})
+}
+
+// CopyNamespaceResourcesFromSeed copies the secrets and config maps of the Shoot namespace in the given source Seed
+// into the Shoot namespace of this Botanist's Seed. This way the certificates, keys and the etcd encryption secret
+// of the Shoot are kept, and no new ones are generated when the Shoot is reconciled on the target Seed.
+func (b *Botanist) CopyNamespaceResourcesFromSeed(ctx context.Context, sourceClient client.Client) error {
+	secrets := &corev1.SecretList{}
+	if err := sourceClient.List(ctx, secrets, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return err
+	}
+	for _, secret := range secrets.Items {
+		if secret.Type == corev1.SecretTypeServiceAccountToken {
+			continue
+		}
+
+		toCreate := &corev1.Secret{
+			ObjectMeta: copiedObjectMeta(secret.ObjectMeta),
+			Type:       secret.Type,
+			Data:       secret.Data,
+		}
+		if err := b.K8sSeedClient.Client().Create(ctx, toCreate); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := sourceClient.List(ctx, configMaps, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return err
+	}
+	for _, configMap := range configMaps.Items {
+		toCreate := &corev1.ConfigMap{
+			ObjectMeta: copiedObjectMeta(configMap.ObjectMeta),
+			Data:       configMap.Data,
+			BinaryData: configMap.BinaryData,
+		}
+		if err := b.K8sSeedClient.Client().Create(ctx, toCreate); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RestoreExtensionResourcesFromSeed creates the migrated extension resources of the given source Seed in the Shoot
+// namespace of this Botanist's Seed. The resources are annotated with the restore operation and carry the state
+// exported by the extension controllers on the source Seed.
+func (b *Botanist) RestoreExtensionResourcesFromSeed(ctx context.Context, sourceClient client.Client) error {
+	return b.forEachExtensionResource(ctx, sourceClient, func(ctx context.Context, obj *unstructured.Unstructured) error {
+		toCreate := &unstructured.Unstructured{}
+		toCreate.SetAPIVersion(obj.GetAPIVersion())
+		toCreate.SetKind(obj.GetKind())
+		toCreate.SetName(obj.GetName())
+		toCreate.SetNamespace(obj.GetNamespace())
+		toCreate.SetLabels(obj.GetLabels())
+		toCreate.SetAnnotations(obj.GetAnnotations())
+		setGardenerOperation(toCreate, v1alpha1constants.GardenerOperationRestore)
+		if spec, ok := obj.Object["spec"]; ok {
+			toCreate.Object["spec"] = spec
+		}
+
+		if err := b.K8sSeedClient.Client().Create(ctx, toCreate); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				return nil
+			}
+			return err
+		}
+
+		status, ok := obj.Object["status"]
+		if !ok {
+			return nil
+		}
+		toCreate.Object["status"] = status
+		return b.K8sSeedClient.Client().Status().Update(ctx, toCreate)
+	})
+}
+
+// ReleaseMigratedExtensionResources deletes the migrated extension resources of the Shoot and waits until they are
+// gone. Extension controllers must not delete any external resources for extension resources whose last operation
+// is a succeeded migration.
+func (b *Botanist) ReleaseMigratedExtensionResources(ctx context.Context) error {
+	c := b.K8sSeedClient.Client()
+
+	return b.forEachExtensionResource(ctx, c, func(ctx context.Context, obj *unstructured.Unstructured) error {
+		var (
+			kind = obj.GetKind()
+			name = obj.GetName()
+		)
+
+		if err := health.CheckExtensionObjectMigrated(extensions.UnstructuredAccessor(obj)); err != nil {
+			return fmt.Errorf("refusing to release %s %s/%s which has not been migrated: %v", kind, b.Shoot.SeedNamespace, name, err)
+		}
+		if err := c.Delete(ctx, obj, kubernetes.DefaultDeleteOptionFuncs...); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+
+		return retry.UntilTimeout(ctx, DefaultInterval, shoot.ExtensionDefaultTimeout, func(ctx context.Context) (bool, error) {
+			if err := c.Get(ctx, kutil.Key(b.Shoot.SeedNamespace, name), obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					return retry.Ok()
+				}
+				return retry.SevereError(err)
+			}
+			b.Logger.Infof("Waiting until %s %s/%s has been released...", kind, b.Shoot.SeedNamespace, name)
+			return retry.MinorError(fmt.Errorf("%s %s is still present", kind, name))
+		})
+	})
+}
+
+// ReleaseBackupEntryExtension migrates the BackupEntry extension resource of the Shoot in this Botanist's Seed and
+// deletes it afterwards. The backups in the bucket are kept as the BackupEntry has been assigned to another Seed.
+func (b *Botanist) ReleaseBackupEntryExtension(ctx context.Context) error {
+	var (
+		c    = b.K8sSeedClient.Client()
+		name = common.GenerateBackupEntryName(b.Shoot.Info.Status.TechnicalID, b.Shoot.Info.Status.UID)
+	)
+
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := c.Get(ctx, kutil.Key(name), backupEntry); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if health.CheckExtensionObjectMigrated(backupEntry) != nil {
+		if _, ok := backupEntry.Annotations[v1alpha1constants.GardenerOperation]; !ok {
+			metav1.SetMetaDataAnnotation(&backupEntry.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationMigrate)
+			if err := c.Update(ctx, backupEntry); err != nil {
+				return err
+			}
+		}
+
+		if err := retry.UntilTimeout(ctx, DefaultInterval, ControlPlaneMigrationTimeout, func(ctx context.Context) (bool, error) {
+			if err := c.Get(ctx, kutil.Key(name), backupEntry); err != nil {
+				return retry.SevereError(err)
+			}
+			if err := health.CheckExtensionObjectMigrated(backupEntry); err != nil {
+				b.Logger.WithError(err).Errorf("BackupEntry %s did not get migrated yet", name)
+				return retry.MinorError(err)
+			}
+			return retry.Ok()
+		}); err != nil {
+			return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed waiting for backup entry %s to be migrated: %v", name, err))
+		}
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("entry-%s", name), Namespace: common.GardenNamespace}}
+	if err := c.Delete(ctx, secret); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	return client.IgnoreNotFound(c.Delete(ctx, backupEntry))
+}
+
+func (b *Botanist) forEachExtensionResource(ctx context.Context, c client.Client, fn func(context.Context, *unstructured.Unstructured) error) error {
+	var fns []flow.TaskFn
+
+	for _, kind := range MigratedExtensionKinds {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(extensionsv1alpha1.SchemeGroupVersion.WithKind(kind + "List"))
+		if err := c.List(ctx, list, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+			return err
+		}
+
+		for _, item := range list.Items {
+			obj := item.DeepCopy()
+			fns = append(fns, func(ctx context.Context) error {
+				return fn(ctx, obj)
+			})
+		}
+	}
+
+	return flow.ParallelExitOnError(fns...)(ctx)
+}
+
+func setGardenerOperation(obj *unstructured.Unstructured, operation string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[v1alpha1constants.GardenerOperation] = operation
+	obj.SetAnnotations(annotations)
+}
+
+func copiedObjectMeta(meta metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        meta.Name,
+		Namespace:   meta.Namespace,
+		Labels:      meta.Labels,
+		Annotations: meta.Annotations,
+	}
+}
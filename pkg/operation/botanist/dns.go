@@ -17,48 +17,64 @@ package botanist
 import (
 	"context"
 	"fmt"
-	"path/filepath"
+	"net"
 	"strings"
 	"time"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/garden"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/flow"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/retry"
 
 	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	"github.com/hashicorp/go-multierror"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kretry "k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-var dnsChartPath = filepath.Join(common.ChartPath, "seed-dns")
-
 const (
 	// DNSPurposeInternal is a constant for a DNS record used for the internal domain name.
 	DNSPurposeInternal = "internal"
 	// DNSPurposeExternal is a constant for a DNS record used for the external domain name.
 	DNSPurposeExternal = "external"
+	// DNSPurposeAdditional is a constant for the prefix of DNS records used for the domain names of additional DNS
+	// providers.
+	DNSPurposeAdditional = "additional"
+
+	// DNSRecordDefaultTimeout is the default timeout and defines how long Gardener should wait
+	// for a successful reconciliation or deletion of a DNSRecord resource.
+	DNSRecordDefaultTimeout = 2 * time.Minute
 )
 
 // DeployInternalDomainDNSRecord deploys the DNS record for the internal cluster domain.
 func (b *Botanist) DeployInternalDomainDNSRecord(ctx context.Context) error {
-	if err := b.deployDNSProvider(ctx, DNSPurposeInternal, b.Garden.InternalDomain.Provider, b.Garden.InternalDomain.SecretData, []string{b.Shoot.InternalClusterDomain}, nil, b.Garden.InternalDomain.IncludeZones, b.Garden.InternalDomain.ExcludeZones); err != nil {
-		return err
-	}
-	if err := b.deployDNSEntry(ctx, DNSPurposeInternal, common.GetAPIServerDomain(b.Shoot.InternalClusterDomain), b.APIServerAddress); err != nil {
+	if err := b.deployDNSRecord(ctx, DNSPurposeInternal, b.Garden.InternalDomain, common.GetAPIServerDomain(b.Shoot.InternalClusterDomain), b.APIServerAddress); err != nil {
 		return err
 	}
-	return b.deleteLegacyTerraformDNSResources(ctx, common.TerraformerPurposeInternalDNSDeprecated)
+	return b.handOverLegacyDNSResources(ctx, DNSPurposeInternal)
 }
 
 // DestroyInternalDomainDNSRecord destroys the DNS record for the internal cluster domain.
 func (b *Botanist) DestroyInternalDomainDNSRecord(ctx context.Context) error {
-	if err := b.deleteDNSEntry(ctx, DNSPurposeInternal); err != nil {
+	if err := b.deleteLegacyDNSResources(ctx, DNSPurposeInternal); err != nil {
 		return err
 	}
-	return b.deleteDNSProvider(ctx, DNSPurposeInternal)
+	return b.deleteDNSRecord(ctx, DNSPurposeInternal)
 }
 
 // DeployExternalDomainDNSRecord deploys the DNS record for the external cluster domain.
@@ -67,147 +83,355 @@ func (b *Botanist) DeployExternalDomainDNSRecord(ctx context.Context) error {
 		return nil
 	}
 
-	if err := b.deployDNSProvider(ctx, DNSPurposeExternal, b.Shoot.ExternalDomain.Provider, b.Shoot.ExternalDomain.SecretData, sets.NewString(append(b.Shoot.ExternalDomain.IncludeDomains, *b.Shoot.ExternalClusterDomain)...).List(), b.Shoot.ExternalDomain.ExcludeDomains, b.Shoot.ExternalDomain.IncludeZones, b.Shoot.ExternalDomain.ExcludeZones); err != nil {
+	if err := b.deployDNSRecord(ctx, DNSPurposeExternal, b.Shoot.ExternalDomain, common.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain), common.GetAPIServerDomain(b.Shoot.InternalClusterDomain)); err != nil {
 		return err
 	}
-	if err := b.deployDNSEntry(ctx, DNSPurposeExternal, common.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain), common.GetAPIServerDomain(b.Shoot.InternalClusterDomain)); err != nil {
-		return err
-	}
-	return b.deleteLegacyTerraformDNSResources(ctx, common.TerraformerPurposeExternalDNSDeprecated)
+	return b.handOverLegacyDNSResources(ctx, DNSPurposeExternal)
 }
 
 // DestroyExternalDomainDNSRecord destroys the DNS record for the external cluster domain.
 func (b *Botanist) DestroyExternalDomainDNSRecord(ctx context.Context) error {
-	if err := b.deleteDNSEntry(ctx, DNSPurposeExternal); err != nil {
+	if err := b.deleteLegacyDNSResources(ctx, DNSPurposeExternal); err != nil {
 		return err
 	}
-	return b.deleteDNSProvider(ctx, DNSPurposeExternal)
+	return b.deleteDNSRecord(ctx, DNSPurposeExternal)
 }
 
-func (b *Botanist) deployDNSProvider(ctx context.Context, name, provider string, secretData map[string][]byte, includeDomains, excludeDomains, includeZones, excludeZones []string) error {
-	values := map[string]interface{}{
-		"name":       name,
-		"purpose":    name,
-		"provider":   provider,
-		"secretData": secretData,
-		"domains": map[string]interface{}{
-			"include": includeDomains,
-			"exclude": excludeDomains,
-		},
-		"zones": map[string]interface{}{
-			"include": includeZones,
-			"exclude": excludeZones,
-		},
+// DeployAdditionalDomainDNSRecords deploys one DNS record for every additional DNS provider of the shoot. Records of
+// additional providers which have been removed from the shoot are destroyed. The state of the record of every
+// additional provider is reported in the shoot status.
+func (b *Botanist) DeployAdditionalDomainDNSRecords(ctx context.Context) error {
+	var (
+		wanted   = sets.NewString()
+		statuses []gardenv1beta1.DNSProviderStatus
+		result   error
+	)
+
+	for _, additionalDomain := range b.Shoot.AdditionalDomains {
+		purpose := AdditionalDNSRecordPurpose(additionalDomain)
+		wanted.Insert(purpose)
+
+		if strings.HasSuffix(additionalDomain.Domain, ".nip.io") {
+			continue
+		}
+
+		status := gardenv1beta1.DNSProviderStatus{
+			Type:   additionalDomain.Provider,
+			Domain: additionalDomain.Domain,
+			State:  gardenv1beta1.DNSProviderStateReady,
+		}
+		if err := b.deployDNSRecord(ctx, purpose, additionalDomain, common.GetAPIServerDomain(additionalDomain.Domain), common.GetAPIServerDomain(b.Shoot.InternalClusterDomain)); err != nil {
+			status.State = gardenv1beta1.DNSProviderStateError
+			status.Description = err.Error()
+			result = multierror.Append(result, err)
+		}
+		statuses = append(statuses, status)
 	}
 
-	if err := b.ChartApplierSeed.ApplyChart(ctx, filepath.Join(dnsChartPath, "provider"), b.Shoot.SeedNamespace, name, nil, values); err != nil {
-		return err
+	if err := b.destroyAdditionalDomainDNSRecords(ctx, wanted); err != nil {
+		result = multierror.Append(result, err)
+	}
+	if err := b.updateDNSProviderStatuses(statuses); err != nil {
+		result = multierror.Append(result, err)
 	}
+	return result
+}
 
-	return b.waitUntilDNSProviderReady(ctx, name)
+// DestroyAdditionalDomainDNSRecords destroys the DNS records of all additional DNS providers of the shoot.
+func (b *Botanist) DestroyAdditionalDomainDNSRecords(ctx context.Context) error {
+	return b.destroyAdditionalDomainDNSRecords(ctx, sets.NewString())
 }
 
-func (b *Botanist) waitUntilDNSProviderReady(ctx context.Context, name string) error {
-	var (
-		status  string
-		message string
-	)
+// destroyAdditionalDomainDNSRecords destroys the DNS records of additional DNS providers whose purpose is not
+// contained in the given set.
+func (b *Botanist) destroyAdditionalDomainDNSRecords(ctx context.Context, wanted sets.String) error {
+	dnsRecordList := &extensionsv1alpha1.DNSRecordList{}
+	if err := b.K8sSeedClient.Client().List(ctx, dnsRecordList, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+		return err
+	}
 
-	if err := retry.UntilTimeout(ctx, 5*time.Second, 2*time.Minute, func(ctx context.Context) (done bool, err error) {
-		provider := &dnsv1alpha1.DNSProvider{}
-		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: b.Shoot.SeedNamespace}, provider); err != nil {
-			return retry.SevereError(err)
+	var fns []flow.TaskFn
+	for _, dnsRecord := range dnsRecordList.Items {
+		purpose := strings.TrimPrefix(dnsRecord.Name, b.Shoot.Info.Name+"-")
+		if !strings.HasPrefix(purpose, DNSPurposeAdditional+"-") || wanted.Has(purpose) {
+			continue
 		}
 
-		if provider.Status.State == dnsv1alpha1.STATE_READY {
-			return retry.Ok()
-		}
+		fns = append(fns, func(ctx context.Context) error {
+			return b.deleteDNSRecord(ctx, purpose)
+		})
+	}
 
-		status = provider.Status.State
-		if msg := provider.Status.Message; msg != nil {
-			message = *msg
-		}
+	return flow.Parallel(fns...)(ctx)
+}
 
-		b.Logger.Infof("Waiting for %q DNS provider to be ready... (status=%s, message=%s)", name, status, message)
-		return retry.MinorError(fmt.Errorf("DNS provider %q is not ready (status=%s, message=%s)", name, status, message))
-	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed to create DNS provider for %q DNS record: %q (status=%s, message=%s)", name, err.Error(), status, message))
+// updateDNSProviderStatuses replaces the statuses of the additional DNS providers in the shoot status. Nothing is
+// updated if the statuses have not changed.
+func (b *Botanist) updateDNSProviderStatuses(statuses []gardenv1beta1.DNSProviderStatus) error {
+	if apiequality.Semantic.DeepEqual(b.Shoot.Info.Status.DNSProviders, statuses) {
+		return nil
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(b.K8sGardenClient.Garden(), kretry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+		shoot.Status.DNSProviders = statuses
+		return shoot, nil
+	})
+	if err != nil {
+		return err
 	}
 
+	b.Shoot.Info = newShoot
 	return nil
 }
 
-func (b *Botanist) deleteDNSProvider(ctx context.Context, name string) error {
-	if err := b.K8sSeedClient.Client().Delete(ctx, &dnsv1alpha1.DNSProvider{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}); err != nil && !apierrors.IsNotFound(err) {
-		return err
+// AdditionalDNSRecordPurpose returns the purpose of the DNS record of the given additional domain. It is derived from
+// the provider type and the domain (which are unique among the additional providers of a shoot) so that the record
+// is not re-created if additional providers are reordered, added, or removed.
+func AdditionalDNSRecordPurpose(domain *garden.Domain) string {
+	return fmt.Sprintf("%s-%s", DNSPurposeAdditional, utils.ComputeSHA256Hex([]byte(domain.Provider + "/" + domain.Domain))[:8])
+}
+
+// ComputeDNSRecordType returns the type of the DNS record pointing to the given target, i.e. an A record if the
+// target is an IP address and a CNAME record otherwise.
+func ComputeDNSRecordType(target string) extensionsv1alpha1.DNSRecordType {
+	if net.ParseIP(target) != nil {
+		return extensionsv1alpha1.DNSRecordTypeA
 	}
+	return extensionsv1alpha1.DNSRecordTypeCNAME
+}
 
-	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
-	defer cancel()
+func (b *Botanist) dnsRecordName(purpose string) string {
+	return fmt.Sprintf("%s-%s", b.Shoot.Info.Name, purpose)
+}
 
-	return kutil.WaitUntilResourceDeleted(ctx, b.K8sSeedClient.Client(), &dnsv1alpha1.DNSProvider{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}, 5*time.Second)
+func dnsRecordSecretName(purpose string) string {
+	return fmt.Sprintf("dnsrecord-%s", purpose)
 }
 
-func (b *Botanist) deployDNSEntry(ctx context.Context, name, dnsName, target string) error {
-	values := map[string]interface{}{
-		"name":    name,
-		"dnsName": dnsName,
-		"targets": []string{target},
+// ComputeDNSRecordZone returns the hosted zone for a DNS record with the given name of the given domain. `DNSRecord`
+// resources only support a single hosted zone, hence the zone is only set if exactly one zone is included, and the
+// record is rejected if the extension controller would have to determine the zone while some zones are excluded.
+// The record name must be part of the included domains (if any) and must not be part of the excluded domains.
+func ComputeDNSRecordZone(domain *garden.Domain, name string) (*string, error) {
+	if len(domain.IncludeDomains) > 0 && !domainsContain(domain.IncludeDomains, name) {
+		return nil, fmt.Errorf("DNS record name %q is not part of the included domains %v", name, domain.IncludeDomains)
+	}
+	if domainsContain(domain.ExcludeDomains, name) {
+		return nil, fmt.Errorf("DNS record name %q is part of the excluded domains %v", name, domain.ExcludeDomains)
+	}
+
+	switch {
+	case len(domain.IncludeZones) == 1:
+		return &domain.IncludeZones[0], nil
+	case len(domain.IncludeZones) > 1:
+		return nil, fmt.Errorf("DNS record name %q cannot be created in one of multiple included zones %v, exactly one zone must be included", name, domain.IncludeZones)
+	case len(domain.ExcludeZones) > 0:
+		return nil, fmt.Errorf("DNS record name %q cannot be created if zones %v are excluded, exactly one zone must be included instead", name, domain.ExcludeZones)
+	}
+	return nil, nil
+}
+
+// domainsContain returns true if the given name is equal to or a subdomain of one of the given domains.
+func domainsContain(domains []string, name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	for _, domain := range domains {
+		domain = strings.TrimSuffix(domain, ".")
+		if name == domain || strings.HasSuffix(name, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// deployDNSRecord creates the secret containing the credentials of the DNS provider and the `DNSRecord` extension
+// resource for the given purpose in the shoot namespace in the seed cluster. It waits until an external controller
+// did reconcile the record successfully.
+func (b *Botanist) deployDNSRecord(ctx context.Context, purpose string, domain *garden.Domain, name, target string) error {
+	zone, err := ComputeDNSRecordZone(domain, name)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      dnsRecordSecretName(purpose),
+			Namespace: b.Shoot.SeedNamespace,
+		},
+	}
+	if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), secret, func() error {
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = domain.SecretData
+		return nil
+	}); err != nil {
+		return err
 	}
 
-	if err := b.ChartApplierSeed.ApplyChart(ctx, filepath.Join(dnsChartPath, "entry"), b.Shoot.SeedNamespace, name, nil, values); err != nil {
+	dnsRecord := &extensionsv1alpha1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.dnsRecordName(purpose),
+			Namespace: b.Shoot.SeedNamespace,
+		},
+	}
+	if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), dnsRecord, func() error {
+		metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
+		dnsRecord.Spec = extensionsv1alpha1.DNSRecordSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type: domain.Provider,
+			},
+			SecretRef: corev1.SecretReference{
+				Name:      secret.Name,
+				Namespace: secret.Namespace,
+			},
+			Zone:       zone,
+			Name:       name,
+			RecordType: ComputeDNSRecordType(target),
+			Values:     []string{target},
+		}
+		return nil
+	}); err != nil {
 		return err
 	}
 
-	return b.waitUntilDNSEntryReady(ctx, name)
+	return b.waitUntilDNSRecordReady(ctx, purpose)
 }
 
-func (b *Botanist) waitUntilDNSEntryReady(ctx context.Context, name string) error {
-	var (
-		status  string
-		message string
-	)
+func (b *Botanist) waitUntilDNSRecordReady(ctx context.Context, purpose string) error {
+	name := b.dnsRecordName(purpose)
 
-	if err := retry.UntilTimeout(ctx, 5*time.Second, 2*time.Minute, func(ctx context.Context) (done bool, err error) {
-		entry := &dnsv1alpha1.DNSEntry{}
-		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: b.Shoot.SeedNamespace}, entry); err != nil {
+	if err := retry.UntilTimeout(ctx, DefaultInterval, DNSRecordDefaultTimeout, func(ctx context.Context) (bool, error) {
+		dnsRecord := &extensionsv1alpha1.DNSRecord{}
+		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: b.Shoot.SeedNamespace}, dnsRecord); err != nil {
 			return retry.SevereError(err)
 		}
+		if err := health.CheckExtensionObject(dnsRecord); err != nil {
+			b.Logger.WithError(err).Errorf("%q DNS record did not get ready yet", purpose)
+			return retry.MinorError(err)
+		}
+		return retry.Ok()
+	}); err != nil {
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed to create %q DNS record: %v", purpose, err))
+	}
+	return nil
+}
 
-		if entry.Status.ObservedGeneration == entry.Generation && entry.Status.State == dnsv1alpha1.STATE_READY {
-			return retry.Ok()
+// deleteDNSRecord deletes the `DNSRecord` extension resource for the given purpose and waits until it is gone before
+// the secret containing the credentials of the DNS provider is deleted.
+func (b *Botanist) deleteDNSRecord(ctx context.Context, purpose string) error {
+	var (
+		name      = b.dnsRecordName(purpose)
+		lastError *gardencorev1alpha1.LastError
+	)
+
+	if err := b.K8sSeedClient.Client().Delete(ctx, &extensionsv1alpha1.DNSRecord{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	if err := retry.UntilTimeout(ctx, DefaultInterval, DNSRecordDefaultTimeout, func(ctx context.Context) (bool, error) {
+		dnsRecord := &extensionsv1alpha1.DNSRecord{}
+		if err := b.K8sSeedClient.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: b.Shoot.SeedNamespace}, dnsRecord); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
 		}
 
-		status = entry.Status.State
-		if msg := entry.Status.Message; msg != nil {
-			message = *msg
+		if lastErr := dnsRecord.Status.LastError; lastErr != nil {
+			b.Logger.Errorf("%q DNS record did not get deleted yet, lastError is: %s", purpose, lastErr.Description)
+			lastError = lastErr
 		}
 
-		b.Logger.Infof("Waiting for %q DNS record to be ready... (status=%s, message=%s)", name, status, message)
-		return retry.MinorError(fmt.Errorf("DNS record %q is not ready (status=%s, message=%s)", name, status, message))
+		b.Logger.Infof("Waiting for %q DNS record to be deleted...", purpose)
+		return retry.MinorError(common.WrapWithLastError(fmt.Errorf("%q DNS record is still present", purpose), lastError))
 	}); err != nil {
-		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("Failed to create %q DNS record: %q (status=%s, message=%s)", name, err.Error(), status, message))
+		message := fmt.Sprintf("Failed to delete %q DNS record", purpose)
+		if lastError != nil {
+			return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, lastError.Description))
+		}
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, err.Error()))
 	}
 
-	return nil
+	return client.IgnoreNotFound(b.K8sSeedClient.Client().Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: dnsRecordSecretName(purpose)}}))
+}
+
+// handOverLegacyDNSResources hands the DNS record of the given purpose over from the `DNSEntry` and `DNSProvider`
+// resources of the dns-controller-manager to the `DNSRecord` extension resource. It must only be called after the
+// `DNSRecord` is ready. The finalizers of the legacy resources are removed before they are deleted, so that the
+// dns-controller-manager cannot remove the record from the DNS provider. The provider is orphaned first, so that the
+// dns-controller-manager does not have the credentials for the record anymore when the entry is deleted.
+func (b *Botanist) handOverLegacyDNSResources(ctx context.Context, purpose string) error {
+	if err := b.orphanLegacyDNSObject(ctx, &dnsv1alpha1.DNSProvider{}, purpose); err != nil {
+		return err
+	}
+	return b.orphanLegacyDNSObject(ctx, &dnsv1alpha1.DNSEntry{}, purpose)
 }
 
-func (b *Botanist) deleteDNSEntry(ctx context.Context, name string) error {
-	if err := b.K8sSeedClient.Client().Delete(ctx, &dnsv1alpha1.DNSEntry{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}); err != nil && !apierrors.IsNotFound(err) {
+func (b *Botanist) orphanLegacyDNSObject(ctx context.Context, obj runtime.Object, name string) error {
+	key := client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: name}
+
+	if err := b.K8sSeedClient.Client().Get(ctx, key, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
 		return err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
-	defer cancel()
+	if err := b.removeLegacyDNSObjectFinalizers(ctx, obj); err != nil {
+		return err
+	}
+	if err := b.K8sSeedClient.Client().Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return err
+	}
 
-	return kutil.WaitUntilResourceDeleted(ctx, b.K8sSeedClient.Client(), &dnsv1alpha1.DNSEntry{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: name}}, 5*time.Second)
+	// The dns-controller-manager might have added its finalizer again before the object was deleted.
+	return retry.UntilTimeout(ctx, DefaultInterval, DNSRecordDefaultTimeout, func(ctx context.Context) (bool, error) {
+		if err := b.K8sSeedClient.Client().Get(ctx, key, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
+		}
+		if err := b.removeLegacyDNSObjectFinalizers(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return retry.MinorError(err)
+		}
+		return retry.MinorError(fmt.Errorf("legacy DNS object %q is still present", name))
+	})
 }
 
-func (b *Botanist) deleteLegacyTerraformDNSResources(ctx context.Context, purpose string) error {
-	tf, err := b.NewShootTerraformer(purpose)
+// removeLegacyDNSObjectFinalizers removes all finalizers of the given legacy DNS object.
+func (b *Botanist) removeLegacyDNSObjectFinalizers(ctx context.Context, obj runtime.Object) error {
+	accessor, err := meta.Accessor(obj)
 	if err != nil {
 		return err
 	}
+	if len(accessor.GetFinalizers()) == 0 {
+		return nil
+	}
+
+	accessor.SetFinalizers(nil)
+	return b.K8sSeedClient.Client().Update(ctx, obj)
+}
+
+// deleteLegacyDNSResources deletes the `DNSEntry` and `DNSProvider` resources of the dns-controller-manager which
+// were used for the given purpose before the DNS records were managed via `DNSRecord` extension resources. The entry
+// is deleted first so that the dns-controller-manager can still remove the record with the provider's credentials.
+// It must only be used if the DNS record shall be removed from the DNS provider.
+func (b *Botanist) deleteLegacyDNSResources(ctx context.Context, purpose string) error {
+	if err := b.deleteLegacyDNSObject(ctx, &dnsv1alpha1.DNSEntry{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: purpose}}); err != nil {
+		return err
+	}
+	return b.deleteLegacyDNSObject(ctx, &dnsv1alpha1.DNSProvider{ObjectMeta: metav1.ObjectMeta{Namespace: b.Shoot.SeedNamespace, Name: purpose}})
+}
+
+func (b *Botanist) deleteLegacyDNSObject(ctx context.Context, obj runtime.Object) error {
+	if err := b.K8sSeedClient.Client().Delete(ctx, obj); err != nil {
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return nil
+		}
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DNSRecordDefaultTimeout)
+	defer cancel()
 
-	return tf.CleanupConfiguration(ctx)
+	return kutil.WaitUntilResourceDeleted(ctx, b.K8sSeedClient.Client(), obj, DefaultInterval)
 }
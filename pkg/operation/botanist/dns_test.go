@@ -0,0 +1,315 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	fakegarden "github.com/gardener/gardener/pkg/client/garden/clientset/versioned/fake"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/garden"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	dnsv1alpha1 "github.com/gardener/external-dns-management/pkg/apis/dns/v1alpha1"
+	"github.com/golang/mock/gomock"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"github.com/onsi/gomega/types"
+)
+
+var _ = Describe("dns", func() {
+	DescribeTable("#ComputeDNSRecordType",
+		func(target string, expected extensionsv1alpha1.DNSRecordType) {
+			Expect(botanist.ComputeDNSRecordType(target)).To(Equal(expected))
+		},
+
+		Entry("IPv4 address", "1.2.3.4", extensionsv1alpha1.DNSRecordTypeA),
+		Entry("IPv6 address", "2001:db8::1", extensionsv1alpha1.DNSRecordTypeA),
+		Entry("hostname", "abc.elb.amazonaws.com", extensionsv1alpha1.DNSRecordTypeCNAME),
+	)
+
+	DescribeTable("#ComputeDNSRecordZone",
+		func(domain *garden.Domain, name string, zoneMatcher, errMatcher types.GomegaMatcher) {
+			zone, err := botanist.ComputeDNSRecordZone(domain, name)
+			Expect(zone).To(zoneMatcher)
+			Expect(err).To(errMatcher)
+		},
+
+		Entry("no zones and domains", &garden.Domain{}, "api.foo.example.com", BeNil(), Not(HaveOccurred())),
+		Entry("exactly one included zone", &garden.Domain{IncludeZones: []string{"ZFOO"}, ExcludeZones: []string{"ZBAR"}}, "api.foo.example.com", PointTo(Equal("ZFOO")), Not(HaveOccurred())),
+		Entry("multiple included zones", &garden.Domain{IncludeZones: []string{"ZFOO", "ZBAR"}}, "api.foo.example.com", BeNil(), HaveOccurred()),
+		Entry("excluded zones only", &garden.Domain{ExcludeZones: []string{"ZBAR"}}, "api.foo.example.com", BeNil(), HaveOccurred()),
+		Entry("name in included domains", &garden.Domain{IncludeDomains: []string{"bar.com", "example.com"}}, "api.foo.example.com", BeNil(), Not(HaveOccurred())),
+		Entry("name not in included domains", &garden.Domain{IncludeDomains: []string{"bar.com", "ample.com"}}, "api.foo.example.com", BeNil(), HaveOccurred()),
+		Entry("name in excluded domains", &garden.Domain{IncludeDomains: []string{"example.com"}, ExcludeDomains: []string{"foo.example.com"}}, "api.foo.example.com", BeNil(), HaveOccurred()),
+		Entry("name not in excluded domains", &garden.Domain{IncludeDomains: []string{"example.com"}, ExcludeDomains: []string{"bar.example.com"}}, "*.ingress.foo.example.com", BeNil(), Not(HaveOccurred())),
+	)
+
+	Describe("#AdditionalDNSRecordPurpose", func() {
+		It("should derive the purpose from the provider type and the domain", func() {
+			purpose := botanist.AdditionalDNSRecordPurpose(&garden.Domain{Provider: "aws-route53", Domain: "foo.example.com"})
+
+			Expect(purpose).To(HavePrefix("additional-"))
+			Expect(purpose).To(HaveLen(len("additional-") + 8))
+			Expect(botanist.AdditionalDNSRecordPurpose(&garden.Domain{Provider: "aws-route53", Domain: "foo.example.com", SecretData: map[string][]byte{"foo": nil}})).To(Equal(purpose))
+			Expect(botanist.AdditionalDNSRecordPurpose(&garden.Domain{Provider: "google-clouddns", Domain: "foo.example.com"})).NotTo(Equal(purpose))
+			Expect(botanist.AdditionalDNSRecordPurpose(&garden.Domain{Provider: "aws-route53", Domain: "bar.example.com"})).NotTo(Equal(purpose))
+		})
+	})
+
+	Describe("#DeployInternalDomainDNSRecord", func() {
+		var (
+			ctrl                 *gomock.Controller
+			k8sSeedClient        *mock.MockInterface
+			k8sSeedRuntimeClient *mockclient.MockClient
+			b                    *botanist.Botanist
+
+			ctx       = context.TODO()
+			namespace = "shoot--foo--bar"
+			notFound  = apierrors.NewNotFound(schema.GroupResource{}, "")
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+
+			k8sSeedClient = mock.NewMockInterface(ctrl)
+			k8sSeedRuntimeClient = mockclient.NewMockClient(ctrl)
+			k8sSeedClient.EXPECT().Client().Return(k8sSeedRuntimeClient).AnyTimes()
+
+			b = &botanist.Botanist{Operation: &operation.Operation{
+				Logger:           logrus.NewEntry(logrus.New()),
+				K8sSeedClient:    k8sSeedClient,
+				APIServerAddress: "1.2.3.4",
+				Garden: &garden.Garden{
+					InternalDomain: &garden.Domain{Provider: "aws-route53", IncludeZones: []string{"ZFOO"}},
+				},
+				Shoot: &shoot.Shoot{
+					Info:                  &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar"}},
+					SeedNamespace:         namespace,
+					InternalClusterDomain: "bar.foo.internal.example.com",
+				},
+			}}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should create the DNS record before it orphans the legacy DNS resources", func() {
+			orphaned := func(obj metav1.Object) {
+				Expect(obj.GetFinalizers()).To(BeEmpty())
+			}
+
+			gomock.InOrder(
+				k8sSeedRuntimeClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "dnsrecord-internal"}, gomock.AssignableToTypeOf(&corev1.Secret{})).Return(notFound),
+				k8sSeedRuntimeClient.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&corev1.Secret{})),
+				k8sSeedRuntimeClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "bar-internal"}, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{})).Return(notFound),
+				k8sSeedRuntimeClient.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{})).DoAndReturn(func(_ context.Context, dnsRecord *extensionsv1alpha1.DNSRecord) error {
+					Expect(dnsRecord.Spec.Zone).To(PointTo(Equal("ZFOO")))
+					Expect(dnsRecord.Spec.Name).To(Equal("api.bar.foo.internal.example.com"))
+					return nil
+				}),
+				k8sSeedRuntimeClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: "bar-internal"}, gomock.AssignableToTypeOf(&extensionsv1alpha1.DNSRecord{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, dnsRecord *extensionsv1alpha1.DNSRecord) error {
+					dnsRecord.Status.LastOperation = &gardencorev1alpha1.LastOperation{State: gardencorev1alpha1.LastOperationStateSucceeded}
+					return nil
+				}),
+
+				k8sSeedRuntimeClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "internal"}, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSProvider{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, provider *dnsv1alpha1.DNSProvider) error {
+					provider.Finalizers = []string{"dns.gardener.cloud/compound"}
+					return nil
+				}),
+				k8sSeedRuntimeClient.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSProvider{})).Do(func(_ context.Context, provider *dnsv1alpha1.DNSProvider) { orphaned(provider) }),
+				k8sSeedRuntimeClient.EXPECT().Delete(ctx, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSProvider{})),
+				k8sSeedRuntimeClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: "internal"}, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSProvider{})).Return(notFound),
+
+				k8sSeedRuntimeClient.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "internal"}, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSEntry{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, entry *dnsv1alpha1.DNSEntry) error {
+					entry.Finalizers = []string{"dns.gardener.cloud/compound"}
+					return nil
+				}),
+				k8sSeedRuntimeClient.EXPECT().Update(ctx, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSEntry{})).Do(func(_ context.Context, entry *dnsv1alpha1.DNSEntry) { orphaned(entry) }),
+				k8sSeedRuntimeClient.EXPECT().Delete(ctx, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSEntry{})),
+				k8sSeedRuntimeClient.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: namespace, Name: "internal"}, gomock.AssignableToTypeOf(&dnsv1alpha1.DNSEntry{})).Return(notFound),
+			)
+
+			Expect(b.DeployInternalDomainDNSRecord(ctx)).To(Succeed())
+		})
+
+		It("should neither create the DNS record nor touch the legacy DNS resources for unsupported zone configurations", func() {
+			b.Garden.InternalDomain.IncludeZones = []string{"ZFOO", "ZBAR"}
+
+			Expect(b.DeployInternalDomainDNSRecord(ctx)).NotTo(Succeed())
+		})
+	})
+
+	Describe("#DeployAdditionalDomainDNSRecords", func() {
+		const (
+			namespace = "shoot--foo--bar"
+			name      = "bar"
+		)
+
+		var (
+			ctrl         *gomock.Controller
+			c            client.Client
+			gardenClient *fakegarden.Clientset
+			b            *botanist.Botanist
+
+			ctx       = context.TODO()
+			succeeded = extensionsv1alpha1.DNSRecordStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1alpha1.LastOperation{State: gardencorev1alpha1.LastOperationStateSucceeded},
+				},
+			}
+
+			domain       *garden.Domain
+			purpose      = botanist.AdditionalDNSRecordPurpose(&garden.Domain{Provider: "aws-route53", Domain: "bar.example.com"})
+			stalePurpose = botanist.AdditionalDNSRecordPurpose(&garden.Domain{Provider: "aws-route53", Domain: "old.example.com"})
+
+			dnsRecord = func(purpose string) *extensionsv1alpha1.DNSRecord {
+				return &extensionsv1alpha1.DNSRecord{
+					ObjectMeta: metav1.ObjectMeta{Name: name + "-" + purpose, Namespace: namespace},
+					Status:     succeeded,
+				}
+			}
+			dnsRecordNames = func() []string {
+				dnsRecordList := &extensionsv1alpha1.DNSRecordList{}
+				Expect(c.List(ctx, dnsRecordList, client.InNamespace(namespace))).To(Succeed())
+
+				var names []string
+				for _, dnsRecord := range dnsRecordList.Items {
+					names = append(names, dnsRecord.Name)
+				}
+				return names
+			}
+			dnsProviderStatuses = func() []gardenv1beta1.DNSProviderStatus {
+				shootObj, err := gardenClient.GardenV1beta1().Shoots("garden-foo").Get(name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				return shootObj.Status.DNSProviders
+			}
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			domain = &garden.Domain{Provider: "aws-route53", Domain: "bar.example.com", IncludeZones: []string{"ZFOO"}}
+
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			c = &reconciledDNSRecordsClient{fake.NewFakeClientWithScheme(scheme, dnsRecord(purpose), dnsRecord(stalePurpose), dnsRecord(botanist.DNSPurposeInternal))}
+
+			shootObj := &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"}}
+			gardenClient = fakegarden.NewSimpleClientset(shootObj)
+
+			k8sSeedClient := mock.NewMockInterface(ctrl)
+			k8sSeedClient.EXPECT().Client().Return(c).AnyTimes()
+			k8sGardenClient := mock.NewMockInterface(ctrl)
+			k8sGardenClient.EXPECT().Garden().Return(gardenClient).AnyTimes()
+
+			b = &botanist.Botanist{Operation: &operation.Operation{
+				Logger:          logrus.NewEntry(logrus.New()),
+				K8sSeedClient:   k8sSeedClient,
+				K8sGardenClient: k8sGardenClient,
+				Shoot: &shoot.Shoot{
+					Info:                  shootObj.DeepCopy(),
+					SeedNamespace:         namespace,
+					InternalClusterDomain: "bar.foo.internal.example.com",
+					AdditionalDomains:     []*garden.Domain{domain, {Provider: "aws-route53", Domain: "bar.1.2.3.4.nip.io"}},
+				},
+			}}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should deploy the records, destroy the stale ones and report the state in the shoot status", func() {
+			Expect(b.DeployAdditionalDomainDNSRecords(ctx)).To(Succeed())
+
+			Expect(dnsRecordNames()).To(ConsistOf(name+"-"+purpose, name+"-"+botanist.DNSPurposeInternal))
+
+			record := &extensionsv1alpha1.DNSRecord{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name + "-" + purpose}, record)).To(Succeed())
+			Expect(record.Spec.Type).To(Equal("aws-route53"))
+			Expect(record.Spec.Zone).To(PointTo(Equal("ZFOO")))
+			Expect(record.Spec.Name).To(Equal("api.bar.example.com"))
+			Expect(record.Spec.Values).To(ConsistOf("api.bar.foo.internal.example.com"))
+
+			expected := []gardenv1beta1.DNSProviderStatus{{Type: "aws-route53", Domain: "bar.example.com", State: gardenv1beta1.DNSProviderStateReady}}
+			Expect(dnsProviderStatuses()).To(Equal(expected))
+			Expect(b.Shoot.Info.Status.DNSProviders).To(Equal(expected))
+		})
+
+		It("should report the error of a record in the shoot status", func() {
+			domain.IncludeZones = []string{"ZFOO", "ZBAR"}
+
+			Expect(b.DeployAdditionalDomainDNSRecords(ctx)).NotTo(Succeed())
+
+			Expect(dnsRecordNames()).To(ConsistOf(name+"-"+purpose, name+"-"+botanist.DNSPurposeInternal))
+			Expect(dnsProviderStatuses()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Type":        Equal("aws-route53"),
+				"Domain":      Equal("bar.example.com"),
+				"State":       Equal(gardenv1beta1.DNSProviderStateError),
+				"Description": ContainSubstring("exactly one zone must be included"),
+			})))
+		})
+
+		It("should not update the shoot status if it has not changed", func() {
+			b.Shoot.Info.Status.DNSProviders = []gardenv1beta1.DNSProviderStatus{{Type: "aws-route53", Domain: "bar.example.com", State: gardenv1beta1.DNSProviderStateReady}}
+
+			Expect(b.DeployAdditionalDomainDNSRecords(ctx)).To(Succeed())
+
+			Expect(gardenClient.Actions()).To(BeEmpty())
+		})
+
+		It("should destroy all records of additional providers", func() {
+			Expect(b.DestroyAdditionalDomainDNSRecords(ctx)).To(Succeed())
+
+			Expect(dnsRecordNames()).To(ConsistOf(name + "-" + botanist.DNSPurposeInternal))
+		})
+	})
+})
+
+// reconciledDNSRecordsClient behaves like an extension controller which has already reconciled all `DNSRecord`s: it
+// removes the operation annotation from every `DNSRecord` it returns.
+type reconciledDNSRecordsClient struct {
+	client.Client
+}
+
+func (c *reconciledDNSRecordsClient) Get(ctx context.Context, key client.ObjectKey, obj runtime.Object) error {
+	if err := c.Client.Get(ctx, key, obj); err != nil {
+		return err
+	}
+	if dnsRecord, ok := obj.(*extensionsv1alpha1.DNSRecord); ok {
+		delete(dnsRecord.Annotations, v1alpha1constants.GardenerOperation)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/retry"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ETCDMainVolumeClaimName is the name of the PersistentVolumeClaim holding the data of the main etcd.
+var ETCDMainVolumeClaimName = fmt.Sprintf("main-etcd-%s-0", v1alpha1constants.StatefulSetNameETCDMain)
+
+// DeleteETCDMainVolume deletes the volume of the main etcd and waits until it is gone. The main etcd must have been
+// scaled down before. When it is scaled up again its backup sidecar does not find valid data and restores the etcd
+// from the snapshots in the backup bucket.
+func (b *Botanist) DeleteETCDMainVolume(ctx context.Context) error {
+	pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: ETCDMainVolumeClaimName, Namespace: b.Shoot.SeedNamespace}}
+	if err := b.K8sSeedClient.Client().Delete(ctx, pvc, kubernetes.DefaultDeleteOptionFuncs...); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	return retry.UntilTimeout(ctx, DefaultInterval, 5*time.Minute, func(ctx context.Context) (bool, error) {
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(pvc.Namespace, pvc.Name), pvc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
+		}
+		b.Logger.Infof("Waiting until the volume of the main etcd has been deleted...")
+		return retry.MinorError(fmt.Errorf("persistent volume claim %s still exists", pvc.Name))
+	})
+}
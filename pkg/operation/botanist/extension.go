@@ -26,8 +26,6 @@ import (
 
 	"github.com/gardener/gardener/pkg/utils/retry"
 
-	utilclient "github.com/gardener/gardener/pkg/utils/kubernetes/client"
-
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
@@ -42,34 +40,31 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-// DeployExtensionResources creates the `Extension` extension resource in the shoot namespace in the seed
-// cluster. Gardener waits until an external controller did reconcile the cluster successfully.
-func (b *Botanist) DeployExtensionResources(ctx context.Context) error {
-	fns := make([]flow.TaskFn, 0, len(b.Shoot.Extensions))
-	for _, extension := range b.Shoot.Extensions {
-		var (
-			extensionType  = extension.Spec.Type
-			providerConfig = extension.Spec.ProviderConfig
-			toApply        = extensionsv1alpha1.Extension{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      extension.Name,
-					Namespace: extension.Namespace,
-				},
-			}
-		)
+// DeployExtensionResource creates the `Extension` extension resource of the given type in the shoot namespace in the
+// seed cluster. Gardener waits until an external controller did reconcile the cluster successfully.
+func (b *Botanist) DeployExtensionResource(ctx context.Context, extensionType string) error {
+	extension, ok := b.Shoot.Extensions[extensionType]
+	if !ok {
+		return fmt.Errorf("extension %q is not required for the shoot", extensionType)
+	}
 
-		fns = append(fns, func(ctx context.Context) error {
-			return kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), &toApply, func() error {
-				metav1.SetMetaDataAnnotation(&toApply.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
+	var (
+		providerConfig = extension.Spec.ProviderConfig
+		toApply        = extensionsv1alpha1.Extension{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      extension.Name,
+				Namespace: extension.Namespace,
+			},
+		}
+	)
 
-				toApply.Spec.Type = extensionType
-				toApply.Spec.ProviderConfig = providerConfig
-				return nil
-			})
-		})
-	}
+	return kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), &toApply, func() error {
+		metav1.SetMetaDataAnnotation(&toApply.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
 
-	return flow.Parallel(fns...)(ctx)
+		toApply.Spec.Type = extensionType
+		toApply.Spec.ProviderConfig = providerConfig
+		return nil
+	})
 }
 
 // DeleteStaleExtensionResources deletes unused extensions from the shoot namespace in the seed.
@@ -102,42 +97,88 @@ func (b *Botanist) DeleteStaleExtensionResources(ctx context.Context) error {
 	return flow.Parallel(fns...)(ctx)
 }
 
-// WaitUntilExtensionResourcesReady waits until all extension resources report `Succeeded` in their last operation state.
-// The state must be reported before the passed context is cancelled or an extension's timeout has been reached.
-// As soon as one timeout has been overstepped the function returns an error, further waits on extensions will be aborted.
-func (b *Botanist) WaitUntilExtensionResourcesReady(ctx context.Context) error {
-	fns := make([]flow.TaskFn, 0, len(b.Shoot.Extensions))
-	for _, extension := range b.Shoot.Extensions {
-		var (
-			name      = extension.Name
-			namespace = extension.Namespace
-		)
-		fns = append(fns, func(ctx context.Context) error {
-			if err := retry.UntilTimeout(ctx, DefaultInterval, shoot.ExtensionDefaultTimeout, func(ctx context.Context) (bool, error) {
-				req := &extensionsv1alpha1.Extension{}
-				if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, name), req); err != nil {
-					return retry.SevereError(err)
-				}
+// WaitUntilExtensionResourceReady waits until the extension resource of the given type reports `Succeeded` in its last
+// operation state. The state must be reported before the passed context is cancelled or the extension's timeout has
+// been reached.
+func (b *Botanist) WaitUntilExtensionResourceReady(ctx context.Context, extensionType string) error {
+	extension, ok := b.Shoot.Extensions[extensionType]
+	if !ok {
+		return fmt.Errorf("extension %q is not required for the shoot", extensionType)
+	}
 
-				if err := health.CheckExtensionObject(req); err != nil {
-					b.Logger.WithError(err).Errorf("Extension %s/%s did not get ready yet", namespace, name)
-					return retry.MinorError(err)
-				}
+	var (
+		name      = extension.Name
+		namespace = extension.Namespace
+	)
 
-				return retry.Ok()
-			}); err != nil {
-				return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed waiting for extension %s to be ready: %v", name, err))
-			}
-			return nil
-		})
+	if err := retry.UntilTimeout(ctx, DefaultInterval, extension.Timeout, func(ctx context.Context) (bool, error) {
+		req := &extensionsv1alpha1.Extension{}
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, name), req); err != nil {
+			return retry.SevereError(err)
+		}
+
+		if err := health.CheckExtensionObject(req); err != nil {
+			b.Logger.WithError(err).Errorf("Extension %s/%s did not get ready yet", namespace, name)
+			return retry.MinorError(err)
+		}
+
+		return retry.Ok()
+	}); err != nil {
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("failed waiting for extension %s to be ready: %v", name, err))
+	}
+	return nil
+}
+
+// DeleteExtensionResource deletes the extension resource of the given type from the Shoot namespace in the Seed.
+func (b *Botanist) DeleteExtensionResource(ctx context.Context, extensionType string) error {
+	toDelete := &extensionsv1alpha1.Extension{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      extensionType,
+			Namespace: b.Shoot.SeedNamespace,
+		},
+	}
+	if extension, ok := b.Shoot.Extensions[extensionType]; ok {
+		toDelete.ObjectMeta = extension.ObjectMeta
 	}
 
-	return flow.ParallelExitOnError(fns...)(ctx)
+	return client.IgnoreNotFound(b.K8sSeedClient.Client().Delete(ctx, toDelete, kubernetes.DefaultDeleteOptionFuncs...))
 }
 
-// DeleteExtensionResources deletes all extension resources from the Shoot namespace in the Seed.
-func (b *Botanist) DeleteExtensionResources(ctx context.Context) error {
-	return utilclient.Delete(ctx, b.K8sSeedClient.Client(), &extensionsv1alpha1.ExtensionList{}, utilclient.CollectionMatching(client.InNamespace(b.Shoot.SeedNamespace)))
+// WaitUntilExtensionResourceDeleted waits until the extension resource of the given type is gone or the context is
+// cancelled.
+func (b *Botanist) WaitUntilExtensionResourceDeleted(ctx context.Context, extensionType string) error {
+	var (
+		lastError *gardencorev1alpha1.LastError
+		name      = extensionType
+		namespace = b.Shoot.SeedNamespace
+	)
+	if extension, ok := b.Shoot.Extensions[extensionType]; ok {
+		name, namespace = extension.Name, extension.Namespace
+	}
+
+	if err := retry.UntilTimeout(ctx, DefaultInterval, shoot.ExtensionDefaultTimeout, func(ctx context.Context) (bool, error) {
+		extension := &extensionsv1alpha1.Extension{}
+		if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(namespace, name), extension); err != nil {
+			if apierrors.IsNotFound(err) {
+				return retry.Ok()
+			}
+			return retry.SevereError(err)
+		}
+
+		if lastErr := extension.Status.LastError; lastErr != nil {
+			b.Logger.Errorf("Extension %s did not get deleted yet, lastError is: %s", name, lastErr.Description)
+			lastError = lastErr
+		}
+
+		return retry.MinorError(common.WrapWithLastError(fmt.Errorf("extension %s is still present", name), lastError))
+	}); err != nil {
+		message := fmt.Sprintf("Failed waiting for extension %s to be deleted", name)
+		if lastError != nil {
+			return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, lastError.Description))
+		}
+		return gardencorev1alpha1helper.DetermineError(fmt.Sprintf("%s: %s", message, err.Error()))
+	}
+	return nil
 }
 
 // WaitUntilExtensionResourcesDeleted waits until all extension resources are gone or the context is cancelled.
@@ -21,29 +21,32 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gardener/gardener/pkg/api/extensions"
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	machine "github.com/gardener/gardener/pkg/client/machine/clientset/versioned"
-	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
-	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/hashicorp/go-multierror"
 
 	prometheusmodel "github.com/prometheus/common/model"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func mustGardenRoleLabelSelector(gardenRoles ...string) labels.Selector {
@@ -73,7 +76,26 @@ var Now = time.Now
 
 // HealthChecker contains the condition thresholds.
 type HealthChecker struct {
-	conditionThresholds map[gardencorev1alpha1.ConditionType]time.Duration
+	conditionThresholds                map[gardencorev1alpha1.ConditionType]time.Duration
+	staleExtensionHealthCheckThreshold *metav1.Duration
+}
+
+// ExtensionCondition contains a health condition reported by an extension resource together with information
+// about the resource it was reported by.
+type ExtensionCondition struct {
+	Condition          gardencorev1alpha1.Condition
+	ExtensionKind      string
+	ExtensionType      string
+	ExtensionName      string
+	ExtensionNamespace string
+}
+
+// extensionConditionTypesToShootConditionTypes maps the health condition types reported by extensions to the
+// Shoot conditions they are aggregated into.
+var extensionConditionTypesToShootConditionTypes = map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionType{
+	extensionsv1alpha1.ConditionTypeControlPlaneHealthy:     gardenv1beta1.ShootControlPlaneHealthy,
+	extensionsv1alpha1.ConditionTypeSystemComponentsHealthy: gardenv1beta1.ShootSystemComponentsHealthy,
+	extensionsv1alpha1.ConditionTypeEveryNodeReady:          gardenv1beta1.ShootEveryNodeReady,
 }
 
 func (b *HealthChecker) checkRequiredDeployments(condition gardencorev1alpha1.Condition, requiredNames sets.String, objects []*appsv1.Deployment) *gardencorev1alpha1.Condition {
@@ -300,6 +322,27 @@ func (b *HealthChecker) CheckSystemComponents(
 	return nil, nil
 }
 
+// CheckExtensionCondition checks whether the given health conditions reported by extensions are healthy and
+// have been updated recently.
+func (b *HealthChecker) CheckExtensionCondition(condition gardencorev1alpha1.Condition, extensionConditions []ExtensionCondition) *gardencorev1alpha1.Condition {
+	for _, extensionCondition := range extensionConditions {
+		if b.staleExtensionHealthCheckThreshold != nil {
+			if delta := Now().Sub(extensionCondition.Condition.LastUpdateTime.Time); delta > b.staleExtensionHealthCheckThreshold.Duration {
+				c := b.FailedCondition(condition, fmt.Sprintf("%sOutdatedHealthCheckReport", extensionCondition.ExtensionKind),
+					fmt.Sprintf("%s extension (%s/%s) of type %q has not updated its health status for %s.", extensionCondition.ExtensionKind, extensionCondition.ExtensionNamespace, extensionCondition.ExtensionName, extensionCondition.ExtensionType, delta.Round(time.Second)))
+				return &c
+			}
+		}
+
+		if extensionCondition.Condition.Status != gardencorev1alpha1.ConditionTrue {
+			c := b.FailedCondition(condition, fmt.Sprintf("%sUnhealthyReport", extensionCondition.ExtensionKind),
+				fmt.Sprintf("%s extension (%s/%s) of type %q reports failing health check: %s", extensionCondition.ExtensionKind, extensionCondition.ExtensionNamespace, extensionCondition.ExtensionName, extensionCondition.ExtensionType, extensionCondition.Condition.Message))
+			return &c
+		}
+	}
+	return nil
+}
+
 // FailedCondition returns a progressing or false condition depending on the progressing threshold.
 func (b *HealthChecker) FailedCondition(condition gardencorev1alpha1.Condition, reason, message string) gardencorev1alpha1.Condition {
 	switch condition.Status {
@@ -522,9 +565,11 @@ func (b *HealthChecker) CheckOptionalAddonsSystemComponents(
 	return nil, nil
 }
 
-// CheckLoggingControlPlane checks whether the logging components in the given listers are complete and healthy.
+// CheckLoggingControlPlane checks whether the logging components of the given backend in the given listers are
+// complete and healthy.
 func (b *HealthChecker) CheckLoggingControlPlane(
 	namespace string,
+	backend LoggingBackend,
 	condition gardencorev1alpha1.Condition,
 	deploymentLister kutil.DeploymentLister,
 	statefulSetLister kutil.StatefulSetLister,
@@ -535,7 +580,7 @@ func (b *HealthChecker) CheckLoggingControlPlane(
 		return nil, err
 	}
 
-	if exitCondition := b.checkRequiredDeployments(condition, common.RequiredLoggingDeployments, deploymentList); exitCondition != nil {
+	if exitCondition := b.checkRequiredDeployments(condition, backend.RequiredDeployments(), deploymentList); exitCondition != nil {
 		return exitCondition, nil
 	}
 	if exitCondition := b.checkDeployments(condition, deploymentList); exitCondition != nil {
@@ -547,7 +592,7 @@ func (b *HealthChecker) CheckLoggingControlPlane(
 		return nil, err
 	}
 
-	if exitCondition := b.checkRequiredStatefulSets(condition, common.RequiredLoggingStatefulSets, statefulSetList); exitCondition != nil {
+	if exitCondition := b.checkRequiredStatefulSets(condition, backend.RequiredStatefulSets(), statefulSetList); exitCondition != nil {
 		return exitCondition, nil
 	}
 	if exitCondition := b.checkStatefulSets(condition, statefulSetList); exitCondition != nil {
@@ -564,6 +609,7 @@ func (b *Botanist) checkControlPlane(
 	seedDeploymentLister kutil.DeploymentLister,
 	seedStatefulSetLister kutil.StatefulSetLister,
 	machineDeploymentLister kutil.MachineDeploymentLister,
+	extensionConditions []ExtensionCondition,
 ) (*gardencorev1alpha1.Condition, error) {
 
 	if exitCondition, err := checker.CheckControlPlane(b.Shoot.Info, b.Shoot.SeedNamespace, condition, seedDeploymentLister, seedStatefulSetLister, machineDeploymentLister); err != nil || exitCondition != nil {
@@ -572,11 +618,14 @@ func (b *Botanist) checkControlPlane(
 	if exitCondition, err := checker.CheckMonitoringControlPlane(b.Shoot.SeedNamespace, b.Shoot.WantsAlertmanager, condition, seedDeploymentLister, seedStatefulSetLister); err != nil || exitCondition != nil {
 		return exitCondition, err
 	}
-	if controllermanagerfeatures.FeatureGate.Enabled(features.Logging) {
-		if exitCondition, err := checker.CheckLoggingControlPlane(b.Shoot.SeedNamespace, condition, seedDeploymentLister, seedStatefulSetLister); err != nil || exitCondition != nil {
+	if b.WantsLogging() {
+		if exitCondition, err := checker.CheckLoggingControlPlane(b.Shoot.SeedNamespace, b.LoggingBackend(), condition, seedDeploymentLister, seedStatefulSetLister); err != nil || exitCondition != nil {
 			return exitCondition, nil
 		}
 	}
+	if exitCondition := checker.CheckExtensionCondition(condition, extensionConditions); exitCondition != nil {
+		return exitCondition, nil
+	}
 
 	c := gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ControlPlaneRunning", "All control plane components are healthy.")
 	return &c, nil
@@ -588,6 +637,7 @@ func (b *Botanist) checkSystemComponents(
 	condition gardencorev1alpha1.Condition,
 	shootDeploymentLister kutil.DeploymentLister,
 	shootDaemonSetLister kutil.DaemonSetLister,
+	extensionConditions []ExtensionCondition,
 ) (*gardencorev1alpha1.Condition, error) {
 
 	if exitCondition, err := checker.CheckSystemComponents(metav1.NamespaceSystem, condition, shootDeploymentLister, shootDaemonSetLister); err != nil || exitCondition != nil {
@@ -599,6 +649,9 @@ func (b *Botanist) checkSystemComponents(
 	if exitCondition, err := checker.CheckOptionalAddonsSystemComponents(metav1.NamespaceSystem, condition, shootDeploymentLister, shootDaemonSetLister); err != nil || exitCondition != nil {
 		return exitCondition, err
 	}
+	if exitCondition := checker.CheckExtensionCondition(condition, extensionConditions); exitCondition != nil {
+		return exitCondition, nil
+	}
 
 	c := gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "SystemComponentsRunning", "All system components are healthy.")
 	return &c, nil
@@ -611,11 +664,15 @@ func (b *Botanist) checkClusterNodes(
 	condition gardencorev1alpha1.Condition,
 	shootNodeLister kutil.NodeLister,
 	seedMachineDeploymentLister kutil.MachineDeploymentLister,
+	extensionConditions []ExtensionCondition,
 ) (*gardencorev1alpha1.Condition, error) {
 
 	if exitCondition, err := checker.CheckClusterNodes(b.Shoot.SeedNamespace, condition, shootNodeLister, seedMachineDeploymentLister); err != nil || exitCondition != nil {
 		return exitCondition, err
 	}
+	if exitCondition := checker.CheckExtensionCondition(condition, extensionConditions); exitCondition != nil {
+		return exitCondition, nil
+	}
 
 	c := gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "EveryNodeReady", "Every node registered to the cluster is ready.")
 	return &c, nil
@@ -782,14 +839,94 @@ var (
 )
 
 // NewHealthChecker creates a new health checker.
-func NewHealthChecker(conditionThresholds map[gardencorev1alpha1.ConditionType]time.Duration) *HealthChecker {
+func NewHealthChecker(conditionThresholds map[gardencorev1alpha1.ConditionType]time.Duration, staleExtensionHealthCheckThreshold *metav1.Duration) *HealthChecker {
 	return &HealthChecker{
-		conditionThresholds: conditionThresholds,
+		conditionThresholds:                conditionThresholds,
+		staleExtensionHealthCheckThreshold: staleExtensionHealthCheckThreshold,
+	}
+}
+
+// extensionKindsToShootConditionTypes maps the kinds of the extension resources whose health conditions are checked to
+// the Shoot conditions they may contribute to. Conditions reported by an extension resource for other Shoot conditions
+// are ignored.
+var extensionKindsToShootConditionTypes = []struct {
+	kind           string
+	conditionTypes []gardencorev1alpha1.ConditionType
+}{
+	{extensionsv1alpha1.ContainerRuntimeResource, []gardencorev1alpha1.ConditionType{gardenv1beta1.ShootEveryNodeReady, gardenv1beta1.ShootSystemComponentsHealthy}},
+	{extensionsv1alpha1.ControlPlaneResource, []gardencorev1alpha1.ConditionType{gardenv1beta1.ShootControlPlaneHealthy, gardenv1beta1.ShootSystemComponentsHealthy}},
+	{extensionsv1alpha1.ExtensionResource, []gardencorev1alpha1.ConditionType{gardenv1beta1.ShootControlPlaneHealthy, gardenv1beta1.ShootEveryNodeReady, gardenv1beta1.ShootSystemComponentsHealthy}},
+	{extensionsv1alpha1.NetworkResource, []gardencorev1alpha1.ConditionType{gardenv1beta1.ShootSystemComponentsHealthy}},
+	{extensionsv1alpha1.WorkerResource, []gardencorev1alpha1.ConditionType{gardenv1beta1.ShootControlPlaneHealthy, gardenv1beta1.ShootEveryNodeReady}},
+}
+
+// GetExtensionConditions returns the health conditions reported by the extension resources in the Shoot namespace
+// grouped by the Shoot condition type they are aggregated into. Each kind is listed independently; if the resources of
+// a kind cannot be listed, the error is returned for all Shoot condition types the kind contributes to.
+func (b *Botanist) GetExtensionConditions(ctx context.Context) (map[gardencorev1alpha1.ConditionType][]ExtensionCondition, map[gardencorev1alpha1.ConditionType]error) {
+	var (
+		extensionConditions = make(map[gardencorev1alpha1.ConditionType][]ExtensionCondition)
+		errs                = make(map[gardencorev1alpha1.ConditionType]error)
+	)
+
+	for _, mapping := range extensionKindsToShootConditionTypes {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(extensionsv1alpha1.SchemeGroupVersion.WithKind(mapping.kind + "List"))
+		if err := b.K8sSeedClient.Client().List(ctx, list, client.InNamespace(b.Shoot.SeedNamespace)); err != nil {
+			for _, conditionType := range mapping.conditionTypes {
+				errs[conditionType] = multierror.Append(errs[conditionType], fmt.Errorf("could not list %s resources: %v", mapping.kind, err))
+			}
+			continue
+		}
+
+		for _, item := range list.Items {
+			obj := extensions.UnstructuredAccessor(item.DeepCopy())
+			for _, condition := range obj.GetExtensionStatus().GetConditions() {
+				shootConditionType, ok := extensionConditionTypesToShootConditionTypes[condition.Type]
+				if !ok || !containsConditionType(mapping.conditionTypes, shootConditionType) {
+					continue
+				}
+
+				extensionConditions[shootConditionType] = append(extensionConditions[shootConditionType], ExtensionCondition{
+					Condition:          condition,
+					ExtensionKind:      mapping.kind,
+					ExtensionType:      obj.GetExtensionSpec().GetExtensionType(),
+					ExtensionName:      obj.GetName(),
+					ExtensionNamespace: obj.GetNamespace(),
+				})
+			}
+		}
+	}
+
+	return extensionConditions, errs
+}
+
+func containsConditionType(conditionTypes []gardencorev1alpha1.ConditionType, conditionType gardencorev1alpha1.ConditionType) bool {
+	for _, t := range conditionTypes {
+		if t == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+// extensionConditionsUnknown returns the given condition with status Unknown and true if the health conditions of the
+// extension resources contributing to it could not be retrieved.
+func (b *Botanist) extensionConditionsUnknown(condition gardencorev1alpha1.Condition, errs map[gardencorev1alpha1.ConditionType]error) (gardencorev1alpha1.Condition, bool) {
+	err, ok := errs[condition.Type]
+	if !ok {
+		return condition, false
 	}
+
+	message := fmt.Sprintf("Could not retrieve the health conditions of the extension resources: %+v", err)
+	b.Logger.Error(message)
+	return gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(condition, message), true
 }
 
-func (b *Botanist) healthChecks(initializeShootClients func() error, thresholdMappings map[gardencorev1alpha1.ConditionType]time.Duration, apiserverAvailability, controlPlane, nodes, systemComponents gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition) {
-	if b.Shoot.HibernationEnabled || (b.Shoot.Info.Status.IsHibernated != nil && *b.Shoot.Info.Status.IsHibernated) {
+func (b *Botanist) healthChecks(initializeShootClients func() error, thresholdMappings map[gardencorev1alpha1.ConditionType]time.Duration, staleExtensionHealthCheckThreshold *metav1.Duration, apiserverAvailability, controlPlane, nodes, systemComponents gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition) {
+	// The control plane is only expected to run if the shoot is not hibernated at all or if it is hibernated in the
+	// `WorkersOnly` mode. While a hibernated shoot is being woken up all conditions are not checked.
+	if b.Shoot.ControlPlaneHibernationEnabled || (!b.Shoot.HibernationEnabled && b.Shoot.Info.Status.IsHibernated != nil && *b.Shoot.Info.Status.IsHibernated) {
 		return shootHibernatedCondition(apiserverAvailability), shootHibernatedCondition(controlPlane), shootHibernatedCondition(nodes), shootHibernatedCondition(systemComponents)
 	}
 
@@ -798,9 +935,11 @@ func (b *Botanist) healthChecks(initializeShootClients func() error, thresholdMa
 		seedStatefulSetLister       = makeStatefulSetLister(b.K8sSeedClient.Kubernetes(), b.Shoot.SeedNamespace, seedStatefulSetListOptions)
 		seedMachineDeploymentLister = makeMachineDeploymentLister(b.K8sSeedClient.Machine(), b.Shoot.SeedNamespace, seedMachineDeploymentListOptions)
 
-		checker = NewHealthChecker(thresholdMappings)
+		checker = NewHealthChecker(thresholdMappings, staleExtensionHealthCheckThreshold)
 	)
 
+	extensionConditions, extensionErrs := b.GetExtensionConditions(context.TODO())
+
 	if err := initializeShootClients(); err != nil {
 		message := fmt.Sprintf("Could not initialize Shoot client for health check: %+v", err)
 		b.Logger.Error(message)
@@ -808,7 +947,10 @@ func (b *Botanist) healthChecks(initializeShootClients func() error, thresholdMa
 		nodes = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(nodes, message)
 		systemComponents = gardencorev1alpha1helper.UpdatedConditionUnknownErrorMessage(systemComponents, message)
 
-		newControlPlane, err := b.checkControlPlane(checker, controlPlane, seedDeploymentLister, seedStatefulSetLister, seedMachineDeploymentLister)
+		if unknown, ok := b.extensionConditionsUnknown(controlPlane, extensionErrs); ok {
+			return apiserverAvailability, unknown, nodes, systemComponents
+		}
+		newControlPlane, err := b.checkControlPlane(checker, controlPlane, seedDeploymentLister, seedStatefulSetLister, seedMachineDeploymentLister, extensionConditions[gardenv1beta1.ShootControlPlaneHealthy])
 		controlPlane = newConditionOrError(controlPlane, newControlPlane, err)
 		return apiserverAvailability, controlPlane, nodes, systemComponents
 	}
@@ -828,17 +970,39 @@ func (b *Botanist) healthChecks(initializeShootClients func() error, thresholdMa
 	}()
 	go func() {
 		defer wg.Done()
-		newControlPlane, err := b.checkControlPlane(checker, controlPlane, seedDeploymentLister, seedStatefulSetLister, seedMachineDeploymentLister)
+		if unknown, ok := b.extensionConditionsUnknown(controlPlane, extensionErrs); ok {
+			controlPlane = unknown
+			return
+		}
+		newControlPlane, err := b.checkControlPlane(checker, controlPlane, seedDeploymentLister, seedStatefulSetLister, seedMachineDeploymentLister, extensionConditions[gardenv1beta1.ShootControlPlaneHealthy])
 		controlPlane = newConditionOrError(controlPlane, newControlPlane, err)
 	}()
 	go func() {
 		defer wg.Done()
-		newNodes, err := b.checkClusterNodes(checker, nodes, shootNodeLister, seedMachineDeploymentLister)
+		// No nodes are expected to run if all worker pools are hibernated.
+		if b.Shoot.AllWorkerPoolsHibernated() {
+			nodes = shootHibernatedCondition(nodes)
+			return
+		}
+		if unknown, ok := b.extensionConditionsUnknown(nodes, extensionErrs); ok {
+			nodes = unknown
+			return
+		}
+		newNodes, err := b.checkClusterNodes(checker, nodes, shootNodeLister, seedMachineDeploymentLister, extensionConditions[gardenv1beta1.ShootEveryNodeReady])
 		nodes = newConditionOrError(nodes, newNodes, err)
 	}()
 	go func() {
 		defer wg.Done()
-		newSystemComponents, err := b.checkSystemComponents(checker, systemComponents, shootDeploymentLister, shootDaemonSetLister)
+		// The system components cannot be scheduled if all worker pools are hibernated.
+		if b.Shoot.AllWorkerPoolsHibernated() {
+			systemComponents = shootHibernatedCondition(systemComponents)
+			return
+		}
+		if unknown, ok := b.extensionConditionsUnknown(systemComponents, extensionErrs); ok {
+			systemComponents = unknown
+			return
+		}
+		newSystemComponents, err := b.checkSystemComponents(checker, systemComponents, shootDeploymentLister, shootDaemonSetLister, extensionConditions[gardenv1beta1.ShootSystemComponentsHealthy])
 		systemComponents = newConditionOrError(systemComponents, newSystemComponents, err)
 	}()
 	wg.Wait()
@@ -847,8 +1011,9 @@ func (b *Botanist) healthChecks(initializeShootClients func() error, thresholdMa
 }
 
 var unstableOperationTypes = map[gardencorev1alpha1.LastOperationType]struct{}{
-	gardencorev1alpha1.LastOperationTypeCreate: {},
-	gardencorev1alpha1.LastOperationTypeDelete: {},
+	gardencorev1alpha1.LastOperationTypeCreate:  {},
+	gardencorev1alpha1.LastOperationTypeDelete:  {},
+	gardencorev1alpha1.LastOperationTypeMigrate: {},
 }
 
 func isUnstableOperationType(lastOperationType gardencorev1alpha1.LastOperationType) bool {
@@ -870,8 +1035,8 @@ func (b *Botanist) pardonCondition(condition gardencorev1alpha1.Condition) garde
 }
 
 // HealthChecks conducts the health checks on all the given conditions.
-func (b *Botanist) HealthChecks(initializeShootClients func() error, thresholdMappings map[gardencorev1alpha1.ConditionType]time.Duration, apiserverAvailability, controlPlane, nodes, systemComponents gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition) {
-	apiServerAvailable, controlPlaneHealthy, everyNodeReady, systemComponentsHealthy := b.healthChecks(initializeShootClients, thresholdMappings, apiserverAvailability, controlPlane, nodes, systemComponents)
+func (b *Botanist) HealthChecks(initializeShootClients func() error, thresholdMappings map[gardencorev1alpha1.ConditionType]time.Duration, staleExtensionHealthCheckThreshold *metav1.Duration, apiserverAvailability, controlPlane, nodes, systemComponents gardencorev1alpha1.Condition) (gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition, gardencorev1alpha1.Condition) {
+	apiServerAvailable, controlPlaneHealthy, everyNodeReady, systemComponentsHealthy := b.healthChecks(initializeShootClients, thresholdMappings, staleExtensionHealthCheckThreshold, apiserverAvailability, controlPlane, nodes, systemComponents)
 	return b.pardonCondition(apiServerAvailable), b.pardonCondition(controlPlaneHealthy), b.pardonCondition(everyNodeReady), b.pardonCondition(systemComponentsHealthy)
 }
 
@@ -0,0 +1,148 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
+	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+
+	"github.com/golang/mock/gomock"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("health check", func() {
+	var (
+		ctrl                 *gomock.Controller
+		k8sSeedClient        *mock.MockInterface
+		k8sSeedRuntimeClient *mockclient.MockClient
+		b                    *botanist.Botanist
+
+		ctx       = context.TODO()
+		namespace = "shoot--foo--bar"
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		k8sSeedClient = mock.NewMockInterface(ctrl)
+		k8sSeedRuntimeClient = mockclient.NewMockClient(ctrl)
+		k8sSeedClient.EXPECT().Client().Return(k8sSeedRuntimeClient).AnyTimes()
+
+		b = &botanist.Botanist{Operation: &operation.Operation{
+			K8sSeedClient: k8sSeedClient,
+			Shoot:         &shoot.Shoot{SeedNamespace: namespace},
+		}}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#GetExtensionConditions", func() {
+		var (
+			extensionObject = func(kind string, conditionTypes ...gardencorev1alpha1.ConditionType) unstructured.Unstructured {
+				obj := &extensionsv1alpha1.Extension{}
+				obj.Name = kind
+				obj.Namespace = namespace
+				obj.Spec.Type = "foo"
+				for _, conditionType := range conditionTypes {
+					obj.Status.Conditions = append(obj.Status.Conditions, gardencorev1alpha1.Condition{Type: conditionType, Status: gardencorev1alpha1.ConditionTrue})
+				}
+
+				content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+				Expect(err).NotTo(HaveOccurred())
+				return unstructured.Unstructured{Object: content}
+			}
+
+			expectList = func(fn func(kind string) ([]unstructured.Unstructured, error)) {
+				k8sSeedRuntimeClient.EXPECT().List(ctx, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, list *unstructured.UnstructuredList, _ ...client.ListOptionFunc) error {
+					kind := list.GetKind()[:len(list.GetKind())-len("List")]
+					items, err := fn(kind)
+					list.Items = items
+					return err
+				}).AnyTimes()
+			}
+
+			allConditionTypes = []gardencorev1alpha1.ConditionType{
+				extensionsv1alpha1.ConditionTypeControlPlaneHealthy,
+				extensionsv1alpha1.ConditionTypeEveryNodeReady,
+				extensionsv1alpha1.ConditionTypeSystemComponentsHealthy,
+			}
+		)
+
+		It("should only aggregate the conditions declared for the kind of the extension resource", func() {
+			expectList(func(kind string) ([]unstructured.Unstructured, error) {
+				return []unstructured.Unstructured{extensionObject(kind, allConditionTypes...)}, nil
+			})
+
+			conditions, errs := b.GetExtensionConditions(ctx)
+
+			Expect(errs).To(BeEmpty())
+			kinds := func(conditionType gardencorev1alpha1.ConditionType) []string {
+				var kinds []string
+				for _, condition := range conditions[conditionType] {
+					kinds = append(kinds, condition.ExtensionKind)
+				}
+				return kinds
+			}
+			Expect(kinds(gardenv1beta1.ShootControlPlaneHealthy)).To(ConsistOf(
+				extensionsv1alpha1.ControlPlaneResource,
+				extensionsv1alpha1.ExtensionResource,
+				extensionsv1alpha1.WorkerResource,
+			))
+			Expect(kinds(gardenv1beta1.ShootEveryNodeReady)).To(ConsistOf(
+				extensionsv1alpha1.ContainerRuntimeResource,
+				extensionsv1alpha1.ExtensionResource,
+				extensionsv1alpha1.WorkerResource,
+			))
+			Expect(kinds(gardenv1beta1.ShootSystemComponentsHealthy)).To(ConsistOf(
+				extensionsv1alpha1.ContainerRuntimeResource,
+				extensionsv1alpha1.ControlPlaneResource,
+				extensionsv1alpha1.ExtensionResource,
+				extensionsv1alpha1.NetworkResource,
+			))
+		})
+
+		It("should only return errors for the conditions the failing kind contributes to", func() {
+			expectList(func(kind string) ([]unstructured.Unstructured, error) {
+				if kind == extensionsv1alpha1.NetworkResource {
+					return nil, errors.New("fake")
+				}
+				return []unstructured.Unstructured{extensionObject(kind, allConditionTypes...)}, nil
+			})
+
+			conditions, errs := b.GetExtensionConditions(ctx)
+
+			Expect(errs).To(HaveLen(1))
+			Expect(errs).To(HaveKey(gardenv1beta1.ShootSystemComponentsHealthy))
+			Expect(conditions[gardenv1beta1.ShootControlPlaneHealthy]).To(HaveLen(3))
+			Expect(conditions[gardenv1beta1.ShootEveryNodeReady]).To(HaveLen(3))
+		})
+	})
+})
@@ -19,22 +19,109 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/gardener/gardener/pkg/utils"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
-// DeploySeedLogging will install the Helm release "seed-bootstrap/charts/elastic-kibana-curator" in the Seed clusters.
+// LoggingBackend is a backend for the logs of the Shoot control plane components running in the Seed. The Seed wide
+// parts of the backend are deployed by the Seed bootstrapping, the Shoot specific parts by the backend itself.
+type LoggingBackend interface {
+	// Deploy deploys the Shoot specific components of the logging backend into the Shoot namespace of the Seed.
+	Deploy(ctx context.Context) error
+	// RequiredDeployments returns the names of the logging deployments which must exist in the Shoot namespace.
+	RequiredDeployments() sets.String
+	// RequiredStatefulSets returns the names of the logging stateful sets which must exist in the Shoot namespace.
+	RequiredStatefulSets() sets.String
+}
+
+// NewElasticsearchLoggingBackend returns a logging backend deploying an Elasticsearch, Kibana and Curator stack into
+// the Shoot namespace of the Seed.
+func NewElasticsearchLoggingBackend(b *Botanist) LoggingBackend {
+	return &elasticsearchLoggingBackend{b}
+}
+
+// NewExternalLoggingBackend returns a logging backend which relies on the Seed wide fluent-bit shipping the logs to
+// an external endpoint. It does not deploy any Shoot specific components.
+func NewExternalLoggingBackend(b *Botanist) LoggingBackend {
+	return &externalLoggingBackend{b}
+}
+
+// LoggingBackend returns the logging backend configured for the Gardener controller manager.
+func (b *Botanist) LoggingBackend() LoggingBackend {
+	if seed.GetLoggingBackend(b.Config) == config.LoggingBackendExternal {
+		return NewExternalLoggingBackend(b)
+	}
+	return NewElasticsearchLoggingBackend(b)
+}
+
+// WantsLogging returns true if the Logging feature gate is enabled and the Shoot did not opt out of logging.
+func (b *Botanist) WantsLogging() bool {
+	return controllermanagerfeatures.FeatureGate.Enabled(features.Logging) && b.Shoot.WantsLogging
+}
+
+// wantsElasticsearchLogging returns true if an Elasticsearch, Kibana and Curator stack must be deployed into the
+// Shoot namespace of the Seed.
+func (b *Botanist) wantsElasticsearchLogging() bool {
+	return b.WantsLogging() && seed.GetLoggingBackend(b.Config) == config.LoggingBackendElasticsearch
+}
+
+// DeploySeedLogging deploys the Shoot specific components of the configured logging backend into the Seed cluster.
+// If logging is disabled for the Shoot, all logging components are deleted from its namespace in the Seed.
 func (b *Botanist) DeploySeedLogging(ctx context.Context) error {
-	if !controllermanagerfeatures.FeatureGate.Enabled(features.Logging) {
+	if !b.WantsLogging() {
 		return common.DeleteLoggingStack(ctx, b.K8sSeedClient.Client(), b.Shoot.SeedNamespace)
 	}
 
+	return b.LoggingBackend().Deploy(ctx)
+}
+
+type externalLoggingBackend struct {
+	*Botanist
+}
+
+// Deploy implements LoggingBackend. It deletes the remainders of a previously deployed Elasticsearch stack.
+func (e *externalLoggingBackend) Deploy(ctx context.Context) error {
+	return common.DeleteLoggingStack(ctx, e.K8sSeedClient.Client(), e.Shoot.SeedNamespace)
+}
+
+// RequiredDeployments implements LoggingBackend.
+func (e *externalLoggingBackend) RequiredDeployments() sets.String {
+	return sets.NewString()
+}
+
+// RequiredStatefulSets implements LoggingBackend.
+func (e *externalLoggingBackend) RequiredStatefulSets() sets.String {
+	return sets.NewString()
+}
+
+type elasticsearchLoggingBackend struct {
+	*Botanist
+}
+
+// RequiredDeployments implements LoggingBackend.
+func (e *elasticsearchLoggingBackend) RequiredDeployments() sets.String {
+	return common.RequiredLoggingDeployments
+}
+
+// RequiredStatefulSets implements LoggingBackend.
+func (e *elasticsearchLoggingBackend) RequiredStatefulSets() sets.String {
+	return common.RequiredLoggingStatefulSets
+}
+
+// Deploy implements LoggingBackend. It installs the Helm release "seed-bootstrap/charts/elastic-kibana-curator" in
+// the Shoot namespace of the Seed cluster.
+func (e *elasticsearchLoggingBackend) Deploy(ctx context.Context) error {
+	b := e.Botanist
+
 	var (
 		kibanaHost                             = b.Seed.GetIngressFQDN("k", b.Shoot.Info.Name, b.Garden.Project.Name)
 		kibanaCredentials                      = b.Secrets["kibana-logging-sg-credentials"]
@@ -115,11 +202,11 @@ func (b *Botanist) DeploySeedLogging(ctx context.Context) error {
 		"curator": map[string]interface{}{
 			"hourly": map[string]interface{}{
 				"schedule": fmt.Sprintf("%d * * * *", ct.Minute()),
-				"suspend":  b.Shoot.HibernationEnabled,
+				"suspend":  b.Shoot.ControlPlaneHibernationEnabled,
 			},
 			"daily": map[string]interface{}{
 				"schedule": fmt.Sprintf("%d 0,6,12,18 * * *", ct.Minute()%54+5),
-				"suspend":  b.Shoot.HibernationEnabled,
+				"suspend":  b.Shoot.ControlPlaneHibernationEnabled,
 			},
 			"sgUsername": "curator",
 			"sgPassword": string(sgCuratorPassword),
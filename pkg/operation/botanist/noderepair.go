@@ -0,0 +1,287 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// RepairUnhealthyNodes replaces the machines of nodes which have been unhealthy for longer than the threshold of the
+// auto repair policy of their worker pool. It does not replace more machines of a worker pool at the same time than
+// allowed by the policy. After machines of a worker pool have been replaced, no further machines of this pool are
+// replaced before its unhealthy threshold has elapsed again (cool-down). The point in time of the last repair of each
+// worker pool is recorded in the Shoot status. Nothing is done while an operation is running on the Shoot or if it is
+// hibernated.
+func (b *Botanist) RepairUnhealthyNodes(initializeShootClients func() error) error {
+	if b.Shoot.HibernationEnabled {
+		return nil
+	}
+	if lastOperation := b.Shoot.Info.Status.LastOperation; lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateProcessing {
+		return nil
+	}
+
+	var (
+		now             = Now()
+		lastRepairTimes = map[string]time.Time{}
+		policies        = map[string]garden.WorkerAutoRepair{}
+	)
+
+	if autoRepair := b.Shoot.Info.Status.AutoRepair; autoRepair != nil {
+		for _, pool := range autoRepair.Pools {
+			lastRepairTimes[pool.Name] = pool.LastRepairTime.Time
+		}
+	}
+
+	for _, worker := range b.Shoot.GetWorkers() {
+		autoRepair, err := b.Shoot.GetWorkerAutoRepairByName(worker.Name)
+		if err != nil {
+			return fmt.Errorf("could not determine auto repair policy for pool %q: %+v", worker.Name, err)
+		}
+		if autoRepair == nil {
+			continue
+		}
+		if lastRepairTime, ok := lastRepairTimes[worker.Name]; ok && now.Sub(lastRepairTime) < autoRepair.UnhealthyThreshold.Duration {
+			b.Logger.Debugf("Not replacing any machines of worker pool %s because it has been repaired at %s", worker.Name, lastRepairTime)
+			continue
+		}
+		policies[worker.Name] = *autoRepair
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	if err := initializeShootClients(); err != nil {
+		return err
+	}
+
+	nodeList, err := b.K8sShootClient.Kubernetes().CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	machineList, err := b.K8sSeedClient.Machine().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+	machineSetList, err := b.K8sSeedClient.Machine().MachineV1alpha1().MachineSets(b.Shoot.SeedNamespace).List(metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	machinesToRepair, skippedPools := ComputeMachinesToRepair(policies, nodeList.Items, machineList.Items, machineSetList.Items, now)
+	for _, pool := range skippedPools {
+		b.Logger.Warnf("Not replacing any machines of worker pool %s because most of its nodes are unhealthy", pool)
+	}
+	if len(machinesToRepair) == 0 {
+		return nil
+	}
+
+	var repairedPools []string
+	for pool, machineNames := range machinesToRepair {
+		for _, machineName := range machineNames {
+			b.Logger.Infof("Replacing machine %s of worker pool %s because its node has been unhealthy for too long", machineName, pool)
+			if err := b.K8sSeedClient.Machine().MachineV1alpha1().Machines(b.Shoot.SeedNamespace).Delete(machineName, &metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+		repairedPools = append(repairedPools, pool)
+	}
+
+	newShoot, err := kutil.TryUpdateShootStatus(b.K8sGardenClient.Garden(), retry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+		shoot.Status.AutoRepair = UpdateAutoRepairStatus(shoot.Status.AutoRepair, repairedPools, now)
+		return shoot, nil
+	})
+	if err != nil {
+		return fmt.Errorf("could not update the auto repair status: %+v", err)
+	}
+	b.Shoot.Info = newShoot
+	return nil
+}
+
+// UpdateAutoRepairStatus returns a copy of the given auto repair status in which the last repair time of the given
+// worker pools is set to <now>.
+func UpdateAutoRepairStatus(status *gardenv1beta1.ShootAutoRepairStatus, repairedPools []string, now time.Time) *gardenv1beta1.ShootAutoRepairStatus {
+	result := &gardenv1beta1.ShootAutoRepairStatus{}
+	if status != nil {
+		result = status.DeepCopy()
+	}
+
+	for _, pool := range repairedPools {
+		found := false
+		for i := range result.Pools {
+			if result.Pools[i].Name == pool {
+				result.Pools[i].LastRepairTime = metav1.NewTime(now)
+				found = true
+				break
+			}
+		}
+		if !found {
+			result.Pools = append(result.Pools, gardenv1beta1.WorkerPoolAutoRepairStatus{
+				Name:           pool,
+				LastRepairTime: metav1.NewTime(now),
+			})
+		}
+	}
+
+	sort.Slice(result.Pools, func(i, j int) bool { return result.Pools[i].Name < result.Pools[j].Name })
+	return result
+}
+
+// ComputeMachinesToRepair computes the names of the machines which shall be replaced because their nodes have been
+// unhealthy for longer than the threshold of the auto repair policy of their worker pool, grouped by worker pool.
+// Machines of the worker pool which are already being deleted or which are still being created count towards the
+// maximum number of concurrent repairs of the worker pool, no matter whether they have a node. Machines whose nodes
+// have been unhealthy for the longest time are repaired first.
+// If most of the nodes of a worker pool are unhealthy, replacing machines is unlikely to help (e.g., the network or the
+// cloud provider is disrupted), hence, no machines of such pools are replaced. Their names are returned as second value.
+func ComputeMachinesToRepair(policies map[string]garden.WorkerAutoRepair, nodes []corev1.Node, machines []machinev1alpha1.Machine, machineSets []machinev1alpha1.MachineSet, now time.Time) (map[string][]string, []string) {
+	type candidate struct {
+		machineName string
+		since       time.Time
+	}
+
+	var (
+		nodeToPool    = make(map[string]string, len(nodes))
+		nodeToMachine = make(map[string]machinev1alpha1.Machine, len(machines))
+		ownerToPool   = map[string]string{}
+		poolNodes     = map[string]int{}
+		poolUnhealthy = map[string]int{}
+		ongoing       = map[string]int32{}
+		candidates    = map[string][]candidate{}
+	)
+
+	for _, node := range nodes {
+		pool := node.Labels[v1alpha1constants.LabelWorkerPool]
+		nodeToPool[node.Name] = pool
+		poolNodes[pool]++
+		if health.NodeUnhealthySince(&node) != nil {
+			poolUnhealthy[pool]++
+		}
+	}
+
+	// Machines which have no node yet are mapped to their worker pool via the machine deployment (or machine set)
+	// owning them, whose worker pool is known from the nodes of its other machines.
+	machineSetToOwner := make(map[string]string, len(machineSets))
+	for _, machineSet := range machineSets {
+		machineSetToOwner[machineSet.Name] = "MachineSet/" + machineSet.Name
+		if ownerRef := metav1.GetControllerOf(&machineSet); ownerRef != nil && ownerRef.Kind == "MachineDeployment" {
+			machineSetToOwner[machineSet.Name] = "MachineDeployment/" + ownerRef.Name
+		}
+	}
+	machineOwner := func(machine *machinev1alpha1.Machine) string {
+		if ownerRef := metav1.GetControllerOf(machine); ownerRef != nil && ownerRef.Kind == "MachineSet" {
+			if owner, ok := machineSetToOwner[ownerRef.Name]; ok {
+				return owner
+			}
+			return "MachineSet/" + ownerRef.Name
+		}
+		return ""
+	}
+	for _, machine := range machines {
+		pool, ok := nodeToPool[machine.Status.Node]
+		if owner := machineOwner(&machine); ok && len(owner) > 0 {
+			ownerToPool[owner] = pool
+		}
+	}
+
+	for _, machine := range machines {
+		pool, ok := nodeToPool[machine.Status.Node]
+		if !ok {
+			pool, ok = machine.Labels[v1alpha1constants.LabelWorkerPool]
+		}
+		if !ok {
+			pool = ownerToPool[machineOwner(&machine)]
+		}
+		if _, ok := policies[pool]; !ok {
+			continue
+		}
+
+		switch {
+		case machine.DeletionTimestamp != nil,
+			machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineTerminating,
+			machine.Status.CurrentStatus.Phase == machinev1alpha1.MachinePending,
+			machine.Status.CurrentStatus.Phase == machinev1alpha1.MachineAvailable,
+			len(machine.Status.Node) == 0:
+			ongoing[pool]++
+		default:
+			nodeToMachine[machine.Status.Node] = machine
+		}
+	}
+
+	for _, node := range nodes {
+		pool := nodeToPool[node.Name]
+		policy, ok := policies[pool]
+		if !ok {
+			continue
+		}
+		machine, ok := nodeToMachine[node.Name]
+		if !ok {
+			continue
+		}
+
+		if since := health.NodeUnhealthySince(&node); since != nil && now.Sub(*since) >= policy.UnhealthyThreshold.Duration {
+			candidates[pool] = append(candidates[pool], candidate{machine.Name, *since})
+		}
+	}
+
+	var (
+		machinesToRepair = map[string][]string{}
+		skippedPools     []string
+	)
+	for pool, poolCandidates := range candidates {
+		if unhealthy := poolUnhealthy[pool]; unhealthy > 1 && 2*unhealthy > poolNodes[pool] {
+			skippedPools = append(skippedPools, pool)
+			continue
+		}
+
+		maxConcurrentRepairs := int32(1)
+		if policy := policies[pool]; policy.MaxConcurrentRepairs != nil {
+			maxConcurrentRepairs = *policy.MaxConcurrentRepairs
+		}
+
+		sort.Slice(poolCandidates, func(i, j int) bool {
+			if poolCandidates[i].since.Equal(poolCandidates[j].since) {
+				return poolCandidates[i].machineName < poolCandidates[j].machineName
+			}
+			return poolCandidates[i].since.Before(poolCandidates[j].since)
+		})
+
+		for _, c := range poolCandidates {
+			if ongoing[pool] >= maxConcurrentRepairs {
+				break
+			}
+			machinesToRepair[pool] = append(machinesToRepair[pool], c.machineName)
+			ongoing[pool]++
+		}
+	}
+
+	sort.Strings(skippedPools)
+	return machinesToRepair, skippedPools
+}
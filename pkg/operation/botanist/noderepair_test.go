@@ -0,0 +1,259 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"time"
+
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("node repair", func() {
+	Describe("#ComputeMachinesToRepair", func() {
+		var (
+			now = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+			newNode = func(name, pool string, ready corev1.ConditionStatus, since time.Duration) corev1.Node {
+				return corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   name,
+						Labels: map[string]string{v1alpha1constants.LabelWorkerPool: pool},
+					},
+					Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+						Type:               corev1.NodeReady,
+						Status:             ready,
+						LastTransitionTime: metav1.NewTime(now.Add(-since)),
+					}}},
+				}
+			}
+			controllerRef = func(kind, name string) []metav1.OwnerReference {
+				return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: pointer.BoolPtr(true)}}
+			}
+			newMachine = func(name, node, machineSet string) machinev1alpha1.Machine {
+				return machinev1alpha1.Machine{
+					ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: controllerRef("MachineSet", machineSet)},
+					Status:     machinev1alpha1.MachineStatus{Node: node},
+				}
+			}
+			newMachineSet = func(name, machineDeployment string) machinev1alpha1.MachineSet {
+				return machinev1alpha1.MachineSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name, OwnerReferences: controllerRef("MachineDeployment", machineDeployment)},
+				}
+			}
+
+			policies    map[string]garden.WorkerAutoRepair
+			machines    []machinev1alpha1.Machine
+			machineSets []machinev1alpha1.MachineSet
+
+			compute = func(nodes []corev1.Node) []string {
+				machinesToRepair, _ := botanist.ComputeMachinesToRepair(policies, nodes, machines, machineSets, now)
+				var machineNames []string
+				for _, names := range machinesToRepair {
+					machineNames = append(machineNames, names...)
+				}
+				return machineNames
+			}
+		)
+
+		BeforeEach(func() {
+			policies = map[string]garden.WorkerAutoRepair{
+				"pool-a": {UnhealthyThreshold: metav1.Duration{Duration: 10 * time.Minute}},
+			}
+			machines = []machinev1alpha1.Machine{
+				newMachine("machine-1", "node-1", "pool-a-z1-abcde"),
+				newMachine("machine-2", "node-2", "pool-a-z1-abcde"),
+				newMachine("machine-3", "node-3", "pool-a-z1-abcde"),
+				newMachine("machine-4", "node-4", "pool-a-z1-abcde"),
+				newMachine("machine-5", "node-5", "pool-a-z1-abcde"),
+				newMachine("machine-6", "node-6", "pool-a-z1-abcde"),
+				newMachine("machine-7", "node-7", "pool-a-z1-abcde"),
+			}
+			machineSets = []machinev1alpha1.MachineSet{
+				newMachineSet("pool-a-z1-abcde", "pool-a-z1"),
+				newMachineSet("pool-a-z1-fghij", "pool-a-z1"),
+				newMachineSet("pool-b-z1-abcde", "pool-b-z1"),
+			}
+		})
+
+		It("should only repair machines of nodes which are unhealthy for longer than the threshold", func() {
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-2", "pool-a", corev1.ConditionFalse, 5*time.Minute),
+				newNode("node-3", "pool-a", corev1.ConditionFalse, 15*time.Minute),
+				newNode("node-4", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-5", "pool-a", corev1.ConditionTrue, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(ConsistOf("machine-3"))
+		})
+
+		It("should not repair machines of worker pools without policy", func() {
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-b", corev1.ConditionFalse, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(BeEmpty())
+		})
+
+		It("should repair at most the maximum number of concurrent repairs, longest unhealthy first", func() {
+			maxConcurrentRepairs := int32(2)
+			policies["pool-a"] = garden.WorkerAutoRepair{
+				UnhealthyThreshold:   metav1.Duration{Duration: 10 * time.Minute},
+				MaxConcurrentRepairs: &maxConcurrentRepairs,
+			}
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, 20*time.Minute),
+				newNode("node-2", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-3", "pool-a", corev1.ConditionFalse, 30*time.Minute),
+				newNode("node-4", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-5", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-6", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-7", "pool-a", corev1.ConditionTrue, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(ConsistOf("machine-2", "machine-3"))
+		})
+
+		It("should count machines which are already being replaced", func() {
+			deletionTimestamp := metav1.NewTime(now)
+			machines[0].DeletionTimestamp = &deletionTimestamp
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-2", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-3", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-4", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-5", "pool-a", corev1.ConditionTrue, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(BeEmpty())
+		})
+
+		It("should count pending and terminating machines of the pool without node", func() {
+			pending := newMachine("machine-pending", "", "pool-a-z1-fghij")
+			pending.Status.CurrentStatus.Phase = machinev1alpha1.MachinePending
+			machines = append(machines, pending)
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-2", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-3", "pool-a", corev1.ConditionTrue, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(BeEmpty())
+
+			pending.Status.CurrentStatus.Phase = machinev1alpha1.MachineTerminating
+			machines[len(machines)-1] = pending
+			Expect(compute(nodes)).To(BeEmpty())
+		})
+
+		It("should count pending machines without node which carry the worker pool label", func() {
+			pending := newMachine("machine-pending", "", "unknown")
+			pending.Labels = map[string]string{v1alpha1constants.LabelWorkerPool: "pool-a"}
+			pending.Status.CurrentStatus.Phase = machinev1alpha1.MachinePending
+			machines = append(machines, pending)
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-2", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-3", "pool-a", corev1.ConditionTrue, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(BeEmpty())
+		})
+
+		It("should not count machines without node of other pools", func() {
+			policies["pool-b"] = garden.WorkerAutoRepair{UnhealthyThreshold: metav1.Duration{Duration: 10 * time.Minute}}
+			machines = append(machines, newMachine("machine-b", "node-b", "pool-b-z1-abcde"))
+			pending := newMachine("machine-pending", "", "pool-b-z1-abcde")
+			pending.Status.CurrentStatus.Phase = machinev1alpha1.MachinePending
+			machines = append(machines, pending)
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-2", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-3", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-b", "pool-b", corev1.ConditionTrue, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(ConsistOf("machine-1"))
+		})
+
+		It("should not repair pools whose nodes are mostly unhealthy", func() {
+			policies["pool-b"] = garden.WorkerAutoRepair{UnhealthyThreshold: metav1.Duration{Duration: 10 * time.Minute}}
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-2", "pool-a", corev1.ConditionFalse, time.Hour),
+				newNode("node-3", "pool-a", corev1.ConditionTrue, time.Hour),
+				newNode("node-4", "pool-b", corev1.ConditionFalse, time.Hour),
+				newNode("node-5", "pool-b", corev1.ConditionTrue, time.Hour),
+				newNode("node-6", "pool-b", corev1.ConditionTrue, time.Hour),
+			}
+
+			machinesToRepair, skippedPools := botanist.ComputeMachinesToRepair(policies, nodes, machines, machineSets, now)
+			Expect(machinesToRepair).To(Equal(map[string][]string{"pool-b": {"machine-4"}}))
+			Expect(skippedPools).To(ConsistOf("pool-a"))
+		})
+
+		It("should repair the only node of a pool", func() {
+			nodes := []corev1.Node{
+				newNode("node-1", "pool-a", corev1.ConditionFalse, time.Hour),
+			}
+
+			Expect(compute(nodes)).To(ConsistOf("machine-1"))
+		})
+	})
+
+	Describe("#UpdateAutoRepairStatus", func() {
+		var (
+			earlier = metav1.NewTime(time.Date(2019, 10, 1, 11, 0, 0, 0, time.UTC))
+			now     = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+		)
+
+		It("should record the repair time of the repaired pools", func() {
+			Expect(botanist.UpdateAutoRepairStatus(nil, []string{"pool-b", "pool-a"}, now)).To(Equal(&gardenv1beta1.ShootAutoRepairStatus{
+				Pools: []gardenv1beta1.WorkerPoolAutoRepairStatus{
+					{Name: "pool-a", LastRepairTime: metav1.NewTime(now)},
+					{Name: "pool-b", LastRepairTime: metav1.NewTime(now)},
+				},
+			}))
+		})
+
+		It("should only update the repair time of the repaired pools", func() {
+			status := &gardenv1beta1.ShootAutoRepairStatus{
+				Pools: []gardenv1beta1.WorkerPoolAutoRepairStatus{
+					{Name: "pool-a", LastRepairTime: earlier},
+					{Name: "pool-b", LastRepairTime: earlier},
+				},
+			}
+
+			Expect(botanist.UpdateAutoRepairStatus(status, []string{"pool-b"}, now)).To(Equal(&gardenv1beta1.ShootAutoRepairStatus{
+				Pools: []gardenv1beta1.WorkerPoolAutoRepairStatus{
+					{Name: "pool-a", LastRepairTime: earlier},
+					{Name: "pool-b", LastRepairTime: metav1.NewTime(now)},
+				},
+			}))
+			Expect(status.Pools[1].LastRepairTime).To(Equal(earlier))
+		})
+	})
+})
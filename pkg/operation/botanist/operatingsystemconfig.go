@@ -18,11 +18,14 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/chartrenderer"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -38,6 +41,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
 	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +49,10 @@ import (
 
 var operatingSystemConfigChartPath = filepath.Join(common.ChartPath, "seed-operatingsystemconfig")
 
+// workerFileSecretPurpose is the purpose of the secrets which are copied into the namespace of the Shoot in the Seed
+// because they are referenced by the additional files of a worker pool.
+const workerFileSecretPurpose = "worker-file"
+
 // first hard, second soft
 func getEvictionMemoryAvailable(machineTypes []gardenv1beta1.MachineType, machineType string) (string, string) {
 	memoryThreshold, _ := resource.ParseQuantity("8Gi")
@@ -67,11 +75,6 @@ func (b *Botanist) ComputeShootOperatingSystemConfig(ctx context.Context) error
 		machineTypes = b.Shoot.GetMachineTypesFromCloudProfile()
 	)
 
-	originalConfig, err := b.generateOriginalConfig()
-	if err != nil {
-		return err
-	}
-
 	type oscOutput struct {
 		workerName string
 		oscs       *shoot.OperatingSystemConfigs
@@ -85,6 +88,10 @@ func (b *Botanist) ComputeShootOperatingSystemConfig(ctx context.Context) error
 		usedOscNames = make(map[string]string)
 	)
 
+	if err := b.deployWorkerFileSecrets(ctx); err != nil {
+		return err
+	}
+
 	for _, worker := range b.Shoot.GetWorkers() {
 		wg.Add(1)
 
@@ -95,9 +102,21 @@ func (b *Botanist) ComputeShootOperatingSystemConfig(ctx context.Context) error
 				machineImage = b.Shoot.GetDefaultMachineImage()
 			}
 
+			kubernetesVersion, err := b.Shoot.GetWorkerKubernetesVersionByName(worker.Name)
+			if err != nil {
+				results <- &oscOutput{worker.Name, nil, err}
+				return
+			}
+
+			originalConfig, err := b.generateOriginalConfig(kubernetesVersion)
+			if err != nil {
+				results <- &oscOutput{worker.Name, nil, err}
+				return
+			}
+
 			machineImageName := machineImage.Name
 			downloaderConfig := b.generateDownloaderConfig(machineImageName)
-			oscs, err := b.deployOperatingSystemConfigsForWorker(machineTypes, machineImage, utils.MergeMaps(downloaderConfig, nil), utils.MergeMaps(originalConfig, nil), worker)
+			oscs, err := b.deployOperatingSystemConfigsForWorker(machineTypes, machineImage, downloaderConfig, originalConfig, worker)
 			results <- &oscOutput{worker.Name, oscs, err}
 		}(worker)
 	}
@@ -134,7 +153,7 @@ func (b *Botanist) generateDownloaderConfig(machineImageName string) map[string]
 	}
 }
 
-func (b *Botanist) generateOriginalConfig() (map[string]interface{}, error) {
+func (b *Botanist) generateOriginalConfig(kubernetesVersion string) (map[string]interface{}, error) {
 	var (
 		serviceNetwork = b.Shoot.GetServiceNetwork()
 
@@ -142,7 +161,7 @@ func (b *Botanist) generateOriginalConfig() (map[string]interface{}, error) {
 			"kubernetes": map[string]interface{}{
 				"clusterDNS": common.ComputeClusterIP(serviceNetwork, 10),
 				"domain":     gardenv1beta1.DefaultDomain,
-				"version":    b.Shoot.Info.Spec.Kubernetes.Version,
+				"version":    kubernetesVersion,
 			},
 		}
 	)
@@ -151,7 +170,7 @@ func (b *Botanist) generateOriginalConfig() (map[string]interface{}, error) {
 		originalConfig["caBundle"] = *caBundle
 	}
 
-	return b.InjectShootShootImages(originalConfig, common.HyperkubeImageName, common.PauseContainerImageName)
+	return b.InjectShootWorkerImages(originalConfig, kubernetesVersion, common.HyperkubeImageName, common.PauseContainerImageName)
 }
 
 func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenv1beta1.MachineType, machineImage *gardenv1beta1.ShootMachineImage, downloaderConfig, originalConfig map[string]interface{}, worker gardenv1beta1.Worker) (*shoot.OperatingSystemConfigs, error) {
@@ -183,6 +202,75 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenv1
 		}
 	}
 
+	// ensure sane defaults for evictionHard.memoryAvailable and evictionSoft.memoryAvailable
+	evictionHardMemoryAvailable, evictionSoftMemoryAvailable := getEvictionMemoryAvailable(machineTypes, worker.MachineType)
+
+	// use the spec.Kubernetes.Kubelet as default for the worker, every setting of the worker's kubelet configuration
+	// overrides the respective setting of the shoot
+	kubelet := computeKubeletValues(
+		string(b.Secrets[v1alpha1constants.SecretNameCAKubelet].Data[secrets.DataKeyCertificateCA]),
+		evictionHardMemoryAvailable,
+		evictionSoftMemoryAvailable,
+		b.Shoot.Info.Spec.Kubernetes.Kubelet,
+		worker.Kubelet,
+	)
+
+	workerConfig := map[string]interface{}{
+		"name":    worker.Name,
+		"kubelet": kubelet,
+	}
+
+	if worker.CRI != nil {
+		containerRuntimes := make([]string, 0, len(worker.CRI.ContainerRuntimes))
+		for _, containerRuntime := range worker.CRI.ContainerRuntimes {
+			containerRuntimes = append(containerRuntimes, containerRuntime.Type)
+		}
+
+		workerConfig["cri"] = map[string]interface{}{
+			"name":              worker.CRI.Name,
+			"containerRuntimes": containerRuntimes,
+		}
+	}
+
+	workerOperatingSystemConfig, err := b.Shoot.GetWorkerOperatingSystemConfigByName(worker.Name)
+	if err != nil {
+		return nil, err
+	}
+	if workerOperatingSystemConfig != nil {
+		workerConfig["units"], workerConfig["files"] = ComputeWorkerOperatingSystemConfigUnitsAndFiles(workerOperatingSystemConfig)
+	}
+
+	originalConfig["worker"] = workerConfig
+
+	var (
+		downloaderName = fmt.Sprintf("%s-downloader", secretName)
+		originalName   = fmt.Sprintf("%s-original", secretName)
+	)
+	downloaderData, err := b.applyAndWaitForShootOperatingSystemConfig(filepath.Join(operatingSystemConfigChartPath, "downloader"), downloaderName, downloaderConfig)
+	if err != nil {
+		return nil, err
+	}
+	originalData, err := b.applyAndWaitForShootOperatingSystemConfig(filepath.Join(operatingSystemConfigChartPath, "original"), originalName, originalConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &shoot.OperatingSystemConfigs{
+		Downloader: shoot.OperatingSystemConfig{
+			Name: downloaderName,
+			Data: *downloaderData,
+		},
+		Original: shoot.OperatingSystemConfig{
+			Name: originalName,
+			Data: *originalData,
+		},
+	}, nil
+}
+
+// computeKubeletValues computes the chart values for the kubelet of a worker pool. The given kubelet configurations are
+// applied in the given order, i.e. every setting of a configuration overrides the respective setting of the preceding
+// ones.
+func computeKubeletValues(caCert, evictionHardMemoryAvailable, evictionSoftMemoryAvailable string, kubeletConfigs ...*gardenv1beta1.KubeletConfig) map[string]interface{} {
 	var (
 		evictionHard            = map[string]string{}
 		evictionSoft            = map[string]string{}
@@ -190,16 +278,21 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenv1
 		evictionMinimumReclaim  = map[string]string{}
 	)
 
-	// use the spec.Kubernetes.Kubelet as default for worker
-	kubeletConfig := worker.Kubelet
-	if kubeletConfig == nil {
-		kubeletConfig = b.Shoot.Info.Spec.Kubernetes.Kubelet
+	evictionHard["memoryAvailable"], evictionSoft["memoryAvailable"] = evictionHardMemoryAvailable, evictionSoftMemoryAvailable
+
+	var kubelet = map[string]interface{}{
+		"caCert":                  caCert,
+		"evictionHard":            evictionHard,
+		"evictionSoft":            evictionSoft,
+		"evictionSoftGracePeriod": evictionSoftGracePeriod,
+		"evictionMinimumReclaim":  evictionMinimumReclaim,
 	}
 
-	// ensure sane defaults for evictionHard.memoryAvailable and evictionSoft.memoryAvailable
-	evictionHard["memoryAvailable"], evictionSoft["memoryAvailable"] = getEvictionMemoryAvailable(machineTypes, worker.MachineType)
+	for _, kubeletConfig := range kubeletConfigs {
+		if kubeletConfig == nil {
+			continue
+		}
 
-	if kubeletConfig != nil {
 		if kubeletConfig.EvictionHard != nil {
 			eviction := kubeletConfig.EvictionHard
 			if memoryAvailable := eviction.MemoryAvailable; memoryAvailable != nil {
@@ -275,19 +368,16 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenv1
 				evictionMinimumReclaim["nodeFSInodesFree"] = nodeFSInodesFree.String()
 			}
 		}
-	}
 
-	var kubelet = map[string]interface{}{
-		"caCert":                  string(b.Secrets[v1alpha1constants.SecretNameCAKubelet].Data[secrets.DataKeyCertificateCA]),
-		"evictionHard":            evictionHard,
-		"evictionSoft":            evictionSoft,
-		"evictionSoftGracePeriod": evictionSoftGracePeriod,
-		"evictionMinimumReclaim":  evictionMinimumReclaim,
-	}
-
-	if kubeletConfig := kubeletConfig; kubeletConfig != nil {
 		if featureGates := kubeletConfig.FeatureGates; featureGates != nil {
-			kubelet["featureGates"] = featureGates
+			mergedFeatureGates, ok := kubelet["featureGates"].(map[string]bool)
+			if !ok {
+				mergedFeatureGates = make(map[string]bool, len(featureGates))
+			}
+			for featureGate, enabled := range featureGates {
+				mergedFeatureGates[featureGate] = enabled
+			}
+			kubelet["featureGates"] = mergedFeatureGates
 		}
 		if podPIDsLimit := kubeletConfig.PodPIDsLimit; podPIDsLimit != nil {
 			kubelet["podPIDsLimit"] = *podPIDsLimit
@@ -309,34 +399,157 @@ func (b *Botanist) deployOperatingSystemConfigsForWorker(machineTypes []gardenv1
 		}
 	}
 
-	originalConfig["worker"] = map[string]interface{}{
-		"name":    worker.Name,
-		"kubelet": kubelet,
-	}
+	return kubelet
+}
 
+// ComputeWorkerOperatingSystemConfigUnitsAndFiles computes the units and files which are added to the operating system
+// config of a worker pool. Files whose content is referenced from a secret point to the copy of the secret in the
+// namespace of the Shoot in the Seed, and the kernel settings are written to a separate sysctl configuration file.
+func ComputeWorkerOperatingSystemConfigUnitsAndFiles(osc *garden.WorkerOperatingSystemConfig) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File) {
 	var (
-		downloaderName = fmt.Sprintf("%s-downloader", secretName)
-		originalName   = fmt.Sprintf("%s-original", secretName)
+		units = make([]extensionsv1alpha1.Unit, 0, len(osc.Units))
+		files = make([]extensionsv1alpha1.File, 0, len(osc.Files)+1)
 	)
-	downloaderData, err := b.applyAndWaitForShootOperatingSystemConfig(filepath.Join(operatingSystemConfigChartPath, "downloader"), downloaderName, downloaderConfig)
-	if err != nil {
-		return nil, err
+
+	for _, unit := range osc.Units {
+		u := extensionsv1alpha1.Unit{
+			Name:    unit.Name,
+			Command: unit.Command,
+			Enable:  unit.Enable,
+			Content: unit.Content,
+		}
+		for _, dropIn := range unit.DropIns {
+			u.DropIns = append(u.DropIns, extensionsv1alpha1.DropIn{
+				Name:    dropIn.Name,
+				Content: dropIn.Content,
+			})
+		}
+		units = append(units, u)
 	}
-	originalData, err := b.applyAndWaitForShootOperatingSystemConfig(filepath.Join(operatingSystemConfigChartPath, "original"), originalName, originalConfig)
-	if err != nil {
-		return nil, err
+
+	for _, file := range osc.Files {
+		f := extensionsv1alpha1.File{
+			Path:        file.Path,
+			Permissions: file.Permissions,
+		}
+		if ref := file.Content.SecretRef; ref != nil {
+			f.Content.SecretRef = &extensionsv1alpha1.FileContentSecretRef{
+				Name:    computeWorkerFileSecretName(ref.Name),
+				DataKey: ref.DataKey,
+			}
+		}
+		if inline := file.Content.Inline; inline != nil {
+			f.Content.Inline = &extensionsv1alpha1.FileContentInline{
+				Encoding: inline.Encoding,
+				Data:     inline.Data,
+			}
+		}
+		files = append(files, f)
 	}
 
-	return &shoot.OperatingSystemConfigs{
-		Downloader: shoot.OperatingSystemConfig{
-			Name: downloaderName,
-			Data: *downloaderData,
-		},
-		Original: shoot.OperatingSystemConfig{
-			Name: originalName,
-			Data: *originalData,
-		},
-	}, nil
+	if len(osc.Sysctls) > 0 {
+		names := make([]string, 0, len(osc.Sysctls))
+		for name := range osc.Sysctls {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var sysctls strings.Builder
+		for _, name := range names {
+			fmt.Fprintf(&sysctls, "%s = %s\n", name, osc.Sysctls[name])
+		}
+
+		permissions := int32(0644)
+		files = append(files, extensionsv1alpha1.File{
+			Path:        v1alpha1constants.OperatingSystemConfigFilePathWorkerKernelSettings,
+			Permissions: &permissions,
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: sysctls.String(),
+				},
+			},
+		})
+	}
+
+	return units, files
+}
+
+func computeWorkerFileSecretName(name string) string {
+	return fmt.Sprintf("%s-%s", workerFileSecretPurpose, name)
+}
+
+// deployWorkerFileSecrets copies the secrets which are referenced by the additional files of the worker pools from the
+// namespace of the Shoot into the namespace of the Shoot in the Seed, so that the operating system configs can refer to
+// them. Only the referenced data keys are copied. Copies which are no longer referenced are deleted.
+func (b *Botanist) deployWorkerFileSecrets(ctx context.Context) error {
+	referencedDataKeys := map[string]sets.String{}
+	for _, worker := range b.Shoot.GetWorkers() {
+		osc, err := b.Shoot.GetWorkerOperatingSystemConfigByName(worker.Name)
+		if err != nil {
+			return err
+		}
+		if osc == nil {
+			continue
+		}
+
+		for _, file := range osc.Files {
+			if ref := file.Content.SecretRef; ref != nil {
+				if _, ok := referencedDataKeys[ref.Name]; !ok {
+					referencedDataKeys[ref.Name] = sets.NewString()
+				}
+				referencedDataKeys[ref.Name].Insert(ref.DataKey)
+			}
+		}
+	}
+
+	usedSecretNames := sets.NewString()
+	for name, dataKeys := range referencedDataKeys {
+		secret := &corev1.Secret{}
+		if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(b.Shoot.Info.Namespace, name), secret); err != nil {
+			return fmt.Errorf("could not read secret %q referenced by the files of the worker pools: %+v", name, err)
+		}
+
+		data := make(map[string][]byte, dataKeys.Len())
+		for _, dataKey := range dataKeys.List() {
+			value, ok := secret.Data[dataKey]
+			if !ok {
+				return fmt.Errorf("secret %q referenced by the files of the worker pools does not contain data key %q", name, dataKey)
+			}
+			data[dataKey] = value
+		}
+
+		copiedSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      computeWorkerFileSecretName(name),
+				Namespace: b.Shoot.SeedNamespace,
+			},
+		}
+		if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), copiedSecret, func() error {
+			copiedSecret.Labels = map[string]string{v1alpha1constants.GardenerPurpose: workerFileSecretPurpose}
+			copiedSecret.Type = corev1.SecretTypeOpaque
+			copiedSecret.Data = data
+			return nil
+		}); err != nil {
+			return err
+		}
+		usedSecretNames.Insert(copiedSecret.Name)
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := b.K8sSeedClient.Client().List(ctx, secretList, client.InNamespace(b.Shoot.SeedNamespace), client.MatchingLabels(map[string]string{v1alpha1constants.GardenerPurpose: workerFileSecretPurpose})); err != nil {
+		return err
+	}
+
+	for _, secret := range secretList.Items {
+		if usedSecretNames.Has(secret.Name) {
+			continue
+		}
+		if err := b.K8sSeedClient.Client().Delete(ctx, &secret); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (b *Botanist) applyAndWaitForShootOperatingSystemConfig(chartPath, name string, values map[string]interface{}) (*shoot.OperatingSystemConfigData, error) {
@@ -16,17 +16,26 @@ package botanist_test
 
 import (
 	"context"
+	"encoding/json"
 
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/botanist"
 	"github.com/gardener/gardener/pkg/operation/shoot"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 
 	"github.com/golang/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -87,4 +96,229 @@ var _ = Describe("operatingsystemconfig", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("#ComputeKubeletValues", func() {
+		It("should use the memory defaults and the CA certificate if no kubelet configuration is given", func() {
+			Expect(botanist.ExportComputeKubeletValues("ca", "100Mi", "200Mi", nil, nil)).To(Equal(map[string]interface{}{
+				"caCert":                  "ca",
+				"evictionHard":            map[string]string{"memoryAvailable": "100Mi"},
+				"evictionSoft":            map[string]string{"memoryAvailable": "200Mi"},
+				"evictionSoftGracePeriod": map[string]string{},
+				"evictionMinimumReclaim":  map[string]string{},
+			}))
+		})
+
+		It("should merge the kubelet configuration of the worker pool over the one of the shoot", func() {
+			shootKubelet := &gardenv1beta1.KubeletConfig{
+				KubernetesConfig: gardenv1beta1.KubernetesConfig{FeatureGates: map[string]bool{"Foo": true, "Bar": true}},
+				MaxPods:          pointer.Int32Ptr(110),
+				PodPIDsLimit:     pointer.Int64Ptr(100),
+				EvictionHard: &gardenv1beta1.KubeletConfigEviction{
+					MemoryAvailable:  pointer.StringPtr("1Gi"),
+					NodeFSAvailable:  pointer.StringPtr("5%"),
+					ImageFSAvailable: pointer.StringPtr("5%"),
+				},
+			}
+			workerKubelet := &gardenv1beta1.KubeletConfig{
+				KubernetesConfig: gardenv1beta1.KubernetesConfig{FeatureGates: map[string]bool{"Bar": false, "Baz": true}},
+				MaxPods:          pointer.Int32Ptr(250),
+				EvictionHard: &gardenv1beta1.KubeletConfigEviction{
+					NodeFSAvailable: pointer.StringPtr("10%"),
+				},
+			}
+
+			Expect(botanist.ExportComputeKubeletValues("ca", "100Mi", "200Mi", shootKubelet, workerKubelet)).To(Equal(map[string]interface{}{
+				"caCert": "ca",
+				"evictionHard": map[string]string{
+					"memoryAvailable":  "1Gi",
+					"nodeFSAvailable":  "10%",
+					"imageFSAvailable": "5%",
+				},
+				"evictionSoft":            map[string]string{"memoryAvailable": "200Mi"},
+				"evictionSoftGracePeriod": map[string]string{},
+				"evictionMinimumReclaim":  map[string]string{},
+				"featureGates":            map[string]bool{"Foo": true, "Bar": false, "Baz": true},
+				"maxPods":                 int32(250),
+				"podPIDsLimit":            int64(100),
+			}))
+			Expect(shootKubelet.FeatureGates).To(Equal(map[string]bool{"Foo": true, "Bar": true}))
+		})
+	})
+
+	Describe("#ComputeWorkerOperatingSystemConfigUnitsAndFiles", func() {
+		It("should convert the units and files and write the kernel settings to a separate file", func() {
+			var (
+				command     = "start"
+				enable      = true
+				content     = "[Unit]"
+				permissions = int32(0600)
+			)
+
+			units, files := botanist.ComputeWorkerOperatingSystemConfigUnitsAndFiles(&garden.WorkerOperatingSystemConfig{
+				Units: []garden.WorkerUnit{
+					{Name: "foo.service", Command: &command, Enable: &enable, Content: &content},
+					{Name: "bar.service", DropIns: []garden.WorkerUnitDropIn{{Name: "10-bar.conf", Content: "[Service]"}}},
+				},
+				Files: []garden.WorkerFile{
+					{Path: "/etc/foo.conf", Permissions: &permissions, Content: garden.WorkerFileContent{Inline: &garden.WorkerFileContentInline{Encoding: "b64", Data: "Zm9v"}}},
+					{Path: "/etc/ssl/certs/bar.pem", Content: garden.WorkerFileContent{SecretRef: &garden.WorkerFileContentSecretRef{Name: "bar", DataKey: "ca.crt"}}},
+				},
+				Sysctls: map[string]string{"vm.swappiness": "10", "net.ipv4.tcp_keepalive_time": "600"},
+			})
+
+			kernelSettingsPermissions := int32(0644)
+			Expect(units).To(Equal([]extensionsv1alpha1.Unit{
+				{Name: "foo.service", Command: &command, Enable: &enable, Content: &content},
+				{Name: "bar.service", DropIns: []extensionsv1alpha1.DropIn{{Name: "10-bar.conf", Content: "[Service]"}}},
+			}))
+			Expect(files).To(Equal([]extensionsv1alpha1.File{
+				{Path: "/etc/foo.conf", Permissions: &permissions, Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: "Zm9v"}}},
+				{Path: "/etc/ssl/certs/bar.pem", Content: extensionsv1alpha1.FileContent{SecretRef: &extensionsv1alpha1.FileContentSecretRef{Name: "worker-file-bar", DataKey: "ca.crt"}}},
+				{Path: "/etc/sysctl.d/99-k8s-worker.conf", Permissions: &kernelSettingsPermissions, Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "net.ipv4.tcp_keepalive_time = 600\nvm.swappiness = 10\n"}}},
+			}))
+		})
+
+		It("should not add a kernel settings file if no kernel settings are configured", func() {
+			units, files := botanist.ComputeWorkerOperatingSystemConfigUnitsAndFiles(&garden.WorkerOperatingSystemConfig{})
+
+			Expect(units).To(BeEmpty())
+			Expect(files).To(BeEmpty())
+		})
+	})
+	Describe("#deployWorkerFileSecrets", func() {
+		const (
+			projectNamespace = "garden-foo"
+			seedNamespace    = "shoot--foo--bar"
+		)
+
+		var (
+			ctx = context.TODO()
+
+			gardenClient client.Client
+			seedClient   client.Client
+			b            *botanist.Botanist
+
+			secretRefFile = func(path, name, dataKey string) garden.WorkerFile {
+				return garden.WorkerFile{
+					Path:    path,
+					Content: garden.WorkerFileContent{SecretRef: &garden.WorkerFileContentSecretRef{Name: name, DataKey: dataKey}},
+				}
+			}
+			setWorkerFiles = func(files map[string][]garden.WorkerFile) {
+				info := garden.WorkerMigrationInfo{}
+				for workerName, workerFiles := range files {
+					info[workerName] = garden.WorkerMigrationData{OperatingSystemConfig: &garden.WorkerOperatingSystemConfig{Files: workerFiles}}
+				}
+				data, err := json.Marshal(info)
+				Expect(err).NotTo(HaveOccurred())
+				b.Shoot.Info.Annotations = map[string]string{garden.MigrationShootWorkers: string(data)}
+			}
+			workerFileSecret = func(name string) *corev1.Secret {
+				secret := &corev1.Secret{}
+				Expect(seedClient.Get(ctx, kutil.Key(seedNamespace, name), secret)).To(Succeed())
+				return secret
+			}
+			expectWorkerFileSecretGone = func(name string) {
+				err := seedClient.Get(ctx, kutil.Key(seedNamespace, name), &corev1.Secret{})
+				Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+		)
+
+		BeforeEach(func() {
+			gardenClient = fake.NewFakeClient(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: projectNamespace},
+					Data:       map[string][]byte{"ca.crt": []byte("ca"), "other.crt": []byte("other"), "ca.key": []byte("key")},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: projectNamespace},
+					Data:       map[string][]byte{"agent.conf": []byte("new")},
+				},
+			)
+			seedClient = fake.NewFakeClient(
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "worker-file-config",
+						Namespace: seedNamespace,
+						Labels:    map[string]string{v1alpha1constants.GardenerPurpose: "worker-file"},
+					},
+					Data: map[string][]byte{"agent.conf": []byte("old")},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "worker-file-old",
+						Namespace: seedNamespace,
+						Labels:    map[string]string{v1alpha1constants.GardenerPurpose: "worker-file"},
+					},
+				},
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: seedNamespace},
+				},
+			)
+
+			k8sGardenClient := mock.NewMockInterface(ctrl)
+			k8sGardenClient.EXPECT().Client().Return(gardenClient).AnyTimes()
+			k8sSeedClient.EXPECT().Client().Return(seedClient).AnyTimes()
+
+			b = &botanist.Botanist{Operation: &operation.Operation{
+				K8sGardenClient: k8sGardenClient,
+				K8sSeedClient:   k8sSeedClient,
+				Shoot: &shoot.Shoot{
+					SeedNamespace: seedNamespace,
+					CloudProvider: gardenv1beta1.CloudProviderAWS,
+					Info: &gardenv1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: projectNamespace},
+						Spec: gardenv1beta1.ShootSpec{
+							Cloud: gardenv1beta1.Cloud{
+								AWS: &gardenv1beta1.AWSCloud{
+									Workers: []gardenv1beta1.AWSWorker{
+										{Worker: gardenv1beta1.Worker{Name: "cpu-worker"}},
+										{Worker: gardenv1beta1.Worker{Name: "gpu-worker"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			}}
+		})
+
+		It("should create and update the copies of the referenced secrets and delete the unused ones", func() {
+			setWorkerFiles(map[string][]garden.WorkerFile{
+				"cpu-worker": {
+					secretRefFile("/etc/ssl/certs/ca.pem", "ca", "ca.crt"),
+					secretRefFile("/etc/agent.conf", "config", "agent.conf"),
+				},
+				"gpu-worker": {
+					secretRefFile("/etc/ssl/certs/other.pem", "ca", "other.crt"),
+				},
+			})
+
+			Expect(botanist.ExportDeployWorkerFileSecrets(b, ctx)).To(Succeed())
+
+			caSecret := workerFileSecret("worker-file-ca")
+			Expect(caSecret.Labels).To(HaveKeyWithValue(v1alpha1constants.GardenerPurpose, "worker-file"))
+			Expect(caSecret.Data).To(Equal(map[string][]byte{"ca.crt": []byte("ca"), "other.crt": []byte("other")}))
+			Expect(workerFileSecret("worker-file-config").Data).To(Equal(map[string][]byte{"agent.conf": []byte("new")}))
+			expectWorkerFileSecretGone("worker-file-old")
+			Expect(seedClient.Get(ctx, kutil.Key(seedNamespace, "unrelated"), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should delete all copies if no secrets are referenced anymore", func() {
+			Expect(botanist.ExportDeployWorkerFileSecrets(b, ctx)).To(Succeed())
+
+			expectWorkerFileSecretGone("worker-file-config")
+			expectWorkerFileSecretGone("worker-file-old")
+			Expect(seedClient.Get(ctx, kutil.Key(seedNamespace, "unrelated"), &corev1.Secret{})).To(Succeed())
+		})
+
+		It("should fail if a referenced data key does not exist", func() {
+			setWorkerFiles(map[string][]garden.WorkerFile{
+				"cpu-worker": {secretRefFile("/etc/ssl/certs/ca.pem", "ca", "missing.crt")},
+			})
+
+			Expect(botanist.ExportDeployWorkerFileSecrets(b, ctx)).To(MatchError(ContainSubstring(`does not contain data key "missing.crt"`)))
+			Expect(workerFileSecret("worker-file-old")).NotTo(BeNil())
+		})
+	})
 })
@@ -17,12 +17,14 @@ package botanist
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/common"
 	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
@@ -33,6 +35,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kretry "k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -52,8 +56,33 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 		}
 
 		pools []extensionsv1alpha1.WorkerPool
+
+		existingPools   = map[string]extensionsv1alpha1.WorkerPool{}
+		rollWorkerPools = sets.NewString()
+		now             = Now()
 	)
 
+	existingWorker := &extensionsv1alpha1.Worker{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(worker.Namespace, worker.Name), existingWorker); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	for _, pool := range existingWorker.Spec.Pools {
+		existingPools[pool.Name] = pool
+	}
+
+	// The Worker resource carries the roll-worker-pools annotation of the Shoot as long as the request is being
+	// processed, so that the node generations are not incremented again if the Worker is deployed multiple times
+	// before the annotations are removed (see RemoveRollWorkerPoolsAnnotations).
+	rollWorkerPoolsRequested := kutil.HasMetaDataAnnotation(b.Shoot.Info, common.ShootOperation, common.ShootOperationRollWorkerPools)
+	if rollWorkerPoolsRequested {
+		rolledWorkerPools := sets.NewString(common.ParseRollWorkerPools(existingWorker.Annotations[common.ShootRollWorkerPools])...)
+		rollWorkerPools.Insert(common.ParseRollWorkerPools(b.Shoot.Info.Annotations[common.ShootRollWorkerPools])...)
+		rollWorkerPools = rollWorkerPools.Difference(rolledWorkerPools)
+		if rollWorkerPools.Len() > 0 {
+			b.Logger.Infof("Rolling the nodes of the worker pools %s", strings.Join(rollWorkerPools.List(), ","))
+		}
+	}
+
 	for _, worker := range b.Shoot.GetWorkers() {
 		var volume *extensionsv1alpha1.Volume
 		ok, volumeType, volumeSize, err := b.Shoot.GetWorkerVolumesByName(worker.Name)
@@ -72,6 +101,27 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 			machineImage = b.Shoot.GetDefaultMachineImage()
 		}
 
+		zones, err := b.Shoot.GetWorkerZonesByName(worker.Name)
+		if err != nil {
+			return fmt.Errorf("could not determine zones for pool %q: %+v", worker.Name, err)
+		}
+
+		kubernetesVersion, err := b.Shoot.GetWorkerKubernetesVersionByName(worker.Name)
+		if err != nil {
+			return fmt.Errorf("could not determine Kubernetes version for pool %q: %+v", worker.Name, err)
+		}
+
+		maxNodeAge, err := b.Shoot.GetWorkerMaxNodeAgeByName(worker.Name)
+		if err != nil {
+			return fmt.Errorf("could not determine maximum node age for pool %q: %+v", worker.Name, err)
+		}
+
+		var existingPool *extensionsv1alpha1.WorkerPool
+		if pool, ok := existingPools[worker.Name]; ok {
+			existingPool = &pool
+		}
+		nodeGeneration, nodeGenerationTimestamp := ComputeWorkerPoolNodeGeneration(existingPool, rollWorkerPools.Has(worker.Name), maxNodeAge, now)
+
 		pools = append(pools, extensionsv1alpha1.WorkerPool{
 			Name:           worker.Name,
 			Minimum:        worker.AutoScalerMin,
@@ -86,14 +136,23 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 				Name:    string(machineImage.Name),
 				Version: machineImage.Version,
 			},
-			UserData: []byte(b.Shoot.OperatingSystemConfigsMap[worker.Name].Downloader.Data.Content),
-			Volume:   volume,
-			Zones:    b.Shoot.GetZones(),
+			UserData:                []byte(b.Shoot.OperatingSystemConfigsMap[worker.Name].Downloader.Data.Content),
+			Volume:                  volume,
+			Zones:                   zones,
+			KubernetesVersion:       &kubernetesVersion,
+			Hibernated:              b.Shoot.IsWorkerPoolHibernated(worker.Name),
+			NodeGeneration:          nodeGeneration,
+			NodeGenerationTimestamp: nodeGenerationTimestamp,
 		})
 	}
 
-	return kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), worker, func() error {
+	if err := kutil.CreateOrUpdate(ctx, b.K8sSeedClient.Client(), worker, func() error {
 		metav1.SetMetaDataAnnotation(&worker.ObjectMeta, v1alpha1constants.GardenerOperation, v1alpha1constants.GardenerOperationReconcile)
+		if rollWorkerPoolsRequested {
+			metav1.SetMetaDataAnnotation(&worker.ObjectMeta, common.ShootRollWorkerPools, b.Shoot.Info.Annotations[common.ShootRollWorkerPools])
+		} else {
+			delete(worker.Annotations, common.ShootRollWorkerPools)
+		}
 
 		worker.Spec = extensionsv1alpha1.WorkerSpec{
 			DefaultSpec: extensionsv1alpha1.DefaultSpec{
@@ -111,7 +170,59 @@ func (b *Botanist) DeployWorker(ctx context.Context) error {
 			Pools: pools,
 		}
 		return nil
-	})
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RemoveRollWorkerPoolsAnnotations removes the roll-worker-pools operation annotations from the Shoot once the worker
+// extension has reconciled the Worker resource, i.e., once the nodes of the worker pools have been replaced. Afterwards,
+// it removes the copy of the annotation from the Worker resource so that the same worker pools can be rolled again.
+func (b *Botanist) RemoveRollWorkerPoolsAnnotations(ctx context.Context) error {
+	if kutil.HasMetaDataAnnotation(b.Shoot.Info, common.ShootOperation, common.ShootOperationRollWorkerPools) {
+		if _, err := kutil.TryUpdateShootAnnotations(b.K8sGardenClient.Garden(), kretry.DefaultRetry, b.Shoot.Info.ObjectMeta, func(shoot *gardenv1beta1.Shoot) (*gardenv1beta1.Shoot, error) {
+			delete(shoot.Annotations, common.ShootOperation)
+			delete(shoot.Annotations, common.ShootRollWorkerPools)
+			return shoot, nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	worker := &extensionsv1alpha1.Worker{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, b.Shoot.Info.Name), worker); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if _, ok := worker.Annotations[common.ShootRollWorkerPools]; !ok {
+		return nil
+	}
+
+	delete(worker.Annotations, common.ShootRollWorkerPools)
+	return b.K8sSeedClient.Client().Update(ctx, worker)
+}
+
+// ComputeWorkerPoolNodeGeneration computes the node generation of a worker pool and the time at which it was set
+// based on the pool in the existing Worker resource (nil if it does not exist yet). The generation is incremented if
+// rolling the nodes was requested or if the nodes have exceeded the given maximum node age.
+func ComputeWorkerPoolNodeGeneration(existingPool *extensionsv1alpha1.WorkerPool, roll bool, maxNodeAge *metav1.Duration, now time.Time) (int64, *metav1.Time) {
+	var (
+		generation int64
+		timestamp  = metav1.NewTime(now)
+	)
+
+	if existingPool != nil {
+		generation = existingPool.NodeGeneration
+		if existingPool.NodeGenerationTimestamp != nil {
+			timestamp = *existingPool.NodeGenerationTimestamp
+		}
+	}
+
+	if roll || (maxNodeAge != nil && now.Sub(timestamp.Time) >= maxNodeAge.Duration) {
+		return generation + 1, &metav1.Time{Time: now}
+	}
+	return generation, &timestamp
 }
 
 // DestroyWorker deletes the `Worker` extension resource in the shoot namespace in the seed cluster,
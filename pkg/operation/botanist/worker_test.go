@@ -0,0 +1,168 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package botanist_test
+
+import (
+	"context"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	fakegarden "github.com/gardener/gardener/pkg/client/garden/clientset/versioned/fake"
+	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
+	"github.com/gardener/gardener/pkg/operation"
+	"github.com/gardener/gardener/pkg/operation/botanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	"github.com/gardener/gardener/pkg/operation/shoot"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	"github.com/golang/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("worker", func() {
+	Describe("#ComputeWorkerPoolNodeGeneration", func() {
+		var (
+			now          = time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+			rolledAt     = metav1.NewTime(now.Add(-48 * time.Hour))
+			existingPool *extensionsv1alpha1.WorkerPool
+		)
+
+		BeforeEach(func() {
+			existingPool = &extensionsv1alpha1.WorkerPool{
+				NodeGeneration:          3,
+				NodeGenerationTimestamp: &rolledAt,
+			}
+		})
+
+		It("should start with the initial generation for new pools", func() {
+			generation, timestamp := botanist.ComputeWorkerPoolNodeGeneration(nil, false, nil, now)
+
+			Expect(generation).To(BeZero())
+			Expect(timestamp.Time).To(Equal(now))
+		})
+
+		It("should keep the generation if nothing is requested", func() {
+			generation, timestamp := botanist.ComputeWorkerPoolNodeGeneration(existingPool, false, &metav1.Duration{Duration: 72 * time.Hour}, now)
+
+			Expect(generation).To(Equal(int64(3)))
+			Expect(timestamp.Time).To(Equal(rolledAt.Time))
+		})
+
+		It("should increment the generation if rolling the nodes is requested", func() {
+			generation, timestamp := botanist.ComputeWorkerPoolNodeGeneration(existingPool, true, nil, now)
+
+			Expect(generation).To(Equal(int64(4)))
+			Expect(timestamp.Time).To(Equal(now))
+		})
+
+		It("should increment the generation if the maximum node age is exceeded", func() {
+			generation, timestamp := botanist.ComputeWorkerPoolNodeGeneration(existingPool, false, &metav1.Duration{Duration: 24 * time.Hour}, now)
+
+			Expect(generation).To(Equal(int64(4)))
+			Expect(timestamp.Time).To(Equal(now))
+		})
+	})
+	Describe("#RemoveRollWorkerPoolsAnnotations", func() {
+		const (
+			namespace = "shoot--foo--bar"
+			name      = "bar"
+		)
+
+		var (
+			ctrl          *gomock.Controller
+			c             client.Client
+			gardenClient  *fakegarden.Clientset
+			b             *botanist.Botanist
+			rollRequested map[string]string
+
+			ctx = context.TODO()
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			rollRequested = map[string]string{
+				common.ShootOperation:       common.ShootOperationRollWorkerPools,
+				common.ShootRollWorkerPools: "cpu-worker",
+			}
+
+			scheme := runtime.NewScheme()
+			Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+			c = fake.NewFakeClientWithScheme(scheme, &extensionsv1alpha1.Worker{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					Namespace:   namespace,
+					Annotations: map[string]string{common.ShootRollWorkerPools: "cpu-worker"},
+				},
+			})
+
+			shootObj := &gardenv1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo", Annotations: rollRequested}}
+			gardenClient = fakegarden.NewSimpleClientset(shootObj)
+
+			k8sSeedClient := mock.NewMockInterface(ctrl)
+			k8sSeedClient.EXPECT().Client().Return(c).AnyTimes()
+			k8sGardenClient := mock.NewMockInterface(ctrl)
+			k8sGardenClient.EXPECT().Garden().Return(gardenClient).AnyTimes()
+
+			b = &botanist.Botanist{Operation: &operation.Operation{
+				K8sSeedClient:   k8sSeedClient,
+				K8sGardenClient: k8sGardenClient,
+				Shoot: &shoot.Shoot{
+					SeedNamespace: namespace,
+					Info:          shootObj.DeepCopy(),
+				},
+			}}
+		})
+
+		AfterEach(func() {
+			ctrl.Finish()
+		})
+
+		It("should remove the annotations from the Shoot and the Worker", func() {
+			Expect(b.RemoveRollWorkerPoolsAnnotations(ctx)).To(Succeed())
+
+			shootObj, err := gardenClient.GardenV1beta1().Shoots("garden-foo").Get(name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(shootObj.Annotations).NotTo(HaveKey(common.ShootOperation))
+			Expect(shootObj.Annotations).NotTo(HaveKey(common.ShootRollWorkerPools))
+
+			worker := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, kutil.Key(namespace, name), worker)).To(Succeed())
+			Expect(worker.Annotations).NotTo(HaveKey(common.ShootRollWorkerPools))
+		})
+
+		It("should remove a left-over annotation from the Worker if the Shoot has already been updated", func() {
+			b.Shoot.Info.Annotations = nil
+
+			Expect(b.RemoveRollWorkerPoolsAnnotations(ctx)).To(Succeed())
+
+			worker := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, kutil.Key(namespace, name), worker)).To(Succeed())
+			Expect(worker.Annotations).NotTo(HaveKey(common.ShootRollWorkerPools))
+		})
+
+		It("should succeed if the Worker does not exist", func() {
+			Expect(c.Delete(ctx, &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}})).To(Succeed())
+
+			Expect(b.RemoveRollWorkerPoolsAnnotations(ctx)).To(Succeed())
+		})
+	})
+})
@@ -43,6 +43,12 @@ func (b *AlicloudBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error)
 
 // GetEtcdBackupSnapstore returns the etcd backup snapstore object.
 func (b *AlicloudBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snapstore.SnapStore, error) {
+	return NewEtcdBackupSnapstore(secretData, common.ETCDMainBackupPrefix)
+}
+
+// NewEtcdBackupSnapstore returns the snapstore object for the etcd snapshots stored under the given prefix in the
+// bucket given in the secret data.
+func NewEtcdBackupSnapstore(secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
 	var (
 		accessKeyID     = string(secretData[AccessKeyID])
 		secretAccessKey = string(secretData[AccessKeySecret])
@@ -60,5 +66,5 @@ func (b *AlicloudBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte)
 		return nil, err
 	}
 
-	return snapstore.NewOSSFromBucket("etcd-main/v1", "", 10, bucketOSS), nil
+	return snapstore.NewOSSFromBucket(prefix, "", 10, bucketOSS), nil
 }
@@ -48,6 +48,12 @@ func (b *AWSBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 
 // GetEtcdBackupSnapstore returns the etcd backup snapstore object.
 func (b *AWSBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snapstore.SnapStore, error) {
+	return NewEtcdBackupSnapstore(secretData, common.ETCDMainBackupPrefix)
+}
+
+// NewEtcdBackupSnapstore returns the snapstore object for the etcd snapshots stored under the given prefix in the
+// bucket given in the secret data.
+func NewEtcdBackupSnapstore(secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
 	var (
 		accessKeyID     = string(secretData[AccessKeyID])
 		secretAccessKey = string(secretData[SecretAccessKey])
@@ -66,5 +72,5 @@ func (b *AWSBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snap
 
 	cli := s3.New(s, config)
 
-	return snapstore.NewS3FromClient(bucket, "etcd-main/v1", "", 10, cli), nil
+	return snapstore.NewS3FromClient(bucket, prefix, "", 10, cli), nil
 }
@@ -50,6 +50,12 @@ func (b *AzureBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 
 // GetEtcdBackupSnapstore returns the etcd backup snapstore object.
 func (b *AzureBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snapstore.SnapStore, error) {
+	return NewEtcdBackupSnapstore(secretData, common.ETCDMainBackupPrefix)
+}
+
+// NewEtcdBackupSnapstore returns the snapstore object for the etcd snapshots stored under the given prefix in the
+// bucket given in the secret data.
+func NewEtcdBackupSnapstore(secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
 	var (
 		storageAccount = string(secretData["storage-account"])
 		storageKey     = string(secretData["storage-key"])
@@ -69,5 +75,5 @@ func (b *AzureBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (sn
 	}
 	serviceURL := azblob.NewServiceURL(*u, p)
 	containerURL := serviceURL.NewContainerURL(container)
-	return snapstore.GetABSSnapstoreFromClient(container, "etcd-main/v1", "", 10, &containerURL)
+	return snapstore.GetABSSnapstoreFromClient(container, prefix, "", 10, &containerURL)
 }
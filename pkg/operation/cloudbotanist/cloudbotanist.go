@@ -16,7 +16,9 @@ package cloudbotanist
 
 import (
 	"errors"
+	"fmt"
 
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation"
 	"github.com/gardener/gardener/pkg/operation/cloudbotanist/alicloudbotanist"
@@ -60,3 +62,22 @@ func New(o *operation.Operation, purpose string) (CloudBotanist, error) {
 		return nil, errors.New("unsupported cloud provider")
 	}
 }
+
+// NewEtcdBackupSnapstore creates the snapstore object of the given cloud provider for the etcd snapshots stored under
+// the given prefix. The secret data must contain the credentials for the object store and the name of the bucket.
+func NewEtcdBackupSnapstore(cloudProvider gardenv1beta1.CloudProvider, secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
+	switch cloudProvider {
+	case gardenv1beta1.CloudProviderAWS:
+		return awsbotanist.NewEtcdBackupSnapstore(secretData, prefix)
+	case gardenv1beta1.CloudProviderAzure:
+		return azurebotanist.NewEtcdBackupSnapstore(secretData, prefix)
+	case gardenv1beta1.CloudProviderGCP:
+		return gcpbotanist.NewEtcdBackupSnapstore(secretData, prefix)
+	case gardenv1beta1.CloudProviderAlicloud:
+		return alicloudbotanist.NewEtcdBackupSnapstore(secretData, prefix)
+	case gardenv1beta1.CloudProviderOpenStack:
+		return openstackbotanist.NewEtcdBackupSnapstore(secretData, prefix)
+	default:
+		return nil, fmt.Errorf("etcd backups are not supported for cloud provider %q", cloudProvider)
+	}
+}
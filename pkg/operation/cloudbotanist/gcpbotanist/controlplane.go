@@ -48,6 +48,12 @@ func (b *GCPBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error) {
 
 // GetEtcdBackupSnapstore returns the etcd backup snapstore object.
 func (b *GCPBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snapstore.SnapStore, error) {
+	return NewEtcdBackupSnapstore(secretData, common.ETCDMainBackupPrefix)
+}
+
+// NewEtcdBackupSnapstore returns the snapstore object for the etcd snapshots stored under the given prefix in the
+// bucket given in the secret data.
+func NewEtcdBackupSnapstore(secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
 	var (
 		serviceAccountJSON = secretData[ServiceAccountJSON]
 		bucket             = string(secretData[common.BackupBucketName])
@@ -58,5 +64,5 @@ func (b *GCPBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snap
 	}
 
 	gcsClient := gcs.AdaptClient(client)
-	return snapstore.NewGCSSnapStoreFromClient(bucket, "etcd-main/v1", "", 10, gcsClient), nil
+	return snapstore.NewGCSSnapStoreFromClient(bucket, prefix, "", 10, gcsClient), nil
 }
@@ -50,6 +50,12 @@ func (b *OpenStackBotanist) GenerateEtcdBackupConfig() (map[string][]byte, error
 
 // GetEtcdBackupSnapstore returns the etcd backup snapstore object.
 func (b *OpenStackBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte) (snapstore.SnapStore, error) {
+	return NewEtcdBackupSnapstore(secretData, common.ETCDMainBackupPrefix)
+}
+
+// NewEtcdBackupSnapstore returns the snapstore object for the etcd snapshots stored under the given prefix in the
+// bucket given in the secret data.
+func NewEtcdBackupSnapstore(secretData map[string][]byte, prefix string) (snapstore.SnapStore, error) {
 	var (
 		bucket = string(secretData[common.BackupBucketName])
 	)
@@ -83,5 +89,5 @@ func (b *OpenStackBotanist) GetEtcdBackupSnapstore(secretData map[string][]byte)
 
 	}
 
-	return snapstore.NewSwiftSnapstoreFromClient(bucket, "etcd-main/v1", "", 10, client), nil
+	return snapstore.NewSwiftSnapstoreFromClient(bucket, prefix, "", 10, client), nil
 }
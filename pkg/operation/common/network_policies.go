@@ -15,9 +15,15 @@
 package common
 
 import (
+	"fmt"
 	"net"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 )
 
+// LookupIP looks up the IP addresses of the given host. It is exposed for testing.
+var LookupIP = net.LookupIP
+
 // Private8BitBlock returns a private network (RFC1918) 10.0.0.0/8 IPv4 block
 func Private8BitBlock() *net.IPNet {
 	return &net.IPNet{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 32)}
@@ -116,3 +122,66 @@ func excludeBlock(parentBlock *net.IPNet, cidrs ...string) ([]string, error) {
 	}
 	return matchedCIDRs, nil
 }
+
+// EgressDestinationNetworks returns the networks in CIDR notation of the given egress <destinations>. FQDNs are
+// resolved to their IPv4 addresses. Networks which lie within one of the given <forbiddenNetworks> (e.g., the
+// networks of the seed) cannot be excepted in a network policy and are therefore dropped, they are returned as
+// second value.
+func EgressDestinationNetworks(destinations []gardenv1beta1.EgressDestination, forbiddenNetworks ...string) ([]string, []string, error) {
+	var (
+		networks = []string{}
+		dropped  []string
+	)
+
+	forbidden := make([]*net.IPNet, 0, len(forbiddenNetworks))
+	for _, cidr := range forbiddenNetworks {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, err
+		}
+		forbidden = append(forbidden, n)
+	}
+
+	add := func(cidr string) error {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return err
+		}
+		for _, f := range forbidden {
+			if ones, _ := n.Mask.Size(); f.Contains(n.IP) && ones >= maskSize(f) {
+				dropped = append(dropped, cidr)
+				return nil
+			}
+		}
+		networks = append(networks, cidr)
+		return nil
+	}
+
+	for _, destination := range destinations {
+		if destination.CIDR != nil {
+			if err := add(*destination.CIDR); err != nil {
+				return nil, nil, err
+			}
+		}
+		if destination.FQDN != nil {
+			ips, err := LookupIP(*destination.FQDN)
+			if err != nil {
+				return nil, nil, fmt.Errorf("could not resolve egress destination %q: %v", *destination.FQDN, err)
+			}
+			for _, ip := range ips {
+				if ip4 := ip.To4(); ip4 != nil {
+					if err := add(fmt.Sprintf("%s/32", ip4)); err != nil {
+						return nil, nil, err
+					}
+				}
+			}
+		}
+	}
+
+	return networks, dropped, nil
+}
+
+func maskSize(n *net.IPNet) int {
+	ones, _ := n.Mask.Size()
+	return ones
+}
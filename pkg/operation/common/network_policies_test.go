@@ -15,8 +15,10 @@
 package common_test
 
 import (
+	"fmt"
 	"net"
 
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	. "github.com/gardener/gardener/pkg/operation/common"
 
 	. "github.com/onsi/ginkgo"
@@ -61,4 +63,62 @@ var _ = Describe("networkpolicies", func() {
 			Expect(result).To(ConsistOf(expectedResult))
 		})
 	})
+
+	Describe("#EgressDestinationNetworks", func() {
+		var oldLookupIP func(string) ([]net.IP, error)
+
+		BeforeEach(func() {
+			oldLookupIP = LookupIP
+		})
+
+		AfterEach(func() {
+			LookupIP = oldLookupIP
+		})
+
+		It("should return the CIDRs and the resolved IPv4 addresses", func() {
+			var (
+				cidr = "10.250.0.0/16"
+				fqdn = "webhook.example.com"
+			)
+			LookupIP = func(host string) ([]net.IP, error) {
+				Expect(host).To(Equal(fqdn))
+				return []net.IP{net.ParseIP("1.2.3.4"), net.ParseIP("2001:db8::1")}, nil
+			}
+
+			result, dropped, err := EgressDestinationNetworks([]gardenv1beta1.EgressDestination{{CIDR: &cidr}, {FQDN: &fqdn}})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]string{"10.250.0.0/16", "1.2.3.4/32"}))
+			Expect(dropped).To(BeEmpty())
+		})
+
+		It("should drop networks and resolved addresses within the forbidden networks", func() {
+			var (
+				cidr        = "10.0.0.0/8"
+				seedCIDR    = "100.96.1.0/24"
+				fqdn        = "webhook.example.com"
+				seedNetwork = "100.96.0.0/11"
+			)
+			LookupIP = func(string) ([]net.IP, error) {
+				return []net.IP{net.ParseIP("1.2.3.4"), net.ParseIP("100.96.0.10")}, nil
+			}
+
+			result, dropped, err := EgressDestinationNetworks([]gardenv1beta1.EgressDestination{{CIDR: &cidr}, {CIDR: &seedCIDR}, {FQDN: &fqdn}}, seedNetwork)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal([]string{"10.0.0.0/8", "1.2.3.4/32"}))
+			Expect(dropped).To(Equal([]string{"100.96.1.0/24", "100.96.0.10/32"}))
+		})
+
+		It("should return an error if a FQDN cannot be resolved", func() {
+			fqdn := "webhook.example.com"
+			LookupIP = func(string) ([]net.IP, error) {
+				return nil, fmt.Errorf("no such host")
+			}
+
+			_, _, err := EgressDestinationNetworks([]gardenv1beta1.EgressDestination{{FQDN: &fqdn}})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
@@ -73,6 +73,12 @@ const (
 	// allow deleting the Shoot (if the annotation is not set any DELETE request will be denied).
 	ConfirmationDeletion = "confirmation.garden.sapcloud.io/deletion"
 
+	// DeletionProtection is an annotation on a Shoot, Project or Seed resource whose value "true" protects the resource
+	// against deletion. The SecretBinding and the Quotas referenced by a protected Shoot cannot be deleted either.
+	// The annotation may only be removed by users which are allowed to "unprotect" the "deletionprotection"
+	// subresource of the resource.
+	DeletionProtection = "protection.garden.sapcloud.io/deletion"
+
 	// ControllerManagerInternalConfigMapName is the name of the internal config map in which the Gardener controller
 	// manager stores its configuration.
 	ControllerManagerInternalConfigMapName = "gardener-controller-manager-internal-config"
@@ -109,6 +115,15 @@ const (
 	// EtcdRoleEvents is the constant defining the role for etcd storing events in Shoot.
 	EtcdRoleEvents = "events"
 
+	// EtcdClientPort is the port on which the etcd serves its clients.
+	EtcdClientPort = 2379
+
+	// EtcdCAMountPath is the path at which the CA of the etcd is mounted into the etcd container.
+	EtcdCAMountPath = "/var/etcd/ssl/ca"
+
+	// EtcdClientTLSMountPath is the path at which the client certificate of the etcd is mounted into the etcd container.
+	EtcdClientTLSMountPath = "/var/etcd/ssl/client"
+
 	// EtcdEncryptionSecretName is the name of the shoot-specific secret which contains
 	// that shoot's EncryptionConfiguration. The EncryptionConfiguration contains a key
 	// which the shoot's apiserver uses for encrypting selected etcd content.
@@ -211,6 +226,10 @@ const (
 	// if alerts for this cluster should be ignored
 	GardenIgnoreAlerts = "shoot.garden.sapcloud.io/ignore-alerts"
 
+	// ShootDisableLogging is the key for an annotation of a Shoot cluster whose value indicates if the logs of its
+	// control plane should not be collected. It is also used as label on the Shoot namespace in the Seed.
+	ShootDisableLogging = "shoot.garden.sapcloud.io/disable-logging"
+
 	// GrafanaOperatorsPrefix is a constant for a prefix used for the operators Grafana instance.
 	GrafanaOperatorsPrefix = "go"
 
@@ -318,18 +337,6 @@ const (
 	// deprecated
 	TerraformerPurposeInfraDeprecated = "infra"
 
-	// TerraformerPurposeInternalDNSDeprecated is a constant for the complete Terraform setup with purpose 'internal cluster domain'
-	// deprecated
-	TerraformerPurposeInternalDNSDeprecated = "internal-dns"
-
-	// TerraformerPurposeExternalDNSDeprecated is a constant for the complete Terraform setup with purpose 'external cluster domain'.
-	// deprecated
-	TerraformerPurposeExternalDNSDeprecated = "external-dns"
-
-	// TerraformerPurposeIngressDNSDeprecated is a constant for the complete Terraform setup with purpose 'ingress domain'.
-	// deprecated
-	TerraformerPurposeIngressDNSDeprecated = "ingress"
-
 	// TerraformerPurposeBackup is a constant for the complete Terraform setup with purpose 'etcd backup'.
 	TerraformerPurposeBackup = "backup"
 
@@ -370,6 +377,39 @@ const (
 	// kubeconfig that is handed out to the user shall be rotated.
 	ShootOperationRotateKubeconfigCredentials = "rotate-kubeconfig-credentials"
 
+	// ShootOperationMigrate is a constant for an annotation on a Shoot indicating that its control plane shall be migrated
+	// to the Seed given in the ShootMigrationTargetSeed annotation.
+	ShootOperationMigrate = "migrate"
+
+	// ShootMigrationTargetSeed is a constant for an annotation on a Shoot which contains the name of the Seed the control
+	// plane shall be migrated to.
+	ShootMigrationTargetSeed = "shoot.garden.sapcloud.io/migration-target-seed"
+
+	// ShootMigrationSourceSeed is a constant for an annotation on a Shoot which contains the name of the Seed the control
+	// plane has been migrated from. It is set by the Gardener as soon as the Shoot has been switched to the target Seed
+	// and removed after the migration has been completed.
+	ShootMigrationSourceSeed = "shoot.garden.sapcloud.io/migration-source-seed"
+
+	// ShootOperationRestoreETCD is a constant for an annotation on a Shoot indicating that its main etcd shall be
+	// restored from the backups to the point in time given in the ShootETCDRestoreTarget annotation.
+	ShootOperationRestoreETCD = "restore-etcd"
+
+	// ShootETCDRestoreTarget is a constant for an annotation on a Shoot which contains the point in time the main etcd
+	// shall be restored to. It is either a timestamp in RFC3339 format or an etcd revision.
+	ShootETCDRestoreTarget = "shoot.garden.sapcloud.io/etcd-restore-target"
+
+	// ShootOperationRollWorkerPools is a constant for an annotation on a Shoot indicating that the nodes of the worker
+	// pools given in the ShootRollWorkerPools annotation shall be replaced.
+	ShootOperationRollWorkerPools = "roll-worker-pools"
+
+	// ShootRollWorkerPools is a constant for an annotation on a Shoot which contains the comma-separated list of the
+	// names of the worker pools whose nodes shall be replaced.
+	ShootRollWorkerPools = "shoot.garden.sapcloud.io/roll-worker-pools"
+
+	// ShootAppliedPresets is a constant for an annotation on a Shoot which contains the comma-separated list of the
+	// (Cluster)ShootPresets that have been applied to the Shoot at creation time, in the order of their application.
+	ShootAppliedPresets = "shoot.garden.sapcloud.io/applied-presets"
+
 	// ShootTasks is a constant for an annotation on a Shoot which states that certain tasks should be done.
 	ShootTasks = "shoot.garden.sapcloud.io/tasks"
 
@@ -415,6 +455,9 @@ const (
 	// BackupNamespacePrefix is a constant for backup namespace created for shoot's backup infrastructure related resources.
 	BackupNamespacePrefix = "backup"
 
+	// ETCDMainBackupPrefix is the prefix under which the snapshots of the main etcd are stored in a backup bucket.
+	ETCDMainBackupPrefix = "etcd-main/v1"
+
 	// GardenerResourceManagerImageName is the name of the GardenerResourceManager image.
 	GardenerResourceManagerImageName = "gardener-resource-manager"
 
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path"
 	"regexp"
 	"sort"
 	"strconv"
@@ -124,6 +125,19 @@ func GenerateBackupEntryName(seedNamespace string, shootUID types.UID) string {
 	return fmt.Sprintf("%s--%s", seedNamespace, shootUID)
 }
 
+// GenerateBackupEntryETCDMainPrefix returns the prefix under which the snapshots of the main etcd are stored in the
+// shared backup bucket for the BackupEntry with the given name.
+func GenerateBackupEntryETCDMainPrefix(backupEntryName string) string {
+	return path.Join(backupEntryName, ETCDMainBackupPrefix)
+}
+
+// GenerateBackupEntryETCDMainFencedPrefix returns the prefix under which the snapshots of the main etcd newer than the
+// given revision are kept when the etcd is restored to this revision. The prefix is not below the one returned by
+// GenerateBackupEntryETCDMainPrefix, hence, the backup sidecar does not see the fenced snapshots.
+func GenerateBackupEntryETCDMainFencedPrefix(backupEntryName string, revision int64) string {
+	return path.Join(backupEntryName, fmt.Sprintf("etcd-main-fenced-%d", revision), "v1")
+}
+
 // ExtractShootDetailsFromBackupEntryName returns Shoot resource technicalID its UID from provided <backupEntryName>.
 func ExtractShootDetailsFromBackupEntryName(backupEntryName string) (shootTechnicalID, shootUID string) {
 	tokens := strings.Split(backupEntryName, "--")
@@ -324,6 +338,25 @@ func DeleteLoggingStack(ctx context.Context, k8sClient client.Client, namespace
 		return errors.New("must provide non-nil kubernetes client to common.DeleteLoggingStack")
 	}
 
+	return deleteLoggingResources(ctx, k8sClient, namespace, nil)
+}
+
+// DeleteElasticsearchLoggingStack deletes all resources of the EFK logging stack in the given namespace except for
+// fluent-bit, i.e., Elasticsearch, Kibana, Curator and Fluentd.
+func DeleteElasticsearchLoggingStack(ctx context.Context, k8sClient client.Client, namespace string) error {
+	if k8sClient == nil {
+		return errors.New("must provide non-nil kubernetes client to common.DeleteElasticsearchLoggingStack")
+	}
+
+	return deleteLoggingResources(ctx, k8sClient, namespace, func(obj metav1.Object) bool {
+		return obj.GetLabels()["app"] == "fluent-bit"
+	})
+}
+
+// deleteLoggingResources deletes all logging resources in the given namespace for which the given keep function does
+// not return true.
+func deleteLoggingResources(ctx context.Context, k8sClient client.Client, namespace string, keep func(metav1.Object) bool) error {
+
 	// Delete the resources below that match "garden.sapcloud.io/role=logging"
 	lists := []runtime.Object{
 		&corev1.ConfigMapList{},
@@ -351,6 +384,15 @@ func DeleteLoggingStack(ctx context.Context, k8sClient client.Client, namespace
 		}
 
 		if err := meta.EachListItem(list, func(obj runtime.Object) error {
+			if keep != nil {
+				accessor, err := meta.Accessor(obj)
+				if err != nil {
+					return err
+				}
+				if keep(accessor) {
+					return nil
+				}
+			}
 			return client.IgnoreNotFound(k8sClient.Delete(ctx, obj, kubernetes.DefaultDeleteOptionFuncs...))
 		}); err != nil {
 			return err
@@ -653,3 +695,48 @@ func WrapWithLastError(err error, lastError *gardencorev1alpha1.LastError) error
 	}
 	return errors2.Wrapf(err, "last error: %s", lastError.Description)
 }
+
+// ETCDRestoreTarget is the point in time the main etcd of a Shoot shall be restored to. Exactly one of the fields is set.
+type ETCDRestoreTarget struct {
+	// Timestamp is the point in time given as a timestamp.
+	Timestamp *time.Time
+	// Revision is the point in time given as an etcd revision.
+	Revision *int64
+}
+
+// String returns the restore target in the format of the ShootETCDRestoreTarget annotation.
+func (t *ETCDRestoreTarget) String() string {
+	if t.Revision != nil {
+		return fmt.Sprintf("revision %d", *t.Revision)
+	}
+	return fmt.Sprintf("timestamp %s", t.Timestamp.UTC().Format(time.RFC3339))
+}
+
+// ParseETCDRestoreTarget parses the value of the ShootETCDRestoreTarget annotation. It accepts either a timestamp in
+// RFC3339 format or a positive etcd revision.
+func ParseETCDRestoreTarget(value string) (*ETCDRestoreTarget, error) {
+	if revision, err := strconv.ParseInt(value, 10, 64); err == nil {
+		if revision <= 0 {
+			return nil, fmt.Errorf("etcd revision must be positive, got %d", revision)
+		}
+		return &ETCDRestoreTarget{Revision: &revision}, nil
+	}
+
+	timestamp, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("restore target %q is neither an etcd revision nor a timestamp in RFC3339 format", value)
+	}
+	return &ETCDRestoreTarget{Timestamp: &timestamp}, nil
+}
+
+// ParseRollWorkerPools parses the value of the ShootRollWorkerPools annotation and returns the names of the worker
+// pools whose nodes shall be replaced.
+func ParseRollWorkerPools(value string) []string {
+	var names []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); len(name) > 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
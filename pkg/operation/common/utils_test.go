@@ -428,4 +428,34 @@ var _ = Describe("common", func() {
 				utils.NewMaintenanceTime(1, 0, 0),
 				utils.NewMaintenanceTime(1, 45, 0))),
 	)
+
+	Describe("#ParseETCDRestoreTarget", func() {
+		It("should parse an etcd revision", func() {
+			target, err := ParseETCDRestoreTarget("1234")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(target.Timestamp).To(BeNil())
+			Expect(*target.Revision).To(Equal(int64(1234)))
+		})
+
+		It("should parse a timestamp", func() {
+			target, err := ParseETCDRestoreTarget("2019-10-01T12:30:00Z")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(target.Revision).To(BeNil())
+			Expect(target.Timestamp.Equal(time.Date(2019, 10, 1, 12, 30, 0, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("should reject a non-positive revision", func() {
+			_, err := ParseETCDRestoreTarget("0")
+
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an invalid value", func() {
+			_, err := ParseETCDRestoreTarget("yesterday")
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
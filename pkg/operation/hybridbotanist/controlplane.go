@@ -151,6 +151,26 @@ func (b *HybridBotanist) deployNetworkPolicies(ctx context.Context, denyAll bool
 	}
 
 	seedNetworks := b.Seed.Info.Spec.Networks
+
+	if b.Shoot.Info.Spec.Networking != nil && len(b.Shoot.Info.Spec.Networking.ControlPlaneEgressDestinations) > 0 {
+		// The control plane must never be allowed to reach the networks of the seed as they give access to the
+		// control planes of other shoots.
+		forbiddenNets := append([]string{seedNetworks.Nodes, seedNetworks.Pods, seedNetworks.Services}, excludeNets...)
+
+		egressNetworks, droppedNetworks, err := common.EgressDestinationNetworks(b.Shoot.Info.Spec.Networking.ControlPlaneEgressDestinations, forbiddenNets...)
+		if err != nil {
+			return err
+		}
+		if len(droppedNetworks) > 0 {
+			b.Logger.Warnf("Ignoring control plane egress destinations %v as they lie within the networks of the seed", droppedNetworks)
+		}
+		egressNetworkValues, err := common.ExceptNetworks(egressNetworks, forbiddenNets...)
+		if err != nil {
+			return err
+		}
+		values["egressDestinations"] = egressNetworkValues
+	}
+
 	allCIDRNetworks := append([]string{seedNetworks.Nodes, seedNetworks.Pods, seedNetworks.Services}, shootCIDRNetworks...)
 	allCIDRNetworks = append(allCIDRNetworks, excludeNets...)
 
@@ -284,7 +304,7 @@ func (b *HybridBotanist) DeployKubeAPIServer() error {
 			defaultValues["replicas"] = *replicas
 		}
 		// If the shoot is hibernated then we want to keep the number of replicas (scale down happens later).
-		if b.Shoot.HibernationEnabled && (replicas == nil || *replicas == 0) {
+		if b.Shoot.ControlPlaneHibernationEnabled && (replicas == nil || *replicas == 0) {
 			defaultValues["replicas"] = 0
 		}
 
@@ -473,7 +493,7 @@ func (b *HybridBotanist) DeployKubeControllerManager() error {
 		"objectCount": b.Shoot.GetNodeCount(),
 	}
 
-	if b.Shoot.HibernationEnabled {
+	if b.Shoot.ControlPlaneHibernationEnabled {
 		replicaCount, err := common.CurrentReplicaCount(b.K8sSeedClient.Client(), b.Shoot.SeedNamespace, v1alpha1constants.DeploymentNameKubeControllerManager)
 		if err != nil {
 			return err
@@ -571,6 +591,13 @@ func (b *HybridBotanist) DeployETCD(ctx context.Context) error {
 			"enabled": hvpaEnabled,
 		},
 		"storageCapacity": b.Seed.GetValidVolumeSize("10Gi"),
+		"servicePorts": map[string]interface{}{
+			"client": common.EtcdClientPort,
+		},
+		"tlsMountPaths": map[string]interface{}{
+			"ca":     common.EtcdCAMountPath,
+			"client": common.EtcdClientTLSMountPath,
+		},
 	}
 
 	etcd, err := b.InjectSeedShootImages(etcdConfig, common.ETCDImageName)
@@ -586,6 +613,7 @@ func (b *HybridBotanist) DeployETCD(ctx context.Context) error {
 			if lastSnapshotRevision > 0 {
 				etcd["failBelowRevision"] = lastSnapshotRevision
 			}
+			etcd["backup"] = ComputeETCDBackupValues(b.Shoot.Info.Spec.Backup, b.ShootBackup)
 		}
 
 		foundEtcd := true
@@ -608,7 +636,7 @@ func (b *HybridBotanist) DeployETCD(ctx context.Context) error {
 			}
 		}
 
-		if b.Shoot.HibernationEnabled {
+		if b.Shoot.ControlPlaneHibernationEnabled {
 			// NOTE: This is for backword compatibility.
 			// Scale up and scale down the etcd, so that it will store atleast one latest backup on new shared bucket.
 			// And we can get rid of old bucket i.e. BackupInfra resources.
@@ -642,6 +670,7 @@ func (b *HybridBotanist) DeployETCD(ctx context.Context) error {
 		}
 
 		delete(etcd, "failBelowRevision")
+		delete(etcd, "backup")
 	}
 
 	return nil
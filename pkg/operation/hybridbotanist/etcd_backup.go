@@ -0,0 +1,48 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+)
+
+// ComputeETCDBackupValues computes the chart values for the backups of the main etcd. The settings in the Shoot
+// specification take precedence over the schedule configured for the Gardener controller manager.
+func ComputeETCDBackupValues(backup *gardenv1beta1.ShootBackup, defaultBackup *config.ShootBackup) map[string]interface{} {
+	values := map[string]interface{}{}
+
+	if defaultBackup != nil && len(defaultBackup.Schedule) > 0 {
+		values["fullSnapshotSchedule"] = defaultBackup.Schedule
+	}
+	if backup == nil {
+		return values
+	}
+
+	if backup.Schedule != nil {
+		values["fullSnapshotSchedule"] = *backup.Schedule
+	}
+	if backup.DeltaSnapshotPeriod != nil {
+		values["deltaSnapshotPeriod"] = backup.DeltaSnapshotPeriod.Duration.String()
+	}
+	if backup.GarbageCollectionPolicy != nil {
+		values["garbageCollectionPolicy"] = string(*backup.GarbageCollectionPolicy)
+	}
+	if backup.MaxBackups != nil {
+		values["maxBackups"] = *backup.MaxBackups
+	}
+
+	return values
+}
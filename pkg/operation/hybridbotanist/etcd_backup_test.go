@@ -0,0 +1,65 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"time"
+
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("etcd backup", func() {
+	Describe("#ComputeETCDBackupValues", func() {
+		defaultBackup := &config.ShootBackup{Schedule: "0 */24 * * *"}
+
+		It("should use the default schedule if the Shoot does not specify backup settings", func() {
+			Expect(ComputeETCDBackupValues(nil, defaultBackup)).To(Equal(map[string]interface{}{
+				"fullSnapshotSchedule": "0 */24 * * *",
+			}))
+		})
+
+		It("should not set any values if neither the Shoot nor the default specify backup settings", func() {
+			Expect(ComputeETCDBackupValues(nil, nil)).To(BeEmpty())
+		})
+
+		It("should prefer the backup settings of the Shoot", func() {
+			var (
+				schedule   = "0 */12 * * *"
+				limitBased = gardenv1beta1.BackupGarbageCollectionPolicyLimitBased
+				maxBackups = int32(7)
+			)
+
+			values := ComputeETCDBackupValues(&gardenv1beta1.ShootBackup{
+				Schedule:                &schedule,
+				DeltaSnapshotPeriod:     &metav1.Duration{Duration: 5 * time.Minute},
+				GarbageCollectionPolicy: &limitBased,
+				MaxBackups:              &maxBackups,
+			}, defaultBackup)
+
+			Expect(values).To(Equal(map[string]interface{}{
+				"fullSnapshotSchedule":    "0 */12 * * *",
+				"deltaSnapshotPeriod":     "5m0s",
+				"garbageCollectionPolicy": "LimitBased",
+				"maxBackups":              int32(7),
+			}))
+		})
+	})
+})
@@ -0,0 +1,241 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/operation/cloudbotanist"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// SelectETCDRestoreSnapshots selects the full snapshot and the delta snapshots from the given list which restore the
+// etcd to the given target. The latest full snapshot taken before the target is combined with all delta snapshots
+// taken after it and before the target. Chunks of snapshots which are still being uploaded are ignored.
+func SelectETCDRestoreSnapshots(snapList snapstore.SnapList, target *common.ETCDRestoreTarget) (*snapstore.Snapshot, snapstore.SnapList, error) {
+	var candidates snapstore.SnapList
+	for _, snapshot := range snapList {
+		if snapshot.IsChunk {
+			continue
+		}
+		if target.Revision != nil && snapshot.LastRevision > *target.Revision {
+			continue
+		}
+		if target.Timestamp != nil && snapshot.CreatedOn.After(*target.Timestamp) {
+			continue
+		}
+		candidates = append(candidates, snapshot)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].LastRevision < candidates[j].LastRevision
+	})
+
+	var (
+		fullSnapshot   *snapstore.Snapshot
+		deltaSnapshots snapstore.SnapList
+	)
+	for _, snapshot := range candidates {
+		if snapshot.Kind == snapstore.SnapshotKindFull {
+			fullSnapshot = snapshot
+			deltaSnapshots = nil
+			continue
+		}
+		if fullSnapshot != nil && snapshot.StartRevision > fullSnapshot.LastRevision {
+			deltaSnapshots = append(deltaSnapshots, snapshot)
+		}
+	}
+
+	if fullSnapshot == nil {
+		return nil, nil, fmt.Errorf("no full snapshot of the main etcd found before %s", target)
+	}
+	return fullSnapshot, deltaSnapshots, nil
+}
+
+// FenceETCDSnapshots moves all snapshots newer than the given revision from <store> to <fenceStore>. The backup
+// sidecar restores the latest full snapshot and all delta snapshots after it, hence, it restores the etcd exactly to
+// the given revision once the newer snapshots are fenced. Chunks of incomplete uploads are ignored by the sidecar and
+// are left untouched. Snapshots which have already been fenced are not listed anymore, so the function can be retried.
+// It returns the number of fenced snapshots.
+func FenceETCDSnapshots(store, fenceStore snapstore.SnapStore, revision int64) (int, error) {
+	snapList, err := store.List()
+	if err != nil {
+		return 0, err
+	}
+
+	var fenced int
+	for _, snapshot := range snapList {
+		if snapshot.IsChunk || snapshot.LastRevision <= revision {
+			continue
+		}
+
+		rc, err := store.Fetch(*snapshot)
+		if err != nil {
+			return fenced, fmt.Errorf("could not fetch snapshot %s: %v", snapshot.SnapName, err)
+		}
+		if err := fenceStore.Save(*snapshot, rc); err != nil {
+			return fenced, fmt.Errorf("could not fence snapshot %s: %v", snapshot.SnapName, err)
+		}
+		if err := store.Delete(*snapshot); err != nil {
+			return fenced, fmt.Errorf("could not delete fenced snapshot %s: %v", snapshot.SnapName, err)
+		}
+		fenced++
+	}
+	return fenced, nil
+}
+
+// etcdMainBackupEntryName returns the name of the BackupEntry holding the snapshots of the main etcd.
+func (b *HybridBotanist) etcdMainBackupEntryName() string {
+	return common.GenerateBackupEntryName(b.Shoot.SeedNamespace, b.Shoot.Info.Status.UID)
+}
+
+// newETCDMainSnapstore returns the snapstore for the given prefix in the bucket of the BackupEntry of the Shoot. It
+// uses the backup secret in the Shoot namespace of the Seed which is also used by the backup sidecar of the etcd.
+func (b *HybridBotanist) newETCDMainSnapstore(ctx context.Context, prefix string) (snapstore.SnapStore, error) {
+	if b.Seed.Info.Spec.Backup == nil {
+		return nil, fmt.Errorf("seed %s does not have backups enabled", b.Seed.Info.Name)
+	}
+
+	secret := &corev1.Secret{}
+	if err := b.K8sSeedClient.Client().Get(ctx, kutil.Key(b.Shoot.SeedNamespace, common.BackupSecretName), secret); err != nil {
+		return nil, fmt.Errorf("could not read the etcd backup secret: %v", err)
+	}
+	return cloudbotanist.NewEtcdBackupSnapstore(b.Seed.Info.Spec.Backup.Provider, secret.Data, prefix)
+}
+
+// DetermineETCDRestoreRevision determines the revision the main etcd is restored to when restoring it to the given
+// target. It is the last revision of the snapshots selected by SelectETCDRestoreSnapshots. Shoots whose snapshots are
+// still stored by a BackupInfrastructure cannot be restored, because the sidecar refuses to restore revisions below
+// the latest snapshot in the old bucket.
+func (b *HybridBotanist) DetermineETCDRestoreRevision(ctx context.Context, target *common.ETCDRestoreTarget) (int64, error) {
+	backupInfra := &gardenv1beta1.BackupInfrastructure{}
+	backupInfraName := common.GenerateBackupInfrastructureName(b.Shoot.Info.Status.TechnicalID, b.Shoot.Info.Status.UID)
+	if err := b.K8sGardenClient.Client().Get(ctx, kutil.Key(b.Shoot.Info.Namespace, backupInfraName), backupInfra); err == nil {
+		return 0, fmt.Errorf("the snapshots of the shoot are still stored by backup infrastructure %s, it cannot be restored to an earlier point in time", backupInfraName)
+	} else if !apierrors.IsNotFound(err) {
+		return 0, err
+	}
+
+	store, err := b.newETCDMainSnapstore(ctx, common.GenerateBackupEntryETCDMainPrefix(b.etcdMainBackupEntryName()))
+	if err != nil {
+		return 0, err
+	}
+	snapList, err := store.List()
+	if err != nil {
+		return 0, err
+	}
+
+	fullSnapshot, deltaSnapshots, err := SelectETCDRestoreSnapshots(snapList, target)
+	if err != nil {
+		return 0, err
+	}
+
+	revision := fullSnapshot.LastRevision
+	if len(deltaSnapshots) > 0 {
+		revision = deltaSnapshots[len(deltaSnapshots)-1].LastRevision
+	}
+	b.Logger.Infof("Restoring main etcd to %s from full snapshot %s and %d delta snapshot(s) up to revision %d", target, fullSnapshot.SnapName, len(deltaSnapshots), revision)
+	return revision, nil
+}
+
+// FenceETCDMainSnapshots moves all snapshots of the main etcd newer than the given revision out of the prefix the
+// backup sidecar restores from, see FenceETCDSnapshots. The etcd must have been scaled down before such that no new
+// snapshots are written. The fenced snapshots are kept below the prefix returned by ETCDMainFencedPrefix.
+func (b *HybridBotanist) FenceETCDMainSnapshots(ctx context.Context, revision int64) error {
+	store, err := b.newETCDMainSnapstore(ctx, common.GenerateBackupEntryETCDMainPrefix(b.etcdMainBackupEntryName()))
+	if err != nil {
+		return err
+	}
+	fenceStore, err := b.newETCDMainSnapstore(ctx, b.ETCDMainFencedPrefix(revision))
+	if err != nil {
+		return err
+	}
+
+	fenced, err := FenceETCDSnapshots(store, fenceStore, revision)
+	if err != nil {
+		return err
+	}
+	b.Logger.Infof("Fenced %d snapshot(s) of the main etcd newer than revision %d", fenced, revision)
+	return nil
+}
+
+// ETCDMainFencedPrefix returns the prefix in the backup bucket below which the snapshots of the main etcd newer than
+// the given revision are kept.
+func (b *HybridBotanist) ETCDMainFencedPrefix(revision int64) string {
+	return common.GenerateBackupEntryETCDMainFencedPrefix(b.etcdMainBackupEntryName(), revision)
+}
+
+// VerifyETCDMainRevision checks that the main etcd reports the given revision. It must be called after the etcd has
+// been restored and before any control plane component accessing the etcd is scaled up again.
+func (b *HybridBotanist) VerifyETCDMainRevision(ctx context.Context, revision int64) error {
+	reader, err := kubernetes.NewPodExecutor(b.K8sSeedClient.RESTConfig()).Execute(ctx, b.Shoot.SeedNamespace, fmt.Sprintf("%s-0", v1alpha1constants.StatefulSetNameETCDMain), "etcd", ETCDMainRevisionCommand())
+	if err != nil {
+		return fmt.Errorf("could not read the revision of the main etcd: %v", err)
+	}
+	output, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return err
+	}
+
+	actual, err := ParseETCDRevision(output)
+	if err != nil {
+		return err
+	}
+	if actual != revision {
+		return fmt.Errorf("main etcd reports revision %d but has been restored from the snapshots up to revision %d", actual, revision)
+	}
+	return nil
+}
+
+// ETCDMainRevisionCommand returns the etcdctl command printing the current revision of the main etcd as part of the
+// response header. It uses the client port and the certificate paths the etcd chart is deployed with, see DeployETCD.
+func ETCDMainRevisionCommand() string {
+	var (
+		endpoint = fmt.Sprintf("https://%s-0:%d", v1alpha1constants.StatefulSetNameETCDMain, common.EtcdClientPort)
+		cert     = path.Join(common.EtcdClientTLSMountPath, "tls.crt")
+		key      = path.Join(common.EtcdClientTLSMountPath, "tls.key")
+		caCert   = path.Join(common.EtcdCAMountPath, "ca.crt")
+	)
+	return fmt.Sprintf("ETCDCTL_API=3 etcdctl --cert=%s --key=%s --cacert=%s --endpoints=%s get foo -w json", cert, key, caCert, endpoint)
+}
+
+// ParseETCDRevision returns the revision from the response header in the given JSON output of etcdctl.
+func ParseETCDRevision(output []byte) (int64, error) {
+	response := struct {
+		Header *struct {
+			Revision int64 `json:"revision"`
+		} `json:"header"`
+	}{}
+	if err := json.Unmarshal(output, &response); err != nil {
+		return 0, fmt.Errorf("could not parse the response of etcd: %v", err)
+	}
+	if response.Header == nil {
+		return 0, fmt.Errorf("response of etcd does not contain a header")
+	}
+	return response.Header.Revision, nil
+}
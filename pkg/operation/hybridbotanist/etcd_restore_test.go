@@ -0,0 +1,178 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hybridbotanist_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/operation/hybridbotanist"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("etcd restore", func() {
+	Describe("#SelectETCDRestoreSnapshots", func() {
+		var (
+			base = time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+
+			full1  = &snapstore.Snapshot{Kind: snapstore.SnapshotKindFull, StartRevision: 0, LastRevision: 100, CreatedOn: base, SnapName: "full1"}
+			delta1 = &snapstore.Snapshot{Kind: snapstore.SnapshotKindDelta, StartRevision: 101, LastRevision: 150, CreatedOn: base.Add(time.Hour), SnapName: "delta1"}
+			delta2 = &snapstore.Snapshot{Kind: snapstore.SnapshotKindDelta, StartRevision: 151, LastRevision: 200, CreatedOn: base.Add(2 * time.Hour), SnapName: "delta2"}
+			full2  = &snapstore.Snapshot{Kind: snapstore.SnapshotKindFull, StartRevision: 0, LastRevision: 250, CreatedOn: base.Add(3 * time.Hour), SnapName: "full2"}
+			delta3 = &snapstore.Snapshot{Kind: snapstore.SnapshotKindDelta, StartRevision: 251, LastRevision: 300, CreatedOn: base.Add(4 * time.Hour), SnapName: "delta3"}
+			chunk  = &snapstore.Snapshot{Kind: snapstore.SnapshotKindFull, StartRevision: 0, LastRevision: 120, CreatedOn: base.Add(time.Hour), SnapName: "chunk", IsChunk: true}
+
+			snapList = snapstore.SnapList{full1, delta1, chunk, delta2, full2, delta3}
+		)
+
+		revision := func(revision int64) *common.ETCDRestoreTarget {
+			return &common.ETCDRestoreTarget{Revision: &revision}
+		}
+		timestamp := func(timestamp time.Time) *common.ETCDRestoreTarget {
+			return &common.ETCDRestoreTarget{Timestamp: &timestamp}
+		}
+
+		It("should select the latest full snapshot and the delta snapshots up to the revision", func() {
+			fullSnapshot, deltaSnapshots, err := SelectETCDRestoreSnapshots(snapList, revision(220))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fullSnapshot).To(Equal(full1))
+			Expect(deltaSnapshots).To(Equal(snapstore.SnapList{delta1, delta2}))
+		})
+
+		It("should select the snapshots taken before the timestamp", func() {
+			fullSnapshot, deltaSnapshots, err := SelectETCDRestoreSnapshots(snapList, timestamp(base.Add(90*time.Minute)))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fullSnapshot).To(Equal(full1))
+			Expect(deltaSnapshots).To(Equal(snapstore.SnapList{delta1}))
+		})
+
+		It("should not select delta snapshots taken before the full snapshot", func() {
+			fullSnapshot, deltaSnapshots, err := SelectETCDRestoreSnapshots(snapList, revision(1000))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fullSnapshot).To(Equal(full2))
+			Expect(deltaSnapshots).To(Equal(snapstore.SnapList{delta3}))
+		})
+
+		It("should fail if there is no full snapshot before the target", func() {
+			_, _, err := SelectETCDRestoreSnapshots(snapList, revision(50))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#FenceETCDSnapshots", func() {
+		var (
+			dir        string
+			store      snapstore.SnapStore
+			fenceStore snapstore.SnapStore
+		)
+
+		save := func(store snapstore.SnapStore, snapshot *snapstore.Snapshot) {
+			Expect(store.Save(*snapshot, ioutil.NopCloser(strings.NewReader(snapshot.SnapName)))).To(Succeed())
+		}
+		names := func(store snapstore.SnapStore) []string {
+			snapList, err := store.List()
+			Expect(err).NotTo(HaveOccurred())
+
+			var names []string
+			for _, snapshot := range snapList {
+				names = append(names, snapshot.SnapName)
+			}
+			return names
+		}
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "etcd-restore")
+			Expect(err).NotTo(HaveOccurred())
+
+			store, err = snapstore.NewLocalSnapStore(filepath.Join(dir, "etcd-main"))
+			Expect(err).NotTo(HaveOccurred())
+			fenceStore, err = snapstore.NewLocalSnapStore(filepath.Join(dir, "etcd-main-fenced"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(dir)).To(Succeed())
+		})
+
+		It("should move the snapshots newer than the revision to the fence store", func() {
+			var (
+				full1  = snapstore.NewSnapshot(snapstore.SnapshotKindFull, 0, 100)
+				delta1 = snapstore.NewSnapshot(snapstore.SnapshotKindDelta, 101, 150)
+				delta2 = snapstore.NewSnapshot(snapstore.SnapshotKindDelta, 151, 200)
+				full2  = snapstore.NewSnapshot(snapstore.SnapshotKindFull, 0, 250)
+			)
+			for _, snapshot := range []*snapstore.Snapshot{full1, delta1, delta2, full2} {
+				save(store, snapshot)
+			}
+
+			fenced, err := FenceETCDSnapshots(store, fenceStore, 150)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fenced).To(Equal(2))
+			Expect(names(store)).To(ConsistOf(full1.SnapName, delta1.SnapName))
+			Expect(names(fenceStore)).To(ConsistOf(delta2.SnapName, full2.SnapName))
+
+			rc, err := fenceStore.Fetch(*full2)
+			Expect(err).NotTo(HaveOccurred())
+			defer rc.Close()
+			Expect(ioutil.ReadAll(rc)).To(Equal([]byte(full2.SnapName)))
+		})
+
+		It("should not fence anything if there are no newer snapshots", func() {
+			full := snapstore.NewSnapshot(snapstore.SnapshotKindFull, 0, 100)
+			save(store, full)
+
+			fenced, err := FenceETCDSnapshots(store, fenceStore, 100)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fenced).To(BeZero())
+			Expect(names(store)).To(ConsistOf(full.SnapName))
+			Expect(names(fenceStore)).To(BeEmpty())
+		})
+	})
+
+	Describe("#ETCDMainRevisionCommand", func() {
+		It("should use the client port and certificates the etcd is deployed with", func() {
+			Expect(ETCDMainRevisionCommand()).To(Equal("ETCDCTL_API=3 etcdctl --cert=/var/etcd/ssl/client/tls.crt --key=/var/etcd/ssl/client/tls.key --cacert=/var/etcd/ssl/ca/ca.crt --endpoints=https://etcd-main-0:2379 get foo -w json"))
+		})
+	})
+
+	Describe("#ParseETCDRevision", func() {
+		It("should return the revision from the response header", func() {
+			revision, err := ParseETCDRevision([]byte(`{"header":{"cluster_id":1,"member_id":2,"revision":4711,"raft_term":3}}`))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(Equal(int64(4711)))
+		})
+
+		It("should fail if the response does not contain a header", func() {
+			_, err := ParseETCDRevision([]byte(`{}`))
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
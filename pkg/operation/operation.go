@@ -130,6 +130,7 @@ func newOperation(
 		operation.Shoot = shootObj
 		operation.Shoot.IgnoreAlerts = helper.ShootIgnoreAlerts(shoot)
 		operation.Shoot.WantsAlertmanager = helper.ShootWantsAlertmanager(shoot, secrets) && !operation.Shoot.IgnoreAlerts
+		operation.Shoot.WantsLogging = !helper.ShootLoggingDisabled(shoot)
 
 		shootedSeed, err := helper.ReadShootedSeed(shoot)
 		if err != nil {
@@ -369,6 +370,12 @@ func (o *Operation) InjectShootShootImages(values map[string]interface{}, names
 	return o.injectImages(values, names, imagevector.RuntimeVersion(o.ShootVersion()), imagevector.TargetVersion(o.ShootVersion()))
 }
 
+// InjectShootWorkerImages injects images that shall run on the nodes of a Shoot worker pool and target the given
+// Kubernetes version of the worker pool.
+func (o *Operation) InjectShootWorkerImages(values map[string]interface{}, kubernetesVersion string, names ...string) (map[string]interface{}, error) {
+	return o.injectImages(values, names, imagevector.RuntimeVersion(kubernetesVersion), imagevector.TargetVersion(kubernetesVersion))
+}
+
 func (o *Operation) newTerraformer(purpose, namespace, name string) (*terraformer.Terraformer, error) {
 	image, err := o.ImageVector.FindImage(common.TerraformerImageName, imagevector.RuntimeVersion(o.K8sSeedClient.Version()), imagevector.TargetVersion(o.K8sSeedClient.Version()))
 	if err != nil {
@@ -0,0 +1,318 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	controllermanagerconfig "github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllermanagerfeatures "github.com/gardener/gardener/pkg/controllermanager/features"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/operation/common"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// excessCapacityPerReplica are the resources requested by a single replica of the reserve-excess-capacity
+	// deployment.
+	excessCapacityPerReplica = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("500m"),
+		corev1.ResourceMemory: resource.MustParse("1200Mi"),
+	}
+
+	capacityResourceNames = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+)
+
+// CheckHealth computes the capacity of the Seed cluster and checks the health of the components deployed by
+// BootstrapCluster. It returns the capacity and one condition per component, updated from the given conditions.
+func CheckHealth(seed *Seed, config *controllermanagerconfig.ControllerManagerConfiguration, numberOfAssociatedShoots int, conditions []gardencorev1alpha1.Condition) (*gardenv1beta1.SeedCapacity, []gardencorev1alpha1.Condition, error) {
+	ctx := context.TODO()
+
+	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seed.Secret,
+		kubernetes.WithClientConnectionOptions(config.SeedClientConnection),
+		kubernetes.WithClientOptions(client.Options{
+			Scheme: kubernetes.SeedScheme,
+		}),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	capacity, err := ComputeCapacity(ctx, k8sSeedClient.Client(), seed, numberOfAssociatedShoots)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return capacity, CheckComponents(ctx, k8sSeedClient.Client(), seed, config, conditions), nil
+}
+
+// seedComponent is a group of workloads deployed into the garden namespace of the Seed cluster by BootstrapCluster.
+// Components which are not deployed by Gardener are found by the labels of their workloads in the given namespace.
+type seedComponent struct {
+	conditionType gardencorev1alpha1.ConditionType
+	name          string
+	enabled       bool
+	deployments   []string
+	statefulSets  []string
+	daemonSets    []string
+
+	namespace      string
+	workloadLabels map[string]string
+}
+
+// nginxIngressLabels are the labels of the nginx-ingress controller which must be deployed into the kube-system
+// namespace of every Seed cluster, see docs/deployment/kubernetes.md.
+var nginxIngressLabels = map[string]string{
+	"app":       "nginx-ingress",
+	"component": "controller",
+}
+
+func seedComponents(seed *Seed, config *controllermanagerconfig.ControllerManagerConfiguration) []seedComponent {
+	var (
+		loggingEnabled       = controllermanagerfeatures.FeatureGate.Enabled(features.Logging)
+		elasticsearchEnabled = loggingEnabled && GetLoggingBackend(config) == controllermanagerconfig.LoggingBackendElasticsearch
+		autoscalers          = []string{"vpa-admission-controller", "vpa-recommender", "vpa-updater"}
+		loggingDeployments   []string
+		loggingStatefulSets  []string
+	)
+
+	if controllermanagerfeatures.FeatureGate.Enabled(features.HVPA) {
+		autoscalers = append(autoscalers, "hvpa-controller")
+	}
+	if elasticsearchEnabled {
+		loggingDeployments = []string{"kibana-logging"}
+		loggingStatefulSets = []string{common.FluentdEsStatefulSetName, "elasticsearch-logging"}
+	}
+
+	return []seedComponent{
+		{
+			conditionType: gardenv1beta1.SeedMonitoringHealthy,
+			name:          "monitoring",
+			enabled:       true,
+			deployments:   []string{"grafana"},
+			statefulSets:  []string{"prometheus", "aggregate-prometheus", "alertmanager"},
+		},
+		{
+			conditionType: gardenv1beta1.SeedLoggingHealthy,
+			name:          "logging",
+			enabled:       loggingEnabled,
+			deployments:   loggingDeployments,
+			statefulSets:  loggingStatefulSets,
+			daemonSets:    []string{"fluent-bit"},
+		},
+		{
+			conditionType: gardenv1beta1.SeedAutoscalingHealthy,
+			name:          "autoscaling",
+			enabled:       true,
+			deployments:   autoscalers,
+		},
+		{
+			conditionType: gardenv1beta1.SeedResourceManagerHealthy,
+			name:          "resource manager",
+			enabled:       true,
+			deployments:   []string{"gardener-resource-manager"},
+		},
+		{
+			conditionType: gardenv1beta1.SeedExcessCapacityReserved,
+			name:          "excess capacity reservation",
+			enabled:       seed.reserveExcessCapacity,
+			deployments:   []string{"reserve-excess-capacity"},
+		},
+		{
+			conditionType:  gardenv1beta1.SeedIngressHealthy,
+			name:           "nginx-ingress controller",
+			enabled:        true,
+			namespace:      metav1.NamespaceSystem,
+			workloadLabels: nginxIngressLabels,
+		},
+	}
+}
+
+// CheckComponents checks the health of the components deployed by BootstrapCluster into the Seed cluster and
+// returns one condition per component, updated from the given conditions.
+func CheckComponents(ctx context.Context, c client.Client, seed *Seed, config *controllermanagerconfig.ControllerManagerConfiguration, conditions []gardencorev1alpha1.Condition) []gardencorev1alpha1.Condition {
+	var updated []gardencorev1alpha1.Condition
+
+	for _, component := range seedComponents(seed, config) {
+		condition := gardencorev1alpha1helper.GetOrInitCondition(conditions, component.conditionType)
+
+		if !component.enabled {
+			updated = append(updated, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ComponentDisabled", fmt.Sprintf("The %s is disabled.", component.name)))
+			continue
+		}
+
+		if err := checkComponent(ctx, c, component); err != nil {
+			if apierrors.IsNotFound(err) || isUnhealthyError(err) {
+				updated = append(updated, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionFalse, "ComponentUnhealthy", err.Error()))
+			} else {
+				updated = append(updated, gardencorev1alpha1helper.UpdatedConditionUnknownError(condition, err))
+			}
+			continue
+		}
+
+		updated = append(updated, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "ComponentHealthy", fmt.Sprintf("The %s is healthy.", component.name)))
+	}
+
+	return updated
+}
+
+type unhealthyError struct {
+	error
+}
+
+func isUnhealthyError(err error) bool {
+	_, ok := err.(*unhealthyError)
+	return ok
+}
+
+func checkComponent(ctx context.Context, c client.Client, component seedComponent) error {
+	if component.workloadLabels != nil {
+		return checkLabeledWorkloads(ctx, c, component)
+	}
+
+	for _, name := range component.deployments {
+		deployment := &appsv1.Deployment{}
+		if err := c.Get(ctx, kutil.Key(common.GardenNamespace, name), deployment); err != nil {
+			return err
+		}
+		if err := health.CheckDeployment(deployment); err != nil {
+			return &unhealthyError{fmt.Errorf("deployment %s is unhealthy: %v", name, err)}
+		}
+	}
+
+	for _, name := range component.statefulSets {
+		statefulSet := &appsv1.StatefulSet{}
+		if err := c.Get(ctx, kutil.Key(common.GardenNamespace, name), statefulSet); err != nil {
+			return err
+		}
+		if err := health.CheckStatefulSet(statefulSet); err != nil {
+			return &unhealthyError{fmt.Errorf("stateful set %s is unhealthy: %v", name, err)}
+		}
+	}
+
+	for _, name := range component.daemonSets {
+		daemonSet := &appsv1.DaemonSet{}
+		if err := c.Get(ctx, kutil.Key(common.GardenNamespace, name), daemonSet); err != nil {
+			return err
+		}
+		if err := health.CheckDaemonSet(daemonSet); err != nil {
+			return &unhealthyError{fmt.Errorf("daemon set %s is unhealthy: %v", name, err)}
+		}
+	}
+
+	return nil
+}
+
+// checkLabeledWorkloads checks the deployments and daemon sets with the labels of the given component. At least one
+// such workload must exist.
+func checkLabeledWorkloads(ctx context.Context, c client.Client, component seedComponent) error {
+	var (
+		selector    = client.MatchingLabels(component.workloadLabels)
+		namespace   = client.InNamespace(component.namespace)
+		deployments = &appsv1.DeploymentList{}
+		daemonSets  = &appsv1.DaemonSetList{}
+	)
+
+	if err := c.List(ctx, deployments, namespace, selector); err != nil {
+		return err
+	}
+	if err := c.List(ctx, daemonSets, namespace, selector); err != nil {
+		return err
+	}
+	if len(deployments.Items) == 0 && len(daemonSets.Items) == 0 {
+		return &unhealthyError{fmt.Errorf("no deployment or daemon set with labels %v found in namespace %s", component.workloadLabels, component.namespace)}
+	}
+
+	for _, deployment := range deployments.Items {
+		if err := health.CheckDeployment(&deployment); err != nil {
+			return &unhealthyError{fmt.Errorf("deployment %s/%s is unhealthy: %v", deployment.Namespace, deployment.Name, err)}
+		}
+	}
+	for _, daemonSet := range daemonSets.Items {
+		if err := health.CheckDaemonSet(&daemonSet); err != nil {
+			return &unhealthyError{fmt.Errorf("daemon set %s/%s is unhealthy: %v", daemonSet.Namespace, daemonSet.Name, err)}
+		}
+	}
+
+	return nil
+}
+
+// ComputeCapacity computes the allocatable and requested CPU and memory of the Seed cluster, the excess capacity
+// reserved for new shoot control planes and the number of hosted shoot control planes.
+func ComputeCapacity(ctx context.Context, c client.Client, seed *Seed, numberOfAssociatedShoots int) (*gardenv1beta1.SeedCapacity, error) {
+	capacity := &gardenv1beta1.SeedCapacity{
+		Allocatable:        corev1.ResourceList{},
+		Requested:          corev1.ResourceList{},
+		ShootControlPlanes: numberOfAssociatedShoots,
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		return nil, err
+	}
+	for _, node := range nodes.Items {
+		addResources(capacity.Allocatable, node.Status.Allocatable)
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods); err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			addResources(capacity.Requested, container.Resources.Requests)
+		}
+	}
+
+	if seed.reserveExcessCapacity {
+		capacity.ExcessCapacityReserved = corev1.ResourceList{}
+		for i := 0; i < DesiredExcessCapacity(numberOfAssociatedShoots); i++ {
+			addResources(capacity.ExcessCapacityReserved, excessCapacityPerReplica)
+		}
+	}
+
+	return capacity, nil
+}
+
+func addResources(sum, resources corev1.ResourceList) {
+	for _, name := range capacityResourceNames {
+		quantity, ok := resources[name]
+		if !ok {
+			continue
+		}
+		if current, ok := sum[name]; ok {
+			current.Add(quantity)
+			sum[name] = current
+		} else {
+			sum[name] = quantity.DeepCopy()
+		}
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package seed_test
+
+import (
+	"context"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/operation/seed"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var _ = Describe("health", func() {
+	Describe("#CheckComponents", func() {
+		var (
+			ctx = context.TODO()
+
+			healthyDeployment = func(namespace, name string, labels map[string]string) *appsv1.Deployment {
+				return &appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+					Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{
+						{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue},
+					}},
+				}
+			}
+			unhealthyDeployment = func(namespace, name string, labels map[string]string) *appsv1.Deployment {
+				deployment := healthyDeployment(namespace, name, labels)
+				deployment.Status.Conditions[0].Status = corev1.ConditionFalse
+				return deployment
+			}
+			healthyStatefulSet = func(name string) *appsv1.StatefulSet {
+				return &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden"},
+					Status:     appsv1.StatefulSetStatus{ReadyReplicas: 1},
+				}
+			}
+			unhealthyStatefulSet = func(name string) *appsv1.StatefulSet {
+				statefulSet := healthyStatefulSet(name)
+				statefulSet.Status.ReadyReplicas = 0
+				return statefulSet
+			}
+
+			nginxIngressLabels = map[string]string{"app": "nginx-ingress", "component": "controller"}
+
+			// healthyObjects returns healthy workloads of all components enabled by default, replacing the objects
+			// with the same name by the given ones.
+			healthyObjects = func(replacements ...runtime.Object) []runtime.Object {
+				objects := map[string]runtime.Object{
+					"grafana":                   healthyDeployment("garden", "grafana", nil),
+					"prometheus":                healthyStatefulSet("prometheus"),
+					"aggregate-prometheus":      healthyStatefulSet("aggregate-prometheus"),
+					"alertmanager":              healthyStatefulSet("alertmanager"),
+					"vpa-admission-controller":  healthyDeployment("garden", "vpa-admission-controller", nil),
+					"vpa-recommender":           healthyDeployment("garden", "vpa-recommender", nil),
+					"vpa-updater":               healthyDeployment("garden", "vpa-updater", nil),
+					"gardener-resource-manager": healthyDeployment("garden", "gardener-resource-manager", nil),
+					"nginx-ingress-controller":  healthyDeployment("kube-system", "nginx-ingress-controller", nginxIngressLabels),
+				}
+				for _, obj := range replacements {
+					objects[obj.(metav1.Object).GetName()] = obj
+				}
+				var result []runtime.Object
+				for _, obj := range objects {
+					result = append(result, obj)
+				}
+				return result
+			}
+			without = func(objects []runtime.Object, name string) []runtime.Object {
+				var result []runtime.Object
+				for _, obj := range objects {
+					if obj.(metav1.Object).GetName() != name {
+						result = append(result, obj)
+					}
+				}
+				return result
+			}
+		)
+
+		DescribeTable("should report one condition per component",
+			func(objects []runtime.Object, expectedStatuses map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus) {
+				conditions := CheckComponents(ctx, fake.NewFakeClient(objects...), &Seed{}, &config.ControllerManagerConfiguration{}, nil)
+
+				Expect(conditions).To(HaveLen(6))
+				for conditionType, status := range expectedStatuses {
+					condition := gardencorev1alpha1helper.GetCondition(conditions, conditionType)
+					Expect(condition).NotTo(BeNil(), string(conditionType))
+					Expect(condition.Status).To(Equal(status), string(conditionType))
+				}
+			},
+
+			Entry("all components healthy", healthyObjects(), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedMonitoringHealthy:      gardencorev1alpha1.ConditionTrue,
+				gardenv1beta1.SeedLoggingHealthy:         gardencorev1alpha1.ConditionTrue,
+				gardenv1beta1.SeedAutoscalingHealthy:     gardencorev1alpha1.ConditionTrue,
+				gardenv1beta1.SeedResourceManagerHealthy: gardencorev1alpha1.ConditionTrue,
+				gardenv1beta1.SeedExcessCapacityReserved: gardencorev1alpha1.ConditionTrue,
+				gardenv1beta1.SeedIngressHealthy:         gardencorev1alpha1.ConditionTrue,
+			}),
+			Entry("unhealthy monitoring stateful set", healthyObjects(unhealthyStatefulSet("prometheus")), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedMonitoringHealthy:  gardencorev1alpha1.ConditionFalse,
+				gardenv1beta1.SeedAutoscalingHealthy: gardencorev1alpha1.ConditionTrue,
+			}),
+			Entry("missing monitoring deployment", without(healthyObjects(), "grafana"), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedMonitoringHealthy: gardencorev1alpha1.ConditionFalse,
+				gardenv1beta1.SeedIngressHealthy:    gardencorev1alpha1.ConditionTrue,
+			}),
+			Entry("unhealthy autoscaler", healthyObjects(unhealthyDeployment("garden", "vpa-updater", nil)), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedAutoscalingHealthy:     gardencorev1alpha1.ConditionFalse,
+				gardenv1beta1.SeedResourceManagerHealthy: gardencorev1alpha1.ConditionTrue,
+			}),
+			Entry("unhealthy resource manager", healthyObjects(unhealthyDeployment("garden", "gardener-resource-manager", nil)), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedResourceManagerHealthy: gardencorev1alpha1.ConditionFalse,
+				gardenv1beta1.SeedMonitoringHealthy:      gardencorev1alpha1.ConditionTrue,
+			}),
+			Entry("unhealthy nginx-ingress controller", healthyObjects(unhealthyDeployment("kube-system", "nginx-ingress-controller", nginxIngressLabels)), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedIngressHealthy:    gardencorev1alpha1.ConditionFalse,
+				gardenv1beta1.SeedMonitoringHealthy: gardencorev1alpha1.ConditionTrue,
+			}),
+			Entry("missing nginx-ingress controller", without(healthyObjects(), "nginx-ingress-controller"), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedIngressHealthy: gardencorev1alpha1.ConditionFalse,
+			}),
+			Entry("nginx-ingress controller with other labels", healthyObjects(healthyDeployment("kube-system", "nginx-ingress-controller", map[string]string{"app": "other"})), map[gardencorev1alpha1.ConditionType]gardencorev1alpha1.ConditionStatus{
+				gardenv1beta1.SeedIngressHealthy: gardencorev1alpha1.ConditionFalse,
+			}),
+		)
+
+		It("should report disabled components as healthy", func() {
+			conditions := CheckComponents(ctx, fake.NewFakeClient(healthyObjects()...), &Seed{}, &config.ControllerManagerConfiguration{}, nil)
+
+			for _, conditionType := range []gardencorev1alpha1.ConditionType{gardenv1beta1.SeedLoggingHealthy, gardenv1beta1.SeedExcessCapacityReserved} {
+				condition := gardencorev1alpha1helper.GetCondition(conditions, conditionType)
+				Expect(condition).NotTo(BeNil())
+				Expect(condition.Reason).To(Equal("ComponentDisabled"))
+			}
+		})
+
+		It("should check the reserve-excess-capacity deployment if enabled", func() {
+			seed := &Seed{}
+			seed.MustReserveExcessCapacity(true)
+
+			conditions := CheckComponents(ctx, fake.NewFakeClient(healthyObjects()...), seed, &config.ControllerManagerConfiguration{}, nil)
+
+			condition := gardencorev1alpha1helper.GetCondition(conditions, gardenv1beta1.SeedExcessCapacityReserved)
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(gardencorev1alpha1.ConditionFalse))
+		})
+	})
+})
@@ -18,7 +18,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
@@ -39,7 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	utilsecrets "github.com/gardener/gardener/pkg/utils/secrets"
 
-	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	controllermanagerconfig "github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -230,7 +232,7 @@ func deployCertificates(seed *Seed, k8sSeedClient kubernetes.Interface, existing
 }
 
 // BootstrapCluster bootstraps a Seed cluster and deploys various required manifests.
-func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, numberOfAssociatedShoots int) error {
+func BootstrapCluster(seed *Seed, config *controllermanagerconfig.ControllerManagerConfiguration, secrets map[string]*corev1.Secret, imageVector imagevector.ImageVector, numberOfAssociatedShoots int) error {
 	const chartName = "seed-bootstrap"
 
 	k8sSeedClient, err := kubernetes.NewClientFromSecretObject(seed.Secret,
@@ -315,18 +317,40 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 
 	// Logging feature gate
 	var (
-		basicAuth             string
-		kibanaHost            string
-		sgFluentdPassword     string
-		sgFluentdPasswordHash string
-		fluentdReplicaCount   int32
-		loggingEnabled        = controllermanagerfeatures.FeatureGate.Enabled(features.Logging)
-		existingSecretsMap    = map[string]*corev1.Secret{}
-		filters               = strings.Builder{}
-		parsers               = strings.Builder{}
+		basicAuth                 string
+		kibanaHost                string
+		sgFluentdPassword         string
+		sgFluentdPasswordHash     string
+		fluentdReplicaCount       int32
+		loggingEnabled            = controllermanagerfeatures.FeatureGate.Enabled(features.Logging)
+		loggingBackend            = GetLoggingBackend(config)
+		elasticsearchEnabled      = loggingEnabled && loggingBackend == controllermanagerconfig.LoggingBackendElasticsearch
+		externalLoggingOutput     = map[string]interface{}{"enabled": false}
+		loggingExcludedNamespaces []string
+		existingSecretsMap        = map[string]*corev1.Secret{}
+		filters                   = strings.Builder{}
+		parsers                   = strings.Builder{}
 	)
 
 	if loggingEnabled {
+		if loggingBackend == controllermanagerconfig.LoggingBackendExternal {
+			if externalLoggingOutput, err = computeExternalLoggingOutput(seed); err != nil {
+				return err
+			}
+			if err := common.DeleteElasticsearchLoggingStack(context.TODO(), k8sSeedClient.Client(), common.GardenNamespace); err != nil && !apierrors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		// Do not collect the logs of Shoots which opted out of logging.
+		namespaces := &corev1.NamespaceList{}
+		if err = k8sSeedClient.Client().List(context.TODO(), namespaces, client.MatchingLabels(map[string]string{common.ShootDisableLogging: "true"})); err != nil {
+			return err
+		}
+		for _, namespace := range namespaces.Items {
+			loggingExcludedNamespaces = append(loggingExcludedNamespaces, namespace.Name)
+		}
+
 		existingSecrets := &corev1.SecretList{}
 		if err = k8sSeedClient.Client().List(context.TODO(), existingSecrets, client.InNamespace(common.GardenNamespace)); err != nil {
 			return err
@@ -508,7 +532,7 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 			"host": grafanaHost,
 		},
 		"elastic-kibana-curator": map[string]interface{}{
-			"enabled": loggingEnabled,
+			"enabled": elasticsearchEnabled,
 			"ingress": map[string]interface{}{
 				"basicAuthSecret": basicAuth,
 				"host":            kibanaHost,
@@ -534,6 +558,7 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 		"fluentd-es": map[string]interface{}{
 			"enabled": loggingEnabled,
 			"fluentd": map[string]interface{}{
+				"enabled":      elasticsearchEnabled,
 				"replicaCount": fluentdReplicaCount,
 				"sgUsername":   "fluentd",
 				"sgPassword":   sgFluentdPassword,
@@ -544,6 +569,10 @@ func BootstrapCluster(seed *Seed, config *config.ControllerManagerConfiguration,
 					"parsers": parsers.String(),
 					"filters": filters.String(),
 				},
+				"excludedNamespaces": loggingExcludedNamespaces,
+				"output": map[string]interface{}{
+					"external": externalLoggingOutput,
+				},
 			},
 		},
 		"alertmanager": alertManagerConfig,
@@ -611,6 +640,50 @@ func GetFluentdReplicaCount(k8sSeedClient kubernetes.Interface) (int32, error) {
 	return *replicas, nil
 }
 
+// GetLoggingBackend returns the logging backend configured in the given controller manager configuration. It defaults
+// to the Elasticsearch backend.
+func GetLoggingBackend(config *controllermanagerconfig.ControllerManagerConfiguration) controllermanagerconfig.LoggingBackend {
+	if config == nil || config.Logging == nil || len(config.Logging.Backend) == 0 {
+		return controllermanagerconfig.LoggingBackendElasticsearch
+	}
+	return config.Logging.Backend
+}
+
+// computeExternalLoggingOutput computes the values for the fluent-bit output shipping the logs to the external
+// endpoint configured in the specification of the given Seed.
+func computeExternalLoggingOutput(seed *Seed) (map[string]interface{}, error) {
+	if seed.Info.Spec.Logging == nil || seed.Info.Spec.Logging.Endpoint == nil {
+		return nil, fmt.Errorf("seed %q does not specify a logging endpoint which is required by the %q logging backend", seed.Info.Name, controllermanagerconfig.LoggingBackendExternal)
+	}
+
+	endpoint, err := url.Parse(*seed.Info.Spec.Logging.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		tls  = endpoint.Scheme == "https"
+		port = 80
+		uri  = endpoint.RequestURI()
+	)
+	if tls {
+		port = 443
+	}
+	if p := endpoint.Port(); len(p) > 0 {
+		if port, err = strconv.Atoi(p); err != nil {
+			return nil, err
+		}
+	}
+
+	return map[string]interface{}{
+		"enabled": true,
+		"host":    endpoint.Hostname(),
+		"port":    port,
+		"uri":     uri,
+		"tls":     tls,
+	}, nil
+}
+
 // GetIngressFQDN returns the fully qualified domain name of ingress sub-resource for the Seed cluster. The
 // end result is '<subDomain>.<shootName>.<projectName>.<seed-ingress-domain>'.
 func (s *Seed) GetIngressFQDN(subDomain, shootName, projectName string) string {
@@ -17,13 +17,18 @@ package seed_test
 import (
 	"context"
 
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	mock "github.com/gardener/gardener/pkg/mock/gardener/kubernetes"
 	. "github.com/gardener/gardener/pkg/operation/seed"
 	"github.com/golang/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -74,4 +79,84 @@ var _ = Describe("seed", func() {
 			Expect(replicas).To(Equal(expectedReplicas))
 		})
 	})
+
+	Describe("#GetLoggingBackend", func() {
+		It("should default to the elasticsearch backend", func() {
+			Expect(GetLoggingBackend(nil)).To(Equal(config.LoggingBackendElasticsearch))
+			Expect(GetLoggingBackend(&config.ControllerManagerConfiguration{})).To(Equal(config.LoggingBackendElasticsearch))
+		})
+
+		It("should return the configured backend", func() {
+			cfg := &config.ControllerManagerConfiguration{
+				Logging: &config.LoggingConfiguration{Backend: config.LoggingBackendExternal},
+			}
+
+			Expect(GetLoggingBackend(cfg)).To(Equal(config.LoggingBackendExternal))
+		})
+	})
+
+	Describe("#ComputeCapacity", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+		)
+
+		resources := func(cpu, memory string) corev1.ResourceList {
+			return corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			}
+		}
+
+		BeforeEach(func() {
+			fakeClient = fake.NewFakeClient(
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+					Status:     corev1.NodeStatus{Allocatable: resources("2", "4Gi")},
+				},
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: "node-2"},
+					Status:     corev1.NodeStatus{Allocatable: resources("4", "8Gi")},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "garden"},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{
+						{Name: "a", Resources: corev1.ResourceRequirements{Requests: resources("500m", "1Gi")}},
+						{Name: "b", Resources: corev1.ResourceRequirements{Requests: resources("250m", "512Mi")}},
+					}},
+					Status: corev1.PodStatus{Phase: corev1.PodRunning},
+				},
+				&corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "completed", Namespace: "garden"},
+					Spec: corev1.PodSpec{Containers: []corev1.Container{
+						{Name: "a", Resources: corev1.ResourceRequirements{Requests: resources("1", "1Gi")}},
+					}},
+					Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+				},
+			)
+		})
+
+		It("should compute the allocatable and requested resources", func() {
+			capacity, err := ComputeCapacity(ctx, fakeClient, &Seed{}, 5)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity.ShootControlPlanes).To(Equal(5))
+			Expect(capacity.Allocatable.Cpu().Cmp(resource.MustParse("6"))).To(Equal(0))
+			Expect(capacity.Allocatable.Memory().Cmp(resource.MustParse("12Gi"))).To(Equal(0))
+			Expect(capacity.Requested.Cpu().Cmp(resource.MustParse("750m"))).To(Equal(0))
+			Expect(capacity.Requested.Memory().Cmp(resource.MustParse("1536Mi"))).To(Equal(0))
+			Expect(capacity.ExcessCapacityReserved).To(BeNil())
+		})
+
+		It("should compute the reserved excess capacity", func() {
+			seed := &Seed{}
+			seed.MustReserveExcessCapacity(true)
+
+			capacity, err := ComputeCapacity(ctx, fakeClient, seed, 0)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity.ExcessCapacityReserved.Cpu().Cmp(resource.MustParse("4"))).To(Equal(0))
+			Expect(capacity.ExcessCapacityReserved.Memory().Cmp(resource.MustParse("9600Mi"))).To(Equal(0))
+		})
+	})
 })
@@ -17,12 +17,14 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardeninternalversion "github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
 	gardenv1beta1helper "github.com/gardener/gardener/pkg/apis/garden/v1beta1/helper"
@@ -78,8 +80,9 @@ func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformer
 		InternalClusterDomain: ConstructInternalClusterDomain(shoot.Name, projectName, internalDomain),
 		ExternalClusterDomain: ConstructExternalClusterDomain(shoot),
 
-		HibernationEnabled:     helper.HibernationIsEnabled(shoot),
-		WantsClusterAutoscaler: false,
+		HibernationEnabled:             helper.HibernationIsEnabled(shoot),
+		ControlPlaneHibernationEnabled: helper.ControlPlaneHibernationIsEnabled(shoot),
+		WantsClusterAutoscaler:         false,
 
 		Extensions: extensions,
 	}
@@ -92,6 +95,13 @@ func New(k8sGardenClient kubernetes.Interface, k8sGardenInformers gardeninformer
 	}
 	shootObj.ExternalDomain = externalDomain
 
+	// Determine information about the additional domains of the additional DNS providers of the shoot cluster.
+	additionalDomains, err := ConstructAdditionalDomains(context.TODO(), k8sGardenClient.Client(), shoot, secret)
+	if err != nil {
+		return nil, err
+	}
+	shootObj.AdditionalDomains = additionalDomains
+
 	// Determine the cloud provider kind of this Shoot object.
 	cloudProvider, err := helper.DetermineCloudProviderInShoot(shoot.Spec.Cloud)
 	if err != nil {
@@ -218,6 +228,67 @@ func (s *Shoot) GetWorkerVolumesByName(workerName string) (ok bool, volumeType,
 	return false, "", "", fmt.Errorf("could not find worker with name %q", workerName)
 }
 
+// GetWorkerZonesByName returns the availability zones of the worker pool with the given name. If the worker pool does
+// not specify its own zones then the zones of the Shoot are returned.
+func (s *Shoot) GetWorkerZonesByName(workerName string) ([]string, error) {
+	workerMigrationInfo, err := gardenv1beta1helper.GetShootWorkerMigrationInfo(s.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	if data, ok := workerMigrationInfo[workerName]; ok && len(data.Zones) > 0 {
+		return data.Zones, nil
+	}
+	return s.GetZones(), nil
+}
+
+// GetWorkerKubernetesVersionByName returns the Kubernetes version of the kubelets of the worker pool with the given
+// name. If the worker pool does not specify its own version then the Kubernetes version of the Shoot is returned.
+func (s *Shoot) GetWorkerKubernetesVersionByName(workerName string) (string, error) {
+	workerMigrationInfo, err := gardenv1beta1helper.GetShootWorkerMigrationInfo(s.Info)
+	if err != nil {
+		return "", err
+	}
+
+	if data, ok := workerMigrationInfo[workerName]; ok && data.KubernetesVersion != nil {
+		return *data.KubernetesVersion, nil
+	}
+	return s.Info.Spec.Kubernetes.Version, nil
+}
+
+// GetWorkerMaxNodeAgeByName returns the maximum age of the nodes of the worker pool with the given name. It returns
+// nil if the nodes of the worker pool shall not be rolled periodically.
+func (s *Shoot) GetWorkerMaxNodeAgeByName(workerName string) (*metav1.Duration, error) {
+	workerMigrationInfo, err := gardenv1beta1helper.GetShootWorkerMigrationInfo(s.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	return workerMigrationInfo[workerName].MaxNodeAge, nil
+}
+
+// GetWorkerAutoRepairByName returns the auto repair policy of the worker pool with the given name. It returns nil if
+// the machines of unhealthy nodes of the worker pool shall not be replaced.
+func (s *Shoot) GetWorkerAutoRepairByName(workerName string) (*gardeninternalversion.WorkerAutoRepair, error) {
+	workerMigrationInfo, err := gardenv1beta1helper.GetShootWorkerMigrationInfo(s.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	return workerMigrationInfo[workerName].AutoRepair, nil
+}
+
+// GetWorkerOperatingSystemConfigByName returns the additional systemd units, files and kernel settings of the worker
+// pool with the given name. It returns nil if none have been configured.
+func (s *Shoot) GetWorkerOperatingSystemConfigByName(workerName string) (*gardeninternalversion.WorkerOperatingSystemConfig, error) {
+	workerMigrationInfo, err := gardenv1beta1helper.GetShootWorkerMigrationInfo(s.Info)
+	if err != nil {
+		return nil, err
+	}
+
+	return workerMigrationInfo[workerName].OperatingSystemConfig, nil
+}
+
 // GetZones returns the zones of the shoot cluster.
 func (s *Shoot) GetZones() []string {
 	switch s.CloudProvider {
@@ -306,14 +377,34 @@ func (s *Shoot) ComputeCloudConfigSecretName(workerName string) string {
 	return fmt.Sprintf("%s-%s-%s", common.CloudConfigPrefix, workerName, utils.ComputeSHA256Hex([]byte(s.KubernetesMajorMinorVersion))[:5])
 }
 
-// GetReplicas returns the given <wokenUp> number if the shoot is not hibernated, or zero otherwise.
+// GetReplicas returns the given <wokenUp> number if the shoot's control plane is not hibernated, or zero otherwise.
 func (s *Shoot) GetReplicas(wokenUp int) int {
-	if s.HibernationEnabled {
+	if s.ControlPlaneHibernationEnabled {
 		return 0
 	}
 	return wokenUp
 }
 
+// AllWorkerPoolsHibernated returns true if the shoot is hibernated and no worker pool is excluded from the
+// hibernation, i.e., no nodes are expected to run in the shoot cluster.
+func (s *Shoot) AllWorkerPoolsHibernated() bool {
+	return s.HibernationEnabled && len(helper.GetHibernationExcludedWorkerPools(s.Info)) == 0
+}
+
+// IsWorkerPoolHibernated returns true if the shoot is hibernated and the worker pool with the given name is not
+// excluded from the hibernation.
+func (s *Shoot) IsWorkerPoolHibernated(name string) bool {
+	if !s.HibernationEnabled {
+		return false
+	}
+	for _, excluded := range helper.GetHibernationExcludedWorkerPools(s.Info) {
+		if excluded == name {
+			return false
+		}
+	}
+	return true
+}
+
 // ComputeAPIServerURL takes a boolean value identifying whether the component connecting to the API server
 // runs in the Seed cluster <runsInSeed>, and a boolean value <useInternalClusterDomain> which determines whether the
 // internal or the external cluster domain should be used.
@@ -425,6 +516,59 @@ func ConstructExternalDomain(ctx context.Context, client client.Client, shoot *g
 	return externalDomain, nil
 }
 
+// ConstructAdditionalDomains constructs objects containing all relevant information of the additional domains that
+// shall be managed by the additional DNS providers of a shoot cluster. Additional providers without an own domain
+// manage a record for the external domain of the shoot, e.g., in a private hosted zone.
+func ConstructAdditionalDomains(ctx context.Context, client client.Client, shoot *gardenv1beta1.Shoot, shootSecret *corev1.Secret) ([]*garden.Domain, error) {
+	providers, err := gardenv1beta1helper.GetShootAdditionalDNSProviders(shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var additionalDomains []*garden.Domain
+	for _, provider := range providers {
+		if provider.Type == nil || *provider.Type == gardenv1beta1.DNSUnmanaged {
+			continue
+		}
+
+		domain := provider.Domain
+		if domain == nil {
+			domain = shoot.Spec.DNS.Domain
+		}
+		if domain == nil {
+			continue
+		}
+
+		additionalDomain := &garden.Domain{
+			Domain:   *domain,
+			Provider: *provider.Type,
+		}
+
+		if provider.SecretName != nil {
+			secret := &corev1.Secret{}
+			if err := client.Get(ctx, kutil.Key(shoot.Namespace, *provider.SecretName), secret); err != nil {
+				return nil, err
+			}
+			additionalDomain.SecretData = secret.Data
+		} else if shootSecret != nil {
+			additionalDomain.SecretData = shootSecret.Data
+		}
+
+		if provider.Domains != nil {
+			additionalDomain.IncludeDomains = provider.Domains.Include
+			additionalDomain.ExcludeDomains = provider.Domains.Exclude
+		}
+		if provider.Zones != nil {
+			additionalDomain.IncludeZones = provider.Zones.Include
+			additionalDomain.ExcludeZones = provider.Zones.Exclude
+		}
+
+		additionalDomains = append(additionalDomains, additionalDomain)
+	}
+
+	return additionalDomains, nil
+}
+
 // ExtensionDefaultTimeout is the default timeout and defines how long Gardener should wait
 // for a successful reconciliation of this extension resource.
 const ExtensionDefaultTimeout = 3 * time.Minute
@@ -449,6 +593,11 @@ func MergeExtensions(registrations []gardencorev1alpha1.ControllerRegistration,
 				timeout = ExtensionDefaultTimeout
 			}
 
+			lifecyclePhase := gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer
+			if res.LifecyclePhase != nil {
+				lifecyclePhase = *res.LifecyclePhase
+			}
+
 			typeToExtension[res.Type] = Extension{
 				Extension: extensionsv1alpha1.Extension{
 					ObjectMeta: metav1.ObjectMeta{
@@ -461,7 +610,9 @@ func MergeExtensions(registrations []gardencorev1alpha1.ControllerRegistration,
 						},
 					},
 				},
-				Timeout: timeout,
+				Timeout:        timeout,
+				LifecyclePhase: lifecyclePhase,
+				DependsOn:      res.DependsOn,
 			}
 
 			if res.GloballyEnabled != nil && *res.GloballyEnabled {
@@ -483,5 +634,102 @@ func MergeExtensions(registrations []gardencorev1alpha1.ControllerRegistration,
 		}
 	}
 
+	if err := validateExtensionDependencies(requiredExtensions); err != nil {
+		return nil, err
+	}
 	return requiredExtensions, nil
 }
+
+// ExtensionLifecyclePhases is the list of lifecycle phases of extensions in the order of the reconciliation flow.
+var ExtensionLifecyclePhases = []gardencorev1alpha1.ControllerResourceLifecyclePhase{
+	gardencorev1alpha1.ControllerResourceLifecyclePhaseBeforeKubeAPIServer,
+	gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer,
+	gardencorev1alpha1.ControllerResourceLifecyclePhaseAfterWorker,
+}
+
+func lifecyclePhaseIndex(phase gardencorev1alpha1.ControllerResourceLifecyclePhase) int {
+	for i, p := range ExtensionLifecyclePhases {
+		if p == phase {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateExtensionDependencies checks that every extension only depends on extensions of the same or an earlier
+// lifecycle phase and that the dependencies are free of cycles. Dependencies on extensions which are not required
+// for the Shoot are ignored.
+func validateExtensionDependencies(extensions map[string]Extension) error {
+	for extensionType, extension := range extensions {
+		if lifecyclePhaseIndex(extension.LifecyclePhase) < 0 {
+			return fmt.Errorf("extension %q has unknown lifecycle phase %q", extensionType, extension.LifecyclePhase)
+		}
+
+		for _, dependencyType := range extension.DependsOn {
+			dependency, ok := extensions[dependencyType]
+			if !ok {
+				continue
+			}
+			if lifecyclePhaseIndex(dependency.LifecyclePhase) > lifecyclePhaseIndex(extension.LifecyclePhase) {
+				return fmt.Errorf("extension %q of lifecycle phase %q depends on extension %q of later lifecycle phase %q", extensionType, extension.LifecyclePhase, dependencyType, dependency.LifecyclePhase)
+			}
+		}
+	}
+
+	for _, phase := range ExtensionLifecyclePhases {
+		if _, err := SortExtensions(extensions, phase); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SortExtensions returns the extensions of the given lifecycle phase ordered such that every extension follows the
+// extensions of the same phase it depends on. Extensions without mutual dependencies are ordered by their types.
+func SortExtensions(extensions map[string]Extension, phase gardencorev1alpha1.ControllerResourceLifecyclePhase) ([]Extension, error) {
+	var types []string
+	for extensionType, extension := range extensions {
+		if extension.LifecyclePhase == phase {
+			types = append(types, extensionType)
+		}
+	}
+	sort.Strings(types)
+
+	var (
+		sorted   = make([]Extension, 0, len(types))
+		visited  = make(map[string]bool, len(types))
+		visiting = make(map[string]bool, len(types))
+		visit    func(extensionType string) error
+	)
+
+	visit = func(extensionType string) error {
+		if visited[extensionType] {
+			return nil
+		}
+		if visiting[extensionType] {
+			return fmt.Errorf("cyclic dependency between extensions detected at extension %q", extensionType)
+		}
+		visiting[extensionType] = true
+
+		extension := extensions[extensionType]
+		for _, dependencyType := range extension.DependsOn {
+			if dependency, ok := extensions[dependencyType]; ok && dependency.LifecyclePhase == phase {
+				if err := visit(dependencyType); err != nil {
+					return err
+				}
+			}
+		}
+
+		visiting[extensionType] = false
+		visited[extensionType] = true
+		sorted = append(sorted, extension)
+		return nil
+	}
+
+	for _, extensionType := range types {
+		if err := visit(extensionType); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
@@ -16,6 +16,7 @@ package shoot_test
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -26,6 +27,7 @@ import (
 
 	corev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenapi "github.com/gardener/gardener/pkg/apis/garden"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	mockclient "github.com/gardener/gardener/pkg/mock/controller-runtime/client"
 	"github.com/gardener/gardener/pkg/operation/garden"
@@ -37,6 +39,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -91,6 +94,106 @@ var _ = Describe("shoot", func() {
 			})
 		})
 
+		Describe("#IsWorkerPoolHibernated", func() {
+			BeforeEach(func() {
+				shoot.HibernationEnabled = true
+				mode := gardenv1beta1.HibernationModeWorkersOnly
+				shoot.Info.Spec.Hibernation = &gardenv1beta1.Hibernation{
+					Mode:                &mode,
+					ExcludedWorkerPools: []string{"gpu"},
+				}
+			})
+
+			It("should return false if the shoot is not hibernated", func() {
+				shoot.HibernationEnabled = false
+				Expect(shoot.IsWorkerPoolHibernated("cpu")).To(BeFalse())
+			})
+
+			It("should return true for worker pools which are not excluded", func() {
+				Expect(shoot.IsWorkerPoolHibernated("cpu")).To(BeTrue())
+			})
+
+			It("should return false for excluded worker pools", func() {
+				Expect(shoot.IsWorkerPoolHibernated("gpu")).To(BeFalse())
+			})
+		})
+
+		Context("worker pool settings", func() {
+			BeforeEach(func() {
+				shoot.CloudProvider = gardenv1beta1.CloudProviderAWS
+				shoot.Info.Spec.Kubernetes.Version = "1.15.1"
+				shoot.Info.Spec.Cloud.AWS = &gardenv1beta1.AWSCloud{
+					Zones: []string{"eu-west-1a", "eu-west-1b"},
+					Workers: []gardenv1beta1.AWSWorker{
+						{Worker: gardenv1beta1.Worker{Name: "cpu"}, VolumeType: "gp2", VolumeSize: "20Gi"},
+						{Worker: gardenv1beta1.Worker{Name: "gpu"}, VolumeType: "io1", VolumeSize: "50Gi"},
+					},
+				}
+
+				data, err := json.Marshal(gardenapi.WorkerMigrationInfo{
+					"gpu": gardenapi.WorkerMigrationData{
+						KubernetesVersion: pointer.StringPtr("1.14.6"),
+						Zones:             []string{"eu-west-1c"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				shoot.Info.Annotations = map[string]string{gardenapi.MigrationShootWorkers: string(data)}
+			})
+
+			Describe("#GetWorkerVolumesByName", func() {
+				It("should return the volume of the worker pool", func() {
+					ok, volumeType, volumeSize, err := shoot.GetWorkerVolumesByName("gpu")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(ok).To(BeTrue())
+					Expect(volumeType).To(Equal("io1"))
+					Expect(volumeSize).To(Equal("50Gi"))
+				})
+
+				It("should return an error for an unknown worker pool", func() {
+					ok, _, _, err := shoot.GetWorkerVolumesByName("foo")
+					Expect(err).To(HaveOccurred())
+					Expect(ok).To(BeFalse())
+				})
+			})
+
+			Describe("#GetWorkerZonesByName", func() {
+				It("should return the zones of the worker pool", func() {
+					Expect(shoot.GetWorkerZonesByName("gpu")).To(Equal([]string{"eu-west-1c"}))
+				})
+
+				It("should return the zones of the shoot if the worker pool does not specify zones", func() {
+					Expect(shoot.GetWorkerZonesByName("cpu")).To(Equal([]string{"eu-west-1a", "eu-west-1b"}))
+				})
+
+				It("should return the zones of the shoot if there is no migration information", func() {
+					shoot.Info.Annotations = nil
+					Expect(shoot.GetWorkerZonesByName("gpu")).To(Equal([]string{"eu-west-1a", "eu-west-1b"}))
+				})
+
+				It("should return an error if the migration information cannot be decoded", func() {
+					shoot.Info.Annotations[gardenapi.MigrationShootWorkers] = "{"
+					_, err := shoot.GetWorkerZonesByName("gpu")
+					Expect(err).To(HaveOccurred())
+				})
+			})
+
+			Describe("#GetWorkerKubernetesVersionByName", func() {
+				It("should return the Kubernetes version of the worker pool", func() {
+					Expect(shoot.GetWorkerKubernetesVersionByName("gpu")).To(Equal("1.14.6"))
+				})
+
+				It("should return the Kubernetes version of the shoot if the worker pool does not specify a version", func() {
+					Expect(shoot.GetWorkerKubernetesVersionByName("cpu")).To(Equal("1.15.1"))
+				})
+
+				It("should return an error if the migration information cannot be decoded", func() {
+					shoot.Info.Annotations[gardenapi.MigrationShootWorkers] = "{"
+					_, err := shoot.GetWorkerKubernetesVersionByName("gpu")
+					Expect(err).To(HaveOccurred())
+				})
+			})
+		})
+
 		DescribeTable("#ConstructInternalClusterDomain",
 			func(shootName, shootProject, internalDomain, expected string) {
 				Expect(ConstructInternalClusterDomain(shootName, shootProject, internalDomain)).To(Equal(expected))
@@ -237,6 +340,79 @@ var _ = Describe("shoot", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		Describe("#ConstructAdditionalDomains", func() {
+			var (
+				namespace       = "default"
+				domain          = "foo.bar.com"
+				privateDomain   = "foo.corp.internal"
+				privateProvider = "my-private-dns-provider"
+
+				shootSecretData = map[string][]byte{"foo": []byte("bar")}
+				shootSecret     = &corev1.Secret{Data: shootSecretData}
+			)
+
+			It("returns nil because no additional providers are configured", func() {
+				shoot := &gardenv1beta1.Shoot{
+					Spec: gardenv1beta1.ShootSpec{
+						DNS: gardenv1beta1.DNS{
+							Domain: &domain,
+						},
+					},
+				}
+
+				additionalDomains, err := ConstructAdditionalDomains(context.TODO(), c, shoot, shootSecret)
+
+				Expect(additionalDomains).To(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns the additional domains with the referenced or the shoot secret", func() {
+				var (
+					ctx = context.TODO()
+
+					dnsSecretName = "my-private-secret"
+					dnsSecretData = map[string][]byte{"private": []byte("data")}
+					dnsSecretKey  = kutil.Key(namespace, dnsSecretName)
+
+					shoot = &gardenv1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: namespace,
+							Annotations: map[string]string{
+								gardenapi.MigrationShootDNSProviders: `[{"Type":"` + privateProvider + `"},{"Domain":"` + privateDomain + `","SecretName":"` + dnsSecretName + `","Type":"` + privateProvider + `","Zones":{"Include":["ZONE"]}}]`,
+							},
+						},
+						Spec: gardenv1beta1.ShootSpec{
+							DNS: gardenv1beta1.DNS{
+								Domain: &domain,
+							},
+						},
+					}
+				)
+
+				c.EXPECT().Get(ctx, dnsSecretKey, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, secret *corev1.Secret) error {
+					secret.Data = dnsSecretData
+					return nil
+				})
+
+				additionalDomains, err := ConstructAdditionalDomains(ctx, c, shoot, shootSecret)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(additionalDomains).To(Equal([]*garden.Domain{
+					{
+						Domain:     domain,
+						Provider:   privateProvider,
+						SecretData: shootSecretData,
+					},
+					{
+						Domain:       privateDomain,
+						Provider:     privateProvider,
+						SecretData:   dnsSecretData,
+						IncludeZones: []string{"ZONE"},
+					},
+				}))
+			})
+		})
 	})
 
 	Context("Extensions", func() {
@@ -310,7 +486,9 @@ var _ = Describe("shoot", func() {
 									"ProviderConfig": PointTo(Equal(providerConfig.RawExtension)),
 								}),
 							}),
-							"Timeout": Equal(fooReconciliationTimeout.Duration),
+							"Timeout":        Equal(fooReconciliationTimeout.Duration),
+							"LifecyclePhase": Equal(corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer),
+							"DependsOn":      BeNil(),
 						},
 					),
 				),
@@ -341,7 +519,9 @@ var _ = Describe("shoot", func() {
 									"ProviderConfig": BeNil(),
 								}),
 							}),
-							"Timeout": Equal(ExtensionDefaultTimeout),
+							"Timeout":        Equal(ExtensionDefaultTimeout),
+							"LifecyclePhase": Equal(corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer),
+							"DependsOn":      BeNil(),
 						},
 					),
 				),
@@ -377,11 +557,82 @@ var _ = Describe("shoot", func() {
 									"ProviderConfig": PointTo(Equal(providerConfig.RawExtension)),
 								}),
 							}),
-							"Timeout": Equal(ExtensionDefaultTimeout),
+							"Timeout":        Equal(ExtensionDefaultTimeout),
+							"LifecyclePhase": Equal(corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer),
+							"DependsOn":      BeNil(),
 						},
 					),
 				),
 			),
 		)
+
+		It("should return an error if an extension depends on an extension of a later lifecycle phase", func() {
+			var (
+				beforeKubeAPIServer = corev1alpha1.ControllerResourceLifecyclePhaseBeforeKubeAPIServer
+				registration        = corev1alpha1.ControllerRegistration{
+					Spec: corev1alpha1.ControllerRegistrationSpec{
+						Resources: []corev1alpha1.ControllerResource{
+							{
+								Kind:           extensionKind,
+								Type:           fooExtensionType,
+								LifecyclePhase: &beforeKubeAPIServer,
+								DependsOn:      []string{barExtensionType},
+							},
+						},
+					},
+				}
+			)
+
+			_, err := MergeExtensions([]corev1alpha1.ControllerRegistration{registration, barRegistration}, []gardenv1beta1.Extension{fooExtension}, shootNamespace)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error if the extension dependencies contain a cycle", func() {
+			registration := corev1alpha1.ControllerRegistration{
+				Spec: corev1alpha1.ControllerRegistrationSpec{
+					Resources: []corev1alpha1.ControllerResource{
+						{
+							Kind:            extensionKind,
+							Type:            fooExtensionType,
+							GloballyEnabled: test.MakeBoolPointer(true),
+							DependsOn:       []string{barExtensionType},
+						},
+						{
+							Kind:            extensionKind,
+							Type:            barExtensionType,
+							GloballyEnabled: test.MakeBoolPointer(true),
+							DependsOn:       []string{fooExtensionType},
+						},
+					},
+				},
+			}
+
+			_, err := MergeExtensions([]corev1alpha1.ControllerRegistration{registration}, nil, shootNamespace)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Describe("#SortExtensions", func() {
+			It("should order the extensions of a phase by their dependencies", func() {
+				extensions := map[string]Extension{
+					"a": {LifecyclePhase: corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer, DependsOn: []string{"c"}},
+					"b": {LifecyclePhase: corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer},
+					"c": {LifecyclePhase: corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer, DependsOn: []string{"unknown"}},
+					"d": {LifecyclePhase: corev1alpha1.ControllerResourceLifecyclePhaseAfterWorker, DependsOn: []string{"a"}},
+				}
+				for name, ext := range extensions {
+					ext.Spec.Type = name
+					extensions[name] = ext
+				}
+
+				sorted, err := SortExtensions(extensions, corev1alpha1.ControllerResourceLifecyclePhaseAfterKubeAPIServer)
+				Expect(err).NotTo(HaveOccurred())
+
+				var types []string
+				for _, ext := range sorted {
+					types = append(types, ext.Spec.Type)
+				}
+				Expect(types).To(Equal([]string{"c", "a", "b"}))
+			})
+		})
 	})
 })
@@ -17,6 +17,7 @@ package shoot
 import (
 	"time"
 
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
 	"github.com/gardener/gardener/pkg/operation/garden"
@@ -37,11 +38,14 @@ type Shoot struct {
 	InternalClusterDomain string
 	ExternalClusterDomain *string
 	ExternalDomain        *garden.Domain
+	AdditionalDomains     []*garden.Domain
 
-	WantsClusterAutoscaler bool
-	WantsAlertmanager      bool
-	IgnoreAlerts           bool
-	HibernationEnabled     bool
+	WantsClusterAutoscaler         bool
+	WantsAlertmanager              bool
+	IgnoreAlerts                   bool
+	WantsLogging                   bool
+	HibernationEnabled             bool
+	ControlPlaneHibernationEnabled bool
 
 	OperatingSystemConfigsMap map[string]OperatingSystemConfigs
 	Extensions                map[string]Extension
@@ -73,7 +77,9 @@ type OperatingSystemConfigData struct {
 // Extension contains information about the extension api resouce as well as configuration information.
 type Extension struct {
 	extensionsv1alpha1.Extension
-	Timeout time.Duration
+	Timeout        time.Duration
+	LifecyclePhase gardencorev1alpha1.ControllerResourceLifecyclePhase
+	DependsOn      []string
 }
 
 // IncompleteDNSConfigError is a custom error type.
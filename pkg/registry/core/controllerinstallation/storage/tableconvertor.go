@@ -40,6 +40,7 @@ func newTableConvertor() rest.TableConvertor {
 			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
 			{Name: "Registration", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["registration"]},
 			{Name: "Seed", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["seed"]},
+			{Name: "Version", Type: "string", Description: swaggerMetadataDescriptions["version"]},
 			{Name: "Valid", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["Valid"]},
 			{Name: "Installed", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["installed"]},
 			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
@@ -76,6 +77,11 @@ func (c *convertor) ConvertToTable(ctx context.Context, o runtime.Object, tableO
 		cells = append(cells, obj.Name)
 		cells = append(cells, obj.Spec.RegistrationRef.Name)
 		cells = append(cells, obj.Spec.SeedRef.Name)
+		if obj.Status.DeployedVersion != nil {
+			cells = append(cells, *obj.Status.DeployedVersion)
+		} else {
+			cells = append(cells, "<none>")
+		}
 		if cond := helper.GetCondition(obj.Status.Conditions, core.ControllerInstallationValid); cond != nil {
 			cells = append(cells, cond.Status)
 		} else {
@@ -36,10 +36,17 @@ import (
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 )
 
-// StorageProvider is an empty struct.
-type StorageProvider struct{}
+// StorageProvider contains the dependencies of the core storage which are not stored in etcd.
+type StorageProvider struct {
+	// Secrets is used to read the credentials of backup buckets. The `shoots/backups` subresource is only served if
+	// it is set.
+	Secrets corev1client.SecretsGetter
+	// SnapStore creates the snapstores the etcd snapshots of shoots are listed from.
+	SnapStore shootstore.SnapStoreFunc
+}
 
 // NewRESTStorage creates a new API group info object and registers the v1alpha1 core storage.
 func (p StorageProvider) NewRESTStorage(restOptionsGetter generic.RESTOptionsGetter) genericapiserver.APIGroupInfo {
@@ -95,6 +102,10 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	shootStorage := shootstore.NewStorage(restOptionsGetter)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
+	storage["shoots/clone"] = shootstore.NewCloneREST(shootStorage.Shoot, seedStorage.Seed, cloudprofileStorage.CloudProfile)
+	if p.Secrets != nil && p.SnapStore != nil {
+		storage["shoots/backups"] = shootstore.NewBackupsREST(shootStorage.Shoot, backupEntryStorage.BackupEntry, backupBucketStorage.BackupBucket, p.Secrets, p.SnapStore)
+	}
 
 	return storage
 }
@@ -0,0 +1,227 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+)
+
+const (
+	// azureStorageAccount and azureStorageKey are the keys of the storage account credentials in the secret generated
+	// for an Azure BackupBucket.
+	azureStorageAccount = "storageAccount"
+	azureStorageKey     = "storageKey"
+	// azureSnapstoreStorageAccount and azureSnapstoreStorageKey are the keys of the storage account credentials
+	// expected by the Azure snapstore.
+	azureSnapstoreStorageAccount = "storage-account"
+	azureSnapstoreStorageKey     = "storage-key"
+	// alicloudStorageEndpoint is the key of the OSS endpoint expected by the Alicloud snapstore.
+	alicloudStorageEndpoint = "storageEndpoint"
+	// openstackAuthURL is the key of the Keystone URL expected by the OpenStack snapstore.
+	openstackAuthURL = "authURL"
+)
+
+// SnapStoreFunc creates the snapstore for the etcd snapshots stored under the given prefix. The secret data contains
+// the credentials for the object store of the given provider type and the name of the bucket.
+type SnapStoreFunc func(providerType string, secretData map[string][]byte, prefix string) (snapstore.SnapStore, error)
+
+// BackupsREST implements the read-only REST endpoint listing the etcd snapshots of a Shoot.
+type BackupsREST struct {
+	shoots        rest.Getter
+	backupEntries rest.Getter
+	backupBuckets rest.Getter
+	secrets       corev1client.SecretsGetter
+	snapStore     SnapStoreFunc
+}
+
+var (
+	_ rest.Storage = &BackupsREST{}
+	_ rest.Getter  = &BackupsREST{}
+)
+
+// NewBackupsREST returns a REST endpoint which lists the etcd snapshots in the BackupEntry of a Shoot. The credentials
+// for the object store are read from the secret referenced by the BackupBucket of the BackupEntry.
+func NewBackupsREST(shoots, backupEntries, backupBuckets rest.Getter, secrets corev1client.SecretsGetter, snapStore SnapStoreFunc) *BackupsREST {
+	return &BackupsREST{
+		shoots:        shoots,
+		backupEntries: backupEntries,
+		backupBuckets: backupBuckets,
+		secrets:       secrets,
+		snapStore:     snapStore,
+	}
+}
+
+// New creates a new (empty) internal ShootBackupCatalog object.
+func (r *BackupsREST) New() runtime.Object {
+	return &core.ShootBackupCatalog{}
+}
+
+// Get lists the etcd snapshots of the Shoot with the given name.
+func (r *BackupsREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	obj, err := r.shoots.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	shoot := obj.(*garden.Shoot)
+
+	if len(shoot.Status.TechnicalID) == 0 || len(shoot.Status.UID) == 0 {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("shoot %s/%s has not been reconciled yet", shoot.Namespace, shoot.Name))
+	}
+
+	backupEntryName := common.GenerateBackupEntryName(shoot.Status.TechnicalID, shoot.Status.UID)
+	obj, err = r.backupEntries.Get(ctx, backupEntryName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	backupEntry := obj.(*core.BackupEntry)
+
+	obj, err = r.backupBuckets.Get(genericapirequest.WithNamespace(ctx, metav1.NamespaceNone), backupEntry.Spec.BucketName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	backupBucket := obj.(*core.BackupBucket)
+
+	secret, err := r.secrets.Secrets(backupBucket.Spec.SecretRef.Namespace).Get(backupBucket.Spec.SecretRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not read the credentials of backup bucket %s: %v", backupBucket.Name, err))
+	}
+
+	var generatedSecretData map[string][]byte
+	if ref := backupBucket.Status.GeneratedSecretRef; ref != nil {
+		generatedSecret, err := r.secrets.Secrets(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("could not read the generated secret of backup bucket %s: %v", backupBucket.Name, err))
+		}
+		generatedSecretData = generatedSecret.Data
+	}
+
+	secretData, err := computeETCDBackupSecretData(backupBucket, secret.Data, generatedSecretData)
+	if err != nil {
+		return nil, newNotSupportedError(shoot.Name, err)
+	}
+
+	store, err := r.snapStore(backupBucket.Spec.Provider.Type, secretData, common.GenerateBackupEntryETCDMainPrefix(backupEntry.Name))
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+	snapList, err := store.List()
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not list the snapshots in backup bucket %s: %v", backupBucket.Name, err))
+	}
+
+	return &core.ShootBackupCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+		},
+		BackupEntryName: backupEntry.Name,
+		Snapshots:       computeETCDSnapshots(snapList),
+	}, nil
+}
+
+// computeETCDBackupSecretData returns the secret data the snapstore of the provider of the given BackupBucket expects.
+// It is composed of the credentials of the BackupBucket and, for providers whose snapstore does not authenticate with
+// these credentials, of the data of the secret generated for the BackupBucket, in the same way as the etcd backup
+// secret of the Shoot is composed.
+func computeETCDBackupSecretData(backupBucket *core.BackupBucket, secretData, generatedSecretData map[string][]byte) (map[string][]byte, error) {
+	out := make(map[string][]byte, len(secretData)+4)
+	for key, value := range secretData {
+		out[key] = value
+	}
+	out[common.BackupBucketName] = []byte(backupBucket.Name)
+	out["region"] = []byte(backupBucket.Spec.Provider.Region)
+
+	switch garden.CloudProvider(backupBucket.Spec.Provider.Type) {
+	case garden.CloudProviderAzure:
+		// The blob container can only be accessed with the key of the storage account which is generated together
+		// with the BackupBucket.
+		storageAccount, storageKey := generatedSecretData[azureStorageAccount], generatedSecretData[azureStorageKey]
+		if len(storageAccount) == 0 || len(storageKey) == 0 {
+			return nil, fmt.Errorf("backup bucket %s does not provide the credentials of its storage account yet", backupBucket.Name)
+		}
+		out[azureSnapstoreStorageAccount] = storageAccount
+		out[azureSnapstoreStorageKey] = storageKey
+
+	case garden.CloudProviderAlicloud:
+		if len(out[alicloudStorageEndpoint]) == 0 {
+			if len(backupBucket.Spec.Provider.Region) == 0 {
+				return nil, fmt.Errorf("the storage endpoint of backup bucket %s cannot be determined without a region", backupBucket.Name)
+			}
+			out[alicloudStorageEndpoint] = []byte(fmt.Sprintf("https://oss-%s.aliyuncs.com", backupBucket.Spec.Provider.Region))
+		}
+
+	case garden.CloudProviderOpenStack:
+		if len(out[openstackAuthURL]) == 0 {
+			return nil, fmt.Errorf("the credentials of backup bucket %s do not contain the %s", backupBucket.Name, openstackAuthURL)
+		}
+	}
+
+	return out, nil
+}
+
+// newNotSupportedError returns an error stating that the snapshots of the Shoot with the given name cannot be listed.
+func newNotSupportedError(name string, err error) error {
+	return &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status:  metav1.StatusFailure,
+		Code:    http.StatusNotImplemented,
+		Reason:  metav1.StatusReasonMethodNotAllowed,
+		Message: fmt.Sprintf("listing the backups of shoot %s is not supported: %v", name, err),
+		Details: &metav1.StatusDetails{Name: name, Group: core.GroupName, Kind: "Shoot"},
+	}}
+}
+
+// computeETCDSnapshots converts the given snapshots into their API representation ordered by their revisions. Chunks
+// of snapshots which are still being uploaded are omitted.
+func computeETCDSnapshots(snapList snapstore.SnapList) []core.ETCDSnapshot {
+	snapshots := make([]core.ETCDSnapshot, 0, len(snapList))
+	for _, snapshot := range snapList {
+		if snapshot.IsChunk {
+			continue
+		}
+
+		kind := core.ETCDSnapshotKindFull
+		if snapshot.Kind == snapstore.SnapshotKindDelta {
+			kind = core.ETCDSnapshotKindDelta
+		}
+
+		snapshots = append(snapshots, core.ETCDSnapshot{
+			Name:              snapshot.SnapName,
+			Kind:              kind,
+			CreationTimestamp: metav1.NewTime(snapshot.CreatedOn),
+			StartRevision:     snapshot.StartRevision,
+			LastRevision:      snapshot.LastRevision,
+		})
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].LastRevision < snapshots[j].LastRevision
+	})
+	return snapshots
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
+
+	"github.com/gardener/etcd-backup-restore/pkg/snapstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestStorage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Shoot Storage Suite")
+}
+
+type fakeGetter map[string]runtime.Object
+
+func (f fakeGetter) Get(ctx context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	namespace, _ := genericapirequest.NamespaceFrom(ctx)
+	if obj, ok := f[namespace+"/"+name]; ok {
+		return obj, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+}
+
+var _ = Describe("BackupsREST", func() {
+	const (
+		namespace       = "garden-dev"
+		shootName       = "foo"
+		technicalID     = "shoot--dev--foo"
+		shootUID        = "1234"
+		bucketName      = "bucket"
+		secretName      = "backup-secret"
+		secretNamespace = "garden"
+	)
+
+	var (
+		ctx             = genericapirequest.WithNamespace(context.TODO(), namespace)
+		backupEntryName = common.GenerateBackupEntryName(technicalID, shootUID)
+
+		tmpDir        string
+		shoot         *garden.Shoot
+		shoots        fakeGetter
+		backupEntries fakeGetter
+		backupBuckets fakeGetter
+		secrets       *fake.Clientset
+
+		providerType string
+		secretData   map[string][]byte
+		prefix       string
+		snapStore    SnapStoreFunc
+
+		save = func(kind string, startRevision, lastRevision int64, createdOn time.Time) {
+			store, err := snapstore.NewLocalSnapStore(filepath.Join(tmpDir, common.GenerateBackupEntryETCDMainPrefix(backupEntryName)))
+			Expect(err).NotTo(HaveOccurred())
+
+			snapshot := snapstore.Snapshot{Kind: kind, StartRevision: startRevision, LastRevision: lastRevision, CreatedOn: createdOn}
+			snapshot.GenerateSnapshotDirectory()
+			snapshot.GenerateSnapshotName()
+			Expect(store.Save(snapshot, ioutil.NopCloser(strings.NewReader("data")))).To(Succeed())
+		}
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "backups")
+		Expect(err).NotTo(HaveOccurred())
+
+		shoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: shootName, Namespace: namespace},
+			Status:     garden.ShootStatus{TechnicalID: technicalID, UID: shootUID},
+		}
+		shoots = fakeGetter{namespace + "/" + shootName: shoot}
+		backupEntries = fakeGetter{namespace + "/" + backupEntryName: &core.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: backupEntryName, Namespace: namespace},
+			Spec:       core.BackupEntrySpec{BucketName: bucketName},
+		}}
+		backupBuckets = fakeGetter{"/" + bucketName: &core.BackupBucket{
+			ObjectMeta: metav1.ObjectMeta{Name: bucketName},
+			Spec: core.BackupBucketSpec{
+				Provider:  core.BackupBucketProvider{Type: "local", Region: "eu-1"},
+				SecretRef: corev1.SecretReference{Name: secretName, Namespace: secretNamespace},
+			},
+		}}
+		secrets = fake.NewSimpleClientset(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: secretNamespace},
+			Data:       map[string][]byte{"accessKey": []byte("key")},
+		})
+
+		snapStore = func(t string, data map[string][]byte, p string) (snapstore.SnapStore, error) {
+			providerType, secretData, prefix = t, data, p
+			return snapstore.NewLocalSnapStore(filepath.Join(tmpDir, p))
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("should list the full and delta snapshots ordered by their revisions", func() {
+		now := time.Now().UTC().Truncate(time.Second)
+		save(snapstore.SnapshotKindDelta, 11, 20, now.Add(-time.Minute))
+		save(snapstore.SnapshotKindFull, 0, 10, now.Add(-time.Hour))
+		save(snapstore.SnapshotKindFull, 0, 30, now)
+
+		obj, err := NewBackupsREST(shoots, backupEntries, backupBuckets, secrets.CoreV1(), snapStore).Get(ctx, shootName, &metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(providerType).To(Equal("local"))
+		Expect(prefix).To(Equal(backupEntryName + "/" + common.ETCDMainBackupPrefix))
+		Expect(secretData).To(Equal(map[string][]byte{
+			"accessKey":             []byte("key"),
+			common.BackupBucketName: []byte(bucketName),
+			"region":                []byte("eu-1"),
+		}))
+
+		catalog := obj.(*core.ShootBackupCatalog)
+		Expect(catalog.Name).To(Equal(shootName))
+		Expect(catalog.Namespace).To(Equal(namespace))
+		Expect(catalog.BackupEntryName).To(Equal(backupEntryName))
+		Expect(catalog.Snapshots).To(HaveLen(3))
+		Expect(catalog.Snapshots[0].Kind).To(Equal(core.ETCDSnapshotKindFull))
+		Expect(catalog.Snapshots[0].LastRevision).To(Equal(int64(10)))
+		Expect(catalog.Snapshots[0].CreationTimestamp.Time.Equal(now.Add(-time.Hour))).To(BeTrue())
+		Expect(catalog.Snapshots[1].Kind).To(Equal(core.ETCDSnapshotKindDelta))
+		Expect(catalog.Snapshots[1].StartRevision).To(Equal(int64(11)))
+		Expect(catalog.Snapshots[1].LastRevision).To(Equal(int64(20)))
+		Expect(catalog.Snapshots[2].Kind).To(Equal(core.ETCDSnapshotKindFull))
+		Expect(catalog.Snapshots[2].LastRevision).To(Equal(int64(30)))
+	})
+
+	It("should return an empty catalog if no snapshots have been taken yet", func() {
+		Expect(os.MkdirAll(filepath.Join(tmpDir, common.GenerateBackupEntryETCDMainPrefix(backupEntryName)), 0700)).To(Succeed())
+
+		obj, err := NewBackupsREST(shoots, backupEntries, backupBuckets, secrets.CoreV1(), snapStore).Get(ctx, shootName, &metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(obj.(*core.ShootBackupCatalog).Snapshots).To(BeEmpty())
+	})
+
+	It("should return a bad request error if the shoot has not been reconciled yet", func() {
+		shoot.Status = garden.ShootStatus{}
+
+		_, err := NewBackupsREST(shoots, backupEntries, backupBuckets, secrets.CoreV1(), snapStore).Get(ctx, shootName, &metav1.GetOptions{})
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should return a not found error if the shoot has no backup entry", func() {
+		backupEntries = fakeGetter{}
+
+		_, err := NewBackupsREST(shoots, backupEntries, backupBuckets, secrets.CoreV1(), snapStore).Get(ctx, shootName, &metav1.GetOptions{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	Describe("provider specific credentials", func() {
+		const (
+			generatedSecretName      = "generated-backup-secret"
+			generatedSecretNamespace = "garden"
+		)
+
+		get := func(providerType string, generatedSecretData map[string][]byte) error {
+			backupBucket := backupBuckets["/"+bucketName].(*core.BackupBucket)
+			backupBucket.Spec.Provider.Type = providerType
+			if generatedSecretData != nil {
+				backupBucket.Status.GeneratedSecretRef = &corev1.SecretReference{Name: generatedSecretName, Namespace: generatedSecretNamespace}
+				_, err := secrets.CoreV1().Secrets(generatedSecretNamespace).Create(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: generatedSecretName, Namespace: generatedSecretNamespace},
+					Data:       generatedSecretData,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			}
+
+			_, err := NewBackupsREST(shoots, backupEntries, backupBuckets, secrets.CoreV1(), snapStore).Get(ctx, shootName, &metav1.GetOptions{})
+			return err
+		}
+
+		DescribeTable("should pass the credentials expected by the snapstore of the provider",
+			func(provider string, bucketSecretData, generatedSecretData map[string][]byte, expected map[string][]byte) {
+				secrets = fake.NewSimpleClientset(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: secretName, Namespace: secretNamespace},
+					Data:       bucketSecretData,
+				})
+				Expect(os.MkdirAll(filepath.Join(tmpDir, common.GenerateBackupEntryETCDMainPrefix(backupEntryName)), 0700)).To(Succeed())
+
+				Expect(get(provider, generatedSecretData)).To(Succeed())
+				Expect(providerType).To(Equal(provider))
+				Expect(secretData).To(Equal(expected))
+			},
+
+			Entry("aws",
+				"aws",
+				map[string][]byte{"accessKeyID": []byte("id"), "secretAccessKey": []byte("secret")},
+				nil,
+				map[string][]byte{"accessKeyID": []byte("id"), "secretAccessKey": []byte("secret"), common.BackupBucketName: []byte(bucketName), "region": []byte("eu-1")},
+			),
+			Entry("gcp",
+				"gcp",
+				map[string][]byte{"serviceaccount.json": []byte("{}")},
+				nil,
+				map[string][]byte{"serviceaccount.json": []byte("{}"), common.BackupBucketName: []byte(bucketName), "region": []byte("eu-1")},
+			),
+			Entry("azure with the storage account of the generated secret",
+				"azure",
+				map[string][]byte{"clientID": []byte("id"), "clientSecret": []byte("secret")},
+				map[string][]byte{"storageAccount": []byte("account"), "storageKey": []byte("key")},
+				map[string][]byte{"clientID": []byte("id"), "clientSecret": []byte("secret"), "storage-account": []byte("account"), "storage-key": []byte("key"), common.BackupBucketName: []byte(bucketName), "region": []byte("eu-1")},
+			),
+			Entry("alicloud with the storage endpoint of the region",
+				"alicloud",
+				map[string][]byte{"accessKeyID": []byte("id"), "accessKeySecret": []byte("secret")},
+				nil,
+				map[string][]byte{"accessKeyID": []byte("id"), "accessKeySecret": []byte("secret"), "storageEndpoint": []byte("https://oss-eu-1.aliyuncs.com"), common.BackupBucketName: []byte(bucketName), "region": []byte("eu-1")},
+			),
+			Entry("alicloud with the storage endpoint of the secret",
+				"alicloud",
+				map[string][]byte{"accessKeyID": []byte("id"), "accessKeySecret": []byte("secret"), "storageEndpoint": []byte("oss.example.com")},
+				nil,
+				map[string][]byte{"accessKeyID": []byte("id"), "accessKeySecret": []byte("secret"), "storageEndpoint": []byte("oss.example.com"), common.BackupBucketName: []byte(bucketName), "region": []byte("eu-1")},
+			),
+			Entry("openstack",
+				"openstack",
+				map[string][]byte{"username": []byte("user"), "password": []byte("pass"), "authURL": []byte("https://keystone")},
+				nil,
+				map[string][]byte{"username": []byte("user"), "password": []byte("pass"), "authURL": []byte("https://keystone"), common.BackupBucketName: []byte(bucketName), "region": []byte("eu-1")},
+			),
+		)
+
+		DescribeTable("should return a not supported error if the credentials are incomplete",
+			func(provider string, generatedSecretData map[string][]byte) {
+				err := get(provider, generatedSecretData)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.(apierrors.APIStatus).Status().Code).To(Equal(int32(http.StatusNotImplemented)))
+			},
+
+			Entry("azure without generated secret", "azure", nil),
+			Entry("azure without storage key", "azure", map[string][]byte{"storageAccount": []byte("account")}),
+			Entry("openstack without auth url", "openstack", nil),
+		)
+	})
+})
@@ -0,0 +1,346 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	corevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+)
+
+// CloneREST implements the REST endpoint generating a new Shoot from an existing Shoot.
+type CloneREST struct {
+	shoots        rest.Getter
+	seeds         rest.Getter
+	cloudProfiles rest.Getter
+}
+
+var (
+	_ rest.Storage         = &CloneREST{}
+	_ rest.NamedCreater    = &CloneREST{}
+	_ rest.StorageMetadata = &CloneREST{}
+)
+
+// NewCloneREST returns a REST endpoint which generates a new Shoot from an existing Shoot. The generated Shoot is not
+// persisted but returned to the client, i.e., it passes the admission chain when it is created by the client.
+func NewCloneREST(shoots, seeds, cloudProfiles rest.Getter) *CloneREST {
+	return &CloneREST{
+		shoots:        shoots,
+		seeds:         seeds,
+		cloudProfiles: cloudProfiles,
+	}
+}
+
+// New creates a new (empty) internal ShootClone object.
+func (r *CloneREST) New() runtime.Object {
+	return &core.ShootClone{}
+}
+
+// ProducesMIMETypes implements the StorageMetadata interface.
+func (r *CloneREST) ProducesMIMETypes(verb string) []string {
+	return nil
+}
+
+// ProducesObject implements the StorageMetadata interface. The endpoint responds with the generated Shoot.
+func (r *CloneREST) ProducesObject(verb string) interface{} {
+	return garden.Shoot{}
+}
+
+// Create generates a new Shoot from the Shoot with the given name according to the given ShootClone.
+func (r *CloneREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, options *metav1.CreateOptions) (runtime.Object, error) {
+	shootClone, ok := obj.(*core.ShootClone)
+	if !ok {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("not a ShootClone: %T", obj))
+	}
+	if errs := corevalidation.ValidateShootClone(shootClone); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(core.Kind("ShootClone"), name, errs)
+	}
+	if createValidation != nil {
+		if err := createValidation(shootClone.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	obj, err := r.shoots.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	shoot := obj.(*garden.Shoot)
+
+	newShoot := cloneShoot(shoot, &shootClone.Spec)
+
+	if newShoot.Spec.Region != shoot.Spec.Region {
+		obj, err := r.cloudProfiles.Get(genericapirequest.WithNamespace(ctx, metav1.NamespaceNone), shoot.Spec.CloudProfileName, &metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		if err := remapZones(newShoot, obj.(*garden.CloudProfile), shoot.Spec.Region); err != nil {
+			return nil, apierrors.NewBadRequest(err.Error())
+		}
+	}
+
+	if newShoot.Spec.SeedName != nil {
+		obj, err := r.seeds.Get(genericapirequest.WithNamespace(ctx, metav1.NamespaceNone), *newShoot.Spec.SeedName, &metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		applySeedShootDefaults(newShoot, obj.(*garden.Seed))
+	}
+
+	return newShoot, nil
+}
+
+// clonedAnnotationsExcluded is the list of annotations which are maintained by Gardener for a particular Shoot and which
+// are hence not copied to the generated Shoot.
+var clonedAnnotationsExcluded = map[string]bool{
+	common.ConfirmationDeletion:                 true,
+	common.EtcdEncryptionChecksumAnnotationName: true,
+	common.GardenCreatedBy:                      true,
+	common.ShootAppliedPresets:                  true,
+	common.ShootETCDRestoreTarget:               true,
+	common.ShootExpirationTimestamp:             true,
+	common.ShootMigrationSourceSeed:             true,
+	common.ShootMigrationTargetSeed:             true,
+	common.ShootOperation:                       true,
+	common.ShootTasks:                           true,
+	common.ShootUID:                             true,
+}
+
+// clonedLabelsExcluded is the list of labels which are maintained by Gardener for a particular Shoot and which are
+// hence not copied to the generated Shoot.
+var clonedLabelsExcluded = map[string]bool{
+	common.ShootStatus:    true,
+	common.ShootUnhealthy: true,
+}
+
+// cloneShoot generates a new Shoot from the given Shoot. The status, the technical identifiers, the domains, and the
+// node network of the given Shoot are not copied.
+func cloneShoot(shoot *garden.Shoot, spec *core.ShootCloneSpec) *garden.Shoot {
+	newShoot := &garden.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        spec.Name,
+			Namespace:   shoot.Namespace,
+			Labels:      copyMapExcluding(shoot.Labels, clonedLabelsExcluded),
+			Annotations: copyMapExcluding(shoot.Annotations, clonedAnnotationsExcluded),
+		},
+		Spec: *shoot.Spec.DeepCopy(),
+	}
+
+	if spec.Namespace != nil {
+		newShoot.Namespace = *spec.Namespace
+	}
+
+	// The domain of a Shoot must be unique, hence, it is defaulted (or must be specified) for the new Shoot.
+	// The same applies to the domains of the additional DNS providers which point to the API server of the Shoot. If
+	// they are not specified, the additional providers use the domain of the new Shoot.
+	if newShoot.Spec.DNS != nil {
+		newShoot.Spec.DNS.Domain = nil
+		for i := 1; i < len(newShoot.Spec.DNS.Providers); i++ {
+			newShoot.Spec.DNS.Providers[i].Domain = nil
+		}
+	}
+
+	// The node network of a Shoot must not overlap with the networks of other Shoots sharing the same infrastructure
+	// (e.g., an existing VPC), hence, it must be specified for the new Shoot.
+	newShoot.Spec.Networking.Nodes = ""
+	for _, n := range k8sNetworks(newShoot) {
+		n.Nodes = nil
+	}
+
+	if spec.Region != nil && *spec.Region != shoot.Spec.Region {
+		newShoot.Spec.Region = *spec.Region
+		newShoot.Spec.SeedName = nil
+	}
+	if spec.SeedName != nil {
+		newShoot.Spec.SeedName = spec.SeedName
+	}
+
+	newShoot.Spec.Cloud.Region = newShoot.Spec.Region
+	newShoot.Spec.Cloud.Seed = newShoot.Spec.SeedName
+	return newShoot
+}
+
+func copyMapExcluding(in map[string]string, excluded map[string]bool) map[string]string {
+	var out map[string]string
+	for key, value := range in {
+		if excluded[key] {
+			continue
+		}
+		if out == nil {
+			out = make(map[string]string, len(in))
+		}
+		out[key] = value
+	}
+	return out
+}
+
+// remapZones maps the availability zones of the given Shoot from the given source region to the region of the Shoot.
+// A zone is mapped to the zone at the same position in the list of zones of the new region in the CloudProfile.
+func remapZones(shoot *garden.Shoot, cloudProfile *garden.CloudProfile, sourceRegion string) error {
+	var sourceZones, targetZones []garden.AvailabilityZone
+	for _, region := range cloudProfile.Spec.Regions {
+		switch region.Name {
+		case sourceRegion:
+			sourceZones = region.Zones
+		case shoot.Spec.Region:
+			targetZones = region.Zones
+		}
+	}
+	if targetZones == nil {
+		return fmt.Errorf("region %q is not offered by cloud profile %q", shoot.Spec.Region, cloudProfile.Name)
+	}
+
+	mapping := make(map[string]string, len(sourceZones))
+	for i, zone := range sourceZones {
+		if i < len(targetZones) {
+			mapping[zone.Name] = targetZones[i].Name
+		}
+	}
+
+	mapZones := func(zones []string) ([]string, error) {
+		if zones == nil {
+			return nil, nil
+		}
+		mapped := make([]string, 0, len(zones))
+		for _, zone := range zones {
+			targetZone, ok := mapping[zone]
+			if !ok {
+				return nil, fmt.Errorf("availability zone %q of region %q cannot be mapped to a zone of region %q", zone, sourceRegion, shoot.Spec.Region)
+			}
+			mapped = append(mapped, targetZone)
+		}
+		return mapped, nil
+	}
+
+	mapWorkers := func(workers []garden.Worker) error {
+		for i := range workers {
+			zones, err := mapZones(workers[i].Zones)
+			if err != nil {
+				return err
+			}
+			workers[i].Zones = zones
+		}
+		return nil
+	}
+
+	if err := mapWorkers(shoot.Spec.Provider.Workers); err != nil {
+		return err
+	}
+
+	var (
+		cloud = &shoot.Spec.Cloud
+		zones *[]string
+	)
+	switch {
+	case cloud.AWS != nil:
+		zones = &cloud.AWS.Zones
+		if err := mapWorkers(cloud.AWS.Workers); err != nil {
+			return err
+		}
+	case cloud.Azure != nil:
+		if err := mapWorkers(cloud.Azure.Workers); err != nil {
+			return err
+		}
+	case cloud.GCP != nil:
+		zones = &cloud.GCP.Zones
+		if err := mapWorkers(cloud.GCP.Workers); err != nil {
+			return err
+		}
+	case cloud.OpenStack != nil:
+		zones = &cloud.OpenStack.Zones
+		if err := mapWorkers(cloud.OpenStack.Workers); err != nil {
+			return err
+		}
+	case cloud.Alicloud != nil:
+		zones = &cloud.Alicloud.Zones
+		if err := mapWorkers(cloud.Alicloud.Workers); err != nil {
+			return err
+		}
+	case cloud.Packet != nil:
+		zones = &cloud.Packet.Zones
+		if err := mapWorkers(cloud.Packet.Workers); err != nil {
+			return err
+		}
+	}
+
+	if zones != nil {
+		mapped, err := mapZones(*zones)
+		if err != nil {
+			return err
+		}
+		*zones = mapped
+	}
+	return nil
+}
+
+// applySeedShootDefaults sets the pod and service networks of the given Shoot to the default networks for Shoots of
+// the given Seed, if any. This way, the networks of the new Shoot do not overlap with the networks of its Seed.
+func applySeedShootDefaults(shoot *garden.Shoot, seed *garden.Seed) {
+	shootDefaults := seed.Spec.Networks.ShootDefaults
+	if shootDefaults == nil {
+		return
+	}
+
+	networks := k8sNetworks(shoot)
+
+	if pods := shootDefaults.Pods; pods != nil {
+		shoot.Spec.Networking.Pods = copyString(pods)
+		for _, n := range networks {
+			n.Pods = copyString(pods)
+		}
+	}
+	if services := shootDefaults.Services; services != nil {
+		shoot.Spec.Networking.Services = copyString(services)
+		for _, n := range networks {
+			n.Services = copyString(services)
+		}
+	}
+}
+
+// k8sNetworks returns the networks in the provider specific section of the given Shoot.
+func k8sNetworks(shoot *garden.Shoot) []*garden.K8SNetworks {
+	switch cloud := shoot.Spec.Cloud; {
+	case cloud.AWS != nil:
+		return []*garden.K8SNetworks{&cloud.AWS.Networks.K8SNetworks}
+	case cloud.Azure != nil:
+		return []*garden.K8SNetworks{&cloud.Azure.Networks.K8SNetworks}
+	case cloud.GCP != nil:
+		return []*garden.K8SNetworks{&cloud.GCP.Networks.K8SNetworks}
+	case cloud.OpenStack != nil:
+		return []*garden.K8SNetworks{&cloud.OpenStack.Networks.K8SNetworks}
+	case cloud.Alicloud != nil:
+		return []*garden.K8SNetworks{&cloud.Alicloud.Networks.K8SNetworks}
+	case cloud.Packet != nil:
+		return []*garden.K8SNetworks{&cloud.Packet.Networks.K8SNetworks}
+	}
+	return nil
+}
+
+func copyString(s *string) *string {
+	out := *s
+	return &out
+}
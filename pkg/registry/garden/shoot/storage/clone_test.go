@@ -0,0 +1,238 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/pkg/registry/garden/shoot/storage"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("CloneREST", func() {
+	const (
+		namespace        = "garden-dev"
+		shootName        = "foo"
+		cloudProfileName = "aws"
+		seedName         = "aws-eu1"
+	)
+
+	var (
+		ctx = genericapirequest.WithNamespace(context.TODO(), namespace)
+
+		shoot         *garden.Shoot
+		seed          *garden.Seed
+		shoots        fakeGetter
+		seeds         fakeGetter
+		cloudProfiles fakeGetter
+		shootClone    *core.ShootClone
+
+		clone = func() (*garden.Shoot, error) {
+			obj, err := NewCloneREST(shoots, seeds, cloudProfiles).Create(ctx, shootName, shootClone, nil, &metav1.CreateOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return obj.(*garden.Shoot), nil
+		}
+	)
+
+	BeforeEach(func() {
+		var (
+			seedNameCopy = seedName
+			domain       = "foo.dev.example.com"
+			pods         = "100.96.0.0/11"
+			services     = "100.64.0.0/13"
+			nodes        = "10.250.0.0/16"
+			networks     = garden.K8SNetworks{Nodes: &nodes, Pods: &pods, Services: &services}
+		)
+
+		shoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            shootName,
+				Namespace:       namespace,
+				UID:             "1234",
+				ResourceVersion: "42",
+				Labels:          map[string]string{"purpose": "test", common.ShootStatus: "healthy"},
+				Annotations:     map[string]string{"owner": "john", common.ShootOperation: "reconcile", common.GardenCreatedBy: "john"},
+			},
+			Spec: garden.ShootSpec{
+				CloudProfileName: cloudProfileName,
+				Cloud: garden.Cloud{
+					Profile: cloudProfileName,
+					Region:  "eu-west-1",
+					Seed:    &seedNameCopy,
+					AWS: &garden.AWSCloud{
+						Networks: garden.AWSNetworks{K8SNetworks: networks},
+						Workers:  []garden.Worker{{Name: "cpu-worker", Zones: []string{"eu-west-1b"}}},
+						Zones:    []string{"eu-west-1b"},
+					},
+				},
+				DNS:        &garden.DNS{Domain: &domain},
+				Networking: garden.Networking{Nodes: nodes, Pods: &pods, Services: &services},
+				Provider: garden.Provider{
+					Type:    "aws",
+					Workers: []garden.Worker{{Name: "cpu-worker", Zones: []string{"eu-west-1b"}}},
+				},
+				Region:   "eu-west-1",
+				SeedName: &seedNameCopy,
+			},
+			Status: garden.ShootStatus{TechnicalID: "shoot--dev--foo", UID: "1234"},
+		}
+		seed = &garden.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: seedName},
+		}
+		shoots = fakeGetter{namespace + "/" + shootName: shoot}
+		seeds = fakeGetter{"/" + seedName: seed}
+		cloudProfiles = fakeGetter{"/" + cloudProfileName: &garden.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: cloudProfileName},
+			Spec: garden.CloudProfileSpec{
+				Regions: []garden.Region{
+					{Name: "eu-west-1", Zones: []garden.AvailabilityZone{{Name: "eu-west-1a"}, {Name: "eu-west-1b"}, {Name: "eu-west-1c"}}},
+					{Name: "us-east-1", Zones: []garden.AvailabilityZone{{Name: "us-east-1a"}, {Name: "us-east-1b"}}},
+					{Name: "ap-south-1", Zones: []garden.AvailabilityZone{{Name: "ap-south-1a"}}},
+				},
+			},
+		}}
+		shootClone = &core.ShootClone{Spec: core.ShootCloneSpec{Name: "bar"}}
+	})
+
+	It("should reset the status, the technical identifiers, and the domain", func() {
+		newShoot, err := clone()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(newShoot.ObjectMeta).To(Equal(metav1.ObjectMeta{
+			Name:        "bar",
+			Namespace:   namespace,
+			Labels:      map[string]string{"purpose": "test"},
+			Annotations: map[string]string{"owner": "john"},
+		}))
+		Expect(newShoot.Status).To(Equal(garden.ShootStatus{}))
+		Expect(newShoot.Spec.DNS.Domain).To(BeNil())
+		Expect(newShoot.Spec.Region).To(Equal("eu-west-1"))
+		Expect(newShoot.Spec.SeedName).To(PointTo(Equal(seedName)))
+		Expect(newShoot.Spec.Provider).To(Equal(shoot.Spec.Provider))
+		Expect(shoot.Spec.DNS.Domain).NotTo(BeNil())
+	})
+
+	It("should reset the node network and the domains of the additional DNS providers", func() {
+		primaryDomain, additionalDomain := "foo.example.com", "api.foo.example.org"
+		shoot.Spec.DNS.Providers = []garden.DNSProvider{
+			{Domain: &primaryDomain, Type: pointer.StringPtr("aws-route53")},
+			{Domain: &additionalDomain, Type: pointer.StringPtr("aws-route53"), Zones: &garden.DNSIncludeExclude{Include: []string{"Z1"}}},
+		}
+
+		newShoot, err := clone()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newShoot.Spec.Networking.Nodes).To(BeEmpty())
+		Expect(newShoot.Spec.Cloud.AWS.Networks.Nodes).To(BeNil())
+		Expect(newShoot.Spec.Networking.Pods).To(Equal(shoot.Spec.Networking.Pods))
+		Expect(newShoot.Spec.DNS.Providers[0].Domain).To(PointTo(Equal(primaryDomain)))
+		Expect(newShoot.Spec.DNS.Providers[1].Domain).To(BeNil())
+		Expect(newShoot.Spec.DNS.Providers[1].Zones).To(Equal(shoot.Spec.DNS.Providers[1].Zones))
+		Expect(shoot.Spec.Networking.Nodes).To(Equal("10.250.0.0/16"))
+		Expect(shoot.Spec.DNS.Providers[1].Domain).To(PointTo(Equal(additionalDomain)))
+	})
+
+	It("should use the given namespace", func() {
+		targetNamespace := "garden-prod"
+		shootClone.Spec.Namespace = &targetNamespace
+
+		newShoot, err := clone()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newShoot.Namespace).To(Equal(targetNamespace))
+	})
+
+	It("should remap the zones and reset the seed if the region changes", func() {
+		region := "us-east-1"
+		shootClone.Spec.Region = &region
+
+		newShoot, err := clone()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newShoot.Spec.Region).To(Equal(region))
+		Expect(newShoot.Spec.Cloud.Region).To(Equal(region))
+		Expect(newShoot.Spec.SeedName).To(BeNil())
+		Expect(newShoot.Spec.Cloud.Seed).To(BeNil())
+		Expect(newShoot.Spec.Provider.Workers[0].Zones).To(Equal([]string{"us-east-1b"}))
+		Expect(newShoot.Spec.Cloud.AWS.Workers[0].Zones).To(Equal([]string{"us-east-1b"}))
+		Expect(newShoot.Spec.Cloud.AWS.Zones).To(Equal([]string{"us-east-1b"}))
+		Expect(shoot.Spec.Cloud.AWS.Zones).To(Equal([]string{"eu-west-1b"}))
+	})
+
+	It("should fail if a zone cannot be mapped to the new region", func() {
+		region := "ap-south-1"
+		shootClone.Spec.Region = &region
+
+		_, err := clone()
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should fail if the new region is not offered by the cloud profile", func() {
+		region := "sa-east-1"
+		shootClone.Spec.Region = &region
+
+		_, err := clone()
+		Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+	})
+
+	It("should use the default networks of the seed", func() {
+		pods, services := "10.96.0.0/11", "10.64.0.0/13"
+		seed.Spec.Networks.ShootDefaults = &garden.ShootNetworks{Pods: &pods, Services: &services}
+
+		newShoot, err := clone()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newShoot.Spec.Networking.Pods).To(PointTo(Equal(pods)))
+		Expect(newShoot.Spec.Networking.Services).To(PointTo(Equal(services)))
+		Expect(newShoot.Spec.Cloud.AWS.Networks.Pods).To(PointTo(Equal(pods)))
+		Expect(newShoot.Spec.Cloud.AWS.Networks.Services).To(PointTo(Equal(services)))
+		Expect(shoot.Spec.Networking.Pods).To(PointTo(Equal("100.96.0.0/11")))
+	})
+
+	It("should use the given seed", func() {
+		otherSeedName := "aws-us1"
+		shootClone.Spec.SeedName = &otherSeedName
+		seeds["/"+otherSeedName] = &garden.Seed{ObjectMeta: metav1.ObjectMeta{Name: otherSeedName}}
+
+		newShoot, err := clone()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(newShoot.Spec.SeedName).To(PointTo(Equal(otherSeedName)))
+		Expect(newShoot.Spec.Cloud.Seed).To(PointTo(Equal(otherSeedName)))
+	})
+
+	It("should reject invalid requests", func() {
+		shootClone.Spec.Name = ""
+
+		_, err := clone()
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+
+	It("should call the create validation", func() {
+		_, err := NewCloneREST(shoots, seeds, cloudProfiles).Create(ctx, shootName, shootClone, func(runtime.Object) error {
+			return apierrors.NewForbidden(garden.Resource("shoots"), shootName, errors.New("forbidden"))
+		}, &metav1.CreateOptions{})
+		Expect(apierrors.IsForbidden(err)).To(BeTrue())
+	})
+})
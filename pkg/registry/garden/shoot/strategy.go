@@ -112,6 +112,19 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 		return true
 	}
 
+	// The control plane of the Shoot shall be migrated to another Seed. We don't want to remove the annotation so that
+	// the controller-manager can pick it up and migrate the control plane. It has to remove the annotation after it
+	// is done.
+	if oldShoot.Annotations[common.ShootOperation] != common.ShootOperationMigrate && newShoot.Annotations[common.ShootOperation] == common.ShootOperationMigrate {
+		return true
+	}
+
+	// The main etcd of the Shoot shall be restored to an earlier point in time. Same as above, the controller-manager
+	// removes the annotation after it is done.
+	if oldShoot.Annotations[common.ShootOperation] != common.ShootOperationRestoreETCD && newShoot.Annotations[common.ShootOperation] == common.ShootOperationRestoreETCD {
+		return true
+	}
+
 	if lastOperation := newShoot.Status.LastOperation; lastOperation != nil {
 		mustIncrease := false
 
@@ -127,9 +140,9 @@ func mustIncreaseGeneration(oldShoot, newShoot *garden.Shoot) bool {
 				if val == common.ShootOperationReconcile {
 					mustIncrease = true
 				}
-				if val == common.ShootOperationRotateKubeconfigCredentials {
+				if val == common.ShootOperationRotateKubeconfigCredentials || val == common.ShootOperationRollWorkerPools {
 					// We don't want to remove the annotation so that the controller-manager can pick it up and rotate
-					// the credentials. It has to remove the annotation after it is done.
+					// the credentials or roll the worker pools. It has to remove the annotation after it is done.
 					return true
 				}
 			}
@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
 	strategy "github.com/gardener/gardener/pkg/registry/garden/shoot"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -88,6 +89,66 @@ var _ = Describe("MatchShoot", func() {
 var _ = Describe("Strategy", func() {
 
 	Context("PrepareForUpdate", func() {
+		Context("migrate operation", func() {
+			It("should increase the generation and keep the annotation if the migration is requested", func() {
+				oldShoot := newShoot("foo")
+				shoot := newShoot("foo")
+				shoot.Annotations = map[string]string{
+					common.ShootOperation:           common.ShootOperationMigrate,
+					common.ShootMigrationTargetSeed: "bar",
+				}
+
+				strategy.Strategy.PrepareForUpdate(context.TODO(), shoot, oldShoot)
+
+				Expect(shoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootOperation, common.ShootOperationMigrate))
+			})
+
+			It("should not increase the generation if the migration has already been requested", func() {
+				oldShoot := newShoot("foo")
+				oldShoot.Annotations = map[string]string{common.ShootOperation: common.ShootOperationMigrate}
+				shoot := oldShoot.DeepCopy()
+				shoot.Labels["baz"] = "qux"
+
+				strategy.Strategy.PrepareForUpdate(context.TODO(), shoot, oldShoot)
+
+				Expect(shoot.Generation).To(Equal(oldShoot.Generation))
+			})
+		})
+
+		Context("restore-etcd operation", func() {
+			It("should increase the generation and keep the annotation if the restoration is requested", func() {
+				oldShoot := newShoot("foo")
+				shoot := newShoot("foo")
+				shoot.Annotations = map[string]string{
+					common.ShootOperation:         common.ShootOperationRestoreETCD,
+					common.ShootETCDRestoreTarget: "4711",
+				}
+
+				strategy.Strategy.PrepareForUpdate(context.TODO(), shoot, oldShoot)
+
+				Expect(shoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootOperation, common.ShootOperationRestoreETCD))
+			})
+		})
+
+		Context("roll-worker-pools operation", func() {
+			It("should increase the generation and keep the annotation if rolling the worker pools is requested", func() {
+				oldShoot := newShoot("foo")
+				oldShoot.Status.LastOperation = &garden.LastOperation{State: garden.LastOperationStateSucceeded}
+				shoot := oldShoot.DeepCopy()
+				shoot.Annotations = map[string]string{
+					common.ShootOperation:       common.ShootOperationRollWorkerPools,
+					common.ShootRollWorkerPools: "worker-1",
+				}
+
+				strategy.Strategy.PrepareForUpdate(context.TODO(), shoot, oldShoot)
+
+				Expect(shoot.Generation).To(Equal(oldShoot.Generation + 1))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootOperation, common.ShootOperationRollWorkerPools))
+			})
+		})
+
 		Context("invalid GCP network CIRDs", func() {
 			It("should remove more than one GCP networks", func() {
 				shoot := newShoot("foo")
@@ -19,7 +19,10 @@ import (
 	"github.com/gardener/gardener/pkg/apis/settings"
 	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
 	clusteroidcpresetstore "github.com/gardener/gardener/pkg/registry/settings/clusteropenidconnectpreset/storage"
+	clustershootpresetstore "github.com/gardener/gardener/pkg/registry/settings/clustershootpreset/storage"
 	oidcpresetstore "github.com/gardener/gardener/pkg/registry/settings/openidconnectpreset/storage"
+	shootpolicystore "github.com/gardener/gardener/pkg/registry/settings/shootpolicy/storage"
+	shootpresetstore "github.com/gardener/gardener/pkg/registry/settings/shootpreset/storage"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apiserver/pkg/registry/generic"
 	"k8s.io/apiserver/pkg/registry/rest"
@@ -50,5 +53,14 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["openidconnectpresets"] = oidcPresetStorage.OpenIDConnectPreset
 	storage["clusteropenidconnectpresets"] = clusterOIDCStorage.ClusterOpenIDConnectPreset
 
+	shootPresetStorage := shootpresetstore.NewStorage(restOptionsGetter)
+	clusterShootPresetStorage := clustershootpresetstore.NewStorage(restOptionsGetter)
+
+	storage["shootpresets"] = shootPresetStorage.ShootPreset
+	storage["clustershootpresets"] = clusterShootPresetStorage.ClusterShootPreset
+
+	shootPolicyStorage := shootpolicystore.NewStorage(restOptionsGetter)
+	storage["shootpolicies"] = shootPolicyStorage.ShootPolicy
+
 	return storage
 }
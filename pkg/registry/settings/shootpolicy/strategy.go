@@ -0,0 +1,69 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootpolicy
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	"github.com/gardener/gardener/pkg/apis/settings/validation"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+)
+
+type shootPolicyStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for shootpolicies.
+var Strategy = shootPolicyStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootPolicyStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (shootPolicyStrategy) PrepareForCreate(ctx context.Context, obj runtime.Object) {
+
+}
+
+func (shootPolicyStrategy) PrepareForUpdate(ctx context.Context, obj, old runtime.Object) {
+
+}
+
+func (shootPolicyStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
+	policy := obj.(*settings.ShootPolicy)
+	return validation.ValidateShootPolicy(policy)
+}
+
+func (shootPolicyStrategy) Canonicalize(obj runtime.Object) {
+}
+
+func (shootPolicyStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootPolicyStrategy) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	newPolicy := newObj.(*settings.ShootPolicy)
+	oldPolicy := oldObj.(*settings.ShootPolicy)
+	return validation.ValidateShootPolicyUpdate(newPolicy, oldPolicy)
+}
+
+func (shootPolicyStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
@@ -75,6 +75,9 @@ type SchedulerControllerConfiguration struct {
 	// Shoot defines the configuration of the Shoot controller.
 	// +optional
 	Shoot *ShootSchedulerConfiguration
+	// SeedDrain defines the configuration of the controller migrating Shoots away from drained Seeds.
+	// +optional
+	SeedDrain *SeedDrainSchedulerConfiguration
 }
 
 // BackupBucketSchedulerConfiguration defines the configuration of the BackupBucket to Seed
@@ -115,6 +118,20 @@ type ShootSchedulerConfiguration struct {
 	Strategy CandidateDeterminationStrategy
 }
 
+// SeedDrainSchedulerConfiguration defines the configuration of the controller which migrates the control planes
+// of all Shoots away from drained Seeds.
+type SeedDrainSchedulerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs int
+	// ConcurrentMigrations is the maximum number of Shoots per drained Seed whose control planes are
+	// migrated at the same time. Defaults to 3.
+	ConcurrentMigrations int
+	// SyncPeriod is the duration how often the progress of drained Seeds is checked. Defaults to 1m.
+	// +optional
+	SyncPeriod metav1.Duration
+}
+
 // DiscoveryConfiguration defines the configuration of how to discover API groups.
 // It allows to set where to store caching data and to specify the TTL of that data.
 type DiscoveryConfiguration struct {
@@ -95,6 +95,18 @@ func SetDefaults_SchedulerConfiguration(obj *SchedulerConfiguration) {
 		obj.Schedulers.Shoot.Strategy = Default
 	}
 
+	if obj.Schedulers.SeedDrain == nil {
+		obj.Schedulers.SeedDrain = &SeedDrainSchedulerConfiguration{
+			ConcurrentSyncs: 1,
+		}
+	}
+	if obj.Schedulers.SeedDrain.ConcurrentMigrations == 0 {
+		obj.Schedulers.SeedDrain.ConcurrentMigrations = 3
+	}
+	if obj.Schedulers.SeedDrain.SyncPeriod.Duration == 0 {
+		obj.Schedulers.SeedDrain.SyncPeriod = metav1.Duration{Duration: time.Minute}
+	}
+
 }
 
 // SetDefaults_ClientConnection sets defaults for the client connection.
@@ -87,6 +87,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedDrainSchedulerConfiguration)(nil), (*config.SeedDrainSchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedDrainSchedulerConfiguration_To_config_SeedDrainSchedulerConfiguration(a.(*SeedDrainSchedulerConfiguration), b.(*config.SeedDrainSchedulerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedDrainSchedulerConfiguration)(nil), (*SeedDrainSchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedDrainSchedulerConfiguration_To_v1alpha1_SeedDrainSchedulerConfiguration(a.(*config.SeedDrainSchedulerConfiguration), b.(*SeedDrainSchedulerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -247,6 +257,7 @@ func Convert_config_SchedulerConfiguration_To_v1alpha1_SchedulerConfiguration(in
 func autoConvert_v1alpha1_SchedulerControllerConfiguration_To_config_SchedulerControllerConfiguration(in *SchedulerControllerConfiguration, out *config.SchedulerControllerConfiguration, s conversion.Scope) error {
 	out.BackupBucket = (*config.BackupBucketSchedulerConfiguration)(unsafe.Pointer(in.BackupBucket))
 	out.Shoot = (*config.ShootSchedulerConfiguration)(unsafe.Pointer(in.Shoot))
+	out.SeedDrain = (*config.SeedDrainSchedulerConfiguration)(unsafe.Pointer(in.SeedDrain))
 	return nil
 }
 
@@ -258,6 +269,7 @@ func Convert_v1alpha1_SchedulerControllerConfiguration_To_config_SchedulerContro
 func autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in *config.SchedulerControllerConfiguration, out *SchedulerControllerConfiguration, s conversion.Scope) error {
 	out.BackupBucket = (*BackupBucketSchedulerConfiguration)(unsafe.Pointer(in.BackupBucket))
 	out.Shoot = (*ShootSchedulerConfiguration)(unsafe.Pointer(in.Shoot))
+	out.SeedDrain = (*SeedDrainSchedulerConfiguration)(unsafe.Pointer(in.SeedDrain))
 	return nil
 }
 
@@ -266,6 +278,30 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedDrainSchedulerConfiguration_To_config_SeedDrainSchedulerConfiguration(in *SeedDrainSchedulerConfiguration, out *config.SeedDrainSchedulerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ConcurrentMigrations = in.ConcurrentMigrations
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_v1alpha1_SeedDrainSchedulerConfiguration_To_config_SeedDrainSchedulerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedDrainSchedulerConfiguration_To_config_SeedDrainSchedulerConfiguration(in *SeedDrainSchedulerConfiguration, out *config.SeedDrainSchedulerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedDrainSchedulerConfiguration_To_config_SeedDrainSchedulerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedDrainSchedulerConfiguration_To_v1alpha1_SeedDrainSchedulerConfiguration(in *config.SeedDrainSchedulerConfiguration, out *SeedDrainSchedulerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = in.ConcurrentSyncs
+	out.ConcurrentMigrations = in.ConcurrentMigrations
+	out.SyncPeriod = in.SyncPeriod
+	return nil
+}
+
+// Convert_config_SeedDrainSchedulerConfiguration_To_v1alpha1_SeedDrainSchedulerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedDrainSchedulerConfiguration_To_v1alpha1_SeedDrainSchedulerConfiguration(in *config.SeedDrainSchedulerConfiguration, out *SeedDrainSchedulerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedDrainSchedulerConfiguration_To_v1alpha1_SeedDrainSchedulerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -133,6 +133,11 @@ func (in *SchedulerControllerConfiguration) DeepCopyInto(out *SchedulerControlle
 		*out = new(ShootSchedulerConfiguration)
 		**out = **in
 	}
+	if in.SeedDrain != nil {
+		in, out := &in.SeedDrain, &out.SeedDrain
+		*out = new(SeedDrainSchedulerConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -146,6 +151,23 @@ func (in *SchedulerControllerConfiguration) DeepCopy() *SchedulerControllerConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedDrainSchedulerConfiguration) DeepCopyInto(out *SeedDrainSchedulerConfiguration) {
+	*out = *in
+	out.SyncPeriod = in.SyncPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedDrainSchedulerConfiguration.
+func (in *SeedDrainSchedulerConfiguration) DeepCopy() *SeedDrainSchedulerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedDrainSchedulerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -22,6 +22,16 @@ import (
 
 // ValidateConfiguration validates the configuration.
 func ValidateConfiguration(config *schedulerapi.SchedulerConfiguration) error {
+	if err := validateStrategy(config); err != nil {
+		return err
+	}
+	if seedDrain := config.Schedulers.SeedDrain; seedDrain != nil && seedDrain.ConcurrentMigrations <= 0 {
+		return fmt.Errorf("the number of concurrent migrations of drained seeds configured in gardener scheduler must be positive, got %d", seedDrain.ConcurrentMigrations)
+	}
+	return nil
+}
+
+func validateStrategy(config *schedulerapi.SchedulerConfiguration) error {
 	for _, strategy := range schedulerapi.Strategies {
 		if strategy == config.Schedulers.Shoot.Strategy {
 			return nil
@@ -65,6 +65,14 @@ var _ = Describe("gardener-scheduler", func() {
 
 				Expect(err).To(HaveOccurred())
 			})
+
+			It("should fail because the number of concurrent migrations of drained seeds is not positive", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.SeedDrain = &schedulerapi.SeedDrainSchedulerConfiguration{ConcurrentMigrations: 0}
+				err := ValidateConfiguration(&invalidConfiguration)
+
+				Expect(err).To(HaveOccurred())
+			})
 		})
 	})
 })
@@ -48,6 +48,7 @@ type SchedulerController struct {
 
 	seedLister gardencorelisters.SeedLister
 	seedSynced cache.InformerSynced
+	seedQueue  workqueue.RateLimitingInterface
 
 	shootLister gardencorelisters.ShootLister
 	shootSynced cache.InformerSynced
@@ -70,6 +71,7 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		cloudProfileInformer = coreV1Alpha1Informer.CloudProfiles()
 		cloudProfileLister   = cloudProfileInformer.Lister()
 		shootQueue           = workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(config.Schedulers.Shoot.RetrySyncPeriod.Duration, 12*time.Hour), "gardener-shoot-scheduler")
+		seedQueue            = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "gardener-seed-drain")
 	)
 
 	schedulerController := &SchedulerController{
@@ -80,6 +82,7 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		recorder:               recorder,
 		cloudProfileLister:     cloudProfileLister,
 		seedLister:             seedLister,
+		seedQueue:              seedQueue,
 		shootQueue:             shootQueue,
 		shootLister:            shootLister,
 		workerCh:               make(chan int),
@@ -89,6 +92,10 @@ func NewGardenerScheduler(k8sGardenClient kubernetes.Interface, gardenCoreInform
 		AddFunc:    schedulerController.shootAdd,
 		UpdateFunc: schedulerController.shootUpdate,
 	})
+	seedInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    schedulerController.seedAdd,
+		UpdateFunc: schedulerController.seedUpdate,
+	})
 	schedulerController.cloudProfileSynced = cloudProfileInformer.Informer().HasSynced
 	schedulerController.seedSynced = seedInformer.Informer().HasSynced
 	schedulerController.shootSynced = shootInformer.Informer().HasSynced
@@ -122,17 +129,23 @@ func (c *SchedulerController) Run(ctx context.Context, k8sGardenCoreInformers ga
 		controllerutils.DeprecatedCreateWorker(ctx, c.shootQueue, "gardener-scheduler", func(key string) error { return c.reconcileShootKey(ctx, key) }, &waitGroup, c.workerCh)
 	}
 
+	for i := 0; i < c.config.Schedulers.SeedDrain.ConcurrentSyncs; i++ {
+		controllerutils.DeprecatedCreateWorker(ctx, c.seedQueue, "gardener-seed-drain", func(key string) error { return c.reconcileSeedDrainKey(ctx, key) }, &waitGroup, c.workerCh)
+	}
+
 	logger.Logger.Infof("Shoot Scheduler controller initialized with %d workers  (with Strategy: %s)", c.config.Schedulers.Shoot.ConcurrentSyncs, c.config.Schedulers.Shoot.Strategy)
+	logger.Logger.Infof("Seed drain controller initialized with %d workers (with %d concurrent migrations per seed)", c.config.Schedulers.SeedDrain.ConcurrentSyncs, c.config.Schedulers.SeedDrain.ConcurrentMigrations)
 
 	<-ctx.Done()
 	c.shootQueue.ShutDown()
+	c.seedQueue.ShutDown()
 
 	for {
-		if c.shootQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
+		if c.shootQueue.Len() == 0 && c.seedQueue.Len() == 0 && c.numberOfRunningWorkers == 0 {
 			logger.Logger.Debug("No running Scheduler worker and no items left in the queues. Terminated Scheduler controller...")
 			break
 		}
-		logger.Logger.Debugf("Waiting for %d Scheduler worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.shootQueue.Len())
+		logger.Logger.Debugf("Waiting for %d Scheduler worker(s) to finish (%d item(s) left in the queues)...", c.numberOfRunningWorkers, c.shootQueue.Len()+c.seedQueue.Len())
 		time.Sleep(5 * time.Second)
 	}
 
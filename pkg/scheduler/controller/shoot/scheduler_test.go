@@ -385,6 +385,20 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to cordoning", func() {
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			seed.Spec.Taints = []gardencorev1alpha1.SeedTaint{
+				{Key: gardencorev1alpha1.SeedTaintCordoned},
+			}
+			gardenCoreInformerFactory.Core().V1alpha1().Seeds().Informer().GetStore().Add(&seed)
+
+			bestSeed, err := determineSeed(&shoot, gardenCoreInformerFactory.Core().V1alpha1().Seeds().Lister(), gardenCoreInformerFactory.Core().V1alpha1().Shoots().Lister(), gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(), schedulerConfiguration.Schedulers.Shoot.Strategy)
+
+			Expect(err).To(HaveOccurred())
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to invisibility", func() {
 			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
 
@@ -400,6 +414,70 @@ var _ = Describe("Scheduler_Control", func() {
 		})
 	})
 
+	Context("SEED DRAIN - determine the migration target of a Shoot running on a drained Seed", func() {
+		var (
+			drainedSeed gardencorev1alpha1.Seed
+			controller  *SchedulerController
+		)
+
+		BeforeEach(func() {
+			cloudProfile = *cloudProfileBase.DeepCopy()
+			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+
+			drainedSeed = *seedBase.DeepCopy()
+			drainedSeed.Name = "drained"
+			drainedSeed.Spec.Backup = &gardencorev1alpha1.SeedBackup{Provider: providerType}
+			drainedSeed.Spec.Taints = []gardencorev1alpha1.SeedTaint{{Key: gardencorev1alpha1.SeedTaintCordoned}}
+
+			seed = *seedBase.DeepCopy()
+			seed.Spec.Backup = &gardencorev1alpha1.SeedBackup{Provider: providerType}
+
+			shoot = *shootBase.DeepCopy()
+			shoot.Spec.SeedName = &drainedSeed.Name
+
+			controller = &SchedulerController{
+				config:             &schedulerConfiguration,
+				cloudProfileLister: gardenCoreInformerFactory.Core().V1alpha1().CloudProfiles().Lister(),
+			}
+		})
+
+		It("should find a seed with the same backup provider", func() {
+			target, err := controller.determineMigrationTarget(&shoot, &drainedSeed, []*gardencorev1alpha1.Seed{&drainedSeed, &seed}, []*gardencorev1alpha1.Shoot{&shoot})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(target.Name).To(Equal(seed.Name))
+		})
+
+		It("should fail because no other seed uses the same backup provider", func() {
+			seed.Spec.Backup.Provider = "other"
+
+			target, err := controller.determineMigrationTarget(&shoot, &drainedSeed, []*gardencorev1alpha1.Seed{&drainedSeed, &seed}, []*gardencorev1alpha1.Shoot{&shoot})
+
+			Expect(err).To(HaveOccurred())
+			Expect(target).To(BeNil())
+		})
+
+		It("should fail because the other seed is cordoned as well", func() {
+			seed.Spec.Taints = []gardencorev1alpha1.SeedTaint{{Key: gardencorev1alpha1.SeedTaintCordoned}}
+
+			target, err := controller.determineMigrationTarget(&shoot, &drainedSeed, []*gardencorev1alpha1.Seed{&drainedSeed, &seed}, []*gardencorev1alpha1.Shoot{&shoot})
+
+			Expect(err).To(HaveOccurred())
+			Expect(target).To(BeNil())
+		})
+
+		It("should fail because the drained seed does not have backups enabled", func() {
+			drainedSeed.Spec.Backup = nil
+
+			target, err := controller.determineMigrationTarget(&shoot, &drainedSeed, []*gardencorev1alpha1.Seed{&drainedSeed, &seed}, []*gardencorev1alpha1.Shoot{&shoot})
+
+			Expect(err).To(HaveOccurred())
+			Expect(target).To(BeNil())
+		})
+	})
+
 	Context("Scheduling", func() {
 		var (
 			shoot = shootBase.DeepCopy()
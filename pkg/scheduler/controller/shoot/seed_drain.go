@@ -0,0 +1,245 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	v1alpha1constants "github.com/gardener/gardener/pkg/apis/core/v1alpha1/constants"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/operation/common"
+	schedulerutils "github.com/gardener/gardener/pkg/scheduler/utils"
+	kutil "github.com/gardener/gardener/pkg/utils/kubernetes"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// MsgSeedDrained is the message for the Drained condition of a Seed from which all Shoots have been migrated away.
+	MsgSeedDrained = "All shoot control planes have been migrated away from the seed."
+	// EventMigrationScheduled is the reason for the Event on a Shoot whose control plane migration has been scheduled
+	// because its Seed is drained.
+	EventMigrationScheduled = "MigrationScheduled"
+	// EventMigrationSchedulingFailed is the reason for the Event on a Shoot whose control plane cannot be migrated
+	// away from a drained Seed.
+	EventMigrationSchedulingFailed = "MigrationSchedulingFailed"
+)
+
+func (c *SchedulerController) seedAdd(obj interface{}) {
+	seed, ok := obj.(*gardencorev1alpha1.Seed)
+	if !ok {
+		return
+	}
+	if !isSeedDraining(seed) {
+		return
+	}
+
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		logger.Logger.Errorf("Couldn't get key for object %+v: %v", obj, err)
+		return
+	}
+	c.seedQueue.Add(key)
+}
+
+func (c *SchedulerController) seedUpdate(oldObj, newObj interface{}) {
+	c.seedAdd(newObj)
+}
+
+func (c *SchedulerController) reconcileSeedDrainKey(ctx context.Context, key string) error {
+	seed, err := c.seedLister.Get(key)
+	if apierrors.IsNotFound(err) {
+		logger.Logger.Debugf("[SEED DRAIN] %s - skipping because Seed has been deleted", key)
+		return nil
+	}
+	if err != nil {
+		logger.Logger.Infof("[SEED DRAIN] %s - unable to retrieve object from store: %v", key, err)
+		return err
+	}
+	if !isSeedDraining(seed) {
+		return nil
+	}
+
+	drained, err := c.drainSeed(ctx, seed.DeepCopy())
+	if err != nil {
+		return err
+	}
+	if !drained {
+		c.seedQueue.AddAfter(key, c.config.Schedulers.SeedDrain.SyncPeriod.Duration)
+	}
+	return nil
+}
+
+// drainSeed schedules the control plane migration of the Shoots running on the given Seed, respecting the configured
+// number of concurrent migrations, and reports the progress in the Drained condition of the Seed. It returns true
+// once no Shoot is left on the Seed.
+func (c *SchedulerController) drainSeed(ctx context.Context, seed *gardencorev1alpha1.Seed) (bool, error) {
+	seedLogger := logger.NewFieldLogger(logger.Logger, "scheduler", "seed-drain").WithField("seed", seed.Name)
+
+	shootList, err := c.shootLister.List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+	seedList, err := c.seedLister.List(labels.Everything())
+	if err != nil {
+		return false, err
+	}
+
+	var (
+		remaining int
+		migrating int
+		pending   []*gardencorev1alpha1.Shoot
+		blocked   []string
+	)
+
+	for _, shoot := range shootList {
+		switch {
+		case shoot.Annotations[common.ShootMigrationSourceSeed] == seed.Name:
+			remaining++
+			migrating++
+		case shoot.Spec.SeedName == nil || *shoot.Spec.SeedName != seed.Name:
+			continue
+		case shoot.Annotations[common.ShootOperation] == common.ShootOperationMigrate:
+			remaining++
+			migrating++
+		case shoot.DeletionTimestamp != nil:
+			remaining++
+		case isShootFailed(shoot):
+			remaining++
+			blocked = append(blocked, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+		default:
+			remaining++
+			pending = append(pending, shoot)
+		}
+	}
+
+	condition := gardencorev1alpha1helper.GetOrInitCondition(seed.Status.Conditions, gardencorev1alpha1.SeedDrained)
+
+	if remaining == 0 {
+		seedLogger.Info("Seed has been drained")
+		if err := c.updateSeedDrainedCondition(seed, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionTrue, "SeedDrained", MsgSeedDrained)); err != nil {
+			return false, err
+		}
+		return true, c.finishSeedDrain(seed)
+	}
+
+	// Shoots are migrated in a stable order to keep the progress predictable across reconciliations.
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].CreationTimestamp.Before(&pending[j].CreationTimestamp)
+	})
+
+	for _, shoot := range pending {
+		if migrating >= c.config.Schedulers.SeedDrain.ConcurrentMigrations {
+			break
+		}
+
+		target, err := c.determineMigrationTarget(shoot, seed, seedList, shootList)
+		if err != nil {
+			c.recorder.Eventf(shoot, corev1.EventTypeWarning, EventMigrationSchedulingFailed, "Cannot migrate shoot control plane away from drained seed %s: %v", seed.Name, err)
+			blocked = append(blocked, fmt.Sprintf("%s/%s", shoot.Namespace, shoot.Name))
+			continue
+		}
+
+		if _, err := kutil.TryUpdateCoreShoot(c.k8sGardenClient.GardenCore(), retry.DefaultBackoff, shoot.ObjectMeta, func(shoot *gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Shoot, error) {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootMigrationTargetSeed, target.Name)
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.ShootOperation, common.ShootOperationMigrate)
+			return shoot, nil
+		}); err != nil {
+			return false, err
+		}
+
+		seedLogger.Infof("Scheduled control plane migration of shoot %s/%s to seed %s", shoot.Namespace, shoot.Name, target.Name)
+		c.recorder.Eventf(shoot, corev1.EventTypeNormal, EventMigrationScheduled, "Scheduled control plane migration from drained seed %s to seed %s", seed.Name, target.Name)
+		migrating++
+	}
+
+	message := fmt.Sprintf("%d shoot(s) remaining on the seed, %d control plane migration(s) in progress.", remaining, migrating)
+	if len(blocked) > 0 {
+		message += fmt.Sprintf(" The following shoots cannot be migrated: %s.", strings.Join(blocked, ", "))
+	}
+	return false, c.updateSeedDrainedCondition(seed, gardencorev1alpha1helper.UpdatedCondition(condition, gardencorev1alpha1.ConditionProgressing, "DrainInProgress", message))
+}
+
+// determineMigrationTarget determines the Seed the control plane of the given Shoot is migrated to when the given
+// Seed is drained. Only Seeds passing the same checks as a manually requested migration are considered, see
+// schedulerutils.ValidateMigrationTarget.
+func (c *SchedulerController) determineMigrationTarget(shoot *gardencorev1alpha1.Shoot, drainedSeed *gardencorev1alpha1.Seed, seedList []*gardencorev1alpha1.Seed, shootList []*gardencorev1alpha1.Shoot) (*gardencorev1alpha1.Seed, error) {
+	if drainedSeed.Spec.Backup == nil {
+		return nil, fmt.Errorf("seed %s does not have backups enabled", drainedSeed.Name)
+	}
+
+	cloudProfile, err := c.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*gardencorev1alpha1.Seed
+	for _, seed := range seedList {
+		if err := schedulerutils.ValidateMigrationTarget(shoot, cloudProfile, drainedSeed, seed); err != nil {
+			continue
+		}
+		candidates = append(candidates, seed)
+	}
+
+	return determineBestSeedCandidate(shoot, cloudProfile, shootList, candidates, c.config.Schedulers.Shoot.Strategy)
+}
+
+func (c *SchedulerController) updateSeedDrainedCondition(seed *gardencorev1alpha1.Seed, condition gardencorev1alpha1.Condition) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().Seeds().Get(seed.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		current.Status.Conditions = gardencorev1alpha1helper.MergeConditions(current.Status.Conditions, condition)
+		_, err = c.k8sGardenClient.GardenCore().CoreV1alpha1().Seeds().UpdateStatus(current)
+		return err
+	})
+}
+
+// finishSeedDrain removes the drain operation annotation from the given Seed. The cordon taint is kept so that no
+// new Shoots are scheduled onto the drained Seed.
+func (c *SchedulerController) finishSeedDrain(seed *gardencorev1alpha1.Seed) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		current, err := c.k8sGardenClient.GardenCore().CoreV1alpha1().Seeds().Get(seed.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !isSeedDraining(current) {
+			return nil
+		}
+		delete(current.Annotations, v1alpha1constants.GardenerOperation)
+		_, err = c.k8sGardenClient.GardenCore().CoreV1alpha1().Seeds().Update(current)
+		return err
+	})
+}
+
+func isSeedDraining(seed *gardencorev1alpha1.Seed) bool {
+	return seed.Annotations[v1alpha1constants.GardenerOperation] == v1alpha1constants.GardenerOperationDrain
+}
+
+func isShootFailed(shoot *gardencorev1alpha1.Shoot) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil && lastOperation.State == gardencorev1alpha1.LastOperationStateFailed && shoot.Generation == shoot.Status.ObservedGeneration
+}
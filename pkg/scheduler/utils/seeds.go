@@ -0,0 +1,102 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	gardencorev1alpha1helper "github.com/gardener/gardener/pkg/apis/core/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// IsSeedAvailable returns true if the SeedAvailable condition of the given Seed is True.
+func IsSeedAvailable(seed *gardencorev1alpha1.Seed) bool {
+	if cond := gardencorev1alpha1helper.GetCondition(seed.Status.Conditions, gardencorev1alpha1.SeedAvailable); cond != nil {
+		return cond.Status == gardencorev1alpha1.ConditionTrue
+	}
+	return false
+}
+
+// IsSeedUsable returns true if new Shoot control planes may be placed on the given Seed, i.e. if it is not being
+// deleted, neither invisible nor cordoned, and available.
+func IsSeedUsable(seed *gardencorev1alpha1.Seed) bool {
+	return seed.DeletionTimestamp == nil &&
+		!gardencorev1alpha1helper.TaintsHave(seed.Spec.Taints, gardencorev1alpha1.SeedTaintInvisible) &&
+		!gardencorev1alpha1helper.TaintsHave(seed.Spec.Taints, gardencorev1alpha1.SeedTaintCordoned) &&
+		IsSeedAvailable(seed)
+}
+
+// NetworksAreDisjunct returns true if the networks of the given Shoot do not intersect with the networks of the
+// given Seed.
+func NetworksAreDisjunct(seed *gardencorev1alpha1.Seed, shoot *gardencorev1alpha1.Shoot) bool {
+	return len(ValidateNetworkDisjointedness(seed.Spec.Networks, shoot.Spec.Networking.Nodes, shoot.Spec.Networking.Pods, shoot.Spec.Networking.Services, field.NewPath(""))) == 0
+}
+
+// SeedSelectorMatches returns true if the given Seed matches the seed selector of the given CloudProfile.
+func SeedSelectorMatches(cloudProfile *gardencorev1alpha1.CloudProfile, seed *gardencorev1alpha1.Seed) (bool, error) {
+	selector := &metav1.LabelSelector{}
+	if cloudProfile.Spec.SeedSelector != nil {
+		selector = cloudProfile.Spec.SeedSelector
+	}
+	seedSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return false, fmt.Errorf("label selector conversion failed: %v for seedSelector: %v", *selector, err)
+	}
+	return seedSelector.Matches(labels.Set(seed.Labels)), nil
+}
+
+// ValidateMigrationTarget checks whether the control plane of the given Shoot can be migrated from <sourceSeed> to
+// <targetSeed>. Next to the predicates the scheduler applies to every Seed, the target Seed must be in the region of
+// the source Seed and use the same backup provider, because the etcd of the Shoot is restored from the backup bucket
+// of the source Seed.
+func ValidateMigrationTarget(shoot *gardencorev1alpha1.Shoot, cloudProfile *gardencorev1alpha1.CloudProfile, sourceSeed, targetSeed *gardencorev1alpha1.Seed) error {
+	if targetSeed.Name == sourceSeed.Name {
+		return fmt.Errorf("seed %s is already the seed of the shoot", targetSeed.Name)
+	}
+	if !IsSeedUsable(targetSeed) {
+		return fmt.Errorf("seed %s is being deleted, invisible, cordoned or not available", targetSeed.Name)
+	}
+	if shoot.Namespace != common.GardenNamespace && gardencorev1alpha1helper.TaintsHave(targetSeed.Spec.Taints, gardencorev1alpha1.SeedTaintProtected) {
+		return fmt.Errorf("seed %s is protected and only accepts shoots in the %s namespace", targetSeed.Name, common.GardenNamespace)
+	}
+	if targetSeed.Spec.Provider.Type != shoot.Spec.Provider.Type {
+		return fmt.Errorf("seed %s has provider type %s but the shoot has provider type %s", targetSeed.Name, targetSeed.Spec.Provider.Type, shoot.Spec.Provider.Type)
+	}
+	if targetSeed.Spec.Provider.Region != sourceSeed.Spec.Provider.Region {
+		return fmt.Errorf("seed %s is in region %s but the shoot's seed is in region %s", targetSeed.Name, targetSeed.Spec.Provider.Region, sourceSeed.Spec.Provider.Region)
+	}
+	if sourceSeed.Spec.Backup == nil {
+		return fmt.Errorf("seed %s does not have backups enabled, the etcd cannot be restored", sourceSeed.Name)
+	}
+	if targetSeed.Spec.Backup == nil || targetSeed.Spec.Backup.Provider != sourceSeed.Spec.Backup.Provider {
+		return fmt.Errorf("seed %s does not use the backup provider %s", targetSeed.Name, sourceSeed.Spec.Backup.Provider)
+	}
+	if !NetworksAreDisjunct(targetSeed, shoot) {
+		return fmt.Errorf("the networks of seed %s intersect with the networks of the shoot", targetSeed.Name)
+	}
+	matches, err := SeedSelectorMatches(cloudProfile, targetSeed)
+	if err != nil {
+		return err
+	}
+	if !matches {
+		return fmt.Errorf("seed %s does not match the seed selector of cloud profile %s", targetSeed.Name, cloudProfile.Name)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	gardencorev1alpha1 "github.com/gardener/gardener/pkg/apis/core/v1alpha1"
+	. "github.com/gardener/gardener/pkg/scheduler/utils"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("seeds", func() {
+	Describe("#ValidateMigrationTarget", func() {
+		var (
+			cloudProfile *gardencorev1alpha1.CloudProfile
+			shoot        *gardencorev1alpha1.Shoot
+			sourceSeed   *gardencorev1alpha1.Seed
+			targetSeed   *gardencorev1alpha1.Seed
+		)
+
+		BeforeEach(func() {
+			podsCIDR, servicesCIDR := "100.96.0.0/11", "100.64.0.0/13"
+
+			cloudProfile = &gardencorev1alpha1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}}
+			shoot = &gardencorev1alpha1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+				Spec: gardencorev1alpha1.ShootSpec{
+					Provider: gardencorev1alpha1.Provider{Type: "aws"},
+					Networking: gardencorev1alpha1.Networking{
+						Nodes:    "10.250.0.0/16",
+						Pods:     &podsCIDR,
+						Services: &servicesCIDR,
+					},
+				},
+			}
+			sourceSeed = &gardencorev1alpha1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "source"},
+				Spec: gardencorev1alpha1.SeedSpec{
+					Provider: gardencorev1alpha1.SeedProvider{Type: "aws", Region: "eu-west-1"},
+					Backup:   &gardencorev1alpha1.SeedBackup{Provider: "aws"},
+				},
+			}
+			targetSeed = &gardencorev1alpha1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "target", Labels: map[string]string{"foo": "bar"}},
+				Spec: gardencorev1alpha1.SeedSpec{
+					Provider: gardencorev1alpha1.SeedProvider{Type: "aws", Region: "eu-west-1"},
+					Backup:   &gardencorev1alpha1.SeedBackup{Provider: "aws"},
+					Networks: gardencorev1alpha1.SeedNetworks{
+						Nodes:    "10.240.0.0/16",
+						Pods:     "10.241.128.0/17",
+						Services: "10.241.0.0/17",
+					},
+				},
+				Status: gardencorev1alpha1.SeedStatus{
+					Conditions: []gardencorev1alpha1.Condition{
+						{Type: gardencorev1alpha1.SeedAvailable, Status: gardencorev1alpha1.ConditionTrue},
+					},
+				},
+			}
+		})
+
+		It("should accept an eligible target seed", func() {
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).To(Succeed())
+		})
+
+		It("should reject the source seed", func() {
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, sourceSeed)).NotTo(Succeed())
+		})
+
+		It("should reject an unavailable target seed", func() {
+			targetSeed.Status.Conditions[0].Status = gardencorev1alpha1.ConditionFalse
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject an invisible target seed", func() {
+			targetSeed.Spec.Taints = []gardencorev1alpha1.SeedTaint{{Key: gardencorev1alpha1.SeedTaintInvisible}}
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject a cordoned target seed", func() {
+			targetSeed.Spec.Taints = []gardencorev1alpha1.SeedTaint{{Key: gardencorev1alpha1.SeedTaintCordoned}}
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject a protected target seed for shoots outside the garden namespace", func() {
+			targetSeed.Spec.Taints = []gardencorev1alpha1.SeedTaint{{Key: gardencorev1alpha1.SeedTaintProtected}}
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should accept a protected target seed for shoots in the garden namespace", func() {
+			targetSeed.Spec.Taints = []gardencorev1alpha1.SeedTaint{{Key: gardencorev1alpha1.SeedTaintProtected}}
+			shoot.Namespace = "garden"
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).To(Succeed())
+		})
+
+		It("should reject a target seed of another provider type", func() {
+			targetSeed.Spec.Provider.Type = "gcp"
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject a target seed in another region", func() {
+			targetSeed.Spec.Provider.Region = "eu-central-1"
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject a target seed with another backup provider", func() {
+			targetSeed.Spec.Backup.Provider = "gcp"
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject the migration if the source seed has no backups", func() {
+			sourceSeed.Spec.Backup = nil
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject a target seed whose networks intersect with the shoot networks", func() {
+			targetSeed.Spec.Networks.Nodes = shoot.Spec.Networking.Nodes
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+
+		It("should reject a target seed not matching the seed selector of the cloud profile", func() {
+			cloudProfile.Spec.SeedSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "baz"}}
+
+			Expect(ValidateMigrationTarget(shoot, cloudProfile, sourceSeed, targetSeed)).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,151 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldpath
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Path is a parsed field path which selects values of a JSON document. A field path consists of field names
+// separated by dots, e.g. `spec.kubernetes.version`. Lists can be indexed with `[<index>]` or iterated with `[*]`.
+// Field names containing dots can be given in brackets, e.g. `metadata.labels['example.com/foo']`.
+type Path []element
+
+type element struct {
+	key      string
+	index    int
+	isIndex  bool
+	wildcard bool
+}
+
+// Parse parses the given field path.
+func Parse(path string) (Path, error) {
+	var (
+		p   Path
+		s   = strings.TrimPrefix(path, ".")
+		pos = 0
+	)
+
+	if len(s) == 0 {
+		return nil, fmt.Errorf("field path must not be empty")
+	}
+
+	for pos < len(s) {
+		switch s[pos] {
+		case '[':
+			end := strings.IndexByte(s[pos:], ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unterminated bracket at position %d", pos)
+			}
+			elem, err := parseBracket(s[pos+1 : pos+end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid bracket at position %d: %v", pos, err)
+			}
+			p = append(p, elem)
+			pos += end + 1
+
+		case '.':
+			if pos == 0 || pos+1 >= len(s) || s[pos+1] == '.' || s[pos+1] == '[' {
+				return nil, fmt.Errorf("unexpected '.' at position %d", pos)
+			}
+			pos++
+
+		default:
+			if pos > 0 && s[pos-1] != '.' {
+				return nil, fmt.Errorf("expected '.' or '[' at position %d", pos)
+			}
+			end := strings.IndexAny(s[pos:], ".[]'\"")
+			if end == -1 {
+				end = len(s) - pos
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("unexpected %q at position %d", s[pos], pos)
+			}
+			p = append(p, element{key: s[pos : pos+end]})
+			pos += end
+		}
+	}
+
+	return p, nil
+}
+
+func parseBracket(s string) (element, error) {
+	if s == "*" {
+		return element{wildcard: true}, nil
+	}
+	if len(s) >= 2 && (s[0] == '\'' || s[0] == '"') && s[len(s)-1] == s[0] {
+		if len(s) == 2 {
+			return element{}, fmt.Errorf("field name must not be empty")
+		}
+		return element{key: s[1 : len(s)-1]}, nil
+	}
+	index, err := strconv.Atoi(s)
+	if err != nil || index < 0 {
+		return element{}, fmt.Errorf("%q is neither a wildcard, a list index, nor a quoted field name", s)
+	}
+	return element{index: index, isIndex: true}, nil
+}
+
+// Find returns all values of the given JSON document selected by the path. The document must consist of the types
+// produced by unmarshalling JSON into an interface{}. Fields or list items which do not exist are ignored.
+func (p Path) Find(obj interface{}) []interface{} {
+	current := []interface{}{obj}
+
+	for _, elem := range p {
+		var next []interface{}
+
+		for _, value := range current {
+			switch {
+			case elem.wildcard:
+				switch v := value.(type) {
+				case []interface{}:
+					next = append(next, v...)
+				case map[string]interface{}:
+					for _, key := range sortedKeys(v) {
+						next = append(next, v[key])
+					}
+				}
+
+			case elem.isIndex:
+				if list, ok := value.([]interface{}); ok && elem.index < len(list) {
+					next = append(next, list[elem.index])
+				}
+
+			default:
+				if m, ok := value.(map[string]interface{}); ok {
+					if v, ok := m[elem.key]; ok {
+						next = append(next, v)
+					}
+				}
+			}
+		}
+
+		current = next
+	}
+
+	return current
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldpath_test
+
+import (
+	"encoding/json"
+
+	. "github.com/gardener/gardener/pkg/utils/fieldpath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FieldPath", func() {
+	Describe("#Parse", func() {
+		DescribeTable("valid paths",
+			func(path string) {
+				_, err := Parse(path)
+				Expect(err).NotTo(HaveOccurred())
+			},
+			Entry("single field", "spec"),
+			Entry("leading dot", ".spec.kubernetes.version"),
+			Entry("wildcard", "spec.workers[*].machineType"),
+			Entry("index", "spec.workers[0]"),
+			Entry("nested lists", "spec.workers[*].zones[1]"),
+			Entry("quoted field", "metadata.labels['example.com/foo']"),
+			Entry("double quoted field", `metadata.annotations["example.com/foo"].bar`),
+		)
+
+		DescribeTable("invalid paths",
+			func(path string) {
+				_, err := Parse(path)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("empty", ""),
+			Entry("only a dot", "."),
+			Entry("double dot", "spec..version"),
+			Entry("trailing dot", "spec."),
+			Entry("dot before bracket", "spec.[0]"),
+			Entry("unterminated bracket", "spec.workers[0"),
+			Entry("negative index", "spec.workers[-1]"),
+			Entry("invalid index", "spec.workers[a]"),
+			Entry("empty quoted field", "metadata.labels['']"),
+			Entry("field after bracket", "spec.workers[0]name"),
+		)
+	})
+
+	Describe("#Find", func() {
+		var obj interface{}
+
+		BeforeEach(func() {
+			Expect(json.Unmarshal([]byte(`{
+  "metadata": {"labels": {"example.com/foo": "bar"}},
+  "spec": {
+    "version": "1.15.2",
+    "workers": [
+      {"name": "a", "minimum": 1, "zones": ["z1", "z2"]},
+      {"name": "b", "minimum": 3}
+    ]
+  }
+}`), &obj)).To(Succeed())
+		})
+
+		DescribeTable("should find the selected values",
+			func(path string, expected []interface{}) {
+				p, err := Parse(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p.Find(obj)).To(Equal(expected))
+			},
+			Entry("field", "spec.version", []interface{}{"1.15.2"}),
+			Entry("wildcard", "spec.workers[*].minimum", []interface{}{float64(1), float64(3)}),
+			Entry("index", "spec.workers[1].name", []interface{}{"b"}),
+			Entry("nested wildcards", "spec.workers[*].zones[*]", []interface{}{"z1", "z2"}),
+			Entry("map wildcard", "metadata.labels[*]", []interface{}{"bar"}),
+			Entry("quoted field", "metadata.labels['example.com/foo']", []interface{}{"bar"}),
+			Entry("missing field", "spec.kubernetes.version", nil),
+			Entry("index out of range", "spec.workers[2].name", nil),
+			Entry("field of a list", "spec.workers.name", nil),
+		)
+	})
+})
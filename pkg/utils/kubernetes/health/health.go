@@ -230,6 +230,42 @@ func CheckNode(node *corev1.Node) error {
 	return nil
 }
 
+// NodeUnhealthySince returns the time since when the given Node has been unhealthy in the sense of CheckNode, i.e.
+// the earliest transition time of the conditions which are not in their expected state. It returns nil if the node
+// is healthy. If the `corev1.NodeReady` condition is missing then the creation time of the node is returned.
+func NodeUnhealthySince(node *corev1.Node) *time.Time {
+	if CheckNode(node) == nil {
+		return nil
+	}
+
+	var since *time.Time
+	earliest := func(t time.Time) {
+		if since == nil || t.Before(*since) {
+			since = &t
+		}
+	}
+
+	for _, trueConditionType := range trueNodeConditionTypes {
+		condition := getNodeCondition(node.Status.Conditions, trueConditionType)
+		if condition == nil {
+			earliest(node.CreationTimestamp.Time)
+			continue
+		}
+		if condition.Status != corev1.ConditionTrue {
+			earliest(condition.LastTransitionTime.Time)
+		}
+	}
+
+	for _, falseConditionType := range falseNodeConditionTypes {
+		condition := getNodeCondition(node.Status.Conditions, falseConditionType)
+		if condition != nil && condition.Status != corev1.ConditionFalse {
+			earliest(condition.LastTransitionTime.Time)
+		}
+	}
+
+	return since
+}
+
 var (
 	trueMachineDeploymentConditionTypes = []machinev1alpha1.MachineDeploymentConditionType{
 		machinev1alpha1.MachineDeploymentAvailable,
@@ -350,6 +386,34 @@ func CheckExtensionObject(obj extensionsv1alpha1.Object) error {
 	return nil
 }
 
+// CheckExtensionObjectMigrated checks if an extension Object has been migrated or not.
+// An extension object has been migrated if
+// * No gardener.cloud/operation is set
+// * No lastError is in the status
+// * A last operation of type migrate in state succeeded is present
+func CheckExtensionObjectMigrated(obj extensionsv1alpha1.Object) error {
+	status := obj.GetExtensionStatus()
+
+	op, ok := obj.GetAnnotations()[v1alpha1constants.GardenerOperation]
+	if ok {
+		return fmt.Errorf("gardener operation %q is not yet picked up by extension controller", op)
+	}
+
+	if lastErr := status.GetLastError(); lastErr != nil {
+		return fmt.Errorf("extension encountered error during migration: %s", lastErr.GetDescription())
+	}
+
+	lastOp := status.GetLastOperation()
+	if lastOp == nil || lastOp.GetType() != gardencorev1alpha1.LastOperationTypeMigrate {
+		return fmt.Errorf("extension did not record a last migrate operation yet")
+	}
+
+	if lastOp.GetState() != gardencorev1alpha1.LastOperationStateSucceeded {
+		return fmt.Errorf("extension migration state is not succeeded but %v", lastOp.GetState())
+	}
+	return nil
+}
+
 // CheckBackupBucket checks if an backup bucket Object is healthy or not.
 // An extension object is healthy if
 // * Its observed generation is up-to-date
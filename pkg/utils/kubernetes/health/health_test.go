@@ -16,6 +16,7 @@ package health_test
 
 import (
 	"testing"
+	"time"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 
@@ -28,6 +29,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -170,6 +172,40 @@ var _ = Describe("health", func() {
 		)
 	})
 
+	Context("NodeUnhealthySince", func() {
+		var (
+			created      = metav1.NewTime(time.Date(2019, 10, 1, 10, 0, 0, 0, time.UTC))
+			notReady     = metav1.NewTime(time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC))
+			diskPressure = metav1.NewTime(time.Date(2019, 10, 1, 11, 0, 0, 0, time.UTC))
+		)
+
+		It("should return nil for a healthy node", func() {
+			node := &corev1.Node{
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}}},
+			}
+
+			Expect(health.NodeUnhealthySince(node)).To(BeNil())
+		})
+
+		It("should return the creation time if the ready condition is missing", func() {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: created}}
+
+			Expect(health.NodeUnhealthySince(node)).To(PointTo(Equal(created.Time)))
+		})
+
+		It("should return the earliest transition time of the conditions in an unexpected state", func() {
+			node := &corev1.Node{
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: corev1.ConditionFalse, LastTransitionTime: notReady},
+					{Type: corev1.NodeDiskPressure, Status: corev1.ConditionTrue, LastTransitionTime: diskPressure},
+					{Type: corev1.NodeMemoryPressure, Status: corev1.ConditionFalse, LastTransitionTime: created},
+				}},
+			}
+
+			Expect(health.NodeUnhealthySince(node)).To(PointTo(Equal(diskPressure.Time)))
+		})
+	})
+
 	Context("CheckMachineDeployment", func() {
 		DescribeTable("machine deployments",
 			func(machineDeployment *gardenv1alpha1.MachineDeployment, matcher types.GomegaMatcher) {
@@ -371,4 +407,80 @@ var _ = Describe("health", func() {
 				HaveOccurred()),
 		)
 	})
+
+	Context("CheckExtensionObjectMigrated", func() {
+		DescribeTable("extension objects",
+			func(obj extensionsv1alpha1.Object, match types.GomegaMatcher) {
+				Expect(health.CheckExtensionObjectMigrated(obj)).To(match)
+			},
+			Entry("migrated",
+				&extensionsv1alpha1.Infrastructure{
+					Status: extensionsv1alpha1.InfrastructureStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastOperation: &gardencorev1alpha1.LastOperation{
+								Type:  gardencorev1alpha1.LastOperationTypeMigrate,
+								State: gardencorev1alpha1.LastOperationStateSucceeded,
+							},
+						},
+					},
+				},
+				Succeed()),
+			Entry("gardener operation ongoing",
+				&extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							v1alpha1constants.GardenerOperation: v1alpha1constants.GardenerOperationMigrate,
+						},
+					},
+					Status: extensionsv1alpha1.InfrastructureStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastOperation: &gardencorev1alpha1.LastOperation{
+								Type:  gardencorev1alpha1.LastOperationTypeMigrate,
+								State: gardencorev1alpha1.LastOperationStateSucceeded,
+							},
+						},
+					},
+				},
+				HaveOccurred()),
+			Entry("last error non-nil",
+				&extensionsv1alpha1.Infrastructure{
+					Status: extensionsv1alpha1.InfrastructureStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastError: &gardencorev1alpha1.LastError{
+								Description: "something happened",
+							},
+							LastOperation: &gardencorev1alpha1.LastOperation{
+								Type:  gardencorev1alpha1.LastOperationTypeMigrate,
+								State: gardencorev1alpha1.LastOperationStateSucceeded,
+							},
+						},
+					},
+				},
+				HaveOccurred()),
+			Entry("last operation not a migration",
+				&extensionsv1alpha1.Infrastructure{
+					Status: extensionsv1alpha1.InfrastructureStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastOperation: &gardencorev1alpha1.LastOperation{
+								Type:  gardencorev1alpha1.LastOperationTypeReconcile,
+								State: gardencorev1alpha1.LastOperationStateSucceeded,
+							},
+						},
+					},
+				},
+				HaveOccurred()),
+			Entry("last operation not succeeded",
+				&extensionsv1alpha1.Infrastructure{
+					Status: extensionsv1alpha1.InfrastructureStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{
+							LastOperation: &gardencorev1alpha1.LastOperation{
+								Type:  gardencorev1alpha1.LastOperationTypeMigrate,
+								State: gardencorev1alpha1.LastOperationStateProcessing,
+							},
+						},
+					},
+				},
+				HaveOccurred()),
+		)
+	})
 })
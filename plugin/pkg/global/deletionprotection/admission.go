@@ -0,0 +1,531 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/client/garden/clientset/internalversion"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+
+	multierror "github.com/hashicorp/go-multierror"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "DeletionProtection"
+
+	// Subresource is the name of the (virtual) subresource which is used to authorize the removal of the deletion
+	// protection.
+	Subresource = "deletionprotection"
+	// VerbUnprotect is the verb which must be allowed on the deletionprotection subresource in order to remove the
+	// deletion protection.
+	VerbUnprotect = "unprotect"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, NewFactory)
+}
+
+// NewFactory creates a new PluginFactory.
+func NewFactory(config io.Reader) (admission.Interface, error) {
+	return New()
+}
+
+// DeletionProtection contains an admission handler, an authorizer, and listers.
+type DeletionProtection struct {
+	*admission.Handler
+	authorizer          authorizer.Authorizer
+	gardenClient        internalversion.Interface
+	shootLister         gardenlisters.ShootLister
+	projectLister       gardenlisters.ProjectLister
+	seedLister          gardenlisters.SeedLister
+	secretBindingLister gardenlisters.SecretBindingLister
+	readyFunc           admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsAuthorizer(&DeletionProtection{})
+	_ = admissioninitializer.WantsInternalGardenInformerFactory(&DeletionProtection{})
+	_ = admissioninitializer.WantsInternalGardenClientset(&DeletionProtection{})
+
+	_ admission.MutationInterface   = &DeletionProtection{}
+	_ admission.ValidationInterface = &DeletionProtection{}
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new DeletionProtection admission plugin.
+func New() (*DeletionProtection, error) {
+	return &DeletionProtection{
+		Handler: admission.NewHandler(admission.Create, admission.Update, admission.Delete),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (d *DeletionProtection) AssignReadyFunc(f admission.ReadyFunc) {
+	d.readyFunc = f
+	d.SetReadyFunc(f)
+}
+
+// SetAuthorizer gets the authorizer.
+func (d *DeletionProtection) SetAuthorizer(authorizer authorizer.Authorizer) {
+	d.authorizer = authorizer
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (d *DeletionProtection) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	shootInformer := f.Garden().InternalVersion().Shoots()
+	d.shootLister = shootInformer.Lister()
+
+	projectInformer := f.Garden().InternalVersion().Projects()
+	d.projectLister = projectInformer.Lister()
+
+	seedInformer := f.Garden().InternalVersion().Seeds()
+	d.seedLister = seedInformer.Lister()
+
+	secretBindingInformer := f.Garden().InternalVersion().SecretBindings()
+	d.secretBindingLister = secretBindingInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, projectInformer.Informer().HasSynced, seedInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced)
+}
+
+// SetInternalGardenClientset gets the clientset from the Kubernetes client.
+func (d *DeletionProtection) SetInternalGardenClientset(c internalversion.Interface) {
+	d.gardenClient = c
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (d *DeletionProtection) ValidateInitialization() error {
+	if d.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	if d.gardenClient == nil {
+		return errors.New("missing garden client")
+	}
+	if d.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	if d.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	if d.seedLister == nil {
+		return errors.New("missing seed lister")
+	}
+	if d.secretBindingLister == nil {
+		return errors.New("missing secretbinding lister")
+	}
+	return nil
+}
+
+func (d *DeletionProtection) waitForReady() bool {
+	if d.readyFunc == nil {
+		d.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	return d.WaitForReady()
+}
+
+// Admit protects Shoots against deletion at creation time if their Project enables the deletion protection by default
+// and the Shoot does not specify the annotation itself.
+func (d *DeletionProtection) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	if a.GetOperation() != admission.Create || len(a.GetSubresource()) != 0 || !isKind(a, "Shoot") {
+		return nil
+	}
+	if !d.waitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	if _, ok := shoot.Annotations[common.DeletionProtection]; ok {
+		return nil
+	}
+
+	project, err := admissionutils.GetProject(shoot.Namespace, d.projectLister)
+	if err != nil || project.Spec.ShootDeletionProtection == nil || !*project.Spec.ShootDeletionProtection {
+		return nil
+	}
+
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, common.DeletionProtection, "true")
+	return nil
+}
+
+// Validate rejects the deletion of protected Shoots, Projects and Seeds, of Projects containing protected Shoots, and
+// of SecretBindings and Quotas referenced by protected Shoots. It also ensures that only users which are allowed to
+// "unprotect" the "deletionprotection" subresource may remove the protection.
+func (d *DeletionProtection) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	if len(a.GetSubresource()) != 0 || !isKind(a, "Shoot", "Project", "Seed", "SecretBinding", "Quota") {
+		return nil
+	}
+	if !d.waitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	if a.GetOperation() == admission.Delete {
+		return d.validateDeletion(a)
+	}
+	if !isKind(a, "Shoot", "Project", "Seed") {
+		return nil
+	}
+	return d.validateProtectionRemoval(a)
+}
+
+func (d *DeletionProtection) validateProtectionRemoval(a admission.Attributes) error {
+	obj, err := meta.Accessor(a.GetObject())
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("could not access object metadata: %v", err))
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	var removed bool
+	switch a.GetOperation() {
+	case admission.Create:
+		// Shoots may only opt out of the default protection of their Project if the user may remove the protection.
+		if !isKind(a, "Shoot") || isProtected(obj) {
+			return nil
+		}
+		project, err := admissionutils.GetProject(a.GetNamespace(), d.projectLister)
+		removed = err == nil && project.Spec.ShootDeletionProtection != nil && *project.Spec.ShootDeletionProtection
+
+	case admission.Update:
+		oldObj, err := meta.Accessor(a.GetOldObject())
+		if err != nil {
+			return apierrors.NewBadRequest(fmt.Sprintf("could not access old object metadata: %v", err))
+		}
+		removed = isProtected(oldObj) && !isProtected(obj)
+	}
+
+	if !removed {
+		return nil
+	}
+
+	unprotectAttributes := authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            VerbUnprotect,
+		APIGroup:        a.GetResource().Group,
+		APIVersion:      a.GetResource().Version,
+		Resource:        a.GetResource().Resource,
+		Subresource:     Subresource,
+		Namespace:       a.GetNamespace(),
+		Name:            a.GetName(),
+		ResourceRequest: true,
+	}
+	if decision, _, _ := d.authorizer.Authorize(unprotectAttributes); decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user is not allowed to remove the deletion protection (%q annotation), %q on %s/%s is required", common.DeletionProtection, VerbUnprotect, a.GetResource().Resource, Subresource))
+	}
+	return nil
+}
+
+func (d *DeletionProtection) validateDeletion(a admission.Attributes) error {
+	var (
+		listFunc  func() ([]string, error)
+		checkFunc func(name string) error
+		namespace = a.GetNamespace()
+	)
+
+	switch {
+	case isKind(a, "Shoot"):
+		listFunc = func() ([]string, error) {
+			list, err := d.shootLister.Shoots(namespace).List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(list))
+			for _, obj := range list {
+				names = append(names, obj.Name)
+			}
+			return names, nil
+		}
+		checkFunc = func(name string) error {
+			return d.checkShoot(namespace, name)
+		}
+
+	case isKind(a, "Project"):
+		listFunc = func() ([]string, error) {
+			list, err := d.projectLister.List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(list))
+			for _, obj := range list {
+				names = append(names, obj.Name)
+			}
+			return names, nil
+		}
+		checkFunc = d.checkProject
+
+	case isKind(a, "Seed"):
+		listFunc = func() ([]string, error) {
+			list, err := d.seedLister.List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(list))
+			for _, obj := range list {
+				names = append(names, obj.Name)
+			}
+			return names, nil
+		}
+		checkFunc = d.checkSeed
+
+	case isKind(a, "SecretBinding"):
+		listFunc = func() ([]string, error) {
+			list, err := d.secretBindingLister.SecretBindings(namespace).List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(list))
+			for _, obj := range list {
+				names = append(names, obj.Name)
+			}
+			return names, nil
+		}
+		checkFunc = func(name string) error {
+			return d.checkSecretBinding(namespace, name)
+		}
+
+	case isKind(a, "Quota"):
+		// Quotas are not cached, hence collections are checked by looking at all SecretBindings referencing a
+		// Quota in the namespace.
+		listFunc = func() ([]string, error) {
+			list, err := d.secretBindingLister.List(labels.Everything())
+			if err != nil {
+				return nil, err
+			}
+			var names []string
+			for _, binding := range list {
+				for _, quota := range binding.Quotas {
+					if quota.Namespace == namespace {
+						names = append(names, quota.Name)
+					}
+				}
+			}
+			return names, nil
+		}
+		checkFunc = func(name string) error {
+			return d.checkQuota(namespace, name)
+		}
+	}
+
+	// DELETECOLLECTION requests have an empty resource name, see the DeletionConfirmation admission plugin.
+	// They are only allowed if none of the objects is protected.
+	names := []string{a.GetName()}
+	if a.GetName() == "" {
+		var err error
+		if names, err = listFunc(); err != nil {
+			return err
+		}
+	}
+
+	var result error
+	for _, name := range names {
+		if err := checkFunc(name); err != nil {
+			if _, ok := err.(protectedError); !ok {
+				return err
+			}
+			result = multierror.Append(result, err)
+		}
+	}
+
+	if result != nil {
+		return admission.NewForbidden(a, result)
+	}
+	return nil
+}
+
+func (d *DeletionProtection) checkShoot(namespace, name string) error {
+	return ensureNotProtected(
+		fmt.Sprintf("shoot %s/%s", namespace, name),
+		func() (metav1.Object, error) { return d.shootLister.Shoots(namespace).Get(name) },
+		func() (metav1.Object, error) {
+			return d.gardenClient.Garden().Shoots(namespace).Get(name, metav1.GetOptions{})
+		},
+	)
+}
+
+func (d *DeletionProtection) checkSeed(name string) error {
+	return ensureNotProtected(
+		fmt.Sprintf("seed %s", name),
+		func() (metav1.Object, error) { return d.seedLister.Get(name) },
+		func() (metav1.Object, error) { return d.gardenClient.Garden().Seeds().Get(name, metav1.GetOptions{}) },
+	)
+}
+
+func (d *DeletionProtection) checkProject(name string) error {
+	if err := ensureNotProtected(
+		fmt.Sprintf("project %s", name),
+		func() (metav1.Object, error) { return d.projectLister.Get(name) },
+		func() (metav1.Object, error) {
+			return d.gardenClient.Garden().Projects().Get(name, metav1.GetOptions{})
+		},
+	); err != nil {
+		return err
+	}
+
+	project, err := d.projectLister.Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if project.Spec.Namespace == nil {
+		return nil
+	}
+
+	shoots, err := d.protectedShoots(*project.Spec.Namespace, func(*garden.Shoot) bool { return true })
+	if err != nil {
+		return err
+	}
+	if len(shoots) > 0 {
+		return protectedError(fmt.Sprintf("project %s contains shoots which are protected against deletion: %s", name, strings.Join(shoots, ", ")))
+	}
+	return nil
+}
+
+func (d *DeletionProtection) checkSecretBinding(namespace, name string) error {
+	shoots, err := d.protectedShoots(namespace, func(shoot *garden.Shoot) bool { return shoot.Spec.SecretBindingName == name })
+	if err != nil {
+		return err
+	}
+	if len(shoots) > 0 {
+		return protectedError(fmt.Sprintf("secretbinding %s/%s is referenced by shoots which are protected against deletion: %s", namespace, name, strings.Join(shoots, ", ")))
+	}
+	return nil
+}
+
+func (d *DeletionProtection) checkQuota(namespace, name string) error {
+	bindings, err := d.secretBindingLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var shoots []string
+	for _, binding := range bindings {
+		for _, quota := range binding.Quotas {
+			if quota.Namespace != namespace || quota.Name != name {
+				continue
+			}
+			bindingName := binding.Name
+			protected, err := d.protectedShoots(binding.Namespace, func(shoot *garden.Shoot) bool { return shoot.Spec.SecretBindingName == bindingName })
+			if err != nil {
+				return err
+			}
+			for _, shoot := range protected {
+				shoots = append(shoots, binding.Namespace+"/"+shoot)
+			}
+		}
+	}
+
+	if len(shoots) > 0 {
+		return protectedError(fmt.Sprintf("quota %s/%s is referenced by shoots which are protected against deletion: %s", namespace, name, strings.Join(shoots, ", ")))
+	}
+	return nil
+}
+
+// protectedShoots returns the names of all protected Shoots in the given namespace which match the given predicate.
+func (d *DeletionProtection) protectedShoots(namespace string, predicate func(*garden.Shoot) bool) ([]string, error) {
+	shoots, err := d.shootLister.Shoots(namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, shoot := range shoots {
+		if isProtected(shoot) && predicate(shoot) {
+			names = append(names, shoot.Name)
+		}
+	}
+	return names, nil
+}
+
+// ensureNotProtected returns an error if the object is protected against deletion. The object is read from the cache
+// first. If it is protected according to the cache, a live lookup is done to ensure that the protection has not been
+// removed right before the deletion.
+func ensureNotProtected(description string, cacheLookup, liveLookup func() (metav1.Object, error)) error {
+	obj, err := cacheLookup()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !isProtected(obj) {
+		return nil
+	}
+
+	obj, err = liveLookup()
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !isProtected(obj) {
+		return nil
+	}
+
+	return protectedError(fmt.Sprintf("%s is protected against deletion, the %q annotation must be removed first", description, common.DeletionProtection))
+}
+
+// protectedError is returned if an object cannot be deleted because of the deletion protection.
+type protectedError string
+
+func (e protectedError) Error() string {
+	return string(e)
+}
+
+func isProtected(obj metav1.Object) bool {
+	protected, _ := strconv.ParseBool(obj.GetAnnotations()[common.DeletionProtection])
+	return protected
+}
+
+func isKind(a admission.Attributes, kinds ...string) bool {
+	groupKind := a.GetKind().GroupKind()
+	for _, kind := range kinds {
+		if groupKind == garden.Kind(kind) || groupKind == core.Kind(kind) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletionprotection_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/client/garden/clientset/internalversion/fake"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/global/deletionprotection"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+)
+
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == "allowed-user" && a.GetVerb() == VerbUnprotect && a.GetSubresource() == Subresource {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
+var _ = Describe("DeletionProtection", func() {
+	const namespace = "garden-dev"
+
+	var (
+		admissionHandler      *DeletionProtection
+		gardenInformerFactory gardeninformers.SharedInformerFactory
+
+		shootStore         cache.Store
+		projectStore       cache.Store
+		seedStore          cache.Store
+		secretBindingStore cache.Store
+
+		shoot         *garden.Shoot
+		project       *garden.Project
+		seed          *garden.Seed
+		secretBinding *garden.SecretBinding
+
+		protected = map[string]string{common.DeletionProtection: "true"}
+
+		deleteAttrs = func(kind, resource, namespace, name string) admission.Attributes {
+			return admission.NewAttributesRecord(nil, nil, garden.Kind(kind).WithVersion("version"), namespace, name, garden.Resource(resource).WithVersion("version"), "", admission.Delete, false, nil)
+		}
+		// validate creates the live objects from the current state of the test objects, which might differ from the
+		// state of the cached objects.
+		validate = func(attrs admission.Attributes) error {
+			admissionHandler.SetInternalGardenClientset(fake.NewSimpleClientset(shoot, project, seed))
+			return admissionHandler.Validate(attrs, nil)
+		}
+		updateAttrs = func(obj, oldObj *garden.Shoot, username string) admission.Attributes {
+			return admission.NewAttributesRecord(obj, oldObj, garden.Kind("Shoot").WithVersion("version"), obj.Namespace, obj.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: username})
+		}
+	)
+
+	BeforeEach(func() {
+		admissionHandler, _ = New()
+		admissionHandler.AssignReadyFunc(func() bool { return true })
+		admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+
+		gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+		admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+
+		shootStore = gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore()
+		projectStore = gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore()
+		seedStore = gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore()
+		secretBindingStore = gardenInformerFactory.Garden().InternalVersion().SecretBindings().Informer().GetStore()
+
+		shoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace},
+			Spec:       garden.ShootSpec{SecretBindingName: "binding"},
+		}
+		project = &garden.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec:       garden.ProjectSpec{Namespace: pointer.StringPtr(namespace)},
+		}
+		seed = &garden.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+		}
+		secretBinding = &garden.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: namespace},
+			Quotas:     []corev1.ObjectReference{{Name: "quota", Namespace: "garden"}},
+		}
+
+		Expect(projectStore.Add(project)).To(Succeed())
+		Expect(secretBindingStore.Add(secretBinding)).To(Succeed())
+	})
+
+	Describe("#Admit", func() {
+		var attrs admission.Attributes
+
+		JustBeforeEach(func() {
+			attrs = admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+		})
+
+		It("should protect new shoots if the project enables the protection by default", func() {
+			project.Spec.ShootDeletionProtection = pointer.BoolPtr(true)
+
+			Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(common.DeletionProtection, "true"))
+		})
+
+		It("should not overwrite the annotation of the shoot", func() {
+			project.Spec.ShootDeletionProtection = pointer.BoolPtr(true)
+			shoot.Annotations = map[string]string{common.DeletionProtection: "false"}
+
+			Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+			Expect(shoot.Annotations).To(HaveKeyWithValue(common.DeletionProtection, "false"))
+		})
+
+		It("should not protect new shoots by default", func() {
+			Expect(admissionHandler.Admit(attrs, nil)).To(Succeed())
+			Expect(shoot.Annotations).NotTo(HaveKey(common.DeletionProtection))
+		})
+	})
+
+	Describe("#Validate", func() {
+		Context("deletion", func() {
+			It("should allow deleting unprotected objects", func() {
+				Expect(shootStore.Add(shoot)).To(Succeed())
+				Expect(seedStore.Add(seed)).To(Succeed())
+
+				Expect(validate(deleteAttrs("Shoot", "shoots", namespace, shoot.Name))).To(Succeed())
+				Expect(validate(deleteAttrs("Project", "projects", "", project.Name))).To(Succeed())
+				Expect(validate(deleteAttrs("Seed", "seeds", "", seed.Name))).To(Succeed())
+				Expect(validate(deleteAttrs("SecretBinding", "secretbindings", namespace, secretBinding.Name))).To(Succeed())
+				Expect(validate(deleteAttrs("Quota", "quotas", "garden", "quota"))).To(Succeed())
+			})
+
+			It("should allow deleting objects which do not exist", func() {
+				Expect(validate(deleteAttrs("Shoot", "shoots", namespace, "foo"))).To(Succeed())
+			})
+
+			It("should reject deleting protected shoots", func() {
+				shoot.Annotations = protected
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				err := validate(deleteAttrs("Shoot", "shoots", namespace, shoot.Name))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should allow deleting shoots whose protection has just been removed", func() {
+				Expect(shootStore.Add(&garden.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: namespace, Annotations: protected},
+				})).To(Succeed())
+
+				Expect(validate(deleteAttrs("Shoot", "shoots", namespace, shoot.Name))).To(Succeed())
+			})
+
+			It("should reject deleting protected seeds", func() {
+				seed.Annotations = protected
+				Expect(seedStore.Add(seed)).To(Succeed())
+
+				err := validate(deleteAttrs("Seed", "seeds", "", seed.Name))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject deleting protected projects", func() {
+				project.Annotations = protected
+
+				err := validate(deleteAttrs("Project", "projects", "", project.Name))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject deleting projects, secretbindings and quotas referenced by protected shoots", func() {
+				shoot.Annotations = protected
+				Expect(shootStore.Add(shoot)).To(Succeed())
+
+				err := validate(deleteAttrs("Project", "projects", "", project.Name))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("project dev contains shoots which are protected against deletion: shoot"))
+
+				err = validate(deleteAttrs("SecretBinding", "secretbindings", namespace, secretBinding.Name))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("secretbinding garden-dev/binding is referenced by shoots which are protected against deletion: shoot"))
+
+				err = validate(deleteAttrs("Quota", "quotas", "garden", "quota"))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("quota garden/quota is referenced by shoots which are protected against deletion: garden-dev/shoot"))
+			})
+
+			It("should reject deleting collections containing protected objects", func() {
+				shoot.Annotations = protected
+				Expect(shootStore.Add(shoot)).To(Succeed())
+				Expect(shootStore.Add(&garden.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace}})).To(Succeed())
+
+				err := validate(deleteAttrs("Shoot", "shoots", namespace, ""))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		Context("removal of the protection", func() {
+			var oldShoot *garden.Shoot
+
+			BeforeEach(func() {
+				shoot.Annotations = protected
+				oldShoot = shoot.DeepCopy()
+				shoot.Annotations = nil
+			})
+
+			It("should reject users which are not allowed to unprotect the shoot", func() {
+				err := validate(updateAttrs(shoot, oldShoot, "user"))
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should allow users which are allowed to unprotect the shoot", func() {
+				Expect(validate(updateAttrs(shoot, oldShoot, "allowed-user"))).To(Succeed())
+			})
+
+			It("should allow all users to protect the shoot", func() {
+				Expect(validate(updateAttrs(oldShoot, shoot, "user"))).To(Succeed())
+			})
+
+			It("should reject users which opt out of the default protection of the project without permission", func() {
+				project.Spec.ShootDeletionProtection = pointer.BoolPtr(true)
+				shoot.Annotations = map[string]string{common.DeletionProtection: "false"}
+				attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, &user.DefaultInfo{Name: "user"})
+
+				err := validate(attrs)
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -21,8 +21,11 @@ import (
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden"
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
+	gardencorelisters "github.com/gardener/gardener/pkg/client/core/listers/core/internalversion"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
@@ -50,12 +53,14 @@ func Register(plugins *admission.Plugins) {
 // DNS contains listers and and admission handler.
 type DNS struct {
 	*admission.Handler
-	secretLister  kubecorev1listers.SecretLister
-	projectLister gardenlisters.ProjectLister
-	readyFunc     admission.ReadyFunc
+	secretLister                 kubecorev1listers.SecretLister
+	projectLister                gardenlisters.ProjectLister
+	controllerRegistrationLister gardencorelisters.ControllerRegistrationLister
+	readyFunc                    admission.ReadyFunc
 }
 
 var (
+	_ = admissioninitializer.WantsInternalCoreInformerFactory(&DNS{})
 	_ = admissioninitializer.WantsInternalGardenInformerFactory(&DNS{})
 	_ = admissioninitializer.WantsKubeInformerFactory(&DNS{})
 
@@ -75,6 +80,14 @@ func (d *DNS) AssignReadyFunc(f admission.ReadyFunc) {
 	d.SetReadyFunc(f)
 }
 
+// SetInternalCoreInformerFactory gets Lister from SharedInformerFactory.
+func (d *DNS) SetInternalCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	controllerRegistrationInformer := f.Core().InternalVersion().ControllerRegistrations()
+	d.controllerRegistrationLister = controllerRegistrationInformer.Lister()
+
+	readyFuncs = append(readyFuncs, controllerRegistrationInformer.Informer().HasSynced)
+}
+
 // SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
 func (d *DNS) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
 	projectInformer := f.Garden().InternalVersion().Projects()
@@ -99,6 +112,9 @@ func (d *DNS) ValidateInitialization() error {
 	if d.projectLister == nil {
 		return errors.New("missing project lister")
 	}
+	if d.controllerRegistrationLister == nil {
+		return errors.New("missing controller registration lister")
+	}
 	return nil
 }
 
@@ -128,9 +144,9 @@ func (d *DNS) Admit(a admission.Attributes, o admission.ObjectInterfaces) error
 		return apierrors.NewBadRequest("could not convert resource into Shoot object")
 	}
 
-	// If the Shoot manifest specifies the 'unmanaged' DNS provider, then we do nothing.
+	// If the Shoot manifest specifies the 'unmanaged' DNS provider, then we only check the additional providers.
 	if shoot.Spec.DNS != nil && len(shoot.Spec.DNS.Providers) > 0 && *shoot.Spec.DNS.Providers[0].Type == garden.DNSUnmanaged {
-		return nil
+		return checkDNSProvidersRegistered(shoot, d.controllerRegistrationLister)
 	}
 
 	// Generate a Shoot domain if none is configured.
@@ -141,6 +157,44 @@ func (d *DNS) Admit(a admission.Attributes, o admission.ObjectInterfaces) error
 		return apierrors.NewBadRequest(fmt.Sprintf("shoot domain field .spec.dns.domain must be set if provider != %s", garden.DNSUnmanaged))
 	}
 
+	// Every explicitly specified DNS provider type must be supported by a registered extension controller.
+	if err := checkDNSProvidersRegistered(shoot, d.controllerRegistrationLister); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkDNSProvidersRegistered checks that for every DNS provider type of the given Shoot a ControllerRegistration
+// exists that is responsible for DNSRecord resources of this type.
+func checkDNSProvidersRegistered(shoot *garden.Shoot, controllerRegistrationLister gardencorelisters.ControllerRegistrationLister) error {
+	if shoot.Spec.DNS == nil || len(shoot.Spec.DNS.Providers) == 0 {
+		return nil
+	}
+
+	controllerRegistrations, err := controllerRegistrationLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list controller registrations: %+v", err))
+	}
+
+	registeredTypes := make(map[string]struct{})
+	for _, controllerRegistration := range controllerRegistrations {
+		for _, resource := range controllerRegistration.Spec.Resources {
+			if resource.Kind == extensionsv1alpha1.DNSRecordResource {
+				registeredTypes[resource.Type] = struct{}{}
+			}
+		}
+	}
+
+	for _, provider := range shoot.Spec.DNS.Providers {
+		if provider.Type == nil || *provider.Type == garden.DNSUnmanaged {
+			continue
+		}
+		if _, ok := registeredTypes[*provider.Type]; !ok {
+			return apierrors.NewBadRequest(fmt.Sprintf("dns provider type %q is not supported by any registered extension controller", *provider.Type))
+		}
+	}
+
 	return nil
 }
 
@@ -17,7 +17,10 @@ package dns_test
 import (
 	"fmt"
 
+	"github.com/gardener/gardener/pkg/apis/core"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/apis/garden"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/internalversion"
 	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
 	"github.com/gardener/gardener/pkg/operation/common"
 	. "github.com/gardener/gardener/plugin/pkg/shoot/dns"
@@ -35,10 +38,11 @@ import (
 var _ = Describe("dns", func() {
 	Describe("#Admit", func() {
 		var (
-			admissionHandler      *DNS
-			kubeInformerFactory   kubeinformers.SharedInformerFactory
-			gardenInformerFactory gardeninformers.SharedInformerFactory
-			shoot                 garden.Shoot
+			admissionHandler          *DNS
+			kubeInformerFactory       kubeinformers.SharedInformerFactory
+			gardenInformerFactory     gardeninformers.SharedInformerFactory
+			gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+			shoot                     garden.Shoot
 
 			namespace   = "my-namespace"
 			projectName = "my-project"
@@ -89,6 +93,8 @@ var _ = Describe("dns", func() {
 			admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
 			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalCoreInformerFactory(gardenCoreInformerFactory)
 
 			shootBase.Spec.DNS.Domain = nil
 			shootBase.Spec.DNS.Providers = []garden.DNSProvider{
@@ -160,5 +166,56 @@ var _ = Describe("dns", func() {
 				Expect(err).To(MatchError(apierrors.NewBadRequest("shoot domain field .spec.dns.domain must be set if provider != unmanaged")))
 			})
 		})
+
+		Context("provider type validation", func() {
+			var (
+				providerType = "aws-route53"
+				shootDomain  = "my-shoot.my-own-domain.com"
+
+				controllerRegistration = core.ControllerRegistration{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "provider-aws",
+					},
+					Spec: core.ControllerRegistrationSpec{
+						Resources: []core.ControllerResource{
+							{
+								Kind: extensionsv1alpha1.DNSRecordResource,
+								Type: providerType,
+							},
+						},
+					},
+				}
+			)
+
+			BeforeEach(func() {
+				shoot.Spec.DNS.Domain = &shootDomain
+				shoot.Spec.DNS.Providers = []garden.DNSProvider{
+					{
+						Type: &providerType,
+					},
+				}
+			})
+
+			It("should pass because a controller registration for the dns provider type exists", func() {
+				gardenCoreInformerFactory.Core().InternalVersion().ControllerRegistrations().Informer().GetStore().Add(&controllerRegistration)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject because no controller registration for the dns provider type exists", func() {
+				otherRegistration := controllerRegistration.DeepCopy()
+				otherRegistration.Spec.Resources[0].Kind = extensionsv1alpha1.InfrastructureResource
+
+				gardenCoreInformerFactory.Core().InternalVersion().ControllerRegistrations().Informer().GetStore().Add(otherRegistration)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(MatchError(apierrors.NewBadRequest(`dns provider type "aws-route53" is not supported by any registered extension controller`)))
+			})
+		})
 	})
 })
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootMigration"
+
+	// Subresource is the name of the (virtual) subresource which is used to authorize the migration of the control
+	// plane of a Shoot to another Seed.
+	Subresource = "migration"
+	// VerbMigrate is the verb which must be allowed on the migration subresource in order to request a control plane
+	// migration or to change the Seed of a scheduled Shoot.
+	VerbMigrate = "migrate"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, NewFactory)
+}
+
+// NewFactory creates a new PluginFactory.
+func NewFactory(config io.Reader) (admission.Interface, error) {
+	return New()
+}
+
+// ShootMigration contains an admission handler and an authorizer.
+type ShootMigration struct {
+	*admission.Handler
+	authorizer authorizer.Authorizer
+}
+
+var (
+	_ = admissioninitializer.WantsAuthorizer(&ShootMigration{})
+
+	_ admission.ValidationInterface = &ShootMigration{}
+)
+
+// New creates a new ShootMigration admission plugin.
+func New() (*ShootMigration, error) {
+	return &ShootMigration{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// SetAuthorizer gets the authorizer.
+func (m *ShootMigration) SetAuthorizer(authorizer authorizer.Authorizer) {
+	m.authorizer = authorizer
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (m *ShootMigration) ValidateInitialization() error {
+	if m.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	return nil
+}
+
+// Validate ensures that only users which are allowed to "migrate" the "migration" subresource of Shoots may request a
+// control plane migration, change the migration annotations, or change the Seed of an already scheduled Shoot. The
+// annotations are writable by every user who may update the Shoot, hence, they must not be sufficient on their own to
+// move a control plane to another Seed.
+func (m *ShootMigration) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	if len(a.GetSubresource()) != 0 {
+		return nil
+	}
+	if groupKind := a.GetKind().GroupKind(); groupKind != garden.Kind("Shoot") && groupKind != core.Kind("Shoot") {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	oldShoot := &garden.Shoot{}
+	if a.GetOperation() == admission.Update {
+		if oldShoot, ok = a.GetOldObject().(*garden.Shoot); !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	if !migrationChanged(oldShoot, shoot) {
+		return nil
+	}
+
+	migrateAttributes := authorizer.AttributesRecord{
+		User:            a.GetUserInfo(),
+		Verb:            VerbMigrate,
+		APIGroup:        a.GetResource().Group,
+		APIVersion:      a.GetResource().Version,
+		Resource:        a.GetResource().Resource,
+		Subresource:     Subresource,
+		Namespace:       a.GetNamespace(),
+		Name:            a.GetName(),
+		ResourceRequest: true,
+	}
+	if decision, _, _ := m.authorizer.Authorize(migrateAttributes); decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user is not allowed to migrate the control plane of the shoot or to change its seed, %q on %s/%s is required", VerbMigrate, a.GetResource().Resource, Subresource))
+	}
+	return nil
+}
+
+// migrationChanged returns true if the migration of the control plane of the given Shoot is requested, if one of the
+// migration annotations is changed, or if the Seed of an already scheduled Shoot is changed.
+func migrationChanged(oldShoot, shoot *garden.Shoot) bool {
+	if shoot.Annotations[common.ShootOperation] == common.ShootOperationMigrate && oldShoot.Annotations[common.ShootOperation] != common.ShootOperationMigrate {
+		return true
+	}
+	if target, ok := shoot.Annotations[common.ShootMigrationTargetSeed]; ok && target != oldShoot.Annotations[common.ShootMigrationTargetSeed] {
+		return true
+	}
+	if oldShoot.Annotations[common.ShootMigrationSourceSeed] != shoot.Annotations[common.ShootMigrationSourceSeed] {
+		return true
+	}
+	return seedChanged(oldShoot.Spec.Cloud.Seed, shoot.Spec.Cloud.Seed) || seedChanged(oldShoot.Spec.SeedName, shoot.Spec.SeedName)
+}
+
+func seedChanged(oldSeed, seed *string) bool {
+	return oldSeed != nil && (seed == nil || *oldSeed != *seed)
+}
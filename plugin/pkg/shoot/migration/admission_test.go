@@ -0,0 +1,157 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migration_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/migration"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/pointer"
+)
+
+type fakeAuthorizerType struct{}
+
+func (fakeAuthorizerType) Authorize(a authorizer.Attributes) (authorizer.Decision, string, error) {
+	if a.GetUser().GetName() == "allowed-user" && a.GetVerb() == VerbMigrate && a.GetSubresource() == Subresource {
+		return authorizer.DecisionAllow, "", nil
+	}
+	return authorizer.DecisionDeny, "", nil
+}
+
+var _ = Describe("ShootMigration", func() {
+	var (
+		admissionHandler *ShootMigration
+
+		oldShoot *garden.Shoot
+		shoot    *garden.Shoot
+
+		createAttrs = func(obj *garden.Shoot, username string) admission.Attributes {
+			return admission.NewAttributesRecord(obj, nil, garden.Kind("Shoot").WithVersion("version"), obj.Namespace, obj.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, &user.DefaultInfo{Name: username})
+		}
+		updateAttrs = func(obj, oldObj *garden.Shoot, username string) admission.Attributes {
+			return admission.NewAttributesRecord(obj, oldObj, garden.Kind("Shoot").WithVersion("version"), obj.Namespace, obj.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, &user.DefaultInfo{Name: username})
+		}
+	)
+
+	BeforeEach(func() {
+		admissionHandler, _ = New()
+		admissionHandler.SetAuthorizer(fakeAuthorizerType{})
+
+		oldShoot = &garden.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec:       garden.ShootSpec{Cloud: garden.Cloud{Seed: pointer.StringPtr("source")}},
+		}
+		shoot = oldShoot.DeepCopy()
+	})
+
+	It("should allow updates not touching the migration", func() {
+		shoot.Annotations = map[string]string{common.ShootOperation: common.ShootOperationReconcile}
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)).To(Succeed())
+	})
+
+	It("should forbid requesting a migration without permission", func() {
+		shoot.Annotations = map[string]string{
+			common.ShootOperation:           common.ShootOperationMigrate,
+			common.ShootMigrationTargetSeed: "target",
+		}
+
+		err := admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(VerbMigrate))
+	})
+
+	It("should allow requesting a migration with permission", func() {
+		shoot.Annotations = map[string]string{
+			common.ShootOperation:           common.ShootOperationMigrate,
+			common.ShootMigrationTargetSeed: "target",
+		}
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "allowed-user"), nil)).To(Succeed())
+	})
+
+	It("should forbid changing the target seed of a requested migration without permission", func() {
+		oldShoot.Annotations = map[string]string{
+			common.ShootOperation:           common.ShootOperationMigrate,
+			common.ShootMigrationTargetSeed: "target",
+		}
+		shoot = oldShoot.DeepCopy()
+		shoot.Annotations[common.ShootMigrationTargetSeed] = "other"
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)).NotTo(Succeed())
+	})
+
+	It("should allow rolling back a requested migration without permission", func() {
+		oldShoot.Annotations = map[string]string{
+			common.ShootOperation:           common.ShootOperationMigrate,
+			common.ShootMigrationTargetSeed: "target",
+		}
+		shoot.Annotations = map[string]string{common.ShootOperation: common.ShootOperationReconcile}
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)).To(Succeed())
+	})
+
+	It("should forbid changing the source seed annotation without permission", func() {
+		shoot.Annotations = map[string]string{common.ShootMigrationSourceSeed: "other"}
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)).NotTo(Succeed())
+	})
+
+	It("should forbid changing the seed of a scheduled shoot without permission", func() {
+		oldShoot.Annotations = map[string]string{
+			common.ShootOperation:           common.ShootOperationMigrate,
+			common.ShootMigrationTargetSeed: "target",
+		}
+		shoot = oldShoot.DeepCopy()
+		shoot.Spec.Cloud.Seed = pointer.StringPtr("target")
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)).NotTo(Succeed())
+	})
+
+	It("should allow changing the seed of a scheduled shoot with permission", func() {
+		shoot.Spec.Cloud.Seed = pointer.StringPtr("target")
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "allowed-user"), nil)).To(Succeed())
+	})
+
+	It("should allow scheduling a shoot without permission", func() {
+		oldShoot.Spec.Cloud.Seed = nil
+
+		Expect(admissionHandler.Validate(updateAttrs(shoot, oldShoot, "user"), nil)).To(Succeed())
+	})
+
+	It("should forbid creating a shoot with migration annotations without permission", func() {
+		shoot.Annotations = map[string]string{
+			common.ShootOperation:           common.ShootOperationMigrate,
+			common.ShootMigrationTargetSeed: "target",
+		}
+
+		Expect(admissionHandler.Validate(createAttrs(shoot, "user"), nil)).NotTo(Succeed())
+	})
+
+	It("should ignore subresources", func() {
+		shoot.Spec.Cloud.Seed = pointer.StringPtr("target")
+		attrs := admission.NewAttributesRecord(shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "status", admission.Update, false, &user.DefaultInfo{Name: "user"})
+
+		Expect(admissionHandler.Validate(attrs, nil)).To(Succeed())
+	})
+})
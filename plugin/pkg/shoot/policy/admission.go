@@ -0,0 +1,282 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingslister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootPolicy"
+
+	// AuditAnnotationKey is the key of the audit annotation which contains the violations of ShootPolicies
+	// with the Audit enforcement action.
+	AuditAnnotationKey = "shootpolicy.admission.gardener.cloud/violations"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootPolicy contains listers and and admission handler.
+type ShootPolicy struct {
+	*admission.Handler
+
+	projectLister     gardenlisters.ProjectLister
+	shootPolicyLister settingslister.ShootPolicyLister
+	readyFunc         admission.ReadyFunc
+}
+
+var (
+	_                               = admissioninitializer.WantsInternalGardenInformerFactory(&ShootPolicy{})
+	_                               = admissioninitializer.WantsSettingsInformerFactory(&ShootPolicy{})
+	_ admission.ValidationInterface = &ShootPolicy{}
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ShootPolicy admission plugin.
+func New() (*ShootPolicy, error) {
+	return &ShootPolicy{
+		Handler: admission.NewHandler(admission.Create, admission.Update),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootPolicy) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	s.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPolicy) SetSettingsInformerFactory(f settingsinformer.SharedInformerFactory) {
+	shootPolicyInformer := f.Settings().V1alpha1().ShootPolicies()
+	s.shootPolicyLister = shootPolicyInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootPolicyInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootPolicy) ValidateInitialization() error {
+	if s.shootPolicyLister == nil {
+		return errors.New("missing shootpolicy lister")
+	}
+	if s.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+// Validate evaluates the rules of all ShootPolicies selecting the Project of the Shoot. Violations of policies with
+// the Deny enforcement action reject the request, violations of policies with the Audit enforcement action are only
+// recorded in the audit log. On updates, only violations which have not already been present in the old Shoot are
+// reported so that existing Shoots can still be changed (e.g., be deleted) after a policy has been introduced.
+func (s *ShootPolicy) Validate(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	if len(a.GetSubresource()) != 0 || (a.GetKind().GroupKind() != garden.Kind("Shoot") && a.GetKind().GroupKind() != core.Kind("Shoot")) {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+	if admissionutils.SkipVerification(a.GetOperation(), shoot.ObjectMeta) {
+		return nil
+	}
+
+	policies, err := s.shootPolicyLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing shootpolicies: %v", err))
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	// Shoots outside of projects are not subject to ShootPolicies.
+	project, err := admissionutils.GetProject(shoot.Namespace, s.projectLister)
+	if err != nil {
+		return nil
+	}
+
+	obj, err := toJSONObject(shoot)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	var oldObj interface{}
+	if a.GetOperation() == admission.Update {
+		oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+		if oldObj, err = toJSONObject(oldShoot); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	var denyErrs, auditErrs field.ErrorList
+	for _, policy := range policies {
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.ProjectSelector)
+		if err != nil {
+			return apierrors.NewInternalError(fmt.Errorf("label selector conversion failed for projectSelector of shootpolicy %s: %v", policy.Name, err))
+		}
+		if !selector.Matches(labels.Set(project.Labels)) {
+			continue
+		}
+
+		allErrs, err := evaluatePolicy(policy, obj, oldObj)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+
+		if policy.Spec.EnforcementAction == settingsv1alpha1.ShootPolicyEnforcementActionAudit {
+			auditErrs = append(auditErrs, allErrs...)
+		} else {
+			denyErrs = append(denyErrs, allErrs...)
+		}
+	}
+
+	if len(auditErrs) > 0 {
+		if err := a.AddAnnotation(AuditAnnotationKey, auditErrs.ToAggregate().Error()); err != nil {
+			return apierrors.NewInternalError(err)
+		}
+	}
+	if len(denyErrs) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("%+v", denyErrs))
+	}
+	return nil
+}
+
+// evaluatePolicy evaluates the rules of the given policy against the given Shoot. On updates, only violations which
+// have not already been present in the old Shoot are returned, i.e. a rule violated by the old Shoot is still enforced
+// for all other values it selects.
+func evaluatePolicy(policy *settingsv1alpha1.ShootPolicy, obj, oldObj interface{}) (field.ErrorList, error) {
+	allErrs := field.ErrorList{}
+
+	for i := range policy.Spec.Rules {
+		rule := &policy.Spec.Rules[i]
+
+		errs, err := EvaluateRule(policy.Name, rule, obj)
+		if err != nil {
+			return nil, err
+		}
+
+		if oldObj != nil && len(errs) > 0 {
+			oldErrs, err := EvaluateRule(policy.Name, rule, oldObj)
+			if err != nil {
+				return nil, err
+			}
+			errs = newViolations(errs, oldErrs)
+		}
+
+		allErrs = append(allErrs, errs...)
+	}
+
+	return allErrs, nil
+}
+
+// newViolations returns the errors which are not contained in the given old errors. Errors are compared by their type,
+// field, value and detail. Each old error only matches a single error, hence, adding another value violating a rule
+// (e.g., another worker pool) is a new violation.
+func newViolations(errs, oldErrs field.ErrorList) field.ErrorList {
+	existing := make(map[string]int, len(oldErrs))
+	for _, err := range oldErrs {
+		existing[err.Error()]++
+	}
+
+	var newErrs field.ErrorList
+	for _, err := range errs {
+		if existing[err.Error()] > 0 {
+			existing[err.Error()]--
+			continue
+		}
+		newErrs = append(newErrs, err)
+	}
+	return newErrs
+}
+
+// toJSONObject converts the Shoot into its garden.sapcloud.io/v1beta1 JSON representation which the paths of the
+// rules refer to.
+func toJSONObject(shoot *garden.Shoot) (interface{}, error) {
+	external := &gardenv1beta1.Shoot{}
+	if err := api.Scheme.Convert(shoot, external, nil); err != nil {
+		return nil, fmt.Errorf("could not convert shoot %s/%s: %v", shoot.Namespace, shoot.Name, err)
+	}
+
+	data, err := json.Marshal(external)
+	if err != nil {
+		return nil, err
+	}
+
+	var obj interface{}
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
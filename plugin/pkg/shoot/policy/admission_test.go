@@ -0,0 +1,231 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+)
+
+type annotationRecorder struct {
+	admission.Attributes
+	annotations map[string]string
+}
+
+func (r *annotationRecorder) AddAnnotation(key, value string) error {
+	r.annotations[key] = value
+	return nil
+}
+
+var _ = Describe("ShootPolicy", func() {
+	Describe("#Validate", func() {
+		const namespace = "garden-prod"
+
+		var (
+			admissionHandler        *ShootPolicy
+			settingsInformerFactory settingsinformer.SharedInformerFactory
+			gardenInformerFactory   gardeninformers.SharedInformerFactory
+
+			shoot   *garden.Shoot
+			project *garden.Project
+			policy  *settingsv1alpha1.ShootPolicy
+
+			annotations map[string]string
+
+			validate = func(op admission.Operation, oldShoot *garden.Shoot) error {
+				var attrs admission.Attributes
+				if oldShoot != nil {
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", op, false, nil)
+				} else {
+					attrs = admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", op, false, nil)
+				}
+				annotations = map[string]string{}
+				return admissionHandler.Validate(&annotationRecorder{attrs, annotations}, nil)
+			}
+			addPolicy = func(p *settingsv1alpha1.ShootPolicy) {
+				Expect(settingsInformerFactory.Settings().V1alpha1().ShootPolicies().Informer().GetStore().Add(p)).To(Succeed())
+			}
+		)
+
+		BeforeEach(func() {
+			shoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+				},
+				Spec: garden.ShootSpec{
+					Cloud: garden.Cloud{
+						AWS: &garden.AWSCloud{
+							Workers: []garden.Worker{
+								{Name: "a", Minimum: 3, Maximum: 5, Machine: garden.Machine{Type: "m5.large"}},
+								{Name: "b", Minimum: 1, Maximum: 5, Machine: garden.Machine{Type: "m5.xlarge"}},
+							},
+						},
+					},
+					Kubernetes: garden.Kubernetes{
+						AllowPrivilegedContainers: pointer.BoolPtr(true),
+						Version:                   "1.15.2",
+					},
+				},
+			}
+
+			project = &garden.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"purpose": "production"}},
+				Spec:       garden.ProjectSpec{Namespace: pointer.StringPtr(namespace)},
+			}
+
+			policy = &settingsv1alpha1.ShootPolicy{
+				ObjectMeta: metav1.ObjectMeta{Name: "production"},
+				Spec: settingsv1alpha1.ShootPolicySpec{
+					ProjectSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"purpose": "production"},
+					},
+					EnforcementAction: settingsv1alpha1.ShootPolicyEnforcementActionDeny,
+					Rules: []settingsv1alpha1.ShootPolicyRule{
+						{
+							Name:     "minimum-workers",
+							Path:     "spec.cloud.aws.workers[*].autoScalerMin",
+							Operator: settingsv1alpha1.ShootPolicyRuleOperatorGreaterThanOrEqual,
+							Values:   []string{"3"},
+						},
+						{
+							Name:     "no-privileged-containers",
+							Path:     "spec.kubernetes.allowPrivilegedContainers",
+							Operator: settingsv1alpha1.ShootPolicyRuleOperatorIn,
+							Values:   []string{"false"},
+						},
+					},
+				},
+			}
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			settingsInformerFactory = settingsinformer.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		})
+
+		Context("should do nothing when", func() {
+			It("no policies exist", func() {
+				Expect(validate(admission.Create, nil)).To(Succeed())
+			})
+
+			It("the project selector does not match", func() {
+				policy.Spec.ProjectSelector.MatchLabels["purpose"] = "development"
+				addPolicy(policy)
+
+				Expect(validate(admission.Create, nil)).To(Succeed())
+			})
+
+			It("the shoot does not belong to a project", func() {
+				shoot.Namespace = "default"
+				addPolicy(policy)
+
+				Expect(validate(admission.Create, nil)).To(Succeed())
+			})
+
+			It("the shoot fulfills all rules", func() {
+				shoot.Spec.Cloud.AWS.Workers[1].Minimum = 3
+				shoot.Spec.Kubernetes.AllowPrivilegedContainers = pointer.BoolPtr(false)
+				addPolicy(policy)
+
+				Expect(validate(admission.Create, nil)).To(Succeed())
+			})
+
+			It("the shoot is being deleted", func() {
+				now := metav1.Now()
+				shoot.DeletionTimestamp = &now
+				addPolicy(policy)
+
+				Expect(validate(admission.Update, shoot.DeepCopy())).To(Succeed())
+			})
+		})
+
+		It("should reject shoots violating the rules of a policy", func() {
+			addPolicy(policy)
+
+			err := validate(admission.Create, nil)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`spec.cloud.aws.workers[*].autoScalerMin: Invalid value: "1": must be greater than or equal to 3 (rule "minimum-workers" of ShootPolicy "production")`))
+			Expect(err.Error()).To(ContainSubstring(`spec.kubernetes.allowPrivilegedContainers: Invalid value: "true": must be one of ["false"] (rule "no-privileged-containers" of ShootPolicy "production")`))
+		})
+
+		It("should only record the violations of policies in audit mode", func() {
+			policy.Spec.EnforcementAction = settingsv1alpha1.ShootPolicyEnforcementActionAudit
+			addPolicy(policy)
+
+			Expect(validate(admission.Create, nil)).To(Succeed())
+			Expect(annotations).To(HaveKeyWithValue(AuditAnnotationKey, ContainSubstring(`rule "minimum-workers" of ShootPolicy "production"`)))
+		})
+
+		It("should not reject violations on updates which have already been present in the old shoot", func() {
+			addPolicy(policy)
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.Version = "1.15.3"
+
+			Expect(validate(admission.Update, oldShoot)).To(Succeed())
+		})
+
+		It("should reject new violations on updates of rules already violated by the old shoot", func() {
+			addPolicy(policy)
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Cloud.AWS.Workers = append(shoot.Spec.Cloud.AWS.Workers, garden.Worker{Name: "c", Minimum: 2, Maximum: 5, Machine: garden.Machine{Type: "m5.large"}})
+
+			err := validate(admission.Update, oldShoot)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`spec.cloud.aws.workers[*].autoScalerMin: Invalid value: "2"`))
+			Expect(err.Error()).NotTo(ContainSubstring(`Invalid value: "1"`))
+			Expect(err.Error()).NotTo(ContainSubstring("no-privileged-containers"))
+		})
+
+		It("should reject changing a value violating a rule to another violating value", func() {
+			addPolicy(policy)
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Cloud.AWS.Workers[1].Minimum = 2
+
+			err := validate(admission.Update, oldShoot)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(`spec.cloud.aws.workers[*].autoScalerMin: Invalid value: "2"`))
+		})
+
+		It("should enforce rules on updates which have not been violated by the old shoot", func() {
+			shoot.Spec.Kubernetes.AllowPrivilegedContainers = pointer.BoolPtr(false)
+			addPolicy(policy)
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.AllowPrivilegedContainers = pointer.BoolPtr(true)
+
+			err := validate(admission.Update, oldShoot)
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("no-privileged-containers"))
+			Expect(err.Error()).NotTo(ContainSubstring("minimum-workers"))
+		})
+	})
+})
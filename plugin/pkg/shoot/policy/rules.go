@@ -0,0 +1,179 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/fieldpath"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// EvaluateRule evaluates the given rule of the policy with the given name against the given JSON document. It returns
+// a field error for every selected value which violates the rule.
+func EvaluateRule(policyName string, rule *settingsv1alpha1.ShootPolicyRule, obj interface{}) (field.ErrorList, error) {
+	path, err := fieldpath.Parse(rule.Path)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse path of rule %q of shootpolicy %s: %v", rule.Name, policyName, err)
+	}
+
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath(strings.TrimPrefix(rule.Path, "."))
+		values  []interface{}
+	)
+
+	for _, value := range path.Find(obj) {
+		if value != nil {
+			values = append(values, value)
+		}
+	}
+
+	detail := func(defaultMessage string) string {
+		message := defaultMessage
+		if rule.Message != nil {
+			message = *rule.Message
+		}
+		return fmt.Sprintf("%s (rule %q of ShootPolicy %q)", message, rule.Name, policyName)
+	}
+
+	switch rule.Operator {
+	case settingsv1alpha1.ShootPolicyRuleOperatorExists:
+		if len(values) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath, detail("must be specified")))
+		}
+		return allErrs, nil
+
+	case settingsv1alpha1.ShootPolicyRuleOperatorDoesNotExist:
+		if len(values) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath, detail("must not be specified")))
+		}
+		return allErrs, nil
+	}
+
+	for _, value := range values {
+		s := formatValue(value)
+
+		violated, message, err := violates(rule, value, s)
+		if err != nil {
+			return nil, fmt.Errorf("could not evaluate rule %q of shootpolicy %s: %v", rule.Name, policyName, err)
+		}
+		if violated {
+			allErrs = append(allErrs, field.Invalid(fldPath, s, detail(message)))
+		}
+	}
+
+	return allErrs, nil
+}
+
+func violates(rule *settingsv1alpha1.ShootPolicyRule, value interface{}, s string) (bool, string, error) {
+	switch rule.Operator {
+	case settingsv1alpha1.ShootPolicyRuleOperatorIn:
+		return !contains(rule.Values, s), fmt.Sprintf("must be one of %s", quote(rule.Values)), nil
+
+	case settingsv1alpha1.ShootPolicyRuleOperatorNotIn:
+		return contains(rule.Values, s), fmt.Sprintf("must not be one of %s", quote(rule.Values)), nil
+
+	case settingsv1alpha1.ShootPolicyRuleOperatorMatches:
+		matches, err := matchesAny(rule.Values, s)
+		return !matches, fmt.Sprintf("must match one of %s", quote(rule.Values)), err
+
+	case settingsv1alpha1.ShootPolicyRuleOperatorDoesNotMatch:
+		matches, err := matchesAny(rule.Values, s)
+		return matches, fmt.Sprintf("must not match any of %s", quote(rule.Values)), err
+
+	case settingsv1alpha1.ShootPolicyRuleOperatorGreaterThanOrEqual, settingsv1alpha1.ShootPolicyRuleOperatorLessThanOrEqual:
+		if len(rule.Values) != 1 {
+			return false, "", fmt.Errorf("operator %s requires exactly one value", rule.Operator)
+		}
+		limit, err := strconv.ParseFloat(rule.Values[0], 64)
+		if err != nil {
+			return false, "", err
+		}
+		number, ok := toNumber(value)
+		if !ok {
+			return true, "must be a number", nil
+		}
+		if rule.Operator == settingsv1alpha1.ShootPolicyRuleOperatorGreaterThanOrEqual {
+			return number < limit, fmt.Sprintf("must be greater than or equal to %s", rule.Values[0]), nil
+		}
+		return number > limit, fmt.Sprintf("must be less than or equal to %s", rule.Values[0]), nil
+	}
+
+	return false, "", fmt.Errorf("unsupported operator %q", rule.Operator)
+}
+
+func formatValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case string:
+		number, err := strconv.ParseFloat(v, 64)
+		return number, err == nil
+	}
+	return 0, false
+}
+
+func contains(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(expressions []string, s string) (bool, error) {
+	for _, expression := range expressions {
+		re, err := regexp.Compile(expression)
+		if err != nil {
+			return false, err
+		}
+		if re.MatchString(s) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func quote(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy_test
+
+import (
+	"encoding/json"
+
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/policy"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("#EvaluateRule", func() {
+	var obj interface{}
+
+	BeforeEach(func() {
+		Expect(json.Unmarshal([]byte(`{
+  "metadata": {"name": "prod-foo"},
+  "spec": {
+    "kubernetes": {"allowPrivilegedContainers": true, "version": "1.15.2"},
+    "cloud": {"aws": {"workers": [
+      {"name": "a", "machineType": "m5.large", "autoScalerMin": 3},
+      {"name": "b", "machineType": "m5.xlarge", "autoScalerMin": 1}
+    ]}}
+  }
+}`), &obj)).To(Succeed())
+	})
+
+	DescribeTable("should evaluate the rule",
+		func(path string, operator settingsv1alpha1.ShootPolicyRuleOperator, values []string, violations int) {
+			rule := &settingsv1alpha1.ShootPolicyRule{Name: "rule", Path: path, Operator: operator, Values: values}
+
+			allErrs, err := EvaluateRule("policy", rule, obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(allErrs).To(HaveLen(violations))
+		},
+		Entry("In fulfilled", "spec.cloud.aws.workers[*].machineType", settingsv1alpha1.ShootPolicyRuleOperatorIn, []string{"m5.large", "m5.xlarge"}, 0),
+		Entry("In violated", "spec.cloud.aws.workers[*].machineType", settingsv1alpha1.ShootPolicyRuleOperatorIn, []string{"m5.large"}, 1),
+		Entry("In with missing field", "spec.cloud.azure.workers[*].machineType", settingsv1alpha1.ShootPolicyRuleOperatorIn, []string{"m5.large"}, 0),
+		Entry("NotIn fulfilled", "spec.kubernetes.allowPrivilegedContainers", settingsv1alpha1.ShootPolicyRuleOperatorNotIn, []string{"false"}, 0),
+		Entry("NotIn violated", "spec.kubernetes.allowPrivilegedContainers", settingsv1alpha1.ShootPolicyRuleOperatorNotIn, []string{"true"}, 1),
+		Entry("Matches fulfilled", "metadata.name", settingsv1alpha1.ShootPolicyRuleOperatorMatches, []string{"^dev-", "^prod-"}, 0),
+		Entry("Matches violated", "spec.cloud.aws.workers[*].name", settingsv1alpha1.ShootPolicyRuleOperatorMatches, []string{"^a$"}, 1),
+		Entry("DoesNotMatch fulfilled", "spec.kubernetes.version", settingsv1alpha1.ShootPolicyRuleOperatorDoesNotMatch, []string{`^1\.1[0-3]\.`}, 0),
+		Entry("DoesNotMatch violated", "spec.kubernetes.version", settingsv1alpha1.ShootPolicyRuleOperatorDoesNotMatch, []string{`^1\.15\.`}, 1),
+		Entry("GreaterThanOrEqual fulfilled", "spec.cloud.aws.workers[0].autoScalerMin", settingsv1alpha1.ShootPolicyRuleOperatorGreaterThanOrEqual, []string{"3"}, 0),
+		Entry("GreaterThanOrEqual violated", "spec.cloud.aws.workers[*].autoScalerMin", settingsv1alpha1.ShootPolicyRuleOperatorGreaterThanOrEqual, []string{"3"}, 1),
+		Entry("GreaterThanOrEqual with non-numeric value", "spec.cloud.aws.workers[*].name", settingsv1alpha1.ShootPolicyRuleOperatorGreaterThanOrEqual, []string{"3"}, 2),
+		Entry("LessThanOrEqual fulfilled", "spec.cloud.aws.workers[*].autoScalerMin", settingsv1alpha1.ShootPolicyRuleOperatorLessThanOrEqual, []string{"3"}, 0),
+		Entry("LessThanOrEqual violated", "spec.cloud.aws.workers[*].autoScalerMin", settingsv1alpha1.ShootPolicyRuleOperatorLessThanOrEqual, []string{"2.5"}, 1),
+		Entry("Exists fulfilled", "spec.cloud.aws", settingsv1alpha1.ShootPolicyRuleOperatorExists, nil, 0),
+		Entry("Exists violated", "spec.maintenance", settingsv1alpha1.ShootPolicyRuleOperatorExists, nil, 1),
+		Entry("DoesNotExist fulfilled", "spec.hibernation", settingsv1alpha1.ShootPolicyRuleOperatorDoesNotExist, nil, 0),
+		Entry("DoesNotExist violated", "spec.cloud.aws.workers[*]", settingsv1alpha1.ShootPolicyRuleOperatorDoesNotExist, nil, 1),
+	)
+
+	It("should report the violations as field errors", func() {
+		rule := &settingsv1alpha1.ShootPolicyRule{
+			Name:     "machine-types",
+			Path:     "spec.cloud.aws.workers[*].machineType",
+			Operator: settingsv1alpha1.ShootPolicyRuleOperatorIn,
+			Values:   []string{"m5.large"},
+			Message:  pointer.StringPtr("only m5.large machines are allowed"),
+		}
+
+		allErrs, err := EvaluateRule("policy", rule, obj)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":     Equal(field.ErrorTypeInvalid),
+			"Field":    Equal("spec.cloud.aws.workers[*].machineType"),
+			"BadValue": Equal("m5.xlarge"),
+			"Detail":   Equal(`only m5.large machines are allowed (rule "machine-types" of ShootPolicy "policy")`),
+		}))))
+	})
+
+	It("should return an error for invalid paths", func() {
+		rule := &settingsv1alpha1.ShootPolicyRule{Name: "rule", Path: "spec..foo", Operator: settingsv1alpha1.ShootPolicyRuleOperatorExists}
+
+		_, err := EvaluateRule("policy", rule, obj)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,269 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preset
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	gardenlisters "github.com/gardener/gardener/pkg/client/garden/listers/garden/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	settingslister "github.com/gardener/gardener/pkg/client/settings/listers/settings/v1alpha1"
+	"github.com/gardener/gardener/pkg/operation/common"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apiserver/pkg/admission"
+)
+
+const (
+	// PluginName is the name of this admission plugin.
+	PluginName = "ShootPreset"
+
+	kindShootPreset        = "ShootPreset"
+	kindClusterShootPreset = "ClusterShootPreset"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func(config io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootPreset contains listers and and admission handler.
+type ShootPreset struct {
+	*admission.Handler
+
+	projectLister            gardenlisters.ProjectLister
+	shootPresetLister        settingslister.ShootPresetLister
+	clusterShootPresetLister settingslister.ClusterShootPresetLister
+	readyFunc                admission.ReadyFunc
+}
+
+var (
+	_                             = admissioninitializer.WantsInternalGardenInformerFactory(&ShootPreset{})
+	_                             = admissioninitializer.WantsSettingsInformerFactory(&ShootPreset{})
+	_ admission.MutationInterface = &ShootPreset{}
+
+	readyFuncs = []admission.ReadyFunc{}
+)
+
+// New creates a new ShootPreset admission plugin.
+func New() (*ShootPreset, error) {
+	return &ShootPreset{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootPreset) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetInternalGardenInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPreset) SetInternalGardenInformerFactory(f gardeninformers.SharedInformerFactory) {
+	projectInformer := f.Garden().InternalVersion().Projects()
+	s.projectLister = projectInformer.Lister()
+
+	readyFuncs = append(readyFuncs, projectInformer.Informer().HasSynced)
+}
+
+// SetSettingsInformerFactory gets Lister from SharedInformerFactory.
+func (s *ShootPreset) SetSettingsInformerFactory(f settingsinformer.SharedInformerFactory) {
+	shootPresetInformer := f.Settings().V1alpha1().ShootPresets()
+	s.shootPresetLister = shootPresetInformer.Lister()
+
+	clusterShootPresetInformer := f.Settings().V1alpha1().ClusterShootPresets()
+	s.clusterShootPresetLister = clusterShootPresetInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootPresetInformer.Informer().HasSynced, clusterShootPresetInformer.Informer().HasSynced)
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootPreset) ValidateInitialization() error {
+	if s.shootPresetLister == nil {
+		return errors.New("missing shootpreset lister")
+	}
+	if s.clusterShootPresetLister == nil {
+		return errors.New("missing clustershootpreset lister")
+	}
+	if s.projectLister == nil {
+		return errors.New("missing project lister")
+	}
+	return nil
+}
+
+// Admit applies the defaults of all matching ShootPresets and ClusterShootPresets to Shoots that are created.
+func (s *ShootPreset) Admit(a admission.Attributes, o admission.ObjectInterfaces) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+	if !s.WaitForReady() {
+		return admission.NewForbidden(a, errors.New("not yet ready to handle request"))
+	}
+
+	// Ignore all kinds other than Shoot
+	// Ignore all subresource calls
+	// Ignore all operations other than CREATE
+	if len(a.GetSubresource()) != 0 || (a.GetKind().GroupKind() != garden.Kind("Shoot") && a.GetKind().GroupKind() != core.Kind("Shoot")) || a.GetOperation() != admission.Create {
+		return nil
+	}
+	shoot, ok := a.GetObject().(*garden.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	// The annotation is maintained by this plugin only.
+	delete(shoot.Annotations, common.ShootAppliedPresets)
+
+	shootPresets, err := s.shootPresetLister.ShootPresets(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing shootpresets: %v", err))
+	}
+	clusterShootPresets, err := s.clusterShootPresetLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list existing clustershootpresets: %v", err))
+	}
+	if len(shootPresets) == 0 && len(clusterShootPresets) == 0 {
+		return nil
+	}
+
+	project, err := s.getProject(shoot.Namespace)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	presets, err := filterPresets(shootPresets, clusterShootPresets, shoot, project)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	if len(presets) == 0 {
+		return nil
+	}
+
+	var (
+		defaults = make([]*settingsv1alpha1.ShootDefaults, 0, len(presets))
+		applied  = make([]string, 0, len(presets))
+	)
+	for _, preset := range presets {
+		defaults = append(defaults, &preset.spec.Defaults)
+		applied = append(applied, preset.kind+"/"+preset.name)
+	}
+
+	if err := ApplyShootDefaults(shoot, defaults...); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not apply shoot presets: %v", err))
+	}
+
+	if shoot.Annotations == nil {
+		shoot.Annotations = map[string]string{}
+	}
+	shoot.Annotations[common.ShootAppliedPresets] = strings.Join(applied, ",")
+	return nil
+}
+
+func (s *ShootPreset) getProject(namespace string) (*garden.Project, error) {
+	projects, err := s.projectLister.List(labels.Everything())
+	if err != nil {
+		return nil, fmt.Errorf("could not list existing projects: %v", err)
+	}
+	for _, project := range projects {
+		if project.Spec.Namespace != nil && *project.Spec.Namespace == namespace && project.Status.Phase == garden.ProjectReady {
+			return project, nil
+		}
+	}
+	return nil, nil
+}
+
+type matchedPreset struct {
+	kind string
+	name string
+	spec *settingsv1alpha1.ShootPresetSpec
+}
+
+// filterPresets returns the presets matching the given Shoot ordered by increasing precedence. Presets with a higher
+// weight take precedence. For equal weights, ShootPresets take precedence over ClusterShootPresets and presets with
+// lexicographically greater names take precedence over the others. ClusterShootPresets are only considered if the
+// Shoot belongs to a Project.
+func filterPresets(shootPresets []*settingsv1alpha1.ShootPreset, clusterShootPresets []*settingsv1alpha1.ClusterShootPreset, shoot *garden.Shoot, project *garden.Project) ([]matchedPreset, error) {
+	var matched []matchedPreset
+
+	for _, preset := range shootPresets {
+		ok, err := matchesSelector(preset.Spec.ShootSelector, shoot.Labels)
+		if err != nil {
+			return nil, fmt.Errorf("label selector conversion failed for shootSelector of shootpreset %s: %v", preset.Name, err)
+		}
+		if ok {
+			matched = append(matched, matchedPreset{kind: kindShootPreset, name: preset.Name, spec: &preset.Spec})
+		}
+	}
+
+	if project != nil {
+		for _, preset := range clusterShootPresets {
+			ok, err := matchesSelector(preset.Spec.ProjectSelector, project.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("label selector conversion failed for projectSelector of clustershootpreset %s: %v", preset.Name, err)
+			}
+			if !ok {
+				continue
+			}
+			ok, err = matchesSelector(preset.Spec.ShootSelector, shoot.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("label selector conversion failed for shootSelector of clustershootpreset %s: %v", preset.Name, err)
+			}
+			if ok {
+				matched = append(matched, matchedPreset{kind: kindClusterShootPreset, name: preset.Name, spec: &preset.Spec.ShootPresetSpec})
+			}
+		}
+	}
+
+	sort.Slice(matched, func(i, j int) bool {
+		if matched[i].spec.Weight != matched[j].spec.Weight {
+			return matched[i].spec.Weight < matched[j].spec.Weight
+		}
+		if matched[i].kind != matched[j].kind {
+			return matched[i].kind == kindClusterShootPreset
+		}
+		return matched[i].name < matched[j].name
+	})
+
+	return matched, nil
+}
+
+func matchesSelector(labelSelector *metav1.LabelSelector, objLabels map[string]string) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(objLabels)), nil
+}
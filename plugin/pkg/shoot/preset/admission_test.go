@@ -0,0 +1,315 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preset_test
+
+import (
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	gardeninformers "github.com/gardener/gardener/pkg/client/garden/informers/internalversion"
+	settingsinformer "github.com/gardener/gardener/pkg/client/settings/informers/externalversions"
+	"github.com/gardener/gardener/pkg/operation/common"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/preset"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/pointer"
+)
+
+var _ = Describe("ShootPreset", func() {
+	Describe("#Admit", func() {
+		const namespace = "garden-dev"
+
+		var (
+			admissionHandler        *ShootPreset
+			settingsInformerFactory settingsinformer.SharedInformerFactory
+			gardenInformerFactory   gardeninformers.SharedInformerFactory
+
+			shoot         *garden.Shoot
+			project       *garden.Project
+			preset        *settingsv1alpha1.ShootPreset
+			clusterPreset *settingsv1alpha1.ClusterShootPreset
+
+			admit = func(op admission.Operation) error {
+				attrs := admission.NewAttributesRecord(shoot, nil, garden.Kind("Shoot").WithVersion("v1beta1"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("v1beta1"), "", op, false, nil)
+				return admissionHandler.Admit(attrs, nil)
+			}
+			addPresets = func(presets ...interface{}) {
+				for _, p := range presets {
+					switch obj := p.(type) {
+					case *settingsv1alpha1.ShootPreset:
+						Expect(settingsInformerFactory.Settings().V1alpha1().ShootPresets().Informer().GetStore().Add(obj)).To(Succeed())
+					case *settingsv1alpha1.ClusterShootPreset:
+						Expect(settingsInformerFactory.Settings().V1alpha1().ClusterShootPresets().Informer().GetStore().Add(obj)).To(Succeed())
+					}
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			shoot = &garden.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "shoot",
+					Namespace: namespace,
+					Labels:    map[string]string{"purpose": "dev"},
+				},
+				Spec: garden.ShootSpec{
+					Kubernetes: garden.Kubernetes{Version: "1.15.1"},
+				},
+			}
+
+			project = &garden.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev", Labels: map[string]string{"tier": "internal"}},
+				Spec:       garden.ProjectSpec{Namespace: pointer.StringPtr(namespace)},
+				Status:     garden.ProjectStatus{Phase: garden.ProjectReady},
+			}
+
+			preset = &settingsv1alpha1.ShootPreset{
+				ObjectMeta: metav1.ObjectMeta{Name: "preset", Namespace: namespace},
+				Spec: settingsv1alpha1.ShootPresetSpec{
+					ShootSelector: &metav1.LabelSelector{},
+					Weight:        50,
+					Defaults: settingsv1alpha1.ShootDefaults{
+						Hibernation: &gardenv1beta1.Hibernation{
+							Schedules: []gardenv1beta1.HibernationSchedule{{Start: pointer.StringPtr("0 20 * * *")}},
+						},
+						Maintenance: &gardenv1beta1.Maintenance{
+							AutoUpdate: &gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: true},
+							TimeWindow: &gardenv1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+						},
+						AuditConfig: &gardenv1beta1.AuditConfig{
+							AuditPolicy: &gardenv1beta1.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"}},
+						},
+					},
+				},
+			}
+
+			clusterPreset = &settingsv1alpha1.ClusterShootPreset{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster-preset"},
+				Spec: settingsv1alpha1.ClusterShootPresetSpec{
+					ProjectSelector: &metav1.LabelSelector{},
+					ShootPresetSpec: settingsv1alpha1.ShootPresetSpec{
+						ShootSelector: &metav1.LabelSelector{},
+						Weight:        50,
+						Defaults: settingsv1alpha1.ShootDefaults{
+							Addons: &gardenv1beta1.Addons{
+								KubernetesDashboard: &gardenv1beta1.KubernetesDashboard{
+									Addon:              gardenv1beta1.Addon{Enabled: true},
+									AuthenticationMode: pointer.StringPtr("token"),
+								},
+							},
+							Extensions: []gardenv1beta1.Extension{{Type: "shoot-cert-service"}, {Type: "shoot-dns-service"}},
+							Kubelet: &gardenv1beta1.KubeletConfig{
+								KubernetesConfig: gardenv1beta1.KubernetesConfig{FeatureGates: map[string]bool{"Foo": true}},
+								MaxPods:          pointer.Int32Ptr(110),
+							},
+							Maintenance: &gardenv1beta1.Maintenance{
+								AutoUpdate: &gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: false},
+								TimeWindow: &gardenv1beta1.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"},
+							},
+						},
+					},
+				},
+			}
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			settingsInformerFactory = settingsinformer.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetSettingsInformerFactory(settingsInformerFactory)
+			gardenInformerFactory = gardeninformers.NewSharedInformerFactory(nil, 0)
+			admissionHandler.SetInternalGardenInformerFactory(gardenInformerFactory)
+			Expect(gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(project)).To(Succeed())
+		})
+
+		Context("should do nothing when", func() {
+			var expected *garden.Shoot
+
+			BeforeEach(func() {
+				expected = shoot.DeepCopy()
+			})
+
+			It("the operation is not create", func() {
+				addPresets(preset, clusterPreset)
+				Expect(admit(admission.Update)).To(Succeed())
+				Expect(shoot).To(Equal(expected))
+			})
+
+			It("no presets exist", func() {
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot).To(Equal(expected))
+			})
+
+			It("the selectors do not match", func() {
+				preset.Spec.ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "production"}}
+				clusterPreset.Spec.ProjectSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "external"}}
+				addPresets(preset, clusterPreset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot).To(Equal(expected))
+			})
+
+			It("the shoot does not belong to a project", func() {
+				shoot.Namespace = "other"
+				expected.Namespace = "other"
+				addPresets(clusterPreset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot).To(Equal(expected))
+			})
+		})
+
+		It("should return an error if the object is not a shoot", func() {
+			attrs := admission.NewAttributesRecord(&garden.Seed{}, nil, garden.Kind("Shoot").WithVersion("v1beta1"), namespace, "shoot", garden.Resource("shoots").WithVersion("v1beta1"), "", admission.Create, false, nil)
+			err := admissionHandler.Admit(attrs, nil)
+			Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+		})
+
+		Context("should mutate the shoot", func() {
+			It("by applying the defaults of a matching preset and recording it", func() {
+				addPresets(preset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot.Spec.Hibernation).To(Equal(&garden.Hibernation{
+					Schedules: []garden.HibernationSchedule{{Start: pointer.StringPtr("0 20 * * *")}},
+				}))
+				Expect(shoot.Spec.Maintenance).To(Equal(&garden.Maintenance{
+					AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: true},
+					TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				}))
+				Expect(shoot.Spec.Kubernetes.KubeAPIServer).To(Equal(&garden.KubeAPIServerConfig{
+					AuditConfig: &garden.AuditConfig{
+						AuditPolicy: &garden.AuditPolicy{ConfigMapRef: &corev1.ObjectReference{Name: "audit-policy"}},
+					},
+				}))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootAppliedPresets, "ShootPreset/preset"))
+			})
+
+			It("by keeping the settings of the shoot", func() {
+				shoot.Spec.Maintenance = &garden.Maintenance{
+					TimeWindow: &garden.MaintenanceTimeWindow{Begin: "033000+0000", End: "043000+0000"},
+				}
+				shoot.Spec.Addons = &garden.Addons{
+					KubernetesDashboard: &garden.KubernetesDashboard{
+						Addon:              garden.Addon{Enabled: false},
+						AuthenticationMode: pointer.StringPtr("token"),
+					},
+				}
+				shoot.Spec.Extensions = []garden.Extension{{Type: "shoot-dns-service"}, {Type: "foo"}}
+				shoot.Spec.Kubernetes.Kubelet = &garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"Bar": false}},
+				}
+				addPresets(clusterPreset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot.Spec.Maintenance).To(Equal(&garden.Maintenance{
+					AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: false},
+					TimeWindow: &garden.MaintenanceTimeWindow{Begin: "033000+0000", End: "043000+0000"},
+				}))
+				Expect(shoot.Spec.Addons).To(Equal(&garden.Addons{
+					KubernetesDashboard: &garden.KubernetesDashboard{
+						Addon:              garden.Addon{Enabled: false},
+						AuthenticationMode: pointer.StringPtr("token"),
+					},
+				}))
+				Expect(shoot.Spec.Extensions).To(Equal([]garden.Extension{{Type: "shoot-cert-service"}, {Type: "shoot-dns-service"}, {Type: "foo"}}))
+				Expect(shoot.Spec.Kubernetes.Kubelet).To(Equal(&garden.KubeletConfig{
+					KubernetesConfig: garden.KubernetesConfig{FeatureGates: map[string]bool{"Foo": true, "Bar": false}},
+					MaxPods:          pointer.Int32Ptr(110),
+				}))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootAppliedPresets, "ClusterShootPreset/cluster-preset"))
+			})
+
+			It("by preferring namespaced presets over cluster presets with the same weight", func() {
+				addPresets(preset, clusterPreset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&garden.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"}))
+				Expect(shoot.Spec.Kubernetes.Kubelet).NotTo(BeNil())
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootAppliedPresets, "ClusterShootPreset/cluster-preset,ShootPreset/preset"))
+			})
+
+			It("by preferring presets with a higher weight", func() {
+				clusterPreset.Spec.Weight = 51
+				addPresets(preset, clusterPreset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot.Spec.Maintenance.TimeWindow).To(Equal(&garden.MaintenanceTimeWindow{Begin: "010000+0000", End: "020000+0000"}))
+				Expect(shoot.Annotations).To(HaveKeyWithValue(common.ShootAppliedPresets, "ShootPreset/preset,ClusterShootPreset/cluster-preset"))
+			})
+
+			Context("which has been defaulted by the API", func() {
+				BeforeEach(func() {
+					defaulted := &gardenv1beta1.Shoot{}
+					Expect(api.Scheme.Convert(shoot, defaulted, nil)).To(Succeed())
+					gardenv1beta1.SetObjectDefaults_Shoot(defaulted)
+					shoot = &garden.Shoot{}
+					Expect(api.Scheme.Convert(defaulted, shoot, nil)).To(Succeed())
+				})
+
+				It("by applying the defaults of the presets over the defaulted settings", func() {
+					addPresets(preset, clusterPreset)
+
+					Expect(admit(admission.Create)).To(Succeed())
+					Expect(shoot.Spec.Maintenance).To(Equal(&garden.Maintenance{
+						AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: true, MachineImageVersion: pointer.BoolPtr(true)},
+						TimeWindow: &garden.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					}))
+					Expect(shoot.Spec.Addons).To(Equal(&garden.Addons{
+						KubernetesDashboard: &garden.KubernetesDashboard{
+							Addon:              garden.Addon{Enabled: true},
+							AuthenticationMode: pointer.StringPtr("token"),
+						},
+					}))
+				})
+
+				It("by keeping the defaulted settings which are not defaulted by the presets", func() {
+					preset.Spec.Defaults.Maintenance = nil
+					clusterPreset.Spec.Defaults.Addons = nil
+					clusterPreset.Spec.Defaults.Maintenance = &gardenv1beta1.Maintenance{
+						AutoUpdate: &gardenv1beta1.MaintenanceAutoUpdate{KubernetesVersion: false},
+					}
+					expectedTimeWindow := shoot.Spec.Maintenance.TimeWindow.DeepCopy()
+					addPresets(preset, clusterPreset)
+
+					Expect(admit(admission.Create)).To(Succeed())
+					Expect(shoot.Spec.Maintenance).To(Equal(&garden.Maintenance{
+						AutoUpdate: &garden.MaintenanceAutoUpdate{KubernetesVersion: false, MachineImageVersion: pointer.BoolPtr(true)},
+						TimeWindow: expectedTimeWindow,
+					}))
+					Expect(shoot.Spec.Addons).To(Equal(&garden.Addons{
+						KubernetesDashboard: &garden.KubernetesDashboard{
+							Addon:              garden.Addon{Enabled: false},
+							AuthenticationMode: pointer.StringPtr("basic"),
+						},
+					}))
+				})
+			})
+
+			It("by removing a wrongly set annotation", func() {
+				shoot.Annotations = map[string]string{common.ShootAppliedPresets: "ShootPreset/foo"}
+				preset.Spec.ShootSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "production"}}
+				addPresets(preset)
+
+				Expect(admit(admission.Create)).To(Succeed())
+				Expect(shoot.Annotations).NotTo(HaveKey(common.ShootAppliedPresets))
+			})
+		})
+	})
+})
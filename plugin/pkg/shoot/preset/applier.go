@@ -0,0 +1,188 @@
+// Copyright (c) 2019 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preset
+
+import (
+	"encoding/json"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/garden"
+	gardenv1beta1 "github.com/gardener/gardener/pkg/apis/garden/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/settings"
+	settingsv1alpha1 "github.com/gardener/gardener/pkg/apis/settings/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+)
+
+// ApplyShootDefaults applies the given defaults to the Shoot. The defaults must be ordered by increasing precedence, the
+// settings specified in the Shoot itself always take precedence over all defaults. The settings are merged with
+// strategic-merge semantics, i.e. objects are merged recursively and lists are replaced, except for the extensions
+// which are merged by their type. Settings of the Shoot which only hold the values filled in by the API defaulting are
+// not considered to be specified by the user, i.e. the defaults take precedence over them.
+func ApplyShootDefaults(shoot *garden.Shoot, defaults ...*settingsv1alpha1.ShootDefaults) error {
+	specified, apiDefaulted := splitAPIDefaults(getShootDefaults(shoot).DeepCopy(), shoot.Spec.Kubernetes.Version)
+
+	current := &settingsv1alpha1.ShootDefaults{}
+	if err := api.Scheme.Convert(specified, current, nil); err != nil {
+		return err
+	}
+	lowest := &settingsv1alpha1.ShootDefaults{}
+	if err := api.Scheme.Convert(apiDefaulted, lowest, nil); err != nil {
+		return err
+	}
+
+	var (
+		merged     = []byte("{}")
+		extensions []gardenv1beta1.Extension
+	)
+
+	for _, d := range append(append([]*settingsv1alpha1.ShootDefaults{lowest}, defaults...), current) {
+		extensions = mergeExtensions(extensions, d.Extensions)
+
+		withoutExtensions := d.DeepCopy()
+		withoutExtensions.Extensions = nil
+		patch, err := json.Marshal(withoutExtensions)
+		if err != nil {
+			return err
+		}
+
+		merged, err = strategicpatch.StrategicMergePatch(merged, patch, settingsv1alpha1.ShootDefaults{})
+		if err != nil {
+			return err
+		}
+	}
+
+	result := &settingsv1alpha1.ShootDefaults{}
+	if err := json.Unmarshal(merged, result); err != nil {
+		return err
+	}
+	result.Extensions = extensions
+
+	out := &settings.ShootDefaults{}
+	if err := api.Scheme.Convert(result, out, nil); err != nil {
+		return err
+	}
+	setShootDefaults(shoot, out)
+	return nil
+}
+
+// splitAPIDefaults splits the given settings of a Shoot into the settings specified by the user and the settings which
+// only hold the values the API defaulting fills in for unset fields. The defaulting runs when the Shoot is decoded,
+// i.e. before the admission, hence the defaulted values are detected by comparing them with the defaults of an empty
+// Shoot. As the defaulted maintenance time window is chosen randomly, every time window of exactly one hour starting
+// at a full hour is regarded as defaulted.
+func splitAPIDefaults(current *settings.ShootDefaults, kubernetesVersion string) (*settings.ShootDefaults, *settings.ShootDefaults) {
+	empty := &gardenv1beta1.Shoot{}
+	empty.Spec.Kubernetes.Version = kubernetesVersion
+	gardenv1beta1.SetObjectDefaults_Shoot(empty)
+
+	apiDefaulted := &settings.ShootDefaults{}
+
+	if addons := current.Addons; addons != nil && addons.KubernetesDashboard != nil {
+		dashboard := addons.KubernetesDashboard
+		if dashboard.AuthenticationMode != nil && *dashboard.AuthenticationMode == *empty.Spec.Addons.KubernetesDashboard.AuthenticationMode {
+			apiDefaulted.Addons = &garden.Addons{
+				KubernetesDashboard: &garden.KubernetesDashboard{AuthenticationMode: dashboard.AuthenticationMode},
+			}
+			dashboard.AuthenticationMode = nil
+		}
+		if apiequality.Semantic.DeepEqual(dashboard, &garden.KubernetesDashboard{}) {
+			addons.KubernetesDashboard = nil
+		}
+		if apiequality.Semantic.DeepEqual(addons, &garden.Addons{}) {
+			current.Addons = nil
+		}
+	}
+
+	if maintenance := current.Maintenance; maintenance != nil {
+		apiDefaulted.Maintenance = &garden.Maintenance{}
+
+		if autoUpdate := maintenance.AutoUpdate; autoUpdate != nil &&
+			autoUpdate.KubernetesVersion == empty.Spec.Maintenance.AutoUpdate.KubernetesVersion &&
+			apiequality.Semantic.DeepEqual(autoUpdate.MachineImageVersion, empty.Spec.Maintenance.AutoUpdate.MachineImageVersion) {
+			apiDefaulted.Maintenance.AutoUpdate = autoUpdate
+			maintenance.AutoUpdate = nil
+		}
+		if timeWindow := maintenance.TimeWindow; timeWindow != nil && isDefaultMaintenanceTimeWindow(timeWindow) {
+			apiDefaulted.Maintenance.TimeWindow = timeWindow
+			maintenance.TimeWindow = nil
+		}
+		if apiequality.Semantic.DeepEqual(maintenance, &garden.Maintenance{}) {
+			current.Maintenance = nil
+		}
+	}
+
+	return current, apiDefaulted
+}
+
+func isDefaultMaintenanceTimeWindow(timeWindow *garden.MaintenanceTimeWindow) bool {
+	begin, err := utils.ParseMaintenanceTime(timeWindow.Begin)
+	if err != nil {
+		return false
+	}
+	return begin.Minute() == 0 && begin.Second() == 0 &&
+		timeWindow.Begin == begin.Formatted() &&
+		timeWindow.End == begin.Add(1, 0, 0).Formatted()
+}
+
+// mergeExtensions replaces the extensions in the base list with the overrides of the same type and appends the
+// overrides of all other types.
+func mergeExtensions(base, overrides []gardenv1beta1.Extension) []gardenv1beta1.Extension {
+	for _, override := range overrides {
+		replaced := false
+		for i, extension := range base {
+			if extension.Type == override.Type {
+				base[i] = *override.DeepCopy()
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			base = append(base, *override.DeepCopy())
+		}
+	}
+	return base
+}
+
+func getShootDefaults(shoot *garden.Shoot) *settings.ShootDefaults {
+	defaults := &settings.ShootDefaults{
+		Addons:      shoot.Spec.Addons,
+		Extensions:  shoot.Spec.Extensions,
+		Hibernation: shoot.Spec.Hibernation,
+		Kubelet:     shoot.Spec.Kubernetes.Kubelet,
+		Maintenance: shoot.Spec.Maintenance,
+	}
+	if shoot.Spec.Kubernetes.KubeAPIServer != nil {
+		defaults.AuditConfig = shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig
+	}
+	return defaults
+}
+
+func setShootDefaults(shoot *garden.Shoot, defaults *settings.ShootDefaults) {
+	shoot.Spec.Addons = defaults.Addons
+	shoot.Spec.Extensions = defaults.Extensions
+	shoot.Spec.Hibernation = defaults.Hibernation
+	shoot.Spec.Kubernetes.Kubelet = defaults.Kubelet
+	shoot.Spec.Maintenance = defaults.Maintenance
+
+	if defaults.AuditConfig != nil && shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		shoot.Spec.Kubernetes.KubeAPIServer = &garden.KubeAPIServerConfig{}
+	}
+	if shoot.Spec.Kubernetes.KubeAPIServer != nil {
+		shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig = defaults.AuditConfig
+	}
+}
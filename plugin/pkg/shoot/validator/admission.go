@@ -32,10 +32,12 @@ import (
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
 
 	"github.com/Masterminds/semver"
+	"github.com/robfig/cron"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/admission"
 )
@@ -206,6 +208,11 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 		return admission.NewForbidden(a, fmt.Errorf("forbidden to use a protected seed"))
 	}
 
+	// Cordoned seeds keep their existing shoots, but we don't allow new shoots to be assigned to them.
+	if seed != nil && helper.TaintsHave(seed.Spec.Taints, garden.SeedTaintCordoned) && seedAssignmentChanged(a, shoot) {
+		return admission.NewForbidden(a, fmt.Errorf("cannot assign shoot '%s' to seed '%s' because it is cordoned", shoot.Name, seed.Name))
+	}
+
 	// We don't allow shoot to be created on the seed which is already marked to be deleted.
 	if seed != nil && seed.DeletionTimestamp != nil {
 		return admission.NewForbidden(a, fmt.Errorf("cannot create or update shoot '%s' on seed '%s' already marked for deletion", shoot.Name, seed.Name))
@@ -492,9 +499,12 @@ func (v *ValidateShoot) Admit(a admission.Attributes, o admission.ObjectInterfac
 				allErrs = append(allErrs, field.Required(field.NewPath("spec", "networking", "services"), "services is required"))
 			}
 		}
+
+		allErrs = append(allErrs, admissionutils.ValidateEgressDestinationsNotBlocked(seed, shoot.Spec.Networking.ControlPlaneEgressDestinations, field.NewPath("spec", "networking", "controlPlaneEgressDestinations"))...)
 	}
 
 	allErrs = append(allErrs, validateProvider(validationContext)...)
+	allErrs = append(allErrs, validateBackup(validationContext)...)
 
 	dnsErrors, err := validateDNSDomainUniqueness(v.shootLister, shoot.Name, shoot.Spec.DNS)
 	if err != nil {
@@ -874,6 +884,30 @@ func validateProvider(c *validationContext) field.ErrorList {
 		if ok, validVolumeTypes := validateVolumeTypes(c.cloudProfile.Spec.VolumeTypes, worker.Volume, oldWorker.Volume, c.cloudProfile.Spec.Regions, c.shoot.Spec.Region, worker.Zones); !ok {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volume", "type"), worker.Volume, validVolumeTypes))
 		}
+		if !apiequality.Semantic.DeepEqual(worker.CRI, oldWorker.CRI) || !apiequality.Semantic.DeepEqual(worker.Machine.Image, oldWorker.Machine.Image) {
+			allErrs = append(allErrs, validateCRIConstraints(c.cloudProfile.Spec.MachineImages, worker.Machine.Image, worker.CRI, idxPath.Child("cri"))...)
+		}
+		if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+			versionPath := idxPath.Child("kubernetes", "version")
+
+			var oldWorkerVersion string
+			if oldWorker.Kubernetes != nil && oldWorker.Kubernetes.Version != nil {
+				oldWorkerVersion = *oldWorker.Kubernetes.Version
+			}
+
+			ok, validKubernetesVersions, versionDefault := validateKubernetesVersionConstraints(c.cloudProfile.Spec.Kubernetes.Versions, *worker.Kubernetes.Version, oldWorkerVersion)
+			if !ok {
+				allErrs = append(allErrs, field.NotSupported(versionPath, *worker.Kubernetes.Version, validKubernetesVersions))
+			} else {
+				if versionDefault != nil {
+					defaultVersion := versionDefault.String()
+					c.shoot.Spec.Provider.Workers[i].Kubernetes.Version = &defaultVersion
+				}
+				if err := validateWorkerKubernetesVersionSkew(c.shoot.Spec.Kubernetes.Version, *c.shoot.Spec.Provider.Workers[i].Kubernetes.Version); err != nil {
+					allErrs = append(allErrs, field.Invalid(versionPath, *c.shoot.Spec.Provider.Workers[i].Kubernetes.Version, err.Error()))
+				}
+			}
+		}
 
 		for j, zone := range worker.Zones {
 			jdxPath := idxPath.Child("zones").Index(j)
@@ -890,13 +924,108 @@ func validateProvider(c *validationContext) field.ErrorList {
 	return allErrs
 }
 
+// validateBackup validates the etcd backup settings of the Shoot against the backup limits of its CloudProfile and
+// Seed. Settings which have not been changed are not validated again, so that Shoots are not locked once the limits
+// are tightened. All settings are validated again if the Shoot is assigned to another Seed (e.g., by the scheduler
+// after the Shoot has been created), because they have never been checked against the limits of this Seed.
+func validateBackup(c *validationContext) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		path    = field.NewPath("spec", "backup")
+		limits  = getBackupLimits(c.cloudProfile, c.seed)
+		backup  = c.shoot.Spec.Backup
+		old     = c.oldShoot.Spec.Backup
+	)
+
+	if backup == nil || limits == nil {
+		return allErrs
+	}
+	if old == nil || !apiequality.Semantic.DeepEqual(c.shoot.Spec.SeedName, c.oldShoot.Spec.SeedName) {
+		old = &garden.ShootBackup{}
+	}
+
+	if backup.Schedule != nil && limits.MinFullSnapshotInterval != nil && !apiequality.Semantic.DeepEqual(backup.Schedule, old.Schedule) {
+		if schedule, err := cron.ParseStandard(*backup.Schedule); err == nil {
+			if interval := minimumScheduleInterval(schedule); interval < limits.MinFullSnapshotInterval.Duration {
+				allErrs = append(allErrs, field.Invalid(path.Child("schedule"), *backup.Schedule, fmt.Sprintf("full snapshots must not be taken more often than every %s (schedule runs every %s)", limits.MinFullSnapshotInterval.Duration, interval)))
+			}
+		}
+	}
+	if backup.DeltaSnapshotPeriod != nil && limits.MinDeltaSnapshotPeriod != nil && !apiequality.Semantic.DeepEqual(backup.DeltaSnapshotPeriod, old.DeltaSnapshotPeriod) {
+		if backup.DeltaSnapshotPeriod.Duration < limits.MinDeltaSnapshotPeriod.Duration {
+			allErrs = append(allErrs, field.Invalid(path.Child("deltaSnapshotPeriod"), backup.DeltaSnapshotPeriod.Duration.String(), fmt.Sprintf("must not be less than %s", limits.MinDeltaSnapshotPeriod.Duration)))
+		}
+	}
+	if backup.MaxBackups != nil && limits.MaxBackups != nil && !apiequality.Semantic.DeepEqual(backup.MaxBackups, old.MaxBackups) {
+		if *backup.MaxBackups > *limits.MaxBackups {
+			allErrs = append(allErrs, field.Invalid(path.Child("maxBackups"), *backup.MaxBackups, fmt.Sprintf("must not be greater than %d", *limits.MaxBackups)))
+		}
+	}
+
+	return allErrs
+}
+
+// getBackupLimits combines the backup limits of the given CloudProfile and Seed. The stricter limit wins if both
+// define the same limit.
+func getBackupLimits(cloudProfile *garden.CloudProfile, seed *garden.Seed) *garden.BackupLimits {
+	var limits []*garden.BackupLimits
+	if cloudProfile.Spec.BackupLimits != nil {
+		limits = append(limits, cloudProfile.Spec.BackupLimits)
+	}
+	if seed != nil && seed.Spec.BackupLimits != nil {
+		limits = append(limits, seed.Spec.BackupLimits)
+	}
+	if len(limits) == 0 {
+		return nil
+	}
+
+	result := &garden.BackupLimits{}
+	for _, l := range limits {
+		if l.MinFullSnapshotInterval != nil && (result.MinFullSnapshotInterval == nil || l.MinFullSnapshotInterval.Duration > result.MinFullSnapshotInterval.Duration) {
+			result.MinFullSnapshotInterval = l.MinFullSnapshotInterval
+		}
+		if l.MinDeltaSnapshotPeriod != nil && (result.MinDeltaSnapshotPeriod == nil || l.MinDeltaSnapshotPeriod.Duration > result.MinDeltaSnapshotPeriod.Duration) {
+			result.MinDeltaSnapshotPeriod = l.MinDeltaSnapshotPeriod
+		}
+		if l.MaxBackups != nil && (result.MaxBackups == nil || *l.MaxBackups < *result.MaxBackups) {
+			result.MaxBackups = l.MaxBackups
+		}
+	}
+	return result
+}
+
+// minimumScheduleInterval returns the shortest interval between two subsequent runs of the given schedule within
+// one year.
+func minimumScheduleInterval(schedule cron.Schedule) time.Duration {
+	const maxRuns = 1000
+
+	var (
+		start    = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+		end      = start.AddDate(1, 0, 0)
+		previous = schedule.Next(start)
+		minimum  = end.Sub(start)
+	)
+
+	for i := 0; i < maxRuns && !previous.IsZero() && previous.Before(end); i++ {
+		next := schedule.Next(previous)
+		if next.IsZero() {
+			break
+		}
+		if interval := next.Sub(previous); interval < minimum {
+			minimum = interval
+		}
+		previous = next
+	}
+	return minimum
+}
+
 func validateDNSDomainUniqueness(shootLister listers.ShootLister, name string, dns *garden.DNS) (field.ErrorList, error) {
 	var (
 		allErrs = field.ErrorList{}
-		dnsPath = field.NewPath("spec", "dns", "domain")
+		domains = getDNSDomains(dns, field.NewPath("spec", "dns"))
 	)
 
-	if dns == nil || dns.Domain == nil {
+	if len(domains) == 0 {
 		return allErrs, nil
 	}
 
@@ -905,33 +1034,63 @@ func validateDNSDomainUniqueness(shootLister listers.ShootLister, name string, d
 		return allErrs, err
 	}
 
-	for _, shoot := range shoots {
-		if shoot.Name == name {
-			continue
+	for _, domain := range domains {
+		if err := validateDNSDomainUnused(shoots, name, domain); err != nil {
+			allErrs = append(allErrs, err)
 		}
+	}
 
-		var domain *string
-		if shoot.Spec.DNS != nil {
-			domain = shoot.Spec.DNS.Domain
+	return allErrs, nil
+}
+
+// dnsDomain is a domain configured in the DNS section of a shoot together with its field path.
+type dnsDomain struct {
+	fldPath *field.Path
+	domain  string
+}
+
+// getDNSDomains returns all domains configured in the given DNS section, i.e. the domain of the shoot and the
+// domains of the additional DNS providers.
+func getDNSDomains(dns *garden.DNS, fldPath *field.Path) []dnsDomain {
+	var domains []dnsDomain
+
+	if dns == nil {
+		return domains
+	}
+
+	if dns.Domain != nil {
+		domains = append(domains, dnsDomain{fldPath.Child("domain"), *dns.Domain})
+	}
+	for i := 1; i < len(dns.Providers); i++ {
+		if domain := dns.Providers[i].Domain; domain != nil {
+			domains = append(domains, dnsDomain{fldPath.Child("providers").Index(i).Child("domain"), *domain})
 		}
-		if domain == nil {
+	}
+
+	return domains
+}
+
+// validateDNSDomainUnused checks that the given domain is not used by any other shoot than the one with the given name.
+func validateDNSDomainUnused(shoots []*garden.Shoot, name string, domain dnsDomain) *field.Error {
+	for _, shoot := range shoots {
+		if shoot.Name == name {
 			continue
 		}
 
-		// Prevent that this shoot uses the exact same domain of any other shoot in the system.
-		if *domain == *dns.Domain {
-			allErrs = append(allErrs, field.Duplicate(dnsPath, *dns.Domain))
-			break
-		}
+		for _, other := range getDNSDomains(shoot.Spec.DNS, field.NewPath("spec", "dns")) {
+			// Prevent that this shoot uses the exact same domain of any other shoot in the system.
+			if other.domain == domain.domain {
+				return field.Duplicate(domain.fldPath, domain.domain)
+			}
 
-		// Prevent that this shoot uses a subdomain of the domain of any other shoot in the system.
-		if hasDomainIntersection(*domain, *dns.Domain) {
-			allErrs = append(allErrs, field.Forbidden(dnsPath, "the domain is already used by another shoot or it is a subdomain of an already used domain"))
-			break
+			// Prevent that this shoot uses a subdomain of the domain of any other shoot in the system.
+			if hasDomainIntersection(other.domain, domain.domain) {
+				return field.Forbidden(domain.fldPath, "the domain is already used by another shoot or it is a subdomain of an already used domain")
+			}
 		}
 	}
 
-	return allErrs, nil
+	return nil
 }
 
 // hasDomainIntersection checks if domainA is a suffix of domainB or domainB is a suffix of domainA.
@@ -1011,6 +1170,29 @@ func validateKubernetesVersionConstraints(constraints []garden.ExpirableVersion,
 	return false, validValues, nil
 }
 
+// validateWorkerKubernetesVersionSkew checks that the Kubernetes version of a worker pool complies with the supported
+// version skew policy, i.e. the kubelet must not be newer than the control plane and may be at most two minor versions
+// older.
+func validateWorkerKubernetesVersionSkew(controlPlaneVersion, workerVersion string) error {
+	controlPlane, err := semver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return fmt.Errorf("cannot parse control plane version %q: %v", controlPlaneVersion, err)
+	}
+	worker, err := semver.NewVersion(workerVersion)
+	if err != nil {
+		return fmt.Errorf("cannot parse worker pool version %q: %v", workerVersion, err)
+	}
+
+	if worker.GreaterThan(controlPlane) {
+		return fmt.Errorf("worker pool version must not be newer than the control plane version %q", controlPlaneVersion)
+	}
+	if worker.Major() != controlPlane.Major() || controlPlane.Minor()-worker.Minor() > 2 {
+		return fmt.Errorf("worker pool version must be at most two minor versions older than the control plane version %q", controlPlaneVersion)
+	}
+
+	return nil
+}
+
 func validateMachineTypes(constraints []garden.MachineType, machineType, oldMachineType string, regions []garden.Region, region string, zones []string) (bool, []string) {
 	if machineType == oldMachineType {
 		return true, nil
@@ -1216,3 +1398,68 @@ func validateMachineImagesConstraints(constraints []garden.CloudProfileMachineIm
 	}
 	return false, validValues
 }
+
+// validateCRIConstraints validates that the given CRI and its additional container runtimes are supported by the
+// given machine image. Machine images which do not declare any CRI in the CloudProfile only support docker.
+func validateCRIConstraints(constraints []garden.CloudProfileMachineImage, image *garden.ShootMachineImage, cri *garden.CRI, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cri == nil || image == nil {
+		return allErrs
+	}
+
+	var machineImage *garden.CloudProfileMachineImage
+	for i, constraint := range constraints {
+		if constraint.Name == image.Name {
+			machineImage = &constraints[i]
+			break
+		}
+	}
+	if machineImage == nil {
+		return allErrs
+	}
+
+	supportedCRIs := machineImage.CRI
+	if len(supportedCRIs) == 0 {
+		supportedCRIs = []garden.CRI{{Name: garden.CRINameDocker}}
+	}
+
+	var (
+		supportedCRI      *garden.CRI
+		supportedCRINames []string
+	)
+	for i, c := range supportedCRIs {
+		supportedCRINames = append(supportedCRINames, string(c.Name))
+		if c.Name == cri.Name {
+			supportedCRI = &supportedCRIs[i]
+		}
+	}
+	if supportedCRI == nil {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("name"), cri.Name, supportedCRINames))
+		return allErrs
+	}
+
+	supportedContainerRuntimeTypes := sets.NewString()
+	for _, containerRuntime := range supportedCRI.ContainerRuntimes {
+		supportedContainerRuntimeTypes.Insert(containerRuntime.Type)
+	}
+	for j, containerRuntime := range cri.ContainerRuntimes {
+		if !supportedContainerRuntimeTypes.Has(containerRuntime.Type) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("containerRuntimes").Index(j).Child("type"), containerRuntime.Type, supportedContainerRuntimeTypes.List()))
+		}
+	}
+
+	return allErrs
+}
+
+// seedAssignmentChanged returns true if the given Shoot is newly assigned to its Seed with the given admission request.
+func seedAssignmentChanged(a admission.Attributes, shoot *garden.Shoot) bool {
+	if a.GetOperation() != admission.Update {
+		return true
+	}
+	oldShoot, ok := a.GetOldObject().(*garden.Shoot)
+	if !ok || oldShoot.Spec.SeedName == nil {
+		return true
+	}
+	return *oldShoot.Spec.SeedName != *shoot.Spec.SeedName
+}
@@ -360,6 +360,141 @@ var _ = Describe("validator", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 
+			It("create should fail because seed is cordoned", func() {
+				seed.Spec.Taints = []garden.SeedTaint{{Key: garden.SeedTaintCordoned}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("update should fail because shoot is newly assigned to a cordoned seed", func() {
+				seed.Spec.Taints = []garden.SeedTaint{{Key: garden.SeedTaintCordoned}}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("update should pass because shoot already runs on the cordoned seed", func() {
+				seed.Spec.Taints = []garden.SeedTaint{{Key: garden.SeedTaintCordoned}}
+				oldShoot.Spec.SeedName = shoot.Spec.SeedName
+				oldShoot.Spec.Provider.Workers[0].Maximum = 2
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+
+		Context("backup limits", func() {
+			var maxBackups, maxBackupsLimit int32
+
+			BeforeEach(func() {
+				cloudProfile = *cloudProfileBase.DeepCopy()
+				shoot = *shootBase.DeepCopy()
+				shoot.Spec.SeedName = &seedName
+
+				maxBackups, maxBackupsLimit = 5, 10
+				schedule := "0 */12 * * *"
+				limitBased := garden.BackupGarbageCollectionPolicyLimitBased
+				shoot.Spec.Backup = &garden.ShootBackup{
+					Schedule:                &schedule,
+					DeltaSnapshotPeriod:     &metav1.Duration{Duration: 5 * time.Minute},
+					GarbageCollectionPolicy: &limitBased,
+					MaxBackups:              &maxBackups,
+				}
+				cloudProfile.Spec.BackupLimits = &garden.BackupLimits{
+					MinFullSnapshotInterval: &metav1.Duration{Duration: 6 * time.Hour},
+					MinDeltaSnapshotPeriod:  &metav1.Duration{Duration: time.Minute},
+				}
+				seed.Spec.BackupLimits = &garden.BackupLimits{
+					MinDeltaSnapshotPeriod: &metav1.Duration{Duration: 2 * time.Minute},
+					MaxBackups:             &maxBackupsLimit,
+				}
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+			})
+
+			It("should pass because the backup settings are within the limits", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject because full snapshots are taken too often", func() {
+				schedule := "0 */2 * * *"
+				shoot.Spec.Backup.Schedule = &schedule
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject because the stricter delta snapshot period of the seed is violated", func() {
+				shoot.Spec.Backup.DeltaSnapshotPeriod = &metav1.Duration{Duration: 90 * time.Second}
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should reject because too many backups are retained", func() {
+				maxBackups = 11
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
+			It("should pass because unchanged settings are not validated against tightened limits", func() {
+				maxBackups = 11
+				oldShoot := shoot.DeepCopy()
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject unchanged settings violating the limits of the seed the shoot is assigned to", func() {
+				maxBackups = 11
+				oldShoot := shoot.DeepCopy()
+				oldShoot.Spec.SeedName = nil
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
 		})
 
 		Context("name/project length checks", func() {
@@ -667,6 +802,30 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			It("should reject because the domain of an additional dns provider is already used by another shoot", func() {
+				anotherShoot := shoot.DeepCopy()
+				anotherShoot.Name = "another-shoot"
+
+				anotherDomain := fmt.Sprintf("someprefix%s", *anotherShoot.Spec.DNS.Domain)
+				shoot.Spec.DNS.Domain = &anotherDomain
+				shoot.Spec.DNS.Providers = append(shoot.Spec.DNS.Providers, garden.DNSProvider{
+					Domain: anotherShoot.Spec.DNS.Domain,
+					Type:   test.MakeStrPointer("private-provider"),
+				})
+
+				gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+				gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+				gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+				gardenInformerFactory.Garden().InternalVersion().Shoots().Informer().GetStore().Add(anotherShoot)
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+				err := admissionHandler.Admit(attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			})
+
 			It("should allow because the specified domain is not a subdomain of a domain already used by another shoot", func() {
 				anotherShoot := shoot.DeepCopy()
 				anotherShoot.Name = "another-shoot"
@@ -2490,6 +2649,141 @@ var _ = Describe("validator", func() {
 				Expect(apierrors.IsForbidden(err)).To(BeTrue())
 			})
 
+			Context("container runtime interface", func() {
+				BeforeEach(func() {
+					shoot.Spec.Provider.Workers = []garden.Worker{*workers[0].DeepCopy()}
+					shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{
+						Name:    validMachineImageName,
+						Version: validMachineImageVersions[0].Version,
+					}
+				})
+
+				admit := func() error {
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+					attrs := admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+
+					return admissionHandler.Admit(attrs, nil)
+				}
+
+				It("should allow docker for machine images without declared CRIs", func() {
+					shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameDocker}
+
+					Expect(admit()).To(Succeed())
+				})
+
+				It("should reject containerd for machine images without declared CRIs", func() {
+					shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameContainerD}
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should allow containerd and container runtimes supported by the machine image", func() {
+					cloudProfile.Spec.MachineImages[0].CRI = []garden.CRI{
+						{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}}},
+					}
+					shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}}}
+
+					Expect(admit()).To(Succeed())
+				})
+
+				It("should reject container runtimes not supported by the machine image", func() {
+					cloudProfile.Spec.MachineImages[0].CRI = []garden.CRI{
+						{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "gvisor"}}},
+					}
+					shoot.Spec.Provider.Workers[0].CRI = &garden.CRI{Name: garden.CRINameContainerD, ContainerRuntimes: []garden.ContainerRuntime{{Type: "kata-containers"}}}
+
+					err := admit()
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
+			Context("worker pool kubernetes version", func() {
+				BeforeEach(func() {
+					cloudProfile.Spec.Kubernetes.Versions = append(cloudProfile.Spec.Kubernetes.Versions,
+						garden.ExpirableVersion{Version: "1.3.0"},
+						garden.ExpirableVersion{Version: "1.4.5"},
+						garden.ExpirableVersion{Version: "1.5.1"},
+						garden.ExpirableVersion{Version: "1.5.2"},
+						garden.ExpirableVersion{Version: "1.7.0"},
+					)
+					shoot.Spec.Provider.Workers = []garden.Worker{*workers[0].DeepCopy()}
+					shoot.Spec.Provider.Workers[0].Machine.Image = &garden.ShootMachineImage{
+						Name:    validMachineImageName,
+						Version: validMachineImageVersions[0].Version,
+					}
+				})
+
+				admit := func(version string, old *garden.Shoot) error {
+					shoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{Version: &version}
+
+					gardenInformerFactory.Garden().InternalVersion().Projects().Informer().GetStore().Add(&project)
+					gardenInformerFactory.Garden().InternalVersion().CloudProfiles().Informer().GetStore().Add(&cloudProfile)
+					gardenInformerFactory.Garden().InternalVersion().Seeds().Informer().GetStore().Add(&seed)
+
+					var attrs admission.Attributes
+					if old == nil {
+						attrs = admission.NewAttributesRecord(&shoot, nil, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Create, false, nil)
+					} else {
+						attrs = admission.NewAttributesRecord(&shoot, old, garden.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, garden.Resource("shoots").WithVersion("version"), "", admission.Update, false, nil)
+					}
+
+					return admissionHandler.Admit(attrs, nil)
+				}
+
+				It("should allow the same version as the control plane", func() {
+					Expect(admit("1.6.4", nil)).To(Succeed())
+				})
+
+				It("should allow a version which is two minor versions older than the control plane", func() {
+					Expect(admit("1.4.5", nil)).To(Succeed())
+				})
+
+				It("should default a version without patch level to the latest offered patch version", func() {
+					Expect(admit("1.5", nil)).To(Succeed())
+					Expect(*shoot.Spec.Provider.Workers[0].Kubernetes.Version).To(Equal("1.5.2"))
+				})
+
+				It("should reject a version which is three minor versions older than the control plane", func() {
+					err := admit("1.3.0", nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a version which is newer than the control plane", func() {
+					err := admit("1.7.0", nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a version which is not offered by the cloud profile", func() {
+					err := admit("1.5.9", nil)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+
+				It("should reject a control plane upgrade which violates the version skew of a worker pool", func() {
+					oldWorkerVersion := "1.4.5"
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Provider.Workers[0].Kubernetes = &garden.WorkerKubernetes{Version: &oldWorkerVersion}
+					shoot.Spec.Kubernetes.Version = "1.7.0"
+
+					err := admit("1.4.5", oldShoot)
+
+					Expect(err).To(HaveOccurred())
+					Expect(apierrors.IsForbidden(err)).To(BeTrue())
+				})
+			})
+
 			It("should reject due to a machine image with expiration date in the past", func() {
 				imageVersionExpired := "0.0.1-beta"
 
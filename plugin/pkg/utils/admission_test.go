@@ -107,4 +107,67 @@ var _ = Describe("utils", func() {
 			}))))
 		})
 	})
+
+	Describe("#ValidateEgressDestinationsNotBlocked", func() {
+		var (
+			seed = &garden.Seed{
+				Spec: garden.SeedSpec{
+					Networks: garden.SeedNetworks{
+						Nodes:    "10.240.0.0/16",
+						Pods:     "100.96.0.0/11",
+						Services: "100.64.0.0/13",
+					},
+					BlockCIDRs: []string{"169.254.169.254/32"},
+				},
+			}
+			allowedCIDR  = "10.250.0.0/16"
+			blockedCIDR  = "169.254.0.0/16"
+			nodesCIDR    = "10.240.1.0/24"
+			podsCIDR     = "100.96.0.0/8"
+			servicesCIDR = "100.64.0.1/32"
+			fqdn         = "webhook.example.com"
+		)
+
+		It("should pass the validation", func() {
+			errorList := ValidateEgressDestinationsNotBlocked(seed, []garden.EgressDestination{{CIDR: &allowedCIDR}, {FQDN: &fqdn}}, field.NewPath("destinations"))
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid destinations intersecting with blocked networks", func() {
+			errorList := ValidateEgressDestinationsNotBlocked(seed, []garden.EgressDestination{{CIDR: &allowedCIDR}, {CIDR: &blockedCIDR}}, field.NewPath("destinations"))
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("destinations[1].cidr"),
+			}))))
+		})
+
+		It("should forbid destinations intersecting with the seed networks", func() {
+			errorList := ValidateEgressDestinationsNotBlocked(seed, []garden.EgressDestination{{CIDR: &nodesCIDR}, {CIDR: &podsCIDR}, {CIDR: &servicesCIDR}}, field.NewPath("destinations"))
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("destinations[0].cidr"),
+					"Detail": ContainSubstring("node network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("destinations[1].cidr"),
+					"Detail": ContainSubstring("pod network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("destinations[1].cidr"),
+					"Detail": ContainSubstring("service network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("destinations[2].cidr"),
+					"Detail": ContainSubstring("service network"),
+				})),
+			))
+		})
+	})
 })
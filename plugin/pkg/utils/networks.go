@@ -15,6 +15,7 @@
 package utils
 
 import (
+	"fmt"
 	"net"
 
 	"github.com/gardener/gardener/pkg/apis/garden"
@@ -58,6 +59,42 @@ func ValidateNetworkDisjointedness(seedNetworks garden.SeedNetworks, k8sNetworks
 	return allErrs
 }
 
+// ValidateEgressDestinationsNotBlocked validates that none of the given egress <destinations> intersects with the
+// node, pod or service network of the given <seed> or with its blocked CIDRs. FQDN destinations cannot be validated
+// here as they are resolved at reconciliation time, addresses within these networks are dropped then.
+func ValidateEgressDestinationsNotBlocked(seed *garden.Seed, destinations []garden.EgressDestination, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+
+		seedNetworks = map[string]string{
+			seed.Spec.Networks.Nodes:    "node network",
+			seed.Spec.Networks.Pods:     "pod network",
+			seed.Spec.Networks.Services: "service network",
+		}
+		forbiddenCIDRs = []string{seed.Spec.Networks.Nodes, seed.Spec.Networks.Pods, seed.Spec.Networks.Services}
+	)
+
+	for _, blockedCIDR := range seed.Spec.BlockCIDRs {
+		if _, ok := seedNetworks[blockedCIDR]; !ok {
+			seedNetworks[blockedCIDR] = "blocked network"
+			forbiddenCIDRs = append(forbiddenCIDRs, blockedCIDR)
+		}
+	}
+
+	for i, destination := range destinations {
+		if destination.CIDR == nil {
+			continue
+		}
+		for _, forbiddenCIDR := range forbiddenCIDRs {
+			if networksIntersect(forbiddenCIDR, *destination.CIDR) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Index(i).Child("cidr"), fmt.Sprintf("egress destination intersects with %s %s of the seed", seedNetworks[forbiddenCIDR], forbiddenCIDR)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func networksIntersect(cidr1, cidr2 string) bool {
 	_, net1, err1 := net.ParseCIDR(cidr1)
 	_, net2, err2 := net.ParseCIDR(cidr2)